@@ -14,3 +14,13 @@ output "account_lb_monitors_and_read_id" {
 output "user_memberships_read_id" {
   value = data.cloudflare_api_token_permission_groups.all.user["Memberships Read"] // 3518d0f75557482e952c6762d3e64903
 }
+
+# Get R2 bucket level "Workers R2 Storage Bucket Item Read" permission ID.
+output "r2_bucket_item_read_id" {
+  value = data.cloudflare_api_token_permission_groups.all.r2["Workers R2 Storage Bucket Item Read"]
+}
+
+# Only look up the DNS permission groups.
+data "cloudflare_api_token_permission_groups" "dns" {
+  name_filter = "^DNS "
+}
@@ -0,0 +1,21 @@
+data "cloudflare_notification_alert_types" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
+
+resource "cloudflare_notification_policy" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Tunnel health"
+  enabled    = true
+  alert_type = "tunnel_health_event"
+
+  email_integration {
+    id = "myemail@example.com"
+  }
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.cloudflare_notification_alert_types.example.types, "tunnel_health_event")
+      error_message = "The tunnel_health_event alert type is not available for this account."
+    }
+  }
+}
@@ -0,0 +1,15 @@
+data "cloudflare_notification_policy_webhooks" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Alerting webhook"
+}
+
+resource "cloudflare_notification_policy" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Universal SSL events"
+  enabled    = true
+  alert_type = "universal_ssl_event_type"
+
+  webhooks_integration {
+    id = data.cloudflare_notification_policy_webhooks.example.webhooks[0].id
+  }
+}
@@ -0,0 +1,8 @@
+data "cloudflare_records" "example" {
+  zone_id = var.zone_id
+
+  filter {
+    name = "www.example.com"
+    type = "A"
+  }
+}
@@ -0,0 +1,16 @@
+data "cloudflare_regional_hostname_regions" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
+
+resource "cloudflare_regional_hostname" "example" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  hostname   = "example.com"
+  region_key = "eu"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.cloudflare_regional_hostname_regions.example.keys, "eu")
+      error_message = "The eu region is not available for this account."
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "cloudflare_rulesets" "example" {
+  zone_id       = "0da42c8d2132a9ddaf714f9e7c920711"
+  include_rules = true
+
+  filter {
+    name = ".*OWASP.*"
+  }
+}
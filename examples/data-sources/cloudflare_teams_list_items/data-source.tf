@@ -0,0 +1,4 @@
+data "cloudflare_teams_list_items" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  list_id    = "971fc4e8-388e-4ab9-b377-16430c0fc018"
+}
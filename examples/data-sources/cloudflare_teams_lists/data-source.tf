@@ -0,0 +1,5 @@
+data "cloudflare_teams_lists" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Blocked domains"
+  type       = "DOMAIN"
+}
@@ -0,0 +1,8 @@
+data "cloudflare_teams_proxy_endpoint" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "office"
+}
+
+output "proxy_endpoint_subdomain" {
+  value = data.cloudflare_teams_proxy_endpoint.example.subdomain
+}
@@ -0,0 +1,10 @@
+data "cloudflare_tunnel" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "my-tunnel"
+}
+
+resource "cloudflare_tunnel_route" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  tunnel_id  = data.cloudflare_tunnel.example.id
+  network    = "192.0.2.24/32"
+}
@@ -21,3 +21,40 @@ resource "cloudflare_access_application" "staging_app" {
     max_age           = 10
   }
 }
+
+# SaaS application using SAML
+resource "cloudflare_access_application" "saas_saml_app" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "SAML application"
+  type       = "saas"
+
+  saas_app {
+    sp_entity_id         = "saas-app.example.com"
+    consumer_service_url = "https://saas-app.example.com/sso/saml/consume"
+    name_id_format       = "email"
+
+    custom_attributes {
+      name          = "email"
+      friendly_name = "Email"
+      required      = true
+      source {
+        name = "user_email"
+      }
+    }
+  }
+}
+
+# SaaS application using OIDC
+resource "cloudflare_access_application" "saas_oidc_app" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "OIDC application"
+  type       = "saas"
+
+  saas_app {
+    auth_type        = "oidc"
+    redirect_uris    = ["https://saas-app.example.com/sso/oauth2/callback"]
+    grant_types      = ["authorization_code"]
+    scopes           = ["openid", "email", "profile"]
+    app_launcher_url = "https://saas-app.example.com/sso/login"
+  }
+}
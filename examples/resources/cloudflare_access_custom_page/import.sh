@@ -0,0 +1,5 @@
+# Account level custom page import.
+$ terraform import cloudflare_access_custom_page.example account/<account_id>/<custom_page_id>
+
+# Zone level custom page import.
+$ terraform import cloudflare_access_custom_page.example zone/<zone_id>/<custom_page_id>
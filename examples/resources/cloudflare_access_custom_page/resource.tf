@@ -0,0 +1,14 @@
+resource "cloudflare_access_custom_page" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "example"
+  type        = "forbidden"
+  custom_html = "<html><body><h1>Forbidden</h1></body></html>"
+}
+
+resource "cloudflare_access_application" "example" {
+  account_id   = "f037e56e89293a057740de681ac9abbe"
+  name         = "example"
+  domain       = "example.com"
+  type         = "self_hosted"
+  custom_pages = [cloudflare_access_custom_page.example.id]
+}
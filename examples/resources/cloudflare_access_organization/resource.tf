@@ -1,8 +1,12 @@
 resource "cloudflare_access_organization" "example" {
-  account_id      = "f037e56e89293a057740de681ac9abbe"
-  name            = "example.cloudflareaccess.com"
-  auth_domain     = "example.cloudflareaccess.com"
-  is_ui_read_only = false
+  account_id                         = "f037e56e89293a057740de681ac9abbe"
+  name                               = "example.cloudflareaccess.com"
+  auth_domain                        = "example.cloudflareaccess.com"
+  is_ui_read_only                    = false
+  session_duration                   = "24h"
+  warp_auth_session_duration         = "24h"
+  user_seat_expiration_inactive_time = "730h"
+  auto_redirect_to_identity          = false
 
   login_design {
     background_color = "#ffffff"
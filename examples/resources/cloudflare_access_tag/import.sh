@@ -0,0 +1 @@
+$ terraform import cloudflare_access_tag.example <account_id>/<tag_name>
@@ -0,0 +1,13 @@
+resource "cloudflare_access_tag" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "engineering"
+}
+
+resource "cloudflare_access_application" "example" {
+  account_id           = "f037e56e89293a057740de681ac9abbe"
+  name                 = "Internal wiki"
+  domain               = "wiki.example.com"
+  type                 = "self_hosted"
+  app_launcher_visible = true
+  tags                 = [cloudflare_access_tag.example.name]
+}
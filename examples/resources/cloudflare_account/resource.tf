@@ -1,5 +1,9 @@
 resource "cloudflare_account" "example" {
-  name              = "some-enterprise-account"
-  type              = "enterprise"
-  enforce_twofactor = true
+  name = "some-enterprise-account"
+  type = "enterprise"
+
+  settings {
+    enforce_twofactor                = true
+    use_account_custom_ns_by_default = true
+  }
 }
@@ -1 +1 @@
-$ terraform import cloudflare_account_member.example <account_id>/<member_id>
+$ terraform import cloudflare_account_member.example account/<account_id>/<member_id>
@@ -5,3 +5,8 @@ resource "cloudflare_account_member" "example_user" {
     "d784fa8b6d98d27699781bd9a7cf19f0"
   ]
 }
+
+resource "cloudflare_account_member" "example_user_by_role_name" {
+  email_address = "other-user@example.com"
+  role_names    = ["Administrator Read Only"]
+}
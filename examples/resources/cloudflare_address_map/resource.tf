@@ -0,0 +1,24 @@
+resource "cloudflare_address_map" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  description = "My address map"
+  default_sni = "*.example.com"
+  enabled     = true
+
+  ips {
+    ip = "192.0.2.1"
+  }
+
+  ips {
+    ip = "203.0.113.1"
+  }
+
+  memberships {
+    identifier = "f037e56e89293a057740de681ac9abbe"
+    kind       = "account"
+  }
+
+  memberships {
+    identifier = "0da42c8d2132a9ddaf714f9e7c920711"
+    kind       = "zone"
+  }
+}
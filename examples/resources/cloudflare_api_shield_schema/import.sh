@@ -0,0 +1 @@
+$ terraform import cloudflare_api_shield_schema.example <zone_id>/<schema_id>
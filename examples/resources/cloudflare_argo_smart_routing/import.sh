@@ -0,0 +1 @@
+$ terraform import cloudflare_argo_smart_routing.example <zone_id>
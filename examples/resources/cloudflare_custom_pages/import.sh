@@ -1 +1,5 @@
-$ terraform import cloudflare_custom_pages.example <resource_level>/<resource_id>/<custom_page_type>
+# Account level custom page.
+$ terraform import cloudflare_custom_pages.example account/f037e56e89293a057740de681ac9abbe/waf_block
+
+# Zone level custom page.
+$ terraform import cloudflare_custom_pages.example zone/0da42c8d2132a9ddaf714f9e7c920711/always_online
@@ -1 +1 @@
-$ terraform import cloudflare_device_managed_networks.example <account_id>/<device_managed_networks_id>
+$ terraform import cloudflare_device_managed_networks.example account/<account_id>/<device_managed_networks_id>
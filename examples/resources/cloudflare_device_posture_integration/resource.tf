@@ -10,3 +10,16 @@ resource "cloudflare_device_posture_integration" "example" {
     client_secret = "client-secret"
   }
 }
+
+resource "cloudflare_device_posture_integration" "tanium" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Tanium"
+  type       = "tanium_s2s"
+  interval   = "1h"
+  config {
+    api_url              = "https://tanium.example.com/plugin/products/gateway/graphql"
+    client_secret        = "api-token"
+    access_client_id     = "client-id.access"
+    access_client_secret = "client-secret"
+  }
+}
@@ -18,3 +18,21 @@ resource "cloudflare_device_posture_rule" "eaxmple" {
     os_distro_revision = "1.0.0"
   }
 }
+
+resource "cloudflare_device_posture_rule" "client_certificate" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Corporate client certificate"
+  type       = "client_certificate_v2"
+  schedule   = "24h"
+
+  match {
+    platform = "mac"
+  }
+
+  input {
+    certificate_id     = "5ee7c2ec-1d5e-4b64-a5b2-9b2f2b0d4f5e"
+    cn                 = "$${serial_number}.example.com"
+    check_private_key  = true
+    extended_key_usage = ["clientAuth"]
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_dex_test.example account/<account_id>/<dex_test_id>
@@ -0,0 +1,24 @@
+resource "cloudflare_dex_test" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "GET homepage"
+  description = "Send a HTTP GET request to the home endpoint every half hour."
+  interval    = "30m"
+  enabled     = true
+
+  data {
+    kind   = "http"
+    url    = "https://example.com/home"
+    method = "GET"
+  }
+}
+
+resource "cloudflare_dex_test" "traceroute" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Traceroute to example.com"
+  interval   = "1h"
+
+  data {
+    kind = "traceroute"
+    host = "example.com"
+  }
+}
@@ -3,6 +3,7 @@ resource "cloudflare_dlp_profile" "example_predefined" {
   account_id  = "0da42c8d2132a9ddaf714f9e7c920711"
   name        = "Example Predefined Profile"
   type        = "predefined"
+  profile_id  = "c8932cc4-3312-4152-8041-f3f257122dc4"
 
   entry {
 	name = "Mastercard Card Number"
@@ -22,6 +23,8 @@ resource "cloudflare_dlp_profile" "example_custom" {
   description = "A profile with example entries"
   type        = "custom"
 
+  allowed_match_count = 0
+
   entry {
 	name = "Matches visa credit cards"
 	enabled = true
@@ -1,2 +1,2 @@
 # Fallback Domains for default device policies must use "default" as the policy ID.
-$ terraform import cloudflare_fallback_domain.example <account_id>/<policy_id>
+$ terraform import cloudflare_fallback_domain.example account/<account_id>/<policy_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_gateway_audit_ssh_settings.example <account_id>
@@ -0,0 +1,4 @@
+resource "cloudflare_gateway_audit_ssh_settings" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  public_key = "1pyl6I1tL7xfJuFYVzXlUW8uXXlpxegHXBzGCBKaSFA="
+}
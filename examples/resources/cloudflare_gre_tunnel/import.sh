@@ -1 +1 @@
-$ terraform import cloudflare_gre_tunnel.example <account_id>/<tunnel_id>
+$ terraform import cloudflare_gre_tunnel.example account/<account_id>/<tunnel_id>
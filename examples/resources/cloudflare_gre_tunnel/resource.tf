@@ -7,7 +7,12 @@ resource "cloudflare_gre_tunnel" "example" {
   description             = "Tunnel for ISP X"
   ttl                     = 64
   mtu                     = 1476
-  health_check_enabled    = true
-  health_check_target     = "203.0.113.1"
-  health_check_type       = "reply"
+
+  health_check {
+    enabled   = true
+    target    = "203.0.113.1"
+    type      = "reply"
+    rate      = "mid"
+    direction = "bidirectional"
+  }
 }
@@ -0,0 +1 @@
+$ terraform import cloudflare_hostname_tls_setting.example <zone_id>/<setting>/<hostname>
@@ -0,0 +1 @@
+$ terraform import cloudflare_hostname_tls_setting_ciphers.example <zone_id>/<hostname>
@@ -0,0 +1,3 @@
+# The origin password is write-only and must be supplied in configuration
+# after importing.
+$ terraform import cloudflare_hyperdrive_config.example <account_id>/<hyperdrive_config_id>
@@ -0,0 +1,17 @@
+resource "cloudflare_hyperdrive_config" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "my-hyperdrive-config"
+
+  origin {
+    host     = "database.example.com"
+    port     = 5432
+    database = "postgres"
+    user     = "my-user"
+    password = var.database_password
+  }
+
+  caching {
+    disabled = false
+    max_age  = 60
+  }
+}
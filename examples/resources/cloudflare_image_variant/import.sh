@@ -0,0 +1 @@
+$ terraform import cloudflare_image_variant.example <account_id>/<variant_id>
@@ -0,0 +1,13 @@
+resource "cloudflare_image_variant" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  variant_id = "hero"
+
+  options {
+    fit      = "scale-down"
+    width    = 1366
+    height   = 768
+    metadata = "none"
+  }
+
+  never_require_signed_urls = true
+}
@@ -1 +1 @@
-$ terraform import cloudflare_ipsec_tunnel.example <account_id>/<tunnel_id>
+$ terraform import cloudflare_ipsec_tunnel.example account/<account_id>/<tunnel_id>
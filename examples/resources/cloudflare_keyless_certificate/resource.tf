@@ -0,0 +1,22 @@
+resource "cloudflare_keyless_certificate" "example" {
+  zone_id       = "0da42c8d2132a9ddaf714f9e7c920711"
+  bundle_method = "ubiquitous"
+  name          = "example.com Keyless SSL"
+  host          = "example.com"
+  port          = 24008
+  enabled       = true
+  certificate   = "-----INSERT CERTIFICATE-----"
+}
+
+# Reaching the key server through a Cloudflare Tunnel.
+resource "cloudflare_keyless_certificate" "tunnel" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "example.com Keyless SSL over Tunnel"
+  host        = "keyless.example.com"
+  certificate = "-----INSERT CERTIFICATE-----"
+
+  tunnel {
+    private_ip = "10.0.0.1"
+    vnet_id    = "7365377a-85a4-4390-9480-531ef7dc7a3c"
+  }
+}
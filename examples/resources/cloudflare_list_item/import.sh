@@ -0,0 +1 @@
+$ terraform import cloudflare_list_item.example <account_id>/<list_id>/<item_id>
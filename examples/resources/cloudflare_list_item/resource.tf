@@ -0,0 +1,70 @@
+# IP List
+resource "cloudflare_list" "example_ip_list" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "example_list"
+  description = "example IPs for a list"
+  kind        = "ip"
+}
+
+resource "cloudflare_list_item" "example_ip_item" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  list_id    = cloudflare_list.example_ip_list.id
+  comment    = "List Item Comment"
+  ip         = "192.0.2.0"
+}
+
+# Redirect List
+resource "cloudflare_list" "example_redirect_list" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "example_list"
+  description = "example redirects for a list"
+  kind        = "redirect"
+}
+
+resource "cloudflare_list_item" "example_redirect_item" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  list_id    = cloudflare_list.example_redirect_list.id
+
+  redirect {
+    source_url            = "example.com/"
+    target_url            = "https://example1.com"
+    status_code           = 301
+    include_subdomains    = "enabled"
+    subpath_matching      = "enabled"
+    preserve_query_string = "enabled"
+    preserve_path_suffix  = "disabled"
+  }
+}
+
+# ASN List
+resource "cloudflare_list" "example_asn_list" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "example_asn_list"
+  description = "example ASNs for a list"
+  kind        = "asn"
+}
+
+resource "cloudflare_list_item" "example_asn_item" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  list_id    = cloudflare_list.example_asn_list.id
+  comment    = "List Item Comment"
+  asn        = 6789
+}
+
+# Hostname List
+resource "cloudflare_list" "example_hostname_list" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "example_hostname_list"
+  description = "example Hostnames for a list"
+  kind        = "hostname"
+}
+
+resource "cloudflare_list_item" "example_hostname_item" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  list_id    = cloudflare_list.example_hostname_list.id
+  comment    = "List Item Comment"
+
+  hostname {
+    url_hostname = "example.com"
+  }
+}
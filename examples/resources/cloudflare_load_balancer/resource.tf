@@ -26,6 +26,15 @@ resource "cloudflare_load_balancer" "example" {
     pool_ids = [cloudflare_load_balancer_pool.example.id]
   }
 
+  adaptive_routing {
+    failover_across_pools = true
+  }
+
+  location_strategy {
+    prefer_ecs = "always"
+    mode       = "resolver_ip"
+  }
+
   rules {
     name      = "example rule"
     condition = "http.request.uri.path contains \"testing\""
@@ -36,6 +45,20 @@ resource "cloudflare_load_balancer" "example" {
       location     = "www.example.com"
     }
   }
+
+  rules {
+    name      = "random steering for AAAA queries"
+    condition = "dns.qry.type == 28"
+    overrides {
+      steering_policy = "random"
+      random_steering {
+        default_weight = 0.2
+        pool_weights = {
+          (cloudflare_load_balancer_pool.example.id) = 0.8
+        }
+      }
+    }
+  }
 }
 
 resource "cloudflare_load_balancer_pool" "example" {
@@ -30,3 +30,15 @@ resource "cloudflare_load_balancer_monitor" "example" {
   retries     = 5
   description = "example tcp load balancer"
 }
+
+# ICMP Ping Monitor
+resource "cloudflare_load_balancer_monitor" "example" {
+  account_id       = "f037e56e89293a057740de681ac9abbe"
+  type             = "icmp_ping"
+  timeout          = 2
+  interval         = 60
+  retries          = 2
+  consecutive_up   = 3
+  consecutive_down = 2
+  description      = "example icmp load balancer"
+}
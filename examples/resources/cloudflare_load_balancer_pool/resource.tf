@@ -18,6 +18,11 @@ resource "cloudflare_load_balancer_pool" "example" {
       values = ["example-2"]
     }
   }
+  origins {
+    name               = "example-private"
+    address            = "10.0.0.1"
+    virtual_network_id = "a5624d4e-044a-4ff0-b3e1-e2465353d4b4"
+  }
   latitude           = 55
   longitude          = -12
   description        = "example load balancer pool"
@@ -31,6 +36,6 @@ resource "cloudflare_load_balancer_pool" "example" {
     session_policy  = "hash"
   }
   origin_steering {
-    policy = "random"
+    policy = "least_outstanding_requests"
   }
 }
@@ -24,6 +24,42 @@ resource "cloudflare_logpush_job" "http_requests" {
 }
 
 
+# Example Usage (account scoped dataset)
+resource "cloudflare_logpush_job" "gateway_http" {
+  enabled          = true
+  account_id       = var.account_id
+  name             = "gateway-http"
+  destination_conf = "r2://cloudflare-logs/gateway_http/date={DATE}?account-id=${var.account_id}&access-key-id=${cloudflare_api_token.logpush_r2_token.id}&secret-access-key=${sha256(cloudflare_api_token.logpush_r2_token.value)}"
+  dataset          = "gateway_http"
+}
+
+# Example Usage (with output_options, filter and upload limits)
+resource "cloudflare_logpush_job" "http_requests_filtered" {
+  enabled          = true
+  zone_id          = var.zone_id
+  name             = "http-requests-filtered"
+  destination_conf = "r2://cloudflare-logs/http_requests/date={DATE}?account-id=${var.account_id}&access-key-id=${cloudflare_api_token.logpush_r2_token.id}&secret-access-key=${sha256(cloudflare_api_token.logpush_r2_token.value)}"
+  dataset          = "http_requests"
+  filter = jsonencode({
+    where = {
+      key      = "ClientRequestHost"
+      operator = "eq"
+      value    = "example.com"
+    }
+  })
+  max_upload_bytes            = 5000000
+  max_upload_records          = 1000
+  max_upload_interval_seconds = 30
+
+  output_options {
+    field_names      = ["ClientIP", "ClientRequestHost", "EdgeResponseStatus", "RayID"]
+    timestamp_format = "rfc3339"
+    output_type      = "ndjson"
+    cve20214428      = true
+    sample_rate      = 0.5
+  }
+}
+
 # Example Usage (with AWS provider)
 #
 # Please see `cloudflare_logpush_ownership_challenge` for how to use that
@@ -2,3 +2,18 @@ resource "cloudflare_logpush_ownership_challenge" "example" {
   zone_id          = "0da42c8d2132a9ddaf714f9e7c920711"
   destination_conf = "s3://my-bucket-path?region=us-west-2"
 }
+
+# For R2 destinations the challenge contents are read back from the bucket.
+resource "cloudflare_logpush_ownership_challenge" "r2" {
+  account_id       = "f037e56e89293a057740de681ac9abbe"
+  destination_conf = "r2://cloudflare-logs/gateway_http?account-id=f037e56e89293a057740de681ac9abbe&access-key-id=${var.r2_access_key_id}&secret-access-key=${var.r2_secret_access_key}"
+}
+
+resource "cloudflare_logpush_job" "r2" {
+  enabled             = true
+  account_id          = "f037e56e89293a057740de681ac9abbe"
+  name                = "gateway-http"
+  dataset             = "gateway_http"
+  destination_conf    = cloudflare_logpush_ownership_challenge.r2.destination_conf
+  ownership_challenge = cloudflare_logpush_ownership_challenge.r2.ownership_challenge_contents
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_mnm_configuration.example <account_id>
@@ -0,0 +1,6 @@
+resource "cloudflare_mnm_configuration" "example" {
+  account_id       = "f037e56e89293a057740de681ac9abbe"
+  name             = "example"
+  default_sampling = 1
+  router_ips       = ["203.0.113.1", "203.0.113.2"]
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_mnm_rule.example <account_id>/<rule_id>
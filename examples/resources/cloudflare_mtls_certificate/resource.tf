@@ -0,0 +1,6 @@
+resource "cloudflare_mtls_certificate" "example" {
+  account_id   = "f037e56e89293a057740de681ac9abbe"
+  name         = "example"
+  certificates = file("ca.pem")
+  ca           = true
+}
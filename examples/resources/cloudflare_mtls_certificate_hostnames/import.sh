@@ -0,0 +1 @@
+$ terraform import cloudflare_mtls_certificate_hostnames.example <zone_id>/<certificate_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_observatory_scheduled_test.example <zone_id>/<region>/<url>
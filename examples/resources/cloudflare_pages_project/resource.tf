@@ -11,6 +11,7 @@ resource "cloudflare_pages_project" "build_config" {
   name              = "this-is-my-project-01"
   production_branch = "main"
   build_config {
+    build_caching       = true
     build_command       = "npm run build"
     destination_dir     = "build"
     root_dir            = "/"
@@ -70,6 +71,9 @@ resource "cloudflare_pages_project" "deployment_configs" {
         ENVIRONMENT = "production"
         OTHER_VALUE = "other value"
       }
+      secrets = {
+        TURNSTILE_SECRET = "1x0000000000000000000000000000000AA"
+      }
       kv_namespaces = {
         KV_BINDING_1 = "5eb63bbbe01eeed093cb22bb8f5acdc3"
         KV_BINDING_2 = "3cdca5f8bb22bc390deee10ebbb36be5"
@@ -86,6 +90,12 @@ resource "cloudflare_pages_project" "deployment_configs" {
         D1_BINDING_1 = "445e2955-951a-4358-a35b-a4d0c813f63"
         D1_BINDING_2 = "a399414b-c697-409a-a688-377db6433cd9"
       }
+      queue_producers = {
+        QUEUE_BINDING = "some-queue"
+      }
+      analytics_engine_datasets = {
+        AE_BINDING = "some-dataset"
+      }
       compatibility_date  = "2022-08-16"
       compatibility_flags = ["production_flag", "second flag"]
     }
@@ -0,0 +1 @@
+$ terraform import cloudflare_queue.example <account_id>/<queue_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_queue_consumer.example <account_id>/<queue_name>/<script_name>
@@ -0,0 +1,10 @@
+resource "cloudflare_queue_consumer" "example" {
+  account_id        = "f037e56e89293a057740de681ac9abbe"
+  queue_name        = cloudflare_queue.example.name
+  script_name       = cloudflare_worker_script.example.name
+  dead_letter_queue = cloudflare_queue.example_dlq.name
+  max_batch_size    = 10
+  max_batch_timeout = 5
+  max_retries       = 3
+  max_concurrency   = 2
+}
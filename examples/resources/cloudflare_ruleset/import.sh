@@ -3,3 +3,7 @@ $ terraform import cloudflare_ruleset.example account/<account_id>/<ruleset_id>
 
 # Import a zone scoped Ruleset configuration.
 $ terraform import cloudflare_ruleset.example zone/<zone_id>/<ruleset_id>
+
+# Import the entrypoint Ruleset of a phase, such as the custom rules that
+# Firewall Rules are migrated to.
+$ terraform import cloudflare_ruleset.example zone/<zone_id>/phase/http_request_firewall_custom
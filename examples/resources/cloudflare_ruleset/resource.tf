@@ -65,6 +65,40 @@ resource "cloudflare_ruleset" "zone_level_managed_waf_with_category_based_overri
   }
 }
 
+# Zone-level HTTP DDoS attack protection with sensitivity overrides
+resource "cloudflare_ruleset" "zone_level_ddos_l7_overrides" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "HTTP DDoS attack protection overrides"
+  description = "tune the sensitivity of the HTTP DDoS attack protection rules"
+  kind        = "zone"
+  phase       = "ddos_l7"
+
+  rules {
+    action = "execute"
+    action_parameters {
+      id = "4d21379b4f9f4bb088e0729962c8b3cf"
+      overrides {
+        sensitivity_level = "default"
+
+        categories {
+          category          = "botnets"
+          sensitivity_level = "medium"
+        }
+
+        rules {
+          id                = "fdfdac75430c4c47a959592f0aa5e68a"
+          action            = "log"
+          sensitivity_level = "low"
+        }
+      }
+    }
+
+    expression  = "true"
+    description = "override HTTP DDoS attack protection sensitivity"
+    enabled     = true
+  }
+}
+
 # Rewrite the URI path component to a static path
 resource "cloudflare_ruleset" "transform_uri_rule_path" {
   zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
@@ -290,6 +324,12 @@ resource "cloudflare_ruleset" "cache_settings_example" {
         }
       }
       origin_error_page_passthru = false
+      origin_cache_control       = true
+      read_timeout               = 900
+      cache_reserve {
+        eligible          = true
+        minimum_file_size = 100000
+      }
     }
     expression  = "(http.host eq \"example.host.com\")"
     description = "set cache settings rule"
@@ -362,6 +402,18 @@ resource "cloudflare_ruleset" "http_custom_error_example" {
     description = "serve some error response"
     enabled     = true
   }
+
+  rules {
+    action = "serve_error"
+    action_parameters {
+      asset_name   = "maintenance"
+      content_type = "text/html"
+      status_code  = "503"
+    }
+    expression  = "(http.request.uri.path matches \"^/maintenance/\")"
+    description = "serve a custom error asset"
+    enabled     = true
+  }
 }
 
 # Set Configuration Rules for an API route
@@ -377,6 +429,7 @@ resource "cloudflare_ruleset" "http_config_rules_example" {
     action_parameters {
       email_obfuscation = true
       bic               = true
+      rocket_loader     = false
     }
     expression  = "(http.request.uri.path matches \"^/api/\")"
     description = "set config rules for matching request"
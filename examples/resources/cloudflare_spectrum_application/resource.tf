@@ -12,3 +12,22 @@ resource "cloudflare_spectrum_application" "example" {
     "tcp://192.0.2.1:22"
   ]
 }
+
+# BYOIP edge IPs with a port range
+resource "cloudflare_spectrum_application" "range" {
+  zone_id  = "0da42c8d2132a9ddaf714f9e7c920711"
+  protocol = "tcp/1000-2000"
+
+  dns {
+    type = "ADDRESS"
+    name = "range.example.com"
+  }
+
+  origin_direct = ["tcp://192.0.2.1"]
+  origin_port   = "3000-4000"
+
+  edge_ips {
+    type = "static"
+    ips  = ["198.51.100.1"]
+  }
+}
@@ -1,2 +1,2 @@
 # Split Tunnels for default device policies must use "default" as the policy ID.
-$ terraform import cloudflare_split_tunnel.example <account_id>/<policy_id>/<mode>
+$ terraform import cloudflare_split_tunnel.example account/<account_id>/<policy_id>/<mode>
@@ -1 +1 @@
-$ terraform import cloudflare_static_route.example <account_id>/<static_route_id>
+$ terraform import cloudflare_static_route.example account/<account_id>/<static_route_id>
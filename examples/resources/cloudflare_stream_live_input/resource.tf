@@ -0,0 +1,15 @@
+resource "cloudflare_stream_live_input" "example" {
+  account_id      = "f037e56e89293a057740de681ac9abbe"
+  default_creator = "example-creator"
+
+  recording {
+    mode                = "automatic"
+    timeout             = 60
+    require_signed_urls = true
+  }
+}
+
+output "rtmps_stream_key" {
+  value     = cloudflare_stream_live_input.example.rtmps[0].stream_key
+  sensitive = true
+}
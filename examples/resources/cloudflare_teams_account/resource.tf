@@ -25,6 +25,15 @@ resource "cloudflare_teams_account" "example" {
   }
 
   url_browser_isolation_enabled = true
+  protocol_detection_enabled    = true
+
+  body_scanning {
+    inspection_mode = "deep"
+  }
+
+  extended_email_matching {
+    enabled = true
+  }
 
   logging {
     redact_pii = true
@@ -0,0 +1 @@
+$ terraform import cloudflare_teams_account_logging.example <account_id>
@@ -0,0 +1,16 @@
+resource "cloudflare_teams_account_logging" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  redact_pii = true
+
+  settings_by_rule_type {
+    dns {
+      log_all    = false
+      log_blocks = true
+    }
+
+    http {
+      log_all    = true
+      log_blocks = true
+    }
+  }
+}
@@ -5,3 +5,20 @@ resource "cloudflare_teams_list" "example" {
   description = "Serial numbers for all corporate devices."
   items       = ["8GE8721REF", "5RE8543EGG", "1YE2880LNP"]
 }
+
+resource "cloudflare_teams_list" "blocked_domains" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "Blocked domains"
+  type        = "DOMAIN"
+  description = "Domains blocked by threat intelligence."
+
+  items_with_description {
+    value       = "malware.example.com"
+    description = "Malware distribution"
+  }
+
+  items_with_description {
+    value       = "phishing.example.com"
+    description = "Phishing"
+  }
+}
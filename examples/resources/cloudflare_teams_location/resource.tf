@@ -2,12 +2,21 @@ resource "cloudflare_teams_location" "example" {
   account_id     = "f037e56e89293a057740de681ac9abbe"
   name           = "office"
   client_default = true
+  ecs_support    = false
 
-  networks {
-    network = "203.0.113.1/32"
-  }
+  endpoints {
+    ipv4 {
+      enabled  = true
+      networks = ["203.0.113.1/32", "203.0.113.2/32"]
+    }
+
+    doh {
+      enabled  = true
+      networks = ["198.51.100.0/24"]
+    }
 
-  networks {
-    network = "203.0.113.2/32"
+    dot {
+      enabled = false
+    }
   }
 }
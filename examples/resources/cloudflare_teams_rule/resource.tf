@@ -11,3 +11,21 @@ resource "cloudflare_teams_rule" "example" {
     block_page_reason  = "access not permitted"
   }
 }
+
+resource "cloudflare_teams_rule" "resolver" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "internal resolver"
+  description = "Route internal queries to a private resolver"
+  precedence  = 2
+  action      = "resolve"
+  filters     = ["dns_resolver"]
+  traffic     = "any(dns.domains[*] == \"internal.example.com\")"
+  rule_settings {
+    dns_resolvers {
+      ipv4 {
+        ip                            = "10.0.0.53"
+        route_through_private_network = true
+      }
+    }
+  }
+}
@@ -0,0 +1,4 @@
+# The tunnel secret cannot be imported as it is only available when the tunnel
+# is created. It is recommended that you re-create the tunnel if you don't have
+# the secret saved securely before importing.
+$ terraform import cloudflare_tunnel.example <account_id>/<tunnel_id>
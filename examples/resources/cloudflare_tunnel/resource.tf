@@ -0,0 +1,13 @@
+resource "cloudflare_tunnel" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "my-tunnel"
+  secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+}
+
+# Remotely managed tunnel with a provider generated secret. Run the
+# connector using `cloudflared tunnel run --token <tunnel_token>`.
+resource "cloudflare_tunnel" "remotely_managed" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "my-remotely-managed-tunnel"
+  config_src = "cloudflare"
+}
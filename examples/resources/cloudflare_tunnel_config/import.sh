@@ -0,0 +1 @@
+$ terraform import cloudflare_tunnel_config.example <account_id>/<tunnel_id>
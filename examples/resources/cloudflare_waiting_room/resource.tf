@@ -1,9 +1,22 @@
 # Waiting Room
 resource "cloudflare_waiting_room" "example" {
-  zone_id              = "0da42c8d2132a9ddaf714f9e7c920711"
-  name                 = "foo"
-  host                 = "foo.example.com"
-  path                 = "/"
-  new_users_per_minute = 200
-  total_active_users   = 200
-}
\ No newline at end of file
+  zone_id                 = "0da42c8d2132a9ddaf714f9e7c920711"
+  name                    = "foo"
+  host                    = "foo.example.com"
+  path                    = "/"
+  new_users_per_minute    = 200
+  total_active_users      = 200
+  queueing_status_code    = 202
+  cookie_suffix           = "queue1"
+  enabled_origin_commands = ["revoke"]
+
+  additional_routes {
+    host = "shop.example.com"
+    path = "/checkout"
+  }
+
+  # The host defaults to the waiting room host when omitted.
+  additional_routes {
+    path = "/cart"
+  }
+}
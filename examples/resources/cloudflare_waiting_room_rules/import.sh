@@ -1 +1 @@
-$ terraform import cloudflare_waiting_room_event.default <zone_id>/<waiting_room_id>
+$ terraform import cloudflare_waiting_room_rules.default <zone_id>/<waiting_room_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_web_analytics_site.example account/<account_id>/<site_tag>
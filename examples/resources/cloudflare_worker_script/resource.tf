@@ -9,6 +9,9 @@ resource "cloudflare_worker_script" "my_script" {
   name       = "script_1"
   content    = file("script.js")
 
+  compatibility_date  = "2023-03-19"
+  compatibility_flags = ["nodejs_compat"]
+
   kv_namespace_binding {
     name         = "MY_EXAMPLE_KV_NAMESPACE"
     namespace_id = cloudflare_workers_kv_namespace.my_namespace.id
@@ -44,4 +47,14 @@ resource "cloudflare_worker_script" "my_script" {
     name    = "MY_DATASET"
     dataset = "dataset1"
   }
+
+  queue_binding {
+    binding = "MY_QUEUE"
+    queue   = "my-queue"
+  }
+
+  d1_database_binding {
+    name        = "MY_DATABASE"
+    database_id = "4ba1ce6d-1e2b-4c09-8c6f-5f4c1b3b0a3e"
+  }
 }
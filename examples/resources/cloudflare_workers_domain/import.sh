@@ -0,0 +1 @@
+$ terraform import cloudflare_workers_domain.example account/<account_id>/<domain_id>
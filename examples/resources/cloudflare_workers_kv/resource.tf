@@ -9,3 +9,14 @@ resource "cloudflare_workers_kv" "example" {
   key          = "test-key"
   value        = "test value"
 }
+
+resource "cloudflare_workers_kv" "feature_flag" {
+  account_id     = "f037e56e89293a057740de681ac9abbe"
+  namespace_id   = cloudflare_workers_kv_namespace.example_ns.id
+  key            = "feature-flag"
+  value_base64   = base64encode("on")
+  expiration_ttl = 3600
+  metadata = jsonencode({
+    owner = "platform"
+  })
+}
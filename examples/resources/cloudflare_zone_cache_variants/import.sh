@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_cache_variants.example <zone_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_hold.example <zone_id>
@@ -0,0 +1,5 @@
+resource "cloudflare_zone_hold" "example" {
+  zone_id            = "0da42c8d2132a9ddaf714f9e7c920711"
+  include_subdomains = true
+  hold_after         = "2023-12-31T00:00:00Z"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_zone_setting.example <zone_id>/<setting_id>
@@ -0,0 +1,25 @@
+resource "cloudflare_zone_setting" "min_tls_version" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  setting_id = "min_tls_version"
+  value      = "1.2"
+}
+
+resource "cloudflare_zone_setting" "always_use_https" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  setting_id = "always_use_https"
+  value      = true
+}
+
+resource "cloudflare_zone_setting" "security_header" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  setting_id = "security_header"
+  value = jsonencode({
+    strict_transport_security = {
+      enabled            = true
+      max_age            = 86400
+      include_subdomains = true
+      preload            = false
+      nosniff            = true
+    }
+  })
+}
@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceCloudflareApiTokenPermissionGroups() *schema.Resource {
@@ -19,6 +21,12 @@ func dataSourceCloudflareApiTokenPermissionGroups() *schema.Resource {
 			Commonly used as references within [%s](/docs/providers/cloudflare/r/api_token.html) resources.
 		`, "`cloudflare_token`"),
 		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "A regular expression matched against the names of permission groups. Only matching permission groups are included.",
+			},
 			"permissions": {
 				Computed:    true,
 				Type:        schema.TypeMap,
@@ -40,6 +48,11 @@ func dataSourceCloudflareApiTokenPermissionGroups() *schema.Resource {
 				Type:        schema.TypeMap,
 				Description: "Map of permissions for user level resources.",
 			},
+			"r2": {
+				Computed:    true,
+				Type:        schema.TypeMap,
+				Description: "Map of permissions for r2 level resources.",
+			},
 		},
 	}
 }
@@ -53,27 +66,41 @@ func dataSourceCloudflareApiTokenPermissionGroupsRead(ctx context.Context, d *sc
 		return diag.FromErr(fmt.Errorf("error listing API Token Permission Groups: %w", err))
 	}
 
+	var nameFilter *regexp.Regexp
+	if filter, ok := d.GetOk("name_filter"); ok {
+		nameFilter = regexp.MustCompile(filter.(string))
+	}
+
 	permissionDetails := make(map[string]interface{}, 0)
 	zoneScopes := make(map[string]interface{}, 0)
 	accountScopes := make(map[string]interface{}, 0)
 	userScopes := make(map[string]interface{}, 0)
+	r2Scopes := make(map[string]interface{}, 0)
 	ids := []string{}
 
 	for _, v := range permissions {
+		if nameFilter != nil && !nameFilter.MatchString(v.Name) {
+			continue
+		}
+
 		// This is for backwards compatibility and shouldn't be used going forward
 		// due to some permissions overlapping and returning invalid IDs.
 		permissionDetails[v.Name] = v.ID
 		ids = append(ids, v.ID)
 
-		switch v.Scopes[0] {
-		case "com.cloudflare.api.account":
-			accountScopes[v.Name] = v.ID
-		case "com.cloudflare.api.account.zone":
-			zoneScopes[v.Name] = v.ID
-		case "com.cloudflare.api.user":
-			userScopes[v.Name] = v.ID
-		default:
-			tflog.Warn(ctx, fmt.Sprintf("unknown permission scope found: %s", v.Scopes[0]))
+		for _, scope := range v.Scopes {
+			switch scope {
+			case "com.cloudflare.api.account":
+				accountScopes[v.Name] = v.ID
+			case "com.cloudflare.api.account.zone":
+				zoneScopes[v.Name] = v.ID
+			case "com.cloudflare.api.user":
+				userScopes[v.Name] = v.ID
+			case "com.cloudflare.edge.r2.bucket":
+				r2Scopes[v.Name] = v.ID
+			default:
+				tflog.Warn(ctx, fmt.Sprintf("unknown permission scope found: %s", scope))
+			}
 		}
 	}
 
@@ -89,11 +116,15 @@ func dataSourceCloudflareApiTokenPermissionGroupsRead(ctx context.Context, d *sc
 		return diag.FromErr(fmt.Errorf("error setting API Token Permission Groups for user: %w", err))
 	}
 
+	if err = d.Set("r2", r2Scopes); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting API Token Permission Groups for r2: %w", err))
+	}
+
 	if err = d.Set("permissions", permissionDetails); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting API Token Permission Groups: %w", err))
 	}
 
-	d.SetId(stringListChecksum(ids))
+	d.SetId(stringListChecksum(append(ids, d.Get("name_filter").(string))))
 
 	return nil
 }
@@ -1,12 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -91,6 +95,91 @@ func testAccCloudflareApiTokenPermissionGroups(n string) resource.TestCheckFunc
 	}
 }
 
+func TestAccCloudflareApiTokenPermissionGroups_NameFilter(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the API token
+	// permission groups endpoint does not yet support the API tokens and it
+	// results in misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	name := "data.cloudflare_api_token_permission_groups.dns"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "cloudflare_api_token_permission_groups" "dns" {
+  name_filter = "^DNS (Read|Write)$"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone.%", "2"),
+					resource.TestCheckResourceAttr(name, "zone.DNS Read", "82e64a83756745bbbb1c9c2701bf816b"),
+				),
+			},
+		},
+	})
+}
+
 const testAccCloudflareApiTokenPermissionGroupsConfig = `
 data "cloudflare_api_token_permission_groups" "some" {}
 `
+
+func TestCloudflareApiTokenPermissionGroupsScopes(t *testing.T) {
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/user/tokens/permission_groups" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, `[
+  {"id": "4755a26eedb94da69e1066d98aa820be", "name": "DNS Write", "scopes": ["com.cloudflare.api.account.zone"]},
+  {"id": "a1c0fec57cf94af79479a6d827fa518c", "name": "DNS Write", "scopes": ["com.cloudflare.api.account"]},
+  {"id": "82e64a83756745bbbb1c9c2701bf816b", "name": "DNS Read", "scopes": ["com.cloudflare.api.account.zone"]},
+  {"id": "3518d0f75557482e952c6762d3e64903", "name": "Memberships Read", "scopes": ["com.cloudflare.api.user"]},
+  {"id": "2efd5506f9c8494dacb1fa10a3e7d5b6", "name": "Workers R2 Storage Bucket Item Write", "scopes": ["com.cloudflare.edge.r2.bucket"]}
+]`)
+	})
+
+	testCases := map[string]struct {
+		filter   string
+		expected map[string]map[string]interface{}
+	}{
+		"no filter": {
+			expected: map[string]map[string]interface{}{
+				"zone":    {"DNS Write": "4755a26eedb94da69e1066d98aa820be", "DNS Read": "82e64a83756745bbbb1c9c2701bf816b"},
+				"account": {"DNS Write": "a1c0fec57cf94af79479a6d827fa518c"},
+				"user":    {"Memberships Read": "3518d0f75557482e952c6762d3e64903"},
+				"r2":      {"Workers R2 Storage Bucket Item Write": "2efd5506f9c8494dacb1fa10a3e7d5b6"},
+			},
+		},
+		"name filter": {
+			filter: "^DNS Write$",
+			expected: map[string]map[string]interface{}{
+				"zone":    {"DNS Write": "4755a26eedb94da69e1066d98aa820be"},
+				"account": {"DNS Write": "a1c0fec57cf94af79479a6d827fa518c"},
+				"user":    {},
+				"r2":      {},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareApiTokenPermissionGroups().Schema, map[string]interface{}{
+				"name_filter": tc.filter,
+			})
+			if diags := dataSourceCloudflareApiTokenPermissionGroupsRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			for attribute, expected := range tc.expected {
+				if got := d.Get(attribute).(map[string]interface{}); !reflect.DeepEqual(got, expected) {
+					t.Errorf("expected %s permission groups %v, got %v", attribute, expected, got)
+				}
+			}
+		})
+	}
+}
@@ -159,10 +159,15 @@ func dataSourceCloudflareLoadBalancerPoolsRead(d *schema.ResourceData, meta inte
 			continue
 		}
 
+		origins := make([]loadBalancerOrigin, 0, len(p.Origins))
+		for _, o := range p.Origins {
+			origins = append(origins, loadBalancerOrigin{LoadBalancerOrigin: o})
+		}
+
 		pools = append(pools, map[string]interface{}{
 			"id":                 p.ID,
 			"name":               p.Name,
-			"origins":            flattenLoadBalancerOrigins(d, p.Origins),
+			"origins":            flattenLoadBalancerOrigins(d, origins),
 			"enabled":            p.Enabled,
 			"minimum_origins":    p.MinimumOrigins,
 			"latitude":           p.Latitude,
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareNotificationAlertTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, "Reading Notification alert types")
+	available, err := client.GetAvailableNotificationTypes(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Notification alert types: %w", err))
+	}
+
+	type productAlert struct {
+		product string
+		alert   cloudflare.NotificationAlertWithDescription
+	}
+
+	var alerts []productAlert
+	for product, productAlerts := range available.Result {
+		for _, alert := range productAlerts {
+			alerts = append(alerts, productAlert{product: product, alert: alert})
+		}
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].alert.Type < alerts[j].alert.Type
+	})
+
+	types := make([]string, 0)
+	alertTypes := make([]interface{}, 0)
+
+	for _, v := range alerts {
+		alertTypes = append(alertTypes, map[string]interface{}{
+			"type":         v.alert.Type,
+			"display_name": v.alert.DisplayName,
+			"description":  v.alert.Description,
+			"product":      v.product,
+		})
+		types = append(types, v.alert.Type)
+	}
+
+	if err := d.Set("types", types); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting types: %w", err))
+	}
+
+	if err := d.Set("alert_types", alertTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting alert_types: %w", err))
+	}
+
+	d.SetId(stringListChecksum(types))
+	return nil
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareNotificationAlertTypes(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_notification_alert_types.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareNotificationAlertTypesConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "alert_types.0.type"),
+					resource.TestCheckResourceAttrSet(name, "alert_types.0.display_name"),
+					resource.TestCheckResourceAttrSet(name, "alert_types.0.product"),
+					resource.TestCheckTypeSetElemAttr(name, "types.*", "billing_usage_alert"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareNotificationAlertTypesConfig(name string, accountID string) string {
+	return fmt.Sprintf(`data "cloudflare_notification_alert_types" "%[1]s" {
+		account_id = "%[2]s"
+	}`, name, accountID)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareNotificationPolicyWebhooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, "Reading Notification webhook destinations")
+	res, err := client.ListNotificationWebhooks(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Notification webhook destinations: %w", err))
+	}
+
+	integrations := make([]cloudflare.NotificationWebhookIntegration, 0)
+	for _, v := range res.Result {
+		if name != "" && v.Name != name {
+			continue
+		}
+		integrations = append(integrations, v)
+	}
+
+	sort.Slice(integrations, func(i, j int) bool {
+		if integrations[i].Name == integrations[j].Name {
+			return integrations[i].ID < integrations[j].ID
+		}
+		return integrations[i].Name < integrations[j].Name
+	})
+
+	ids := make([]string, 0)
+	webhooks := make([]interface{}, 0)
+
+	for _, v := range integrations {
+		webhooks = append(webhooks, map[string]interface{}{
+			"id":           v.ID,
+			"name":         v.Name,
+			"url":          v.URL,
+			"type":         v.Type,
+			"created_at":   v.CreatedAt.Format(time.RFC3339),
+			"last_success": formatNotificationWebhookTime(v.LastSuccess),
+			"last_failure": formatNotificationWebhookTime(v.LastFailure),
+		})
+		ids = append(ids, v.ID)
+	}
+
+	if err := d.Set("webhooks", webhooks); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting webhooks: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append([]string{accountID}, ids...)))
+	return nil
+}
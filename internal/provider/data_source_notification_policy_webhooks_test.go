@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareNotificationPolicyWebhooksDataSource(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the notification
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_notification_policy_webhooks.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareNotificationPolicyWebhooksDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "webhooks.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "webhooks.0.id", "cloudflare_notification_policy_webhooks."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "webhooks.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "webhooks.0.url", "https://example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareNotificationPolicyWebhooksDataSourceConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_notification_policy_webhooks" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  url        = "https://example.com"
+}
+
+data "cloudflare_notification_policy_webhooks" "%[1]s" {
+  account_id = "%[2]s"
+  name       = cloudflare_notification_policy_webhooks.%[1]s.name
+}`, name, accountID)
+}
@@ -32,9 +32,14 @@ func dataSourceCloudflareRecord() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "A",
-				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}, false),
 				Description:  "DNS record type to filter record results on.",
 			},
+			"content": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS record content to filter record results on.",
+			},
 			"priority": {
 				Type:             schema.TypeInt,
 				Optional:         true,
@@ -80,8 +85,9 @@ func dataSourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData,
 	zoneID := d.Get("zone_id").(string)
 
 	searchRecord := cloudflare.ListDNSRecordsParams{
-		Name: d.Get("hostname").(string),
-		Type: d.Get("type").(string),
+		Name:    d.Get("hostname").(string),
+		Type:    d.Get("type").(string),
+		Content: d.Get("content").(string),
 	}
 
 	records, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), searchRecord)
@@ -94,7 +100,7 @@ func dataSourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if len(records) != 1 && !contains([]string{"MX", "URI"}, searchRecord.Type) {
-		return diag.Errorf("only wanted 1 DNS record. Got %d records", len(records))
+		return diag.Errorf("only wanted 1 DNS record. Got %d records, use `type` or `content` to narrow down the lookup", len(records))
 	} else {
 		var p uint16
 		if priority, ok := d.GetOkExists("priority"); ok {
@@ -90,6 +90,29 @@ func TestAccCloudflareRecordDataSourceMX(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecordDataSourceContent(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_record.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordDataSourceConfigContent(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "hostname", rnd+"."+domain),
+					resource.TestCheckResourceAttr(name, "type", "A"),
+					resource.TestCheckResourceAttr(name, "value", "192.0.2.1"),
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_record."+rnd+"_2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareRecordDataSourceConfig(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 data "cloudflare_record" "%[1]s" {
@@ -143,3 +166,25 @@ resource "cloudflare_record" "%[1]s_2" {
 }
 `, rnd, zoneID, domain)
 }
+
+func testAccCloudflareRecordDataSourceConfigContent(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+data "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  content = "192.0.2.1"
+  hostname = cloudflare_record.%[1]s_2.hostname
+}
+resource "cloudflare_record" "%[1]s" {
+	zone_id = "%[2]s"
+	type = "A"
+	name = "%[1]s.%[3]s"
+	value = "192.0.2.0"
+}
+resource "cloudflare_record" "%[1]s_2" {
+	zone_id = "%[2]s"
+	type = "A"
+	name = cloudflare_record.%[1]s.name
+	value = "192.0.2.1"
+}
+`, rnd, zoneID, domain)
+}
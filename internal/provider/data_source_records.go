@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: heredoc.Doc(`
+			Use this data source to lookup all of the [DNS Records](https://api.cloudflare.com/#dns-records-for-a-zone-properties)
+			of a zone matching the given filters.
+		`),
+		ReadContext: dataSourceCloudflareRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "One or more values used to look up DNS records. If more than one value is given all values must match in order to be included.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "DNS record name to filter record results on.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}, false),
+							Description:  "DNS record type to filter record results on.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "DNS record content to filter record results on.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "DNS record comment to filter record results on.",
+						},
+					},
+				},
+			},
+			"records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of DNS records matching the filter.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The DNS record ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The hostname of the DNS record.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the DNS record.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The content of the DNS record.",
+						},
+						"proxied": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the DNS record is proxied.",
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The TTL of the DNS record.",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The priority of the DNS record.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	params := cloudflare.ListDNSRecordsParams{
+		Name:    d.Get("filter.0.name").(string),
+		Type:    d.Get("filter.0.type").(string),
+		Content: d.Get("filter.0.content").(string),
+		Comment: d.Get("filter.0.comment").(string),
+	}
+
+	// Without any explicit pagination the client fetches every page.
+	records, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing DNS records: %w", err))
+	}
+
+	recordIDs := make([]string, 0, len(records))
+	recordDetails := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		recordDetails = append(recordDetails, map[string]interface{}{
+			"id":       record.ID,
+			"name":     record.Name,
+			"type":     record.Type,
+			"content":  record.Content,
+			"proxied":  cloudflare.Bool(record.Proxied),
+			"ttl":      record.TTL,
+			"priority": int(cloudflare.Uint16(record.Priority)),
+		})
+		recordIDs = append(recordIDs, record.ID)
+	}
+
+	if err := d.Set("records", recordDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting records: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append(recordIDs, zoneID)))
+	return nil
+}
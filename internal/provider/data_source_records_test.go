@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareRecordsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_records.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordsDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "records.#", "2"),
+					resource.TestCheckResourceAttr(name, "records.0.name", rnd+"."+domain),
+					resource.TestCheckResourceAttr(name, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(name, "records.1.type", "A"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordsDataSourceConfig(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+data "cloudflare_records" "%[1]s" {
+  zone_id = "%[2]s"
+
+  filter {
+    name = "%[1]s.%[3]s"
+    type = "A"
+  }
+
+  depends_on = [cloudflare_record.%[1]s, cloudflare_record.%[1]s_2, cloudflare_record.%[1]s_aaaa]
+}
+resource "cloudflare_record" "%[1]s" {
+	zone_id = "%[2]s"
+	type = "A"
+	name = "%[1]s.%[3]s"
+	value = "192.0.2.0"
+}
+resource "cloudflare_record" "%[1]s_2" {
+	zone_id = "%[2]s"
+	type = "A"
+	name = "%[1]s.%[3]s"
+	value = "192.0.2.1"
+}
+resource "cloudflare_record" "%[1]s_aaaa" {
+	zone_id = "%[2]s"
+	type = "AAAA"
+	name = "%[1]s.%[3]s"
+	value = "2001:db8::1"
+}
+`, rnd, zoneID, domain)
+}
+
+func TestCloudflareRecordsDataSourceReadAllPages(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/dns_records" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("comment"); got != "managed" {
+			t.Errorf("expected the comment filter to be sent, got %q", got)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			testAPIResultWithInfo(w, `[
+  {"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "MX", "name": "example.com", "content": "mx1.example.com", "ttl": 1, "priority": 10}
+]`, `{"page": 1, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}`)
+		case "2":
+			testAPIResultWithInfo(w, `[
+  {"id": "023e105f4ecef8ad9ca31a8372d0c353", "type": "A", "name": "www.example.com", "content": "192.0.2.1", "proxied": true, "ttl": 1}
+]`, `{"page": 2, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareRecords().Schema, map[string]interface{}{
+		"zone_id": zoneID,
+		"filter": []interface{}{map[string]interface{}{
+			"comment": "managed",
+		}},
+	})
+
+	if diags := dataSourceCloudflareRecordsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("records.#").(int); got != 2 {
+		t.Fatalf("expected 2 records from both pages, got %d", got)
+	}
+	if got := d.Get("records.0.priority").(int); got != 10 {
+		t.Errorf("expected the MX record priority to be read, got %d", got)
+	}
+	if got := d.Get("records.1.proxied").(bool); !got {
+		t.Errorf("expected the A record to be proxied")
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalHostnameRegion is a region that hostnames can be pinned to.
+type regionalHostnameRegion struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+func dataSourceCloudflareRegionalHostnameRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, "Reading Regional Hostname regions")
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/addressing/regional_hostnames/regions", accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Regional Hostname regions: %w", err))
+	}
+
+	var regions []regionalHostnameRegion
+	if err := json.Unmarshal(res, &regions); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Regional Hostname regions: %w", err))
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].Key < regions[j].Key
+	})
+
+	keys := make([]string, 0)
+	regionDetails := make([]interface{}, 0)
+
+	for _, v := range regions {
+		regionDetails = append(regionDetails, map[string]interface{}{
+			"key":   v.Key,
+			"label": v.Label,
+		})
+		keys = append(keys, v.Key)
+	}
+
+	if err := d.Set("keys", keys); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting keys: %w", err))
+	}
+
+	if err := d.Set("regions", regionDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting regions: %w", err))
+	}
+
+	d.SetId(stringListChecksum(keys))
+	return nil
+}
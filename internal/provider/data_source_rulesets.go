@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareRulesets() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareRulesetsSchema(),
+		ReadContext: dataSourceCloudflareRulesetsRead,
+		Description: "Use this datasource to lookup Rulesets in an account or zone, such as the IDs of managed rulesets and their rules.",
+	}
+}
+
+func dataSourceCloudflareRulesetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	zoneID := d.Get("zone_id").(string)
+
+	filter, err := expandFilterRulesets(d.Get("filter"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rulesetsList, err := listRulesets(ctx, client, accountID, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing rulesets: %w", err))
+	}
+
+	includeRules := d.Get("include_rules").(bool)
+	rulesetIDs := make([]string, 0)
+	rulesets := make([]map[string]interface{}, 0)
+	for _, rs := range rulesetsList {
+		if !filter.match(rs) {
+			continue
+		}
+
+		rulesetData := map[string]interface{}{
+			"id":          rs.ID,
+			"name":        rs.Name,
+			"description": rs.Description,
+			"kind":        rs.Kind,
+			"phase":       rs.Phase,
+			"version":     rs.Version,
+		}
+
+		// The list endpoint doesn't include the rules so they are only fetched,
+		// one ruleset at a time, when requested.
+		if includeRules {
+			tflog.Debug(ctx, fmt.Sprintf("Fetching rules of ruleset %s", rs.ID))
+
+			fullRuleset, err := getRuleset(ctx, client, accountID, zoneID, rs.ID)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error fetching rules of ruleset %q: %w", rs.ID, err))
+			}
+			rulesetData["rules"] = flattenRulesetsDataSourceRules(fullRuleset.Rules)
+		}
+
+		rulesets = append(rulesets, rulesetData)
+		rulesetIDs = append(rulesetIDs, rs.ID)
+	}
+
+	if err := d.Set("rulesets", rulesets); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting rulesets: %w", err))
+	}
+
+	d.SetId(stringListChecksum(rulesetIDs))
+	return nil
+}
+
+// listRulesets returns all rulesets of an account or zone. Pages are requested
+// until one is empty as the API may return fewer rulesets than requested per
+// page. A page repeating rulesets means the API ignored the page parameter
+// and returned all of them at once.
+func listRulesets(ctx context.Context, client *cloudflare.API, accountID, zoneID string) ([]cloudflare.Ruleset, error) {
+	const perPage = 50
+
+	var rulesets []cloudflare.Ruleset
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		uri := fmt.Sprintf("%s?page=%d&per_page=%d", rulesetURI(accountID, zoneID), page, perPage)
+		res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageRulesets []cloudflare.Ruleset
+		if err := json.Unmarshal(res, &pageRulesets); err != nil {
+			return nil, fmt.Errorf("error unmarshalling rulesets: %w", err)
+		}
+
+		if len(pageRulesets) == 0 || seen[pageRulesets[0].ID] {
+			return rulesets, nil
+		}
+
+		for _, rs := range pageRulesets {
+			seen[rs.ID] = true
+		}
+		rulesets = append(rulesets, pageRulesets...)
+	}
+}
+
+func flattenRulesetsDataSourceRules(rules []rulesetRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(rules))
+	for _, r := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"id":          r.ID,
+			"version":     r.Version,
+			"ref":         r.Ref,
+			"action":      r.Action,
+			"expression":  r.Expression,
+			"description": r.Description,
+			"enabled":     r.Enabled,
+			"categories":  r.Categories,
+		})
+	}
+
+	return flattened
+}
+
+type searchRulesets struct {
+	ID      string
+	Name    *regexp.Regexp
+	Kind    string
+	Phase   string
+	Version string
+}
+
+// match reports whether the ruleset satisfies every value set in the filter.
+func (f *searchRulesets) match(rs cloudflare.Ruleset) bool {
+	if f.ID != "" && f.ID != rs.ID {
+		return false
+	}
+
+	if f.Name != nil && !f.Name.MatchString(rs.Name) {
+		return false
+	}
+
+	if f.Kind != "" && f.Kind != rs.Kind {
+		return false
+	}
+
+	if f.Phase != "" && f.Phase != rs.Phase {
+		return false
+	}
+
+	if f.Version != "" && f.Version != rs.Version {
+		return false
+	}
+
+	return true
+}
+
+func expandFilterRulesets(d interface{}) (*searchRulesets, error) {
+	cfg := d.([]interface{})
+	filter := &searchRulesets{}
+	if len(cfg) == 0 || cfg[0] == nil {
+		return filter, nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	filter.ID = m["id"].(string)
+	filter.Kind = m["kind"].(string)
+	filter.Phase = m["phase"].(string)
+	filter.Version = m["version"].(string)
+
+	if name := m["name"].(string); name != "" {
+		match, err := regexp.Compile(name)
+		if err != nil {
+			return nil, fmt.Errorf("error compiling filter name %q: %w", name, err)
+		}
+		filter.Name = match
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareRulesetsDataSource_ManagedWAF(t *testing.T) {
+	t.Parallel()
+
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_rulesets.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetsDataSourceManagedWAFConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rulesets.#", "1"),
+					resource.TestCheckResourceAttr(name, "rulesets.0.id", "efb7b8c949ac4650a09736fc376e9aee"),
+					resource.TestCheckResourceAttr(name, "rulesets.0.kind", "managed"),
+					resource.TestCheckResourceAttr(name, "rulesets.0.phase", "http_request_firewall_managed"),
+					resource.TestCheckResourceAttrSet(name, "rulesets.0.rules.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRulesetsDataSourceManagedWAFConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_rulesets" "%[1]s" {
+  zone_id       = "%[2]s"
+  include_rules = true
+
+  filter {
+    name = "^Cloudflare Managed Ruleset$"
+    kind = "managed"
+  }
+}
+`, rnd, zoneID)
+}
+
+func TestCloudflareRulesetsDataSourceRead(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	testCases := map[string]struct {
+		includeRules bool
+		fetches      int
+	}{
+		"without rules": {includeRules: false, fetches: 0},
+		"with rules":    {includeRules: true, fetches: 1},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fetches := 0
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/zones/" + zoneID + "/rulesets":
+					testAPIResult(w, `[
+  {"id": "efb7b8c949ac4650a09736fc376e9aee", "name": "Cloudflare Managed Ruleset", "kind": "managed", "phase": "http_request_firewall_managed", "version": "52"},
+  {"id": "4814384a9e5d4991b9815dcfc25d2f1f", "name": "Cloudflare OWASP Core Ruleset", "kind": "managed", "phase": "http_request_firewall_managed", "version": "39"},
+  {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "zone entrypoint", "kind": "zone", "phase": "http_request_firewall_custom", "version": "3"}
+]`)
+				case "/zones/" + zoneID + "/rulesets/efb7b8c949ac4650a09736fc376e9aee":
+					fetches++
+					testAPIResult(w, `{
+  "id": "efb7b8c949ac4650a09736fc376e9aee",
+  "name": "Cloudflare Managed Ruleset",
+  "kind": "managed",
+  "phase": "http_request_firewall_managed",
+  "rules": [
+    {
+      "id": "5de7edfa648c4d6891dc3e7f84534ffa",
+      "version": "1",
+      "action": "block",
+      "categories": ["wordpress", "xss"],
+      "description": "WordPress - XSS",
+      "expression": "true",
+      "enabled": true
+    }
+  ]
+}`)
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareRulesetsSchema(), map[string]interface{}{
+				"zone_id":       zoneID,
+				"include_rules": tc.includeRules,
+				"filter": []interface{}{map[string]interface{}{
+					"name": "Managed Ruleset$",
+					"kind": "managed",
+				}},
+			})
+
+			if diags := dataSourceCloudflareRulesetsRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if fetches != tc.fetches {
+				t.Errorf("expected %d ruleset fetches, got %d", tc.fetches, fetches)
+			}
+			if got := d.Get("rulesets.#").(int); got != 1 {
+				t.Fatalf("expected 1 matching ruleset, got %d", got)
+			}
+			if got := d.Get("rulesets.0.id").(string); got != "efb7b8c949ac4650a09736fc376e9aee" {
+				t.Errorf("expected the managed ruleset to match, got %q", got)
+			}
+
+			rules := d.Get("rulesets.0.rules.#").(int)
+			if !tc.includeRules {
+				if rules != 0 {
+					t.Errorf("expected no rules without include_rules, got %d", rules)
+				}
+				return
+			}
+
+			if rules != 1 {
+				t.Fatalf("expected 1 rule, got %d", rules)
+			}
+			if got := d.Get("rulesets.0.rules.0.categories.1").(string); got != "xss" {
+				t.Errorf("expected rule categories to be set, got %q", got)
+			}
+		})
+	}
+}
+
+func TestCloudflareRulesetsDataSourceReadPaginates(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	var pages []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/"+accountID+"/rulesets" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			testAPIResult(w, `[
+  {"id": "efb7b8c949ac4650a09736fc376e9aee", "name": "Cloudflare Managed Ruleset", "kind": "managed", "phase": "http_request_firewall_managed", "version": "52"},
+  {"id": "4814384a9e5d4991b9815dcfc25d2f1f", "name": "Cloudflare OWASP Core Ruleset", "kind": "managed", "phase": "http_request_firewall_managed", "version": "39"}
+]`)
+		case "2":
+			testAPIResult(w, `[
+  {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "account custom ruleset", "kind": "custom", "phase": "http_request_firewall_custom", "version": "3"}
+]`)
+		default:
+			testAPIResult(w, `[]`)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareRulesetsSchema(), map[string]interface{}{
+		"account_id": accountID,
+	})
+
+	if diags := dataSourceCloudflareRulesetsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !reflect.DeepEqual(pages, []string{"1", "2", "3"}) {
+		t.Errorf("expected pages to be requested until an empty one, got %v", pages)
+	}
+	if got := d.Get("rulesets.#").(int); got != 3 {
+		t.Fatalf("expected the rulesets of both pages, got %d", got)
+	}
+	if got := d.Get("rulesets.2.id").(string); got != "2c0fc9fa937b11eaa1b71c4d701ab86e" {
+		t.Errorf("expected the ruleset of the second page, got %q", got)
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTeamsListItems() *schema.Resource {
+	return &schema.Resource{
+		Description: heredoc.Doc(`
+			Use this data source to lookup the items of a Teams List, all
+			pages of items are fetched.
+		`),
+		ReadContext: dataSourceCloudflareTeamsListItemsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"list_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the teams list.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The items of the teams list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The value of the item.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the item.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareTeamsListItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	items, err := listTeamsListItems(ctx, client, accountID, listID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing items of Teams List %q: %w", listID, err))
+	}
+
+	if err := d.Set("items", convertListItemsWithDescriptionToSchema(items)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Teams List items: %w", err))
+	}
+
+	d.SetId(listID)
+	return nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareTeamsListItemsDataSource(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_teams_list_items.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsListItemsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "items.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "items.*", map[string]string{
+						"value":       "asdf-1234",
+						"description": "first serial",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsListItemsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_list" "%[1]s" {
+	account_id  = "%[2]s"
+	name        = "%[1]s"
+	type        = "SERIAL"
+
+	items_with_description {
+		value       = "asdf-1234"
+		description = "first serial"
+	}
+
+	items_with_description {
+		value       = "asdf-5678"
+		description = "second serial"
+	}
+}
+
+data "cloudflare_teams_list_items" "%[1]s" {
+	account_id = "%[2]s"
+	list_id    = cloudflare_teams_list.%[1]s.id
+}
+`, rnd, accountID)
+}
+
+func TestCloudflareTeamsListItemsDataSourcePaginates(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const listID = "971fc4e8-388e-4ab9-b377-16430c0fc018"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/gateway/lists/"+listID+"/items" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Pages after the second are empty, which is where listTeamsListItems
+		// stops paging, see TestListTeamsListItemsPagesUntilEmptyPage.
+		page := r.URL.Query().Get("page")
+		items := []teamsListItem{}
+		count := 0
+		switch page {
+		case "1":
+			count = 1000
+		case "2":
+			count = 5
+		}
+		for i := 0; i < count; i++ {
+			items = append(items, teamsListItem{Value: fmt.Sprintf("%s-%d", page, i), Description: "serial"})
+		}
+
+		testAPIResultJSON(w, items)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareTeamsListItems().Schema, map[string]interface{}{
+		"account_id": accountID,
+		"list_id":    listID,
+	})
+
+	if diags := dataSourceCloudflareTeamsListItemsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("items.#").(int); got != 1005 {
+		t.Errorf("expected 1005 items, got %d", got)
+	}
+	if got := d.Get("items.0.description").(string); got != "serial" {
+		t.Errorf("expected items to have their description, got %q", got)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareTeamsLists() *schema.Resource {
+	return &schema.Resource{
+		Description: heredoc.Doc(`
+			Use this data source to lookup the Teams Lists of an account, for
+			example to find the ID of a list by its name. The items of the
+			lists aren't fetched, use ` + "`cloudflare_teams_list_items`" + ` for them.
+		`),
+		ReadContext: dataSourceCloudflareTeamsListsRead,
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the teams lists to lookup.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IP", "SERIAL", "URL", "DOMAIN", "EMAIL"}, false),
+				Description:  fmt.Sprintf("The type of the teams lists to lookup. %s", renderAvailableDocumentationValuesStringSlice([]string{"IP", "SERIAL", "URL", "DOMAIN", "EMAIL"})),
+			},
+			"lists": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of teams lists matching the filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The teams list ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the teams list.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the teams list.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the teams list.",
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of items in the teams list.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareTeamsListsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+	listType := d.Get("type").(string)
+
+	lists, _, err := client.ListTeamsLists(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListTeamListsParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Teams Lists for account %q: %w", accountID, err))
+	}
+
+	listIDs := make([]string, 0, len(lists))
+	listDetails := make([]interface{}, 0, len(lists))
+	for _, list := range lists {
+		if name != "" && list.Name != name {
+			continue
+		}
+		if listType != "" && list.Type != listType {
+			continue
+		}
+
+		listDetails = append(listDetails, map[string]interface{}{
+			"id":          list.ID,
+			"name":        list.Name,
+			"type":        list.Type,
+			"description": list.Description,
+			"count":       int(list.Count),
+		})
+		listIDs = append(listIDs, list.ID)
+	}
+
+	if err := d.Set("lists", listDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Teams Lists: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append(listIDs, accountID)))
+	return nil
+}
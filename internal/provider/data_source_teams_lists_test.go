@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareTeamsListsDataSource(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_teams_lists.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsListsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "lists.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "lists.0.id", "cloudflare_teams_list."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "lists.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "lists.0.type", "SERIAL"),
+					resource.TestCheckResourceAttr(name, "lists.0.count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsListsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_list" "%[1]s" {
+	account_id  = "%[2]s"
+	name        = "%[1]s"
+	type        = "SERIAL"
+	items       = ["asdf-1234", "asdf-5678"]
+}
+
+data "cloudflare_teams_lists" "%[1]s" {
+	account_id = "%[2]s"
+	name       = cloudflare_teams_list.%[1]s.name
+	type       = "SERIAL"
+}
+`, rnd, accountID)
+}
+
+func TestCloudflareTeamsListsDataSourceFilters(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/gateway/lists" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, `[
+			{"id": "1", "name": "devices", "type": "SERIAL", "count": 3},
+			{"id": "2", "name": "blocked", "type": "DOMAIN", "count": 5000},
+			{"id": "3", "name": "blocked", "type": "IP", "count": 10}
+		]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareTeamsLists().Schema, map[string]interface{}{
+		"account_id": accountID,
+		"name":       "blocked",
+		"type":       "DOMAIN",
+	})
+
+	if diags := dataSourceCloudflareTeamsListsRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("lists.#").(int); got != 1 {
+		t.Fatalf("expected a single list, got %d", got)
+	}
+	if got := d.Get("lists.0.id").(string); got != "2" {
+		t.Errorf("expected list %q, got %q", "2", got)
+	}
+	if got := d.Get("lists.0.count").(int); got != 5000 {
+		t.Errorf("expected the count of the list, got %d", got)
+	}
+}
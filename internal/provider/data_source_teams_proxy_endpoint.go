@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTeamsProxyEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareTeamsProxyEndpointSchema(),
+		ReadContext: dataSourceCloudflareTeamsProxyEndpointRead,
+		Description: "Use this data source to lookup a single [Teams Proxy Endpoint](https://developers.cloudflare.com/cloudflare-one/connections/connect-devices/agentless/pac-files/) by name.",
+	}
+}
+
+func dataSourceCloudflareTeamsProxyEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	endpoints, _, err := client.TeamsProxyEndpoints(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Teams Proxy Endpoints: %w", err))
+	}
+
+	if len(endpoints) == 0 {
+		return diag.FromErr(fmt.Errorf("no Teams Proxy Endpoints found"))
+	}
+
+	var proxyEndpoint cloudflare.TeamsProxyEndpoint
+	for _, endpoint := range endpoints {
+		if endpoint.Name == name {
+			proxyEndpoint = endpoint
+			break
+		}
+	}
+
+	if proxyEndpoint.ID == "" {
+		return diag.FromErr(fmt.Errorf("no Teams Proxy Endpoint matching name %q", name))
+	}
+
+	d.SetId(proxyEndpoint.ID)
+	d.Set("name", proxyEndpoint.Name)
+	d.Set("subdomain", proxyEndpoint.Subdomain)
+	d.Set("ips", proxyEndpoint.IPs)
+
+	return nil
+}
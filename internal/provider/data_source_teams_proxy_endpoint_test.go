@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTeamsProxyEndpointDataSource_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := "data.cloudflare_teams_proxy_endpoint." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsProxyEndpointDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_teams_proxy_endpoint."+rnd, "id"),
+					resource.TestCheckResourceAttrPair(name, "subdomain", "cloudflare_teams_proxy_endpoint."+rnd, "subdomain"),
+					resource.TestCheckResourceAttr(name, "ips.#", "1"),
+					resource.TestCheckResourceAttr(name, "ips.0", "104.16.132.229/32"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsProxyEndpointDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_proxy_endpoint" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  ips        = ["104.16.132.229/32"]
+}
+
+data "cloudflare_teams_proxy_endpoint" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  depends_on = [cloudflare_teams_proxy_endpoint.%[1]s]
+}
+`, rnd, accountID)
+}
+
+func TestAccCloudflareTeamsProxyEndpointDataSource_NotFound(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareTeamsProxyEndpointDataSourceNotFoundConfig(rnd, accountID),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(fmt.Sprintf("no Teams Proxy Endpoint matching name %q", rnd+"-missing"))),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsProxyEndpointDataSourceNotFoundConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_proxy_endpoint" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  ips        = ["104.16.132.229/32"]
+}
+
+data "cloudflare_teams_proxy_endpoint" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s-missing"
+  depends_on = [cloudflare_teams_proxy_endpoint.%[1]s]
+}
+`, rnd, accountID)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTunnel() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareTunnelSchema(),
+		ReadContext: dataSourceCloudflareTunnelRead,
+		Description: "Use this datasource to lookup a tunnel in an account.",
+	}
+}
+
+func dataSourceCloudflareTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("is_deleted", strconv.FormatBool(d.Get("is_deleted").(bool)))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel?%s", accountID, query.Encode()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Tunnels: %w", err))
+	}
+
+	var tunnels []tunnel
+	if err := json.Unmarshal(res, &tunnels); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Tunnels: %w", err))
+	}
+
+	var matches []tunnel
+	for _, t := range tunnels {
+		if t.Name == name {
+			matches = append(matches, t)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("no Tunnel matching name %q found", name)
+	case 1:
+	default:
+		return diag.Errorf("found %d Tunnels matching name %q; use `is_deleted` to narrow the search", len(matches), name)
+	}
+
+	t := matches[0]
+	d.SetId(t.ID)
+	d.Set("status", t.Status)
+	d.Set("remote_config", t.configSrc() == "cloudflare")
+
+	return nil
+}
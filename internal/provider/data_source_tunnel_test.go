@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareTunnelDataSource_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := "data.cloudflare_tunnel." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTunnelDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_tunnel."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "status", "inactive"),
+					resource.TestCheckResourceAttr(name, "remote_config", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTunnelDataSource_NotFound(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "cloudflare_tunnel" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+`, rnd, accountID),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("no Tunnel matching name %q found", rnd)),
+			},
+		},
+	})
+}
+
+func TestCloudflareTunnelDataSourceReadMultipleMatches(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("is_deleted") != "true" {
+			t.Errorf("expected is_deleted=true, got %q", r.URL.RawQuery)
+		}
+
+		testAPIResult(w, `[
+  {"id": "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415", "name": "example", "status": "inactive"},
+  {"id": "0fb3e8ac-3b3a-4dd5-9a3e-90ed0c8b0b17", "name": "example", "status": "inactive"},
+  {"id": "4d2b71a7-6c71-4b36-9ee1-3c3fae3d5c9a", "name": "example-2", "status": "healthy"}
+]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareTunnelSchema(), map[string]interface{}{
+		"account_id": accountID,
+		"name":       "example",
+		"is_deleted": true,
+	})
+
+	diags := dataSourceCloudflareTunnelRead(context.Background(), d, client)
+	if !diags.HasError() {
+		t.Fatal("expected an error for multiple matching tunnels")
+	}
+	if expected := `found 2 Tunnels matching name "example"`; !strings.Contains(diags[0].Summary, expected) {
+		t.Errorf("expected error to contain %q, got %q", expected, diags[0].Summary)
+	}
+}
+
+func testAccCloudflareTunnelDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_tunnel" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  config_src = "cloudflare"
+}
+
+data "cloudflare_tunnel" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  depends_on = [cloudflare_tunnel.%[1]s]
+}
+`, rnd, accountID)
+}
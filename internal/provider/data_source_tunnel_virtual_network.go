@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTunnelVirtualNetwork() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareTunnelVirtualNetworkSchema(),
+		ReadContext: dataSourceCloudflareTunnelVirtualNetworkRead,
+		Description: "Use this datasource to lookup a tunnel virtual network in an account.",
+	}
+}
+
+func dataSourceCloudflareTunnelVirtualNetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	name := d.Get("name").(string)
+
+	virtualNetworks, err := client.ListTunnelVirtualNetworks(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.TunnelVirtualNetworksListParams{
+		Name:      name,
+		IsDeleted: cloudflare.BoolPtr(false),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Tunnel Virtual Networks: %w", err))
+	}
+
+	var matches []cloudflare.TunnelVirtualNetwork
+	for _, virtualNetwork := range virtualNetworks {
+		if virtualNetwork.Name == name {
+			matches = append(matches, virtualNetwork)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("no Tunnel Virtual Network matching name %q found", name)
+	case 1:
+	default:
+		return diag.Errorf("found %d Tunnel Virtual Networks matching name %q", len(matches), name)
+	}
+
+	virtualNetwork := matches[0]
+	d.SetId(virtualNetwork.ID)
+	d.Set("comment", virtualNetwork.Comment)
+	d.Set("is_default", virtualNetwork.IsDefaultNetwork)
+
+	return nil
+}
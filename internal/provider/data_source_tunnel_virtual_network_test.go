@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTunnelVirtualNetworkDataSource_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "data.cloudflare_tunnel_virtual_network." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTunnelVirtualNetworkDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_tunnel_virtual_network."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "comment", "test"),
+					resource.TestCheckResourceAttr(name, "is_default", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTunnelVirtualNetworkDataSource_NotFound(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "cloudflare_tunnel_virtual_network" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+`, rnd, accountID),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("no Tunnel Virtual Network matching name %q found", rnd)),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTunnelVirtualNetworkDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_tunnel_virtual_network" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  comment    = "test"
+}
+
+data "cloudflare_tunnel_virtual_network" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  depends_on = [cloudflare_tunnel_virtual_network.%[1]s]
+}
+`, rnd, accountID)
+}
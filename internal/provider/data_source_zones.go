@@ -2,16 +2,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/net/idna"
 )
 
+const zonesPerPage = 50
+
 func dataSourceCloudflareZones() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceCloudflareZonesRead,
@@ -97,20 +104,28 @@ func dataSourceCloudflareZonesRead(ctx context.Context, d *schema.ResourceData,
 		zoneLookupValue = "contains:" + zoneLookupValue
 	}
 
-	zoneFilter := cloudflare.WithZoneFilters(
-		zoneLookupValue,
-		filter.accountID,
-		filter.status,
-	)
+	params := url.Values{}
+	if zoneLookupValue != "" {
+		if name, err := idna.ToUnicode(zoneLookupValue); err == nil {
+			zoneLookupValue = name
+		}
+		params.Set("name", zoneLookupValue)
+	}
+	if filter.accountID != "" {
+		params.Set("account.id", filter.accountID)
+	}
+	if filter.status != "" {
+		params.Set("status", filter.status)
+	}
 
-	zones, err := client.ListZonesContext(ctx, zoneFilter)
+	zones, err := listAllZones(ctx, client, params)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error listing Zone: %w", err))
 	}
 
 	zoneIds := make([]string, 0)
 	zoneDetails := make([]interface{}, 0)
-	for _, v := range zones.Result {
+	for _, v := range zones {
 		if filter.regexValue != nil {
 			if !filter.regexValue.Match([]byte(v.Name)) {
 				continue
@@ -137,6 +152,36 @@ func dataSourceCloudflareZonesRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// listAllZones fetches every page of zones matching params.
+// ListZonesContext only fetches subsequent pages when the response includes
+// `result_info.total_pages` and refuses explicit pagination, so pages are
+// requested here until a short page is returned instead.
+func listAllZones(ctx context.Context, client *cloudflare.API, params url.Values) ([]cloudflare.Zone, error) {
+	var zones []cloudflare.Zone
+
+	params.Set("per_page", strconv.Itoa(zonesPerPage))
+
+	for page := 1; ; page++ {
+		params.Set("page", strconv.Itoa(page))
+
+		res, err := client.Raw(ctx, http.MethodGet, "/zones?"+params.Encode(), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []cloudflare.Zone
+		if err := json.Unmarshal(res, &result); err != nil {
+			return nil, fmt.Errorf("error unmarshalling zones: %w", err)
+		}
+
+		zones = append(zones, result...)
+
+		if len(result) < zonesPerPage {
+			return zones, nil
+		}
+	}
+}
+
 func expandFilter(d interface{}) (*searchFilter, error) {
 	cfg := d.([]interface{})
 	filter := &searchFilter{}
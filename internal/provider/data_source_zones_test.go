@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"strconv"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func init() {
@@ -62,6 +64,60 @@ func testSweepCloudflareZones(r string) error {
 	return nil
 }
 
+func TestCloudflareZonesPagination(t *testing.T) {
+	const totalZones = 123
+
+	var requestedPages []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		requestedPages = append(requestedPages, query.Get("page"))
+
+		if query.Get("name") != "contains:example" {
+			t.Errorf("expected name filter to be sent, got %q", query.Get("name"))
+		}
+
+		page, _ := strconv.Atoi(query.Get("page"))
+		perPage, _ := strconv.Atoi(query.Get("per_page"))
+
+		zones := make([]cloudflare.Zone, 0, perPage)
+		for i := (page - 1) * perPage; i < page*perPage && i < totalZones; i++ {
+			zones = append(zones, cloudflare.Zone{
+				ID:     fmt.Sprintf("zone-%d", i),
+				Name:   fmt.Sprintf("example-%d.com", i),
+				Paused: i%10 == 0,
+			})
+		}
+
+		// result_info is deliberately omitted, pagination must not depend on it.
+		testAPIResultJSON(w, zones)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareZones().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{
+				"name":        "example",
+				"lookup_type": "contains",
+				"match":       "^example-1",
+			},
+		},
+	})
+
+	if diags := dataSourceCloudflareZonesRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(requestedPages) != 3 {
+		t.Errorf("expected 3 pages to be requested, got %v", requestedPages)
+	}
+
+	// 34 zones match (example-1, example-10..19 and example-100..122) of
+	// which example-10, example-100, example-110 and example-120 are paused.
+	zones := d.Get("zones").([]interface{})
+	if len(zones) != 30 {
+		t.Errorf("expected 30 zones, got %d", len(zones))
+	}
+}
+
 func TestAccCloudflareZonesMatchName(t *testing.T) {
 	t.Parallel()
 	rnd := generateRandomResourceName()
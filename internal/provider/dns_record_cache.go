@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// dnsRecordCaches holds the DNS record cache of each configured client. A
+// provider instance only lives for a single Terraform operation so the
+// cached records don't outlive it.
+var dnsRecordCaches sync.Map
+
+// dnsRecordCache serves reads of DNS records from a single listing of their
+// zone, so that refreshing a large number of cloudflare_record resources
+// doesn't need a request per record.
+type dnsRecordCache struct {
+	mu    sync.Mutex
+	zones map[string]*dnsRecordZoneCache
+}
+
+// dnsRecordZoneCache holds the records of a zone. The lock is held while the
+// zone is listed so concurrent reads wait for the listing instead of each
+// issuing their own request.
+type dnsRecordZoneCache struct {
+	mu      sync.Mutex
+	loaded  bool
+	records map[string]cloudflare.DNSRecord
+}
+
+// enableDNSRecordCache makes getDNSRecord serve reads made with client from
+// a per zone cache.
+func enableDNSRecordCache(client *cloudflare.API) {
+	dnsRecordCaches.Store(client, &dnsRecordCache{zones: make(map[string]*dnsRecordZoneCache)})
+}
+
+func (c *dnsRecordCache) zone(zoneID string) *dnsRecordZoneCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	zone, ok := c.zones[zoneID]
+	if !ok {
+		zone = &dnsRecordZoneCache{}
+		c.zones[zoneID] = zone
+	}
+
+	return zone
+}
+
+// get returns the cached record, listing the records of the zone on first
+// use. When the listing fails the zone is left empty so reads fall back to
+// fetching each record.
+func (z *dnsRecordZoneCache) get(ctx context.Context, client *cloudflare.API, zoneID, recordID string) (cloudflare.DNSRecord, bool) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	if !z.loaded {
+		z.loaded = true
+		z.records = make(map[string]cloudflare.DNSRecord)
+
+		records, _, err := client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.ListDNSRecordsParams{})
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error listing DNS records of zone %q, reading records individually: %s", zoneID, err))
+		}
+
+		for _, record := range records {
+			z.records[record.ID] = record
+		}
+	}
+
+	record, ok := z.records[recordID]
+	return record, ok
+}
+
+func (z *dnsRecordZoneCache) forget(recordID string) {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	delete(z.records, recordID)
+}
+
+// getDNSRecord returns a DNS record from the cache of the client when it is
+// enabled, otherwise or when the record isn't cached it's fetched from the
+// API.
+func getDNSRecord(ctx context.Context, client *cloudflare.API, zoneID, recordID string) (cloudflare.DNSRecord, error) {
+	if cache, ok := dnsRecordCaches.Load(client); ok {
+		if record, ok := cache.(*dnsRecordCache).zone(zoneID).get(ctx, client, zoneID, recordID); ok {
+			return record, nil
+		}
+	}
+
+	return client.GetDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), recordID)
+}
+
+// forgetDNSRecord removes a record from the cache of the client after it has
+// been changed so the next read fetches it from the API.
+func forgetDNSRecord(client *cloudflare.API, zoneID, recordID string) {
+	if cache, ok := dnsRecordCaches.Load(client); ok {
+		cache.(*dnsRecordCache).zone(zoneID).forget(recordID)
+	}
+}
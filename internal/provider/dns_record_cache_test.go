@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+func TestDNSRecordCache(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	recordsPath := "/zones/" + zoneID + "/dns_records"
+
+	var lists, gets int32
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == recordsPath:
+			atomic.AddInt32(&lists, 1)
+			page := r.URL.Query().Get("page")
+			testAPIResultWithInfo(w, fmt.Sprintf(`[
+  {"id": "record-%[1]s-a", "type": "A", "name": "a%[1]s.example.com", "content": "192.0.2.1"},
+  {"id": "record-%[1]s-b", "type": "A", "name": "b%[1]s.example.com", "content": "192.0.2.2"}
+]`, page), fmt.Sprintf(`{"page": %s, "per_page": 2, "count": 2, "total_count": 4, "total_pages": 2}`, page))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, recordsPath+"/"):
+			atomic.AddInt32(&gets, 1)
+			id := strings.TrimPrefix(r.URL.Path, recordsPath+"/")
+			if id == "missing" {
+				testAPIError(w, http.StatusNotFound, 81044, "Record does not exist.")
+				return
+			}
+			testAPIResult(w, fmt.Sprintf(`{"id": %q, "type": "A", "name": "fetched.example.com", "content": "192.0.2.3"}`, id))
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	enableDNSRecordCache(client)
+	defer dnsRecordCaches.Delete(client)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"record-1-a", "record-1-b", "record-2-a", "record-2-b"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+
+			record, err := getDNSRecord(ctx, client, zoneID, id)
+			if err != nil {
+				t.Errorf("unexpected error reading %q: %s", id, err)
+				return
+			}
+			if record.ID != id {
+				t.Errorf("expected record %q, got %q", id, record.ID)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	if lists != 2 || gets != 0 {
+		t.Errorf("expected the zone to be listed once and no records fetched, got %d list requests and %d record requests", lists, gets)
+	}
+
+	forgetDNSRecord(client, zoneID, "record-1-a")
+	record, err := getDNSRecord(ctx, client, zoneID, "record-1-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Name != "fetched.example.com" || gets != 1 {
+		t.Errorf("expected a forgotten record to be fetched from the API, got %q after %d record requests", record.Name, gets)
+	}
+
+	_, err = getDNSRecord(ctx, client, zoneID, "missing")
+	var notFoundError *cloudflare.NotFoundError
+	if !errors.As(err, &notFoundError) {
+		t.Errorf("expected a not found error for a record missing from the zone, got %v", err)
+	}
+
+	if lists != 2 {
+		t.Errorf("expected the zone to only be listed once, got %d list requests", lists)
+	}
+}
+
+func TestDNSRecordCacheDisabled(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/dns_records/372e67954025e0ba6aaa6d586b9e0b59" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, `{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "example.com", "content": "192.0.2.1"}`)
+	})
+
+	record, err := getDNSRecord(context.Background(), client, zoneID, "372e67954025e0ba6aaa6d586b9e0b59")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Content != "192.0.2.1" {
+		t.Errorf("expected the record to be fetched, got content %q", record.Content)
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// firewallCustomRulesPhase is the phase of the custom rules which Firewall
+// Rules are migrated to.
+const firewallCustomRulesPhase = "http_request_firewall_custom"
+
+const firewallRulesMigrationGuideURL = "https://registry.terraform.io/providers/cloudflare/cloudflare/latest/docs/guides/firewall-rules-migration"
+
+// firewallRulesMigrations holds the migration state of the zones read with
+// each configured client. A provider instance only lives for a single
+// Terraform operation, so refreshing many Firewall Rules and filters of a
+// zone reads its custom rules and Firewall Rules once per operation.
+var firewallRulesMigrations sync.Map
+
+// firewallRulesMigration holds the migration state of each zone read with a
+// client.
+type firewallRulesMigration struct {
+	mu    sync.Mutex
+	zones map[string]*firewallRulesZoneMigration
+}
+
+// firewallRulesZoneMigration holds the custom rules of a zone and, once a
+// filter is read, which Firewall Rules use each filter.
+type firewallRulesZoneMigration struct {
+	rulesOnce   sync.Once
+	customRules ruleset
+
+	filtersOnce   sync.Once
+	filterRuleIDs map[string][]string
+}
+
+// getFirewallRulesZoneMigration returns the migration state of a zone read
+// with client.
+func getFirewallRulesZoneMigration(client *cloudflare.API, zoneID string) *firewallRulesZoneMigration {
+	m, _ := firewallRulesMigrations.LoadOrStore(client, &firewallRulesMigration{zones: make(map[string]*firewallRulesZoneMigration)})
+	migration := m.(*firewallRulesMigration)
+
+	migration.mu.Lock()
+	defer migration.mu.Unlock()
+
+	zone, ok := migration.zones[zoneID]
+	if !ok {
+		zone = &firewallRulesZoneMigration{}
+		migration.zones[zoneID] = zone
+	}
+
+	return zone
+}
+
+// getMigratedFirewallRules returns the custom rules of a zone. When
+// Cloudflare migrates the Firewall Rules of a zone, the ref of each custom
+// rule is the ID of the Firewall Rule it was created from.
+//
+// The rules are only used to warn about the migration, so a zone without
+// custom rules, or a token which can't read them, isn't an error.
+func getMigratedFirewallRules(ctx context.Context, client *cloudflare.API, zoneID string) ruleset {
+	zone := getFirewallRulesZoneMigration(client, zoneID)
+	zone.rulesOnce.Do(func() {
+		rs, err := getRulesetPhaseEntrypoint(ctx, client, "", zoneID, firewallCustomRulesPhase)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Unable to read the custom rules of zone %q: %s", zoneID, err))
+			return
+		}
+		zone.customRules = rs
+	})
+
+	return zone.customRules
+}
+
+// getFirewallRulesUsingFilter returns the IDs of the Firewall Rules of a zone
+// which use a filter. Like the custom rules, they are only used to warn about
+// the migration so errors listing them are ignored.
+func getFirewallRulesUsingFilter(ctx context.Context, client *cloudflare.API, zoneID, filterID string) []string {
+	zone := getFirewallRulesZoneMigration(client, zoneID)
+	zone.filtersOnce.Do(func() {
+		zone.filterRuleIDs = make(map[string][]string)
+
+		rules, _, err := client.FirewallRules(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.FirewallRuleListParams{})
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Unable to list the Firewall Rules of zone %q: %s", zoneID, err))
+			return
+		}
+
+		for _, rule := range rules {
+			zone.filterRuleIDs[rule.Filter.ID] = append(zone.filterRuleIDs[rule.Filter.ID], rule.ID)
+		}
+	})
+
+	return zone.filterRuleIDs[filterID]
+}
+
+// firewallRuleMigrationWarning returns a warning naming the custom rule a
+// Firewall Rule was migrated to, if any.
+func firewallRuleMigrationWarning(rs ruleset, zoneID, firewallRuleID string) diag.Diagnostics {
+	for _, rule := range rs.Rules {
+		if rule.Ref != firewallRuleID {
+			continue
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Firewall Rule %q has been migrated to a custom rule", firewallRuleID),
+			Detail: fmt.Sprintf(
+				"The Firewall Rule has been migrated to rule %q of the %s ruleset %q. Manage it with `cloudflare_ruleset` by importing %q and setting `ref = %q` on the rule, then remove this resource from the state with `terraform state rm`. See %s.",
+				rule.ID, firewallCustomRulesPhase, rs.ID, fmt.Sprintf("zone/%s/phase/%s", zoneID, firewallCustomRulesPhase), firewallRuleID, firewallRulesMigrationGuideURL,
+			),
+		}}
+	}
+
+	return nil
+}
+
+// filterMigrationWarning returns a warning naming the custom rules migrated
+// from the Firewall Rules using a filter, if any.
+func filterMigrationWarning(rs ruleset, filterID string, firewallRuleIDs []string) diag.Diagnostics {
+	var ruleIDs []string
+	for _, rule := range rs.Rules {
+		if rule.Ref != "" && contains(firewallRuleIDs, rule.Ref) {
+			ruleIDs = append(ruleIDs, fmt.Sprintf("%q", rule.ID))
+		}
+	}
+
+	if len(ruleIDs) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Filter %q has been migrated to custom rules", filterID),
+		Detail: fmt.Sprintf(
+			"The Firewall Rules using the filter have been migrated to rule %s of the %s ruleset %q. Once they are managed with `cloudflare_ruleset`, remove this resource from the state with `terraform state rm`. See %s.",
+			strings.Join(ruleIDs, ", "), firewallCustomRulesPhase, rs.ID, firewallRulesMigrationGuideURL,
+		),
+	}}
+}
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -15,6 +17,7 @@ func testPagesProjectFull(resourceID, accountID, projectName, repoOwner, repoNam
 		  name = "%[3]s"
 		  production_branch = "main"
 		  build_config {
+			build_caching = true
 			build_command = "npm run build"
 			destination_dir = "build"
 			root_dir = "/"
@@ -113,3 +116,70 @@ func TestAccCloudflarePagesProject_Import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCloudflarePagesProject_ImportExternallyConnectedSource(t *testing.T) {
+	skipPagesProjectForNonConfiguredDefaultAccount(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_pages_project.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	pagesOwner := os.Getenv("CLOUDFLARE_PAGES_OWNER")
+	pagesRepo := os.Getenv("CLOUDFLARE_PAGES_REPO")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPages(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client, err := sharedClient()
+					if err != nil {
+						t.Fatalf("error establishing client: %s", err)
+					}
+
+					_, err = client.CreatePagesProject(context.Background(), accountID, cloudflare.PagesProject{
+						Name:             rnd,
+						ProductionBranch: "main",
+						Source: &cloudflare.PagesProjectSource{
+							Type: "github",
+							Config: &cloudflare.PagesProjectSourceConfig{
+								Owner:                        pagesOwner,
+								RepoName:                     pagesRepo,
+								ProductionBranch:             "main",
+								PRCommentsEnabled:            true,
+								DeploymentsEnabled:           true,
+								ProductionDeploymentsEnabled: true,
+								PreviewDeploymentSetting:     cloudflare.PagesPreviewAllBranches,
+							},
+						},
+					})
+					if err != nil {
+						t.Fatalf("error creating Pages project: %s", err)
+					}
+				},
+				Config:             testPagesProjectExternallyConnected(rnd, accountID),
+				ResourceName:       name,
+				ImportState:        true,
+				ImportStateId:      fmt.Sprintf("%s/%s", accountID, rnd),
+				ImportStatePersist: true,
+			},
+			{
+				Config:   testPagesProjectExternallyConnected(rnd, accountID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testPagesProjectExternallyConnected(resourceID, accountID string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_pages_project" "%[1]s" {
+		  account_id = "%[2]s"
+		  name = "%[1]s"
+		  production_branch = "main"
+		}
+		`, resourceID, accountID)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// magicTransitTunnelHealthCheck is the health check of a GRE or IPsec
+// tunnel, including the rate and direction which cloudflare-go doesn't
+// support yet.
+type magicTransitTunnelHealthCheck struct {
+	Enabled   bool   `json:"enabled"`
+	Target    string `json:"target,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Rate      string `json:"rate,omitempty"`
+	Direction string `json:"direction,omitempty"`
+}
+
+func magicTransitTunnelHealthCheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		Computed:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"health_check_enabled", "health_check_target", "health_check_type"},
+		Description:   "The ICMP health check of the tunnel.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether health checks are enabled for the tunnel.",
+				},
+				"target": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: "The IP address of the customer endpoint that will receive tunnel health checks.",
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"request", "reply"}, false),
+					Description:  fmt.Sprintf("The ICMP echo type for the health check. %s", renderAvailableDocumentationValuesStringSlice([]string{"request", "reply"})),
+				},
+				"rate": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"low", "mid", "high"}, false),
+					Description:  fmt.Sprintf("How frequently the health check is run. %s", renderAvailableDocumentationValuesStringSlice([]string{"low", "mid", "high"})),
+				},
+				"direction": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"unidirectional", "bidirectional"}, false),
+					Description:  fmt.Sprintf("The direction of the flow of the health check packets. %s", renderAvailableDocumentationValuesStringSlice([]string{"unidirectional", "bidirectional"})),
+				},
+			},
+		},
+	}
+}
+
+// expandMagicTransitTunnelHealthCheck returns the health check of a tunnel.
+// Values which aren't configured are taken from state so that, for example,
+// a bidirectional health check isn't reset by unrelated updates.
+func expandMagicTransitTunnelHealthCheck(d *schema.ResourceData) *magicTransitTunnelHealthCheck {
+	_, blockOk := d.GetOk("health_check")
+
+	healthCheck := &magicTransitTunnelHealthCheck{
+		Enabled:   d.Get("health_check.0.enabled").(bool),
+		Target:    d.Get("health_check.0.target").(string),
+		Type:      d.Get("health_check.0.type").(string),
+		Rate:      d.Get("health_check.0.rate").(string),
+		Direction: d.Get("health_check.0.direction").(string),
+	}
+
+	// The deprecated top level attributes take precedence when they are
+	// configured.
+	rawConfig := d.GetRawConfig()
+	configured := func(key string) (cty.Value, bool) {
+		if rawConfig.IsNull() {
+			return cty.NilVal, false
+		}
+		value := rawConfig.GetAttr(key)
+		return value, !value.IsNull() && value.IsKnown()
+	}
+
+	if value, ok := configured("health_check_enabled"); ok {
+		healthCheck.Enabled = value.True()
+	} else if enabled, ok := d.GetOk("health_check_enabled"); ok && !blockOk {
+		healthCheck.Enabled = enabled.(bool)
+	}
+
+	if value, ok := configured("health_check_target"); ok {
+		healthCheck.Target = value.AsString()
+	} else if target, ok := d.GetOk("health_check_target"); ok && !blockOk {
+		healthCheck.Target = target.(string)
+	}
+
+	if value, ok := configured("health_check_type"); ok {
+		healthCheck.Type = value.AsString()
+	} else if healthCheckType, ok := d.GetOk("health_check_type"); ok && !blockOk {
+		healthCheck.Type = healthCheckType.(string)
+	}
+
+	if *healthCheck == (magicTransitTunnelHealthCheck{}) {
+		return nil
+	}
+
+	return healthCheck
+}
+
+// setMagicTransitTunnelHealthCheck sets both the `health_check` block and the
+// deprecated top level attributes.
+func setMagicTransitTunnelHealthCheck(d *schema.ResourceData, healthCheck *magicTransitTunnelHealthCheck) error {
+	if healthCheck == nil {
+		healthCheck = &magicTransitTunnelHealthCheck{}
+	}
+
+	d.Set("health_check_enabled", healthCheck.Enabled)
+	d.Set("health_check_target", healthCheck.Target)
+	d.Set("health_check_type", healthCheck.Type)
+
+	return d.Set("health_check", []map[string]interface{}{{
+		"enabled":   healthCheck.Enabled,
+		"target":    healthCheck.Target,
+		"type":      healthCheck.Type,
+		"rate":      healthCheck.Rate,
+		"direction": healthCheck.Direction,
+	}})
+}
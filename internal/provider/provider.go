@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -149,6 +151,13 @@ func New(version string) func() *schema.Provider {
 					Description: "Whether to print logs from the API client (using the default log library logger). Alternatively, can be configured using the `CLOUDFLARE_API_CLIENT_LOGGING` environment variable.",
 				},
 
+				"disable_dns_record_cache": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_DISABLE_DNS_RECORD_CACHE", false),
+					Description: "Whether to read each `cloudflare_record` individually instead of listing the records of each zone once and reading them from that list. Alternatively, can be configured using the `CLOUDFLARE_DISABLE_DNS_RECORD_CACHE` environment variable.",
+				},
+
 				"account_id": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -173,27 +182,38 @@ func New(version string) func() *schema.Provider {
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
-				"cloudflare_access_identity_provider":    dataSourceCloudflareAccessIdentityProvider(),
-				"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
-				"cloudflare_accounts":                    dataSourceCloudflareAccounts(),
-				"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
-				"cloudflare_devices":                     dataSourceCloudflareDevices(),
-				"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
-				"cloudflare_load_balancer_pools":         dataSourceCloudflareLoadBalancerPools(),
-				"cloudflare_origin_ca_root_certificate":  dataSourceCloudflareOriginCARootCertificate(),
-				"cloudflare_record":                      dataSourceCloudflareRecord(),
-				"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
-				"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
-				"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
-				"cloudflare_zone_dnssec":                 dataSourceCloudflareZoneDNSSEC(),
-				"cloudflare_zone":                        dataSourceCloudflareZone(),
-				"cloudflare_zones":                       dataSourceCloudflareZones(),
+				"cloudflare_access_identity_provider":     dataSourceCloudflareAccessIdentityProvider(),
+				"cloudflare_account_roles":                dataSourceCloudflareAccountRoles(),
+				"cloudflare_accounts":                     dataSourceCloudflareAccounts(),
+				"cloudflare_api_token_permission_groups":  dataSourceCloudflareApiTokenPermissionGroups(),
+				"cloudflare_devices":                      dataSourceCloudflareDevices(),
+				"cloudflare_ip_ranges":                    dataSourceCloudflareIPRanges(),
+				"cloudflare_load_balancer_pools":          dataSourceCloudflareLoadBalancerPools(),
+				"cloudflare_notification_alert_types":     dataSourceCloudflareNotificationAlertTypes(),
+				"cloudflare_notification_policy_webhooks": dataSourceCloudflareNotificationPolicyWebhooks(),
+				"cloudflare_origin_ca_root_certificate":   dataSourceCloudflareOriginCARootCertificate(),
+				"cloudflare_record":                       dataSourceCloudflareRecord(),
+				"cloudflare_records":                      dataSourceCloudflareRecords(),
+				"cloudflare_regional_hostname_regions":    dataSourceCloudflareRegionalHostnameRegions(),
+				"cloudflare_rulesets":                     dataSourceCloudflareRulesets(),
+				"cloudflare_teams_list_items":             dataSourceCloudflareTeamsListItems(),
+				"cloudflare_teams_lists":                  dataSourceCloudflareTeamsLists(),
+				"cloudflare_teams_proxy_endpoint":         dataSourceCloudflareTeamsProxyEndpoint(),
+				"cloudflare_tunnel":                       dataSourceCloudflareTunnel(),
+				"cloudflare_tunnel_virtual_network":       dataSourceCloudflareTunnelVirtualNetwork(),
+				"cloudflare_waf_groups":                   dataSourceCloudflareWAFGroups(),
+				"cloudflare_waf_packages":                 dataSourceCloudflareWAFPackages(),
+				"cloudflare_waf_rules":                    dataSourceCloudflareWAFRules(),
+				"cloudflare_zone_dnssec":                  dataSourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone":                         dataSourceCloudflareZone(),
+				"cloudflare_zones":                        dataSourceCloudflareZones(),
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
 				"cloudflare_access_application":                     resourceCloudflareAccessApplication(),
 				"cloudflare_access_bookmark":                        resourceCloudflareAccessBookmark(),
 				"cloudflare_access_ca_certificate":                  resourceCloudflareAccessCACertificate(),
+				"cloudflare_access_custom_page":                     resourceCloudflareAccessCustomPage(),
 				"cloudflare_access_group":                           resourceCloudflareAccessGroup(),
 				"cloudflare_access_identity_provider":               resourceCloudflareAccessIdentityProvider(),
 				"cloudflare_access_keys_configuration":              resourceCloudflareAccessKeysConfiguration(),
@@ -202,25 +222,34 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_access_policy":                          resourceCloudflareAccessPolicy(),
 				"cloudflare_access_rule":                            resourceCloudflareAccessRule(),
 				"cloudflare_access_service_token":                   resourceCloudflareAccessServiceToken(),
+				"cloudflare_access_tag":                             resourceCloudflareAccessTag(),
 				"cloudflare_account_member":                         resourceCloudflareAccountMember(),
 				"cloudflare_account":                                resourceCloudflareAccount(),
+				"cloudflare_address_map":                            resourceCloudflareAddressMap(),
+				"cloudflare_api_shield_operation":                   resourceCloudflareAPIShieldOperation(),
+				"cloudflare_api_shield_schema":                      resourceCloudflareAPIShieldUserSchema(),
 				"cloudflare_api_shield":                             resourceCloudflareAPIShield(),
 				"cloudflare_api_token":                              resourceCloudflareApiToken(),
+				"cloudflare_argo_smart_routing":                     resourceCloudflareArgoSmartRouting(),
 				"cloudflare_argo_tunnel":                            resourceCloudflareArgoTunnel(),
 				"cloudflare_argo":                                   resourceCloudflareArgo(),
 				"cloudflare_authenticated_origin_pulls_certificate": resourceCloudflareAuthenticatedOriginPullsCertificate(),
 				"cloudflare_authenticated_origin_pulls":             resourceCloudflareAuthenticatedOriginPulls(),
+				"cloudflare_bot_management":                         resourceCloudflareBotManagement(),
 				"cloudflare_byo_ip_prefix":                          resourceCloudflareBYOIPPrefix(),
+				"cloudflare_cache_reserve":                          resourceCloudflareCacheReserve(),
 				"cloudflare_certificate_pack":                       resourceCloudflareCertificatePack(),
 				"cloudflare_custom_hostname_fallback_origin":        resourceCloudflareCustomHostnameFallbackOrigin(),
 				"cloudflare_custom_hostname":                        resourceCloudflareCustomHostname(),
 				"cloudflare_custom_pages":                           resourceCloudflareCustomPages(),
 				"cloudflare_custom_ssl":                             resourceCloudflareCustomSsl(),
+				"cloudflare_d1_database":                            resourceCloudflareD1Database(),
 				"cloudflare_device_settings_policy":                 resourceCloudflareDeviceSettingsPolicy(),
 				"cloudflare_device_policy_certificates":             resourceCloudflareDevicePolicyCertificates(),
 				"cloudflare_device_posture_integration":             resourceCloudflareDevicePostureIntegration(),
 				"cloudflare_device_posture_rule":                    resourceCloudflareDevicePostureRule(),
 				"cloudflare_device_managed_networks":                resourceCloudflareDeviceManagedNetworks(),
+				"cloudflare_dex_test":                               resourceCloudflareDEXTest(),
 				"cloudflare_dlp_profile":                            resourceCloudflareDLPProfile(),
 				"cloudflare_email_routing_address":                  resourceCloudflareEmailRoutingAddress(),
 				"cloudflare_email_routing_catch_all":                resourceCloudflareEmailRoutingCatchAll(),
@@ -229,11 +258,18 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_fallback_domain":                        resourceCloudflareFallbackDomain(),
 				"cloudflare_filter":                                 resourceCloudflareFilter(),
 				"cloudflare_firewall_rule":                          resourceCloudflareFirewallRule(),
+				"cloudflare_gateway_audit_ssh_settings":             resourceCloudflareGatewayAuditSSHSettings(),
 				"cloudflare_gre_tunnel":                             resourceCloudflareGRETunnel(),
 				"cloudflare_healthcheck":                            resourceCloudflareHealthcheck(),
+				"cloudflare_hostname_tls_setting_ciphers":           resourceCloudflareHostnameTLSSettingCiphers(),
+				"cloudflare_hostname_tls_setting":                   resourceCloudflareHostnameTLSSetting(),
+				"cloudflare_hyperdrive_config":                      resourceCloudflareHyperdriveConfig(),
+				"cloudflare_image_variant":                          resourceCloudflareImageVariant(),
 				"cloudflare_ip_list":                                resourceCloudflareIPList(),
 				"cloudflare_ipsec_tunnel":                           resourceCloudflareIPsecTunnel(),
+				"cloudflare_keyless_certificate":                    resourceCloudflareKeylessCertificate(),
 				"cloudflare_list":                                   resourceCloudflareList(),
+				"cloudflare_list_item":                              resourceCloudflareListItem(),
 				"cloudflare_load_balancer_monitor":                  resourceCloudflareLoadBalancerMonitor(),
 				"cloudflare_load_balancer_pool":                     resourceCloudflareLoadBalancerPool(),
 				"cloudflare_load_balancer":                          resourceCloudflareLoadBalancer(),
@@ -242,28 +278,41 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_logpush_ownership_challenge":            resourceCloudflareLogpushOwnershipChallenge(),
 				"cloudflare_magic_firewall_ruleset":                 resourceCloudflareMagicFirewallRuleset(),
 				"cloudflare_managed_headers":                        resourceCloudflareManagedHeaders(),
+				"cloudflare_mnm_configuration":                      resourceCloudflareMNMConfiguration(),
+				"cloudflare_mnm_rule":                               resourceCloudflareMNMRule(),
+				"cloudflare_mtls_certificate_hostnames":             resourceCloudflareMTLSCertificateHostnames(),
+				"cloudflare_mtls_certificate":                       resourceCloudflareMTLSCertificate(),
 				"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhook(),
 				"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
+				"cloudflare_observatory_scheduled_test":             resourceCloudflareObservatoryScheduledTest(),
 				"cloudflare_origin_ca_certificate":                  resourceCloudflareOriginCACertificate(),
 				"cloudflare_page_rule":                              resourceCloudflarePageRule(),
 				"cloudflare_pages_domain":                           resourceCloudflarePagesDomain(),
 				"cloudflare_pages_project":                          resourceCloudflarePagesProject(),
+				"cloudflare_queue_consumer":                         resourceCloudflareQueueConsumer(),
+				"cloudflare_queue":                                  resourceCloudflareQueue(),
+				"cloudflare_r2_bucket":                              resourceCloudflareR2Bucket(),
 				"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
 				"cloudflare_record":                                 resourceCloudflareRecord(),
+				"cloudflare_regional_hostname":                      resourceCloudflareRegionalHostname(),
 				"cloudflare_ruleset":                                resourceCloudflareRuleset(),
 				"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
 				"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
 				"cloudflare_static_route":                           resourceCloudflareStaticRoute(),
+				"cloudflare_stream_live_input":                      resourceCloudflareStreamLiveInput(),
 				"cloudflare_teams_account":                          resourceCloudflareTeamsAccount(),
+				"cloudflare_teams_account_logging":                  resourceCloudflareTeamsAccountLogging(),
 				"cloudflare_teams_list":                             resourceCloudflareTeamsList(),
 				"cloudflare_teams_location":                         resourceCloudflareTeamsLocation(),
 				"cloudflare_teams_proxy_endpoint":                   resourceCloudflareTeamsProxyEndpoint(),
 				"cloudflare_tiered_cache":                           resourceCloudflareTieredCache(),
+				"cloudflare_tunnel":                                 resourceCloudflareTunnel(),
 				"cloudflare_tunnel_config":                          resourceCloudflareTunnelConfig(),
 				"cloudflare_teams_rule":                             resourceCloudflareTeamsRule(),
 				"cloudflare_total_tls":                              resourceCloudflareTotalTLS(),
 				"cloudflare_tunnel_route":                           resourceCloudflareTunnelRoute(),
 				"cloudflare_tunnel_virtual_network":                 resourceCloudflareTunnelVirtualNetwork(),
+				"cloudflare_turnstile_widget":                       resourceCloudflareTurnstileWidget(),
 				"cloudflare_url_normalization_settings":             resourceCloudflareURLNormalizationSettings(),
 				"cloudflare_user_agent_blocking_rule":               resourceCloudflareUserAgentBlockingRules(),
 				"cloudflare_waf_group":                              resourceCloudflareWAFGroup(),
@@ -272,16 +321,22 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_waf_rule":                               resourceCloudflareWAFRule(),
 				"cloudflare_waiting_room_event":                     resourceCloudflareWaitingRoomEvent(),
 				"cloudflare_waiting_room_rules":                     resourceCloudflareWaitingRoomRules(),
+				"cloudflare_waiting_room_settings":                  resourceCloudflareWaitingRoomSettings(),
 				"cloudflare_waiting_room":                           resourceCloudflareWaitingRoom(),
 				"cloudflare_web3_hostname":                          resourceCloudflareWeb3Hostname(),
+				"cloudflare_web_analytics_rule":                     resourceCloudflareWebAnalyticsRule(),
+				"cloudflare_web_analytics_site":                     resourceCloudflareWebAnalyticsSite(),
 				"cloudflare_worker_cron_trigger":                    resourceCloudflareWorkerCronTrigger(),
 				"cloudflare_worker_route":                           resourceCloudflareWorkerRoute(),
 				"cloudflare_worker_script":                          resourceCloudflareWorkerScript(),
+				"cloudflare_workers_domain":                         resourceCloudflareWorkersDomain(),
 				"cloudflare_workers_kv_namespace":                   resourceCloudflareWorkersKVNamespace(),
 				"cloudflare_workers_kv":                             resourceCloudflareWorkerKV(),
 				"cloudflare_zone_cache_variants":                    resourceCloudflareZoneCacheVariants(),
 				"cloudflare_zone_dnssec":                            resourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_hold":                              resourceCloudflareZoneHold(),
 				"cloudflare_zone_lockdown":                          resourceCloudflareZoneLockdown(),
+				"cloudflare_zone_setting":                           resourceCloudflareZoneSetting(),
 				"cloudflare_zone_settings_override":                 resourceCloudflareZoneSettingsOverride(),
 				"cloudflare_zone":                                   resourceCloudflareZone(),
 			},
@@ -301,8 +356,15 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			"https://" + d.Get("api_hostname").(string) + d.Get("api_base_path").(string),
 		)
 		limitOpt := cloudflare.UsingRateLimit(float64(d.Get("rps").(int)))
-		retryOpt := cloudflare.UsingRetryPolicy(d.Get("retries").(int), d.Get("min_backoff").(int), d.Get("max_backoff").(int))
-		options := []cloudflare.Option{limitOpt, retryOpt, baseURL}
+		// retries are handled by the HTTP transport so that `Retry-After` is
+		// respected; the client's own retry loop is disabled so the two don't
+		// compound.
+		retryOpt := cloudflare.UsingRetryPolicy(0, d.Get("min_backoff").(int), d.Get("max_backoff").(int))
+		httpClient := &http.Client{
+			Transport: newRetryTransport(http.DefaultTransport, d.Get("retries").(int), d.Get("min_backoff").(int), d.Get("max_backoff").(int)),
+		}
+		httpClientOpt := cloudflare.HTTPClient(httpClient)
+		options := []cloudflare.Option{limitOpt, retryOpt, httpClientOpt, baseURL}
 
 		options = append(options, cloudflare.Debug(logging.IsDebugOrHigher()))
 
@@ -338,10 +400,16 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diag.FromErr(err)
 		}
 
+		cacheDNSRecords := !d.Get("disable_dns_record_cache").(bool)
+
 		if accountID, ok := d.GetOk("account_id"); ok {
 			tflog.Info(ctx, fmt.Sprintf("using specified account id %s in Cloudflare provider", accountID.(string)))
 			options = append(options, cloudflare.UsingAccount(accountID.(string)))
 		} else {
+			if cacheDNSRecords {
+				enableDNSRecordCache(client)
+			}
+			providerHTTPClients.Store(client, httpClient)
 			return client, diag.FromErr(err)
 		}
 
@@ -352,6 +420,25 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diag.FromErr(err)
 		}
 
+		if cacheDNSRecords {
+			enableDNSRecordCache(client)
+		}
+		providerHTTPClients.Store(client, httpClient)
+
 		return client, nil
 	}
 }
+
+// providerHTTPClients holds the HTTP client of each configured client, for
+// the requests which cloudflare-go can't make such as reading R2 objects.
+var providerHTTPClients sync.Map
+
+// providerHTTPClient returns the HTTP client of client, with the retries
+// configured for the provider.
+func providerHTTPClient(client *cloudflare.API) *http.Client {
+	if httpClient, ok := providerHTTPClients.Load(client); ok {
+		return httpClient.(*http.Client)
+	}
+
+	return http.DefaultClient
+}
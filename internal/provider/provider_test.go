@@ -171,6 +171,20 @@ func testAccPreCheckBYOIPPrefix(t *testing.T) {
 	}
 }
 
+func testAccPreCheckKeylessCertificate(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_KEYLESS_CERTIFICATE"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_KEYLESS_CERTIFICATE is not set")
+	}
+}
+
+func testAccPreCheckHyperdriveOrigin(t *testing.T) {
+	for _, v := range []string{"CLOUDFLARE_HYPERDRIVE_DATABASE_HOSTNAME", "CLOUDFLARE_HYPERDRIVE_DATABASE_NAME", "CLOUDFLARE_HYPERDRIVE_DATABASE_USER", "CLOUDFLARE_HYPERDRIVE_DATABASE_PASSWORD"} {
+		if os.Getenv(v) == "" {
+			t.Skipf("Skipping acceptance test as %s is not set", v)
+		}
+	}
+}
+
 func generateRandomResourceName() string {
 	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
@@ -2,17 +2,57 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// accessApplicationConfig extends the Access Application with the fields
+// that aren't available in cloudflare-go yet. The saas_app field shadows the
+// one of the embedded application.
+type accessApplicationConfig struct {
+	cloudflare.AccessApplication
+	SaasApplication          *accessApplicationSaasApp `json:"saas_app,omitempty"`
+	CustomNonIdentityDenyURL string                    `json:"custom_non_identity_deny_url,omitempty"`
+	Tags                     *[]string                 `json:"tags,omitempty"`
+	AllowAuthenticateViaWarp *bool                     `json:"allow_authenticate_via_warp,omitempty"`
+	OptionsPreflightBypass   *bool                     `json:"options_preflight_bypass,omitempty"`
+	CustomPages              *[]string                 `json:"custom_pages,omitempty"`
+}
+
+// accessApplicationSaasApp is the SaaS configuration of an Access
+// Application, covering both SAML and OIDC applications.
+type accessApplicationSaasApp struct {
+	AuthType string `json:"auth_type,omitempty"`
+
+	// SAML
+	SPEntityID         string                           `json:"sp_entity_id,omitempty"`
+	ConsumerServiceUrl string                           `json:"consumer_service_url,omitempty"`
+	NameIDFormat       string                           `json:"name_id_format,omitempty"`
+	CustomAttributes   []cloudflare.SAMLAttributeConfig `json:"custom_attributes,omitempty"`
+	IDPEntityID        string                           `json:"idp_entity_id,omitempty"`
+	SSOEndpoint        string                           `json:"sso_endpoint,omitempty"`
+
+	// OIDC
+	RedirectURIs   []string `json:"redirect_uris,omitempty"`
+	GrantTypes     []string `json:"grant_types,omitempty"`
+	Scopes         []string `json:"scopes,omitempty"`
+	AppLauncherURL string   `json:"app_launcher_url,omitempty"`
+	ClientID       string   `json:"client_id,omitempty"`
+	ClientSecret   string   `json:"client_secret,omitempty"`
+
+	PublicKey string `json:"public_key,omitempty"`
+}
+
 func resourceCloudflareAccessApplication() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccessApplicationSchema(),
@@ -23,6 +63,9 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessApplicationImport,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIfChange("type", accessApplicationSaasTypeChanged),
+		),
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Access Application resource. Access
 			Applications are used to restrict access to a whole application using an
@@ -36,7 +79,7 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 
 	appType := d.Get("type").(string)
 
-	newAccessApplication := cloudflare.AccessApplication{
+	newAccessApplication := accessApplicationConfig{AccessApplication: cloudflare.AccessApplication{
 		Name:                    d.Get("name").(string),
 		Domain:                  d.Get("domain").(string),
 		Type:                    cloudflare.AccessApplicationType(appType),
@@ -51,8 +94,18 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 		SkipInterstitial:        cloudflare.BoolPtr(d.Get("skip_interstitial").(bool)),
 		AppLauncherVisible:      cloudflare.BoolPtr(d.Get("app_launcher_visible").(bool)),
 		ServiceAuth401Redirect:  cloudflare.BoolPtr(d.Get("service_auth_401_redirect").(bool)),
+	}}
+
+	newAccessApplication.CustomNonIdentityDenyURL = d.Get("custom_non_identity_deny_url").(string)
+	newAccessApplication.OptionsPreflightBypass = cloudflare.BoolPtr(d.Get("options_preflight_bypass").(bool))
+
+	if value, ok := d.GetOkExists("allow_authenticate_via_warp"); ok {
+		newAccessApplication.AllowAuthenticateViaWarp = cloudflare.BoolPtr(value.(bool))
 	}
 
+	newAccessApplication.Tags = expandAccessApplicationStringSet(d, "tags")
+	newAccessApplication.CustomPages = expandAccessApplicationStringSet(d, "custom_pages")
+
 	if value, ok := d.GetOk("allowed_idps"); ok {
 		newAccessApplication.AllowedIdps = expandInterfaceToStringList(value.(*schema.Set).List())
 	}
@@ -76,19 +129,30 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.CreateAccessApplication(ctx, identifier.Value, newAccessApplication)
-	} else {
-		accessApplication, err = client.CreateZoneLevelAccessApplication(ctx, identifier.Value, newAccessApplication)
-	}
+	accessApplication, err := writeAccessApplication(ctx, client, identifier, http.MethodPost, newAccessApplication)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Access Application for %s %q: %w", identifier.Type, identifier.Value, err))
 	}
 
 	d.SetId(accessApplication.ID)
 
-	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
+	if diags := resourceCloudflareAccessApplicationRead(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	// the OIDC client secret is only returned in the creation response so it
+	// has to be persisted from there.
+	if accessApplication.SaasApplication != nil && accessApplication.SaasApplication.ClientSecret != "" {
+		if saasApp, ok := d.Get("saas_app").([]interface{}); ok && len(saasApp) == 1 {
+			saasConfig := saasApp[0].(map[string]interface{})
+			saasConfig["client_secret"] = accessApplication.SaasApplication.ClientSecret
+			if err := d.Set("saas_app", []interface{}{saasConfig}); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting Access Application SaaS app configuration: %w", err))
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -99,13 +163,7 @@ func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.AccessApplication(ctx, identifier.Value, d.Id())
-	} else {
-		accessApplication, err = client.ZoneLevelAccessApplication(ctx, identifier.Value, d.Id())
-	}
-
+	accessApplication, err := getAccessApplication(ctx, client, identifier, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -132,6 +190,22 @@ func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.Reso
 	d.Set("logo_url", accessApplication.LogoURL)
 	d.Set("app_launcher_visible", accessApplication.AppLauncherVisible)
 	d.Set("service_auth_401_redirect", accessApplication.ServiceAuth401Redirect)
+	d.Set("custom_non_identity_deny_url", accessApplication.CustomNonIdentityDenyURL)
+	if accessApplication.Tags != nil {
+		d.Set("tags", *accessApplication.Tags)
+	} else {
+		d.Set("tags", nil)
+	}
+	if accessApplication.CustomPages != nil {
+		d.Set("custom_pages", *accessApplication.CustomPages)
+	} else {
+		d.Set("custom_pages", nil)
+	}
+	d.Set("options_preflight_bypass", cloudflare.Bool(accessApplication.OptionsPreflightBypass))
+
+	if accessApplication.AllowAuthenticateViaWarp != nil {
+		d.Set("allow_authenticate_via_warp", *accessApplication.AllowAuthenticateViaWarp)
+	}
 
 	corsConfig := convertCORSStructToSchema(d, accessApplication.CorsHeaders)
 	if corsConfigErr := d.Set("cors_headers", corsConfig); corsConfigErr != nil {
@@ -151,7 +225,7 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 
 	appType := d.Get("type").(string)
 
-	updatedAccessApplication := cloudflare.AccessApplication{
+	updatedAccessApplication := accessApplicationConfig{AccessApplication: cloudflare.AccessApplication{
 		ID:                      d.Id(),
 		Name:                    d.Get("name").(string),
 		Domain:                  d.Get("domain").(string),
@@ -167,8 +241,18 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 		SkipInterstitial:        cloudflare.BoolPtr(d.Get("skip_interstitial").(bool)),
 		AppLauncherVisible:      cloudflare.BoolPtr(d.Get("app_launcher_visible").(bool)),
 		ServiceAuth401Redirect:  cloudflare.BoolPtr(d.Get("service_auth_401_redirect").(bool)),
+	}}
+
+	updatedAccessApplication.CustomNonIdentityDenyURL = d.Get("custom_non_identity_deny_url").(string)
+	updatedAccessApplication.OptionsPreflightBypass = cloudflare.BoolPtr(d.Get("options_preflight_bypass").(bool))
+
+	if value, ok := d.GetOkExists("allow_authenticate_via_warp"); ok {
+		updatedAccessApplication.AllowAuthenticateViaWarp = cloudflare.BoolPtr(value.(bool))
 	}
 
+	updatedAccessApplication.Tags = expandAccessApplicationStringSet(d, "tags")
+	updatedAccessApplication.CustomPages = expandAccessApplicationStringSet(d, "custom_pages")
+
 	if appType != "saas" {
 		updatedAccessApplication.Domain = d.Get("domain").(string)
 	}
@@ -197,12 +281,7 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
-		accessApplication, err = client.UpdateAccessApplication(ctx, identifier.Value, updatedAccessApplication)
-	} else {
-		accessApplication, err = client.UpdateZoneLevelAccessApplication(ctx, identifier.Value, updatedAccessApplication)
-	}
+	accessApplication, err := writeAccessApplication(ctx, client, identifier, http.MethodPut, updatedAccessApplication)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Access Application for %s %q: %w", identifier.Type, identifier.Value, err))
 	}
@@ -263,3 +342,67 @@ func resourceCloudflareAccessApplicationImport(ctx context.Context, d *schema.Re
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// accessApplicationSaasTypeChanged reports whether an application is
+// switched between a SaaS application and any other type, which can't be
+// done in place.
+func accessApplicationSaasTypeChanged(ctx context.Context, old, new, meta interface{}) bool {
+	return (old.(string) == "saas") != (new.(string) == "saas")
+}
+
+// expandAccessApplicationStringSet returns the values of a set attribute to
+// send to the API. Removing all values sends an empty list as omitting the
+// field keeps the values of the application.
+func expandAccessApplicationStringSet(d *schema.ResourceData, key string) *[]string {
+	if value, ok := d.GetOk(key); ok {
+		values := expandInterfaceToStringList(value.(*schema.Set).List())
+		return &values
+	}
+
+	if old, _ := d.GetChange(key); old.(*schema.Set).Len() > 0 {
+		return &[]string{}
+	}
+
+	return nil
+}
+
+func accessApplicationURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/access/apps", identifier.Type, identifier.Value)
+}
+
+func getAccessApplication(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, applicationID string) (accessApplicationConfig, error) {
+	var app accessApplicationConfig
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", accessApplicationURI(identifier), applicationID), nil, nil)
+	if err != nil {
+		return app, err
+	}
+
+	if err := json.Unmarshal(res, &app); err != nil {
+		return app, fmt.Errorf("error unmarshalling Access Application: %w", err)
+	}
+
+	return app, nil
+}
+
+// writeAccessApplication creates (POST) or updates (PUT) an Access
+// Application.
+func writeAccessApplication(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, method string, app accessApplicationConfig) (accessApplicationConfig, error) {
+	uri := accessApplicationURI(identifier)
+	if method == http.MethodPut {
+		uri = fmt.Sprintf("%s/%s", uri, app.ID)
+	}
+
+	var result accessApplicationConfig
+
+	res, err := client.Raw(ctx, method, uri, app, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Access Application: %w", err)
+	}
+
+	return result, nil
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -184,6 +187,53 @@ func TestAccCloudflareAccessApplication_WithSaas(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "saas_app.0.sp_entity_id", "saas-app.example"),
 					resource.TestCheckResourceAttr(name, "saas_app.0.consumer_service_url", "https://saas-app.example/sso/saml/consume"),
 					resource.TestCheckResourceAttr(name, "saas_app.0.name_id_format", "email"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.idp_entity_id"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.sso_endpoint"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.public_key"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.custom_attributes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "saas_app.0.custom_attributes.*", map[string]string{
+						"name":          "email",
+						"name_format":   "urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+						"friendly_name": "Email",
+						"required":      "true",
+						"source.0.name": "user_email",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "saas_app.0.custom_attributes.*", map[string]string{
+						"name":          "rank",
+						"source.0.name": "rank",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithSaasOIDC(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithSaasOIDC(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "type", "saas"),
+					resource.TestCheckResourceAttr(name, "saas_app.#", "1"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.auth_type", "oidc"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.redirect_uris.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "saas_app.0.redirect_uris.*", "https://saas-app.example/sso/oauth2/callback"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.grant_types.#", "2"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.scopes.#", "4"),
+					resource.TestCheckResourceAttr(name, "saas_app.0.app_launcher_url", "https://saas-app.example/sso/login"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.client_id"),
+					resource.TestCheckResourceAttrSet(name, "saas_app.0.client_secret"),
 				),
 			},
 		},
@@ -264,6 +314,7 @@ func TestAccCloudflareAccessApplication_WithCustomDenyFields(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "session_duration", "24h"),
 					resource.TestCheckResourceAttr(name, "custom_deny_message", "denied!"),
 					resource.TestCheckResourceAttr(name, "custom_deny_url", "https://www.cloudflare.com"),
+					resource.TestCheckResourceAttr(name, "custom_non_identity_deny_url", "https://www.cloudflare.com/non-identity"),
 				),
 			},
 		},
@@ -475,6 +526,55 @@ func TestAccCloudflareAccessApplication_WithAppLauncherVisible(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessApplication_WithTags(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithTags(rnd, domain, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "tags.*", rnd),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithWarpAndPreflightBypass(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithWarpAndPreflightBypass(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "allow_authenticate_via_warp", "false"),
+					resource.TestCheckResourceAttr(name, "options_preflight_bypass", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareAccessApplicationConfigBasic(rnd string, domain string, identifier AccessIdentifier) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -518,12 +618,47 @@ resource "cloudflare_access_application" "%[1]s" {
     consumer_service_url = "https://saas-app.example/sso/saml/consume"
     sp_entity_id  = "saas-app.example"
     name_id_format =  "email"
+
+    custom_attributes {
+      name          = "email"
+      name_format   = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+      friendly_name = "Email"
+      required      = true
+      source {
+        name = "user_email"
+      }
+    }
+
+    custom_attributes {
+      name = "rank"
+      source {
+        name = "rank"
+      }
+    }
   }
   auto_redirect_to_identity = false
 }
 `, rnd, accountID)
 }
 
+func testAccCloudflareAccessApplicationConfigWithSaasOIDC(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  type             = "saas"
+  session_duration = "24h"
+  saas_app {
+    auth_type        = "oidc"
+    redirect_uris    = ["https://saas-app.example/sso/oauth2/callback"]
+    grant_types      = ["authorization_code", "authorization_code_with_pkce"]
+    scopes           = ["openid", "email", "profile", "groups"]
+    app_launcher_url = "https://saas-app.example/sso/login"
+  }
+}
+`, rnd, accountID)
+}
+
 func testAccCloudflareAccessApplicationConfigWithAutoRedirectToIdentity(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_identity_provider" "%[1]s" {
@@ -562,13 +697,14 @@ resource "cloudflare_access_application" "%[1]s" {
 func testAccCloudflareAccessApplicationConfigWithCustomDenyFields(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
-  zone_id                   = "%[2]s"
-  name                      = "%[1]s"
-  domain                    = "%[1]s.%[3]s"
-  type                      = "self_hosted"
-  session_duration          = "24h"
-  custom_deny_message       = "denied!"
-  custom_deny_url           = "https://www.cloudflare.com"
+  zone_id                      = "%[2]s"
+  name                         = "%[1]s"
+  domain                       = "%[1]s.%[3]s"
+  type                         = "self_hosted"
+  session_duration             = "24h"
+  custom_deny_message          = "denied!"
+  custom_deny_url              = "https://www.cloudflare.com"
+  custom_non_identity_deny_url = "https://www.cloudflare.com/non-identity"
 }
 `, rnd, zoneID, domain)
 }
@@ -689,6 +825,38 @@ resource "cloudflare_access_application" "%[1]s" {
 `, rnd, zoneID, domain)
 }
 
+func testAccCloudflareAccessApplicationConfigWithTags(rnd, domain, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_tag" "%[1]s" {
+  account_id = "%[3]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_access_application" "%[1]s" {
+  account_id       = "%[3]s"
+  name             = "%[1]s"
+  domain           = "%[1]s.%[2]s"
+  type             = "self_hosted"
+  session_duration = "24h"
+  tags             = [cloudflare_access_tag.%[1]s.name]
+}
+`, rnd, domain, accountID)
+}
+
+func testAccCloudflareAccessApplicationConfigWithWarpAndPreflightBypass(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  zone_id                     = "%[2]s"
+  name                        = "%[1]s"
+  domain                      = "%[1]s.%[3]s"
+  type                        = "self_hosted"
+  session_duration            = "24h"
+  allow_authenticate_via_warp = false
+  options_preflight_bypass    = true
+}
+`, rnd, zoneID, domain)
+}
+
 func testAccCloudflareAccessApplicationConfigLogoURL(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -959,3 +1127,53 @@ func testAccessApplicationMisconfiguredCORSAllowWildcardOriginWithCredentials(re
   }
   `, resourceID, zone, zoneID)
 }
+
+func TestCloudflareAccessApplicationUpdateClearsTags(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const appID = "ee5b9f1f-1e5e-4d5c-9c4b-7f1a2b3c4d5e"
+
+	var body map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/"+accountID+"/access/apps/"+appID {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+		}
+		testAPIResult(w, `{"id": "`+appID+`", "name": "app", "domain": "example.com", "type": "self_hosted"}`)
+	})
+
+	r := resourceCloudflareAccessApplication()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"account_id":   accountID,
+		"name":         "app",
+		"domain":       "example.com",
+		"tags":         []interface{}{"engineering"},
+		"custom_pages": []interface{}{"699d98642c564d2e855e9661899b7252"},
+	})
+	d.SetId(appID)
+
+	state := d.State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": accountID,
+		"name":       "app",
+		"domain":     "example.com",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for _, key := range []string{"tags", "custom_pages"} {
+		if values, ok := body[key].([]interface{}); !ok || len(values) != 0 {
+			t.Errorf("expected %s to be cleared with an empty list, got %#v", key, body[key])
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessCustomPageInUseErrorCode is returned when deleting a custom page which
+// is still assigned to Access Applications.
+const accessCustomPageInUseErrorCode = 12130
+
+// accessCustomPage is the representation of a custom HTML page shown by
+// Access when a user is denied access to an application.
+type accessCustomPage struct {
+	UID        string `json:"uid,omitempty"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	CustomHTML string `json:"custom_html"`
+	AppCount   int    `json:"app_count,omitempty"`
+}
+
+func resourceCloudflareAccessCustomPage() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAccessCustomPageSchema(),
+		CreateContext: resourceCloudflareAccessCustomPageCreate,
+		ReadContext:   resourceCloudflareAccessCustomPageRead,
+		UpdateContext: resourceCloudflareAccessCustomPageUpdate,
+		DeleteContext: resourceCloudflareAccessCustomPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAccessCustomPageImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to customize the pages your end users will
+			see when trying to reach applications behind Cloudflare Access.
+		`),
+	}
+}
+
+func resourceCloudflareAccessCustomPageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	page := buildAccessCustomPage(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Custom Page %s", page.Name))
+
+	res, err := client.Raw(ctx, http.MethodPost, accessCustomPageURI(identifier), page, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Access Custom Page for %s %q: %w", identifier.Type, identifier.Value, err))
+	}
+
+	var result accessCustomPage
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Access Custom Page: %w", err))
+	}
+
+	d.SetId(result.UID)
+
+	return resourceCloudflareAccessCustomPageRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessCustomPageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", accessCustomPageURI(identifier), d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Access Custom Page %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Access Custom Page %q: %w", d.Id(), err))
+	}
+
+	var page accessCustomPage
+	if err := json.Unmarshal(res, &page); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Access Custom Page: %w", err))
+	}
+
+	d.Set("name", page.Name)
+	d.Set("type", page.Type)
+	d.Set("custom_html", page.CustomHTML)
+	d.Set("app_count", page.AppCount)
+
+	return nil
+}
+
+func resourceCloudflareAccessCustomPageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	page := buildAccessCustomPage(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Custom Page %s", d.Id()))
+
+	_, err = client.Raw(ctx, http.MethodPut, fmt.Sprintf("%s/%s", accessCustomPageURI(identifier), d.Id()), page, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Access Custom Page %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareAccessCustomPageRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessCustomPageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Custom Page %s", d.Id()))
+
+	_, err = client.Raw(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", accessCustomPageURI(identifier), d.Id()), nil, nil)
+	if err != nil {
+		var requestError *cloudflare.RequestError
+		if errors.As(err, &requestError) && requestError.InternalErrorCodeIs(accessCustomPageInUseErrorCode) {
+			return diag.Errorf("Access Custom Page %q is still assigned to Access Applications. Remove it from the `custom_pages` of those applications before deleting it: %s", d.Id(), err)
+		}
+		return diag.FromErr(fmt.Errorf("error deleting Access Custom Page %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAccessCustomPageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	// The "accountID/customPageID" form predates zone level custom pages and
+	// is still accepted.
+	if len(attributes) == 2 {
+		attributes = append([]string{string(AccountType)}, attributes...)
+	}
+
+	if len(attributes) != 3 || (AccessIdentifierType(attributes[0]) != AccountType && AccessIdentifierType(attributes[0]) != ZoneType) {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/customPageID\" or \"zone/zoneID/customPageID\"", d.Id())
+	}
+
+	identifierType, identifierID, customPageID := attributes[0], attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Access Custom Page: id %s for %s %s", customPageID, identifierType, identifierID))
+
+	//lintignore:R001
+	d.Set(fmt.Sprintf("%s_id", identifierType), identifierID)
+	d.SetId(customPageID)
+
+	readErr := resourceCloudflareAccessCustomPageRead(ctx, d, meta)
+	if readErr != nil {
+		return nil, errors.New("failed to read Access Custom Page state")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func accessCustomPageURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/access/custom_pages", identifier.Type, identifier.Value)
+}
+
+func buildAccessCustomPage(d *schema.ResourceData) accessCustomPage {
+	return accessCustomPage{
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		CustomHTML: d.Get("custom_html").(string),
+	}
+}
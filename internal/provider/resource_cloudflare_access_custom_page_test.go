@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareAccessCustomPage_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_access_custom_page." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessCustomPageConfig(rnd, accountID, "<html><body><h1>Access Denied</h1></body></html>"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttr(resourceName, "name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "type", "forbidden"),
+					resource.TestCheckResourceAttr(resourceName, "custom_html", "<html><body><h1>Access Denied</h1></body></html>"),
+					resource.TestCheckResourceAttr(resourceName, "app_count", "0"),
+				),
+			},
+			{
+				Config: testAccCloudflareAccessCustomPageConfig(rnd, accountID, "<html><body><h1>Forbidden</h1></body></html>"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "custom_html", "<html><body><h1>Forbidden</h1></body></html>"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessCustomPage_WithApplication(t *testing.T) {
+	rnd := generateRandomResourceName()
+	appName := "cloudflare_access_application." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessCustomPageConfigWithApplication(rnd, accountID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(appName, "custom_pages.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(appName, "custom_pages.*", "cloudflare_access_custom_page."+rnd, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessCustomPageConfig(rnd, accountID, html string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_custom_page" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  type        = "forbidden"
+  custom_html = "%[3]s"
+}
+`, rnd, accountID, html)
+}
+
+func testAccCloudflareAccessCustomPageConfigWithApplication(rnd, accountID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_custom_page" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  type        = "identity_denied"
+  custom_html = "<html><body><h1>Identity Denied</h1></body></html>"
+}
+
+resource "cloudflare_access_application" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  domain           = "%[1]s.%[3]s"
+  type             = "self_hosted"
+  session_duration = "24h"
+  custom_pages     = [cloudflare_access_custom_page.%[1]s.id]
+}
+`, rnd, accountID, domain)
+}
+
+func TestCloudflareAccessCustomPageImport(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const customPageID = "699d98642c564d2e855e9661899b7252"
+
+	testCases := map[string]struct {
+		id       string
+		path     string
+		expected map[string]string
+	}{
+		"account":        {id: "account/" + accountID + "/" + customPageID, path: "/accounts/" + accountID, expected: map[string]string{"account_id": accountID, "zone_id": ""}},
+		"zone":           {id: "zone/" + zoneID + "/" + customPageID, path: "/zones/" + zoneID, expected: map[string]string{"account_id": "", "zone_id": zoneID}},
+		"without prefix": {id: accountID + "/" + customPageID, path: "/accounts/" + accountID, expected: map[string]string{"account_id": accountID, "zone_id": ""}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != tc.path+"/access/custom_pages/"+customPageID {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				testAPIResult(w, `{"uid": "`+customPageID+`", "name": "example", "type": "forbidden", "custom_html": "<html></html>", "app_count": 1}`)
+			})
+
+			r := resourceCloudflareAccessCustomPage()
+			d := r.Data(nil)
+			d.SetId(tc.id)
+
+			imported, err := r.Importer.StateContext(context.Background(), d, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d = imported[0]
+			if d.Id() != customPageID {
+				t.Errorf("expected ID %q, got %q", customPageID, d.Id())
+			}
+			for key, value := range tc.expected {
+				if got := d.Get(key).(string); got != value {
+					t.Errorf("expected %s %q, got %q", key, value, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudflareAccessCustomPageDeleteInUse(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const customPageID = "699d98642c564d2e855e9661899b7252"
+
+	testCases := map[string]struct {
+		code     int
+		expected string
+	}{
+		"assigned to applications": {code: accessCustomPageInUseErrorCode, expected: "is still assigned to Access Applications"},
+		"other error":              {code: 12000, expected: "error deleting Access Custom Page"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				testAPIError(w, http.StatusBadRequest, tc.code, "unable to delete custom page")
+			})
+
+			// app_count is left at 0 as it can be stale.
+			d := schema.TestResourceDataRaw(t, resourceCloudflareAccessCustomPageSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"name":       "example",
+				"type":       "forbidden",
+			})
+			d.SetId(customPageID)
+
+			diags := resourceCloudflareAccessCustomPageDelete(context.Background(), d, client)
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.expected) {
+				t.Errorf("expected an error containing %q, got %v", tc.expected, diags)
+			}
+		})
+	}
+}
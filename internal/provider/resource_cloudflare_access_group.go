@@ -179,6 +179,16 @@ func resourceCloudflareAccessGroupImport(ctx context.Context, d *schema.Resource
 	return []*schema.ResourceData{d}, nil
 }
 
+// accessGroupAuthContext is used to configure access based on an Azure AD
+// authentication context, which isn't available in cloudflare-go yet.
+type accessGroupAuthContext struct {
+	AuthContext struct {
+		ID                 string `json:"id"`
+		AuthContextID      string `json:"ac_id"`
+		IdentityProviderID string `json:"identity_provider_id"`
+	} `json:"auth_context"`
+}
+
 // appendConditionalAccessGroupFields determines which of the
 // conditional group enforcement fields it should append to the
 // AccessGroup by iterating over the provided values and generating the
@@ -292,6 +302,15 @@ func BuildAccessGroupCondition(options map[string]interface{}) []interface{} {
 					}})
 				}
 			}
+		} else if accessGroupType == "auth_context" {
+			for _, v := range values.([]interface{}) {
+				authContextCfg := v.(map[string]interface{})
+				authContext := accessGroupAuthContext{}
+				authContext.AuthContext.ID = authContextCfg["id"].(string)
+				authContext.AuthContext.AuthContextID = authContextCfg["ac_id"].(string)
+				authContext.AuthContext.IdentityProviderID = authContextCfg["identity_provider_id"].(string)
+				group = append(group, authContext)
+			}
 		} else if accessGroupType == "okta" {
 			for _, v := range values.([]interface{}) {
 				oktaCfg := v.(map[string]interface{})
@@ -400,8 +419,9 @@ func TransformAccessGroupForSchema(ctx context.Context, accessGroup []interface{
 	githubName := ""
 	githubTeams := []string{}
 	githubID := ""
-	azureID := ""
-	azureIDs := []string{}
+	azureIdentityProviders := []string{}
+	azureIDs := map[string][]string{}
+	authContexts := []map[string]interface{}{}
 	samlGroups := []map[string]string{}
 	externalEvaluationURL := ""
 	externalEvaluationKeysURL := ""
@@ -464,9 +484,21 @@ func TransformAccessGroupForSchema(ctx context.Context, accessGroup []interface{
 					githubTeams = append(githubTeams, v.(string))
 				}
 			case "azureAD":
+				// Azure groups are expanded into one condition per group ID,
+				// so collapse them back into a block per identity provider.
 				azureCfg := groupValue.(map[string]interface{})
-				azureID = azureCfg["identity_provider_id"].(string)
-				azureIDs = append(azureIDs, azureCfg["id"].(string))
+				azureIdP := azureCfg["identity_provider_id"].(string)
+				if _, ok := azureIDs[azureIdP]; !ok {
+					azureIdentityProviders = append(azureIdentityProviders, azureIdP)
+				}
+				azureIDs[azureIdP] = append(azureIDs[azureIdP], azureCfg["id"].(string))
+			case "auth_context":
+				authContextCfg := groupValue.(map[string]interface{})
+				authContexts = append(authContexts, map[string]interface{}{
+					"id":                   authContextCfg["id"].(string),
+					"ac_id":                authContextCfg["ac_id"].(string),
+					"identity_provider_id": authContextCfg["identity_provider_id"].(string),
+				})
 			case "saml":
 				samlCfg := groupValue.(map[string]interface{})
 				samlAttrName := samlCfg["attribute_name"].(string)
@@ -565,13 +597,19 @@ func TransformAccessGroupForSchema(ctx context.Context, accessGroup []interface{
 		}
 	}
 
-	if len(azureIDs) > 0 && azureID != "" {
-		groupMap["azure"] = []interface{}{
-			map[string]interface{}{
-				"identity_provider_id": azureID,
-				"id":                   azureIDs,
-			},
+	if len(azureIdentityProviders) > 0 {
+		azure := []interface{}{}
+		for _, azureIdP := range azureIdentityProviders {
+			azure = append(azure, map[string]interface{}{
+				"identity_provider_id": azureIdP,
+				"id":                   azureIDs[azureIdP],
+			})
 		}
+		groupMap["azure"] = azure
+	}
+
+	if len(authContexts) > 0 {
+		groupMap["auth_context"] = authContexts
 	}
 
 	if len(samlGroups) > 0 {
@@ -275,6 +275,68 @@ func TestAccCloudflareAccessGroupWithIDP(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessGroup_AuthContext(t *testing.T) {
+	rnd := generateRandomResourceName()
+	groupName := fmt.Sprintf("cloudflare_access_group.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupWithAuthContext(accountID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareAccessGroupExists(groupName, AccessIdentifier{Type: AccountType, Value: accountID}, &accessGroup),
+					resource.TestCheckResourceAttr(groupName, "account_id", accountID),
+					resource.TestCheckResourceAttr(groupName, "name", rnd),
+					resource.TestCheckResourceAttr(groupName, "include.0.auth_context.#", "1"),
+					resource.TestCheckResourceAttr(groupName, "include.0.auth_context.0.id", "6085da9e-e2a9-4ae4-8c6b-8746b1f1cf82"),
+					resource.TestCheckResourceAttr(groupName, "include.0.auth_context.0.ac_id", "c1"),
+					resource.TestCheckResourceAttrSet(groupName, "include.0.auth_context.0.identity_provider_id"),
+				),
+			},
+			{
+				Config:   testAccCloudflareAccessGroupWithAuthContext(accountID, rnd),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessGroup_AzureGroupIDs(t *testing.T) {
+	rnd := generateRandomResourceName()
+	groupName := fmt.Sprintf("cloudflare_access_group.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupWithAzureGroupIDs(accountID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupName, "name", rnd),
+					resource.TestCheckResourceAttr(groupName, "include.0.azure.#", "1"),
+					resource.TestCheckResourceAttr(groupName, "include.0.azure.0.id.#", "2"),
+					resource.TestCheckResourceAttr(groupName, "include.0.azure.0.id.0", "aa0a4aab-672b-4bdb-bc33-a59f1130a11f"),
+					resource.TestCheckResourceAttr(groupName, "include.0.azure.0.id.1", "bb0a4aab-672b-4bdb-bc33-a59f1130a11f"),
+				),
+			},
+			{
+				Config:   testAccCloudflareAccessGroupWithAzureGroupIDs(accountID, rnd),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudflareAccessGroup_Updated(t *testing.T) {
 	var before, after cloudflare.AccessGroup
 	rnd := generateRandomResourceName()
@@ -463,6 +525,59 @@ resource "cloudflare_access_group" "%[2]s" {
 }`, accountID, rnd, githubOrg, team)
 }
 
+func testAccCloudflareAccessGroupWithAuthContext(accountID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_identity_provider" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  type       = "azureAD"
+  config {
+    client_id     = "test"
+    client_secret = "secret"
+    directory_id  = "directory"
+  }
+}
+
+resource "cloudflare_access_group" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+
+  include {
+    auth_context {
+      id                   = "6085da9e-e2a9-4ae4-8c6b-8746b1f1cf82"
+      ac_id                = "c1"
+      identity_provider_id = cloudflare_access_identity_provider.%[2]s.id
+    }
+  }
+}`, accountID, rnd)
+}
+
+func testAccCloudflareAccessGroupWithAzureGroupIDs(accountID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_identity_provider" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  type       = "azureAD"
+  config {
+    client_id     = "test"
+    client_secret = "secret"
+    directory_id  = "directory"
+  }
+}
+
+resource "cloudflare_access_group" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+
+  include {
+    azure {
+      id                   = ["aa0a4aab-672b-4bdb-bc33-a59f1130a11f", "bb0a4aab-672b-4bdb-bc33-a59f1130a11f"]
+      identity_provider_id = cloudflare_access_identity_provider.%[2]s.id
+    }
+  }
+}`, accountID, rnd)
+}
+
 func testAccCheckCloudflareAccessGroupExists(n string, accessIdentifier AccessIdentifier, accessGroup *cloudflare.AccessGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -15,6 +17,23 @@ import (
 
 const CONCEALED_STRING = "**********************************"
 
+// accessIdentityProviderConfig extends the Access Identity Provider with the
+// SCIM provisioning configuration that isn't available in cloudflare-go yet.
+type accessIdentityProviderConfig struct {
+	cloudflare.AccessIdentityProvider
+	ScimConfig *accessIdentityProviderScimConfig `json:"scim_config,omitempty"`
+}
+
+// accessIdentityProviderScimConfig is the SCIM provisioning configuration of
+// an Access Identity Provider.
+type accessIdentityProviderScimConfig struct {
+	Enabled                bool   `json:"enabled"`
+	Secret                 string `json:"secret,omitempty"`
+	UserDeprovision        bool   `json:"user_deprovision"`
+	SeatDeprovision        bool   `json:"seat_deprovision"`
+	GroupMemberDeprovision bool   `json:"group_member_deprovision"`
+}
+
 func resourceCloudflareAccessIdentityProvider() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccessIdentityProviderSchema(),
@@ -41,12 +60,7 @@ func resourceCloudflareAccessIdentityProviderRead(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	var accessIdentityProvider cloudflare.AccessIdentityProvider
-	if identifier.Type == AccountType {
-		accessIdentityProvider, err = client.AccessIdentityProviderDetails(ctx, identifier.Value, d.Id())
-	} else {
-		accessIdentityProvider, err = client.ZoneLevelAccessIdentityProviderDetails(ctx, identifier.Value, d.Id())
-	}
+	accessIdentityProvider, err := getAccessIdentityProvider(ctx, client, identifier, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -66,6 +80,11 @@ func resourceCloudflareAccessIdentityProviderRead(ctx context.Context, d *schema
 		return diag.FromErr(fmt.Errorf("error setting Access Identity Provider configuration: %w", configErr))
 	}
 
+	scimConfig := convertScimConfigStructToSchema(d, accessIdentityProvider.ScimConfig)
+	if scimConfigErr := d.Set("scim_config", scimConfig); scimConfigErr != nil {
+		return diag.FromErr(fmt.Errorf("error setting Access Identity Provider SCIM configuration: %w", scimConfigErr))
+	}
+
 	return nil
 }
 
@@ -74,11 +93,12 @@ func resourceCloudflareAccessIdentityProviderCreate(ctx context.Context, d *sche
 
 	IDPConfig, _ := convertSchemaToStruct(d)
 
-	identityProvider := cloudflare.AccessIdentityProvider{
+	identityProvider := accessIdentityProviderConfig{AccessIdentityProvider: cloudflare.AccessIdentityProvider{
 		Name:   d.Get("name").(string),
 		Type:   d.Get("type").(string),
 		Config: IDPConfig,
-	}
+	}}
+	identityProvider.ScimConfig = convertScimConfigSchemaToStruct(d)
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Identity Provider from struct: %+v", identityProvider))
 
@@ -87,19 +107,14 @@ func resourceCloudflareAccessIdentityProviderCreate(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
-	var accessIdentityProvider cloudflare.AccessIdentityProvider
-	if identifier.Type == AccountType {
-		accessIdentityProvider, err = client.CreateAccessIdentityProvider(ctx, identifier.Value, identityProvider)
-	} else {
-		accessIdentityProvider, err = client.CreateZoneLevelAccessIdentityProvider(ctx, identifier.Value, identityProvider)
-	}
+	accessIdentityProvider, err := writeAccessIdentityProvider(ctx, client, identifier, http.MethodPost, identityProvider)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Access Identity Provider for ID %q: %w", d.Id(), err))
 	}
 
 	d.SetId(accessIdentityProvider.ID)
 
-	return resourceCloudflareAccessIdentityProviderRead(ctx, d, meta)
+	return resourceCloudflareAccessIdentityProviderReadWithScimSecret(ctx, d, meta, accessIdentityProvider.ScimConfig)
 }
 
 func resourceCloudflareAccessIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -111,11 +126,13 @@ func resourceCloudflareAccessIdentityProviderUpdate(ctx context.Context, d *sche
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("updatedConfig: %+v", IDPConfig))
-	updatedAccessIdentityProvider := cloudflare.AccessIdentityProvider{
+	updatedAccessIdentityProvider := accessIdentityProviderConfig{AccessIdentityProvider: cloudflare.AccessIdentityProvider{
+		ID:     d.Id(),
 		Name:   d.Get("name").(string),
 		Type:   d.Get("type").(string),
 		Config: IDPConfig,
-	}
+	}}
+	updatedAccessIdentityProvider.ScimConfig = convertScimConfigSchemaToStruct(d)
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Identity Provider from struct: %+v", updatedAccessIdentityProvider))
 
@@ -124,12 +141,7 @@ func resourceCloudflareAccessIdentityProviderUpdate(ctx context.Context, d *sche
 		return diag.FromErr(err)
 	}
 
-	var accessIdentityProvider cloudflare.AccessIdentityProvider
-	if identifier.Type == AccountType {
-		accessIdentityProvider, err = client.UpdateAccessIdentityProvider(ctx, identifier.Value, d.Id(), updatedAccessIdentityProvider)
-	} else {
-		accessIdentityProvider, err = client.UpdateZoneLevelAccessIdentityProvider(ctx, identifier.Value, d.Id(), updatedAccessIdentityProvider)
-	}
+	accessIdentityProvider, err := writeAccessIdentityProvider(ctx, client, identifier, http.MethodPut, updatedAccessIdentityProvider)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Access Identity Provider for ID %q: %w", d.Id(), err))
 	}
@@ -138,7 +150,31 @@ func resourceCloudflareAccessIdentityProviderUpdate(ctx context.Context, d *sche
 		return diag.FromErr(fmt.Errorf("failed to find Access Identity Provider ID in update response; resource was empty"))
 	}
 
-	return resourceCloudflareAccessIdentityProviderRead(ctx, d, meta)
+	return resourceCloudflareAccessIdentityProviderReadWithScimSecret(ctx, d, meta, accessIdentityProvider.ScimConfig)
+}
+
+// resourceCloudflareAccessIdentityProviderReadWithScimSecret refreshes the
+// state and persists the SCIM secret from a write response, as it can't be
+// retrieved afterwards.
+func resourceCloudflareAccessIdentityProviderReadWithScimSecret(ctx context.Context, d *schema.ResourceData, meta interface{}, scimConfig *accessIdentityProviderScimConfig) diag.Diagnostics {
+	diags := resourceCloudflareAccessIdentityProviderRead(ctx, d, meta)
+	if diags.HasError() || scimConfig == nil || !isScimSecretRevealed(scimConfig.Secret) {
+		return diags
+	}
+
+	if _, ok := d.GetOk("scim_config.0"); ok {
+		d.Set("scim_config", []interface{}{
+			map[string]interface{}{
+				"enabled":                  d.Get("scim_config.0.enabled"),
+				"secret":                   scimConfig.Secret,
+				"user_deprovision":         d.Get("scim_config.0.user_deprovision"),
+				"seat_deprovision":         d.Get("scim_config.0.seat_deprovision"),
+				"group_member_deprovision": d.Get("scim_config.0.group_member_deprovision"),
+			},
+		})
+	}
+
+	return diags
 }
 
 func resourceCloudflareAccessIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -257,3 +293,89 @@ func convertStructToSchema(d *schema.ResourceData, options cloudflare.AccessIden
 
 	return []interface{}{m}
 }
+
+// convertScimConfigSchemaToStruct builds the SCIM configuration to send to
+// the API. The known secret is sent back so that updating the configuration
+// doesn't rotate it.
+func convertScimConfigSchemaToStruct(d *schema.ResourceData) *accessIdentityProviderScimConfig {
+	if _, ok := d.GetOk("scim_config"); !ok {
+		return nil
+	}
+
+	return &accessIdentityProviderScimConfig{
+		Enabled:                d.Get("scim_config.0.enabled").(bool),
+		Secret:                 d.Get("scim_config.0.secret").(string),
+		UserDeprovision:        d.Get("scim_config.0.user_deprovision").(bool),
+		SeatDeprovision:        d.Get("scim_config.0.seat_deprovision").(bool),
+		GroupMemberDeprovision: d.Get("scim_config.0.group_member_deprovision").(bool),
+	}
+}
+
+// convertScimConfigStructToSchema flattens the SCIM configuration. The API
+// conceals the secret once it has been issued, so the value already in state
+// is kept instead.
+func convertScimConfigStructToSchema(d *schema.ResourceData, scimConfig *accessIdentityProviderScimConfig) []interface{} {
+	if scimConfig == nil {
+		return []interface{}{}
+	}
+
+	secret := d.Get("scim_config.0.secret").(string)
+	if isScimSecretRevealed(scimConfig.Secret) {
+		secret = scimConfig.Secret
+	}
+
+	m := map[string]interface{}{
+		"enabled":                  scimConfig.Enabled,
+		"secret":                   secret,
+		"user_deprovision":         scimConfig.UserDeprovision,
+		"seat_deprovision":         scimConfig.SeatDeprovision,
+		"group_member_deprovision": scimConfig.GroupMemberDeprovision,
+	}
+
+	return []interface{}{m}
+}
+
+func isScimSecretRevealed(secret string) bool {
+	return secret != "" && secret != CONCEALED_STRING
+}
+
+func accessIdentityProviderURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/access/identity_providers", identifier.Type, identifier.Value)
+}
+
+func getAccessIdentityProvider(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, identityProviderID string) (accessIdentityProviderConfig, error) {
+	var identityProvider accessIdentityProviderConfig
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", accessIdentityProviderURI(identifier), identityProviderID), nil, nil)
+	if err != nil {
+		return identityProvider, err
+	}
+
+	if err := json.Unmarshal(res, &identityProvider); err != nil {
+		return identityProvider, fmt.Errorf("error unmarshalling Access Identity Provider: %w", err)
+	}
+
+	return identityProvider, nil
+}
+
+// writeAccessIdentityProvider creates (POST) or updates (PUT) an Access
+// Identity Provider.
+func writeAccessIdentityProvider(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, method string, identityProvider accessIdentityProviderConfig) (accessIdentityProviderConfig, error) {
+	uri := accessIdentityProviderURI(identifier)
+	if method == http.MethodPut {
+		uri = fmt.Sprintf("%s/%s", uri, identityProvider.ID)
+	}
+
+	var result accessIdentityProviderConfig
+
+	res, err := client.Raw(ctx, method, uri, identityProvider, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Access Identity Provider: %w", err)
+	}
+
+	return result, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func init() {
@@ -156,6 +157,42 @@ func TestAccCloudflareAccessIdentityProvider_OAuthWithUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessIdentityProvider_ScimConfig(t *testing.T) {
+	t.Parallel()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_access_identity_provider." + rnd
+	var scimSecret string
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareAccessIdentityProviderScimConfig(accountID, rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "scim_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scim_config.0.user_deprovision", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "scim_config.0.secret"),
+					testAccCaptureResourceAttr(resourceName, "scim_config.0.secret", &scimSecret),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareAccessIdentityProviderScimConfig(accountID, rnd, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scim_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scim_config.0.user_deprovision", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scim_config.0.seat_deprovision", "true"),
+					resource.TestCheckResourceAttrPtr(resourceName, "scim_config.0.secret", &scimSecret),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareAccessIdentityProvider_SAML(t *testing.T) {
 	t.Parallel()
 	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
@@ -222,6 +259,39 @@ resource "cloudflare_access_identity_provider" "%[2]s" {
 }`, accountID, name)
 }
 
+func testAccCheckCloudflareAccessIdentityProviderScimConfig(accountID, name string, deprovision bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_identity_provider" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  type       = "azureAD"
+  config {
+    client_id     = "test"
+    client_secret = "secret"
+    directory_id  = "directory"
+  }
+  scim_config {
+    enabled                  = true
+    user_deprovision         = %[3]t
+    seat_deprovision         = %[3]t
+    group_member_deprovision = %[3]t
+  }
+}`, accountID, name, deprovision)
+}
+
+func testAccCaptureResourceAttr(resourceName, key string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		*value = rs.Primary.Attributes[key]
+
+		return nil
+	}
+}
+
 func testAccCheckCloudflareAccessIdentityProviderSAML(accountID, name string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_identity_provider" "%[2]s" {
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
@@ -16,13 +18,23 @@ type contextKey int
 
 const orgAccessImportCtxKey contextKey = iota
 
+// accessOrganizationConfig extends the Access Organization with the session
+// and seat settings that aren't available in cloudflare-go yet.
+type accessOrganizationConfig struct {
+	cloudflare.AccessOrganization
+	SessionDuration                string `json:"session_duration,omitempty"`
+	WarpAuthSessionDuration        string `json:"warp_auth_session_duration,omitempty"`
+	UserSeatExpirationInactiveTime string `json:"user_seat_expiration_inactive_time,omitempty"`
+	AutoRedirectToIdentity         *bool  `json:"auto_redirect_to_identity,omitempty"`
+}
+
 func resourceCloudflareAccessOrganization() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccessOrganizationSchema(),
 		CreateContext: resourceCloudflareAccessOrganizationCreate,
 		ReadContext:   resourceCloudflareAccessOrganizationRead,
 		UpdateContext: resourceCloudflareAccessOrganizationUpdate,
-		DeleteContext: resourceCloudflareAccessOrganizationNoop,
+		DeleteContext: resourceCloudflareAccessOrganizationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessOrganizationImport,
 		},
@@ -33,11 +45,37 @@ func resourceCloudflareAccessOrganization() *schema.Resource {
 }
 
 func resourceCloudflareAccessOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diag.FromErr(fmt.Errorf("access organizations cannot be created and must be imported"))
-}
+	client := meta.(*cloudflare.API)
 
-func resourceCloudflareAccessOrganizationNoop(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return nil
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The organization is a singleton, so adopt it if it already exists
+	// rather than failing the creation.
+	method := http.MethodPut
+	_, err = client.Raw(ctx, http.MethodGet, accessOrganizationURI(identifier), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("error fetching Access Organization for %s %q: %w", identifier.Type, identifier.Value, err))
+		}
+		method = http.MethodPost
+	}
+
+	organization := buildAccessOrganization(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Organization from struct: %+v", organization))
+
+	_, err = client.Raw(ctx, method, accessOrganizationURI(identifier), organization, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Access Organization for %s %q: %w", identifier.Type, identifier.Value, err))
+	}
+
+	d.SetId(identifier.Value)
+
+	return resourceCloudflareAccessOrganizationRead(ctx, d, meta)
 }
 
 func resourceCloudflareAccessOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -48,19 +86,29 @@ func resourceCloudflareAccessOrganizationRead(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	var organization cloudflare.AccessOrganization
-	if identifier.Type == AccountType {
-		organization, _, err = client.AccessOrganization(ctx, identifier.Value)
-	} else {
-		organization, _, err = client.ZoneLevelAccessOrganization(ctx, identifier.Value)
-	}
+	res, err := client.Raw(ctx, http.MethodGet, accessOrganizationURI(identifier), nil, nil)
 	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Access Organization for %s %s no longer exists", identifier.Type, identifier.Value))
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(fmt.Errorf("error fetching access organization: %w", err))
 	}
 
+	var organization accessOrganizationConfig
+	if err := json.Unmarshal(res, &organization); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Access Organization: %w", err))
+	}
+
 	d.Set("name", organization.Name)
 	d.Set("auth_domain", organization.AuthDomain)
 	d.Set("is_ui_read_only", organization.IsUIReadOnly)
+	d.Set("session_duration", organization.SessionDuration)
+	d.Set("warp_auth_session_duration", organization.WarpAuthSessionDuration)
+	d.Set("user_seat_expiration_inactive_time", organization.UserSeatExpirationInactiveTime)
+	d.Set("auto_redirect_to_identity", cloudflare.Bool(organization.AutoRedirectToIdentity))
 
 	loginDesign := convertLoginDesignStructToSchema(ctx, d, &organization.LoginDesign)
 	if loginDesignErr := d.Set("login_design", loginDesign); loginDesignErr != nil {
@@ -73,13 +121,7 @@ func resourceCloudflareAccessOrganizationRead(ctx context.Context, d *schema.Res
 func resourceCloudflareAccessOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	updatedAccessOrganization := cloudflare.AccessOrganization{
-		Name:         d.Get("name").(string),
-		AuthDomain:   d.Get("auth_domain").(string),
-		IsUIReadOnly: cloudflare.BoolPtr(d.Get("is_ui_read_only").(bool)),
-	}
-	loginDesign := convertLoginDesignSchemaToStruct(d)
-	updatedAccessOrganization.LoginDesign = *loginDesign
+	updatedAccessOrganization := buildAccessOrganization(d)
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Organization from struct: %+v", updatedAccessOrganization))
 
@@ -88,11 +130,7 @@ func resourceCloudflareAccessOrganizationUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	if identifier.Type == AccountType {
-		_, err = client.UpdateAccessOrganization(ctx, identifier.Value, updatedAccessOrganization)
-	} else {
-		_, err = client.UpdateZoneLevelAccessOrganization(ctx, identifier.Value, updatedAccessOrganization)
-	}
+	_, err = client.Raw(ctx, http.MethodPut, accessOrganizationURI(identifier), updatedAccessOrganization, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Access Organization for %s %q: %w", identifier.Type, identifier.Value, err))
 	}
@@ -100,6 +138,16 @@ func resourceCloudflareAccessOrganizationUpdate(ctx context.Context, d *schema.R
 	return resourceCloudflareAccessOrganizationRead(ctx, d, meta)
 }
 
+func resourceCloudflareAccessOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Access Organizations cannot be deleted",
+			Detail:   "The Access Organization has been removed from the Terraform state but its configuration remains unchanged in Cloudflare.",
+		},
+	}
+}
+
 func resourceCloudflareAccessOrganizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	ctx = context.WithValue(ctx, orgAccessImportCtxKey, true)
 
@@ -116,3 +164,23 @@ func resourceCloudflareAccessOrganizationImport(ctx context.Context, d *schema.R
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func accessOrganizationURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/access/organizations", identifier.Type, identifier.Value)
+}
+
+func buildAccessOrganization(d *schema.ResourceData) accessOrganizationConfig {
+	organization := accessOrganizationConfig{AccessOrganization: cloudflare.AccessOrganization{
+		Name:         d.Get("name").(string),
+		AuthDomain:   d.Get("auth_domain").(string),
+		IsUIReadOnly: cloudflare.BoolPtr(d.Get("is_ui_read_only").(bool)),
+		LoginDesign:  *convertLoginDesignSchemaToStruct(d),
+	}}
+
+	organization.SessionDuration = d.Get("session_duration").(string)
+	organization.WarpAuthSessionDuration = d.Get("warp_auth_session_duration").(string)
+	organization.UserSeatExpirationInactiveTime = d.Get("user_seat_expiration_inactive_time").(string)
+	organization.AutoRedirectToIdentity = cloudflare.BoolPtr(d.Get("auto_redirect_to_identity").(bool))
+
+	return organization
+}
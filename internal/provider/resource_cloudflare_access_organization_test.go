@@ -30,6 +30,33 @@ func TestAccCloudflareAccessOrganization(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessOrganization_SessionSettings(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_organization.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessOrganizationConfigSessionSettings(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "auth_domain", "terraform-cfapi.cloudflareaccess.com"),
+					resource.TestCheckResourceAttr(name, "session_duration", "12h"),
+					resource.TestCheckResourceAttr(name, "warp_auth_session_duration", "24h"),
+					resource.TestCheckResourceAttr(name, "user_seat_expiration_inactive_time", "730h"),
+					resource.TestCheckResourceAttr(name, "auto_redirect_to_identity", "false"),
+					resource.TestCheckResourceAttr(name, "login_design.0.background_color", "#FFFFFF"),
+				),
+			},
+		},
+	})
+}
+
 func accessOrgImportStateCheck(instanceStates []*terraform.InstanceState) error {
 	state := instanceStates[0]
 	attrs := state.Attributes
@@ -74,3 +101,26 @@ func testAccCloudflareAccessOrganizationConfigBasic(rnd, accountID string) strin
 		}
 		`, rnd, accountID)
 }
+
+func testAccCloudflareAccessOrganizationConfigSessionSettings(rnd, accountID string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_access_organization" "%[1]s" {
+			account_id                         = "%[2]s"
+			name                               = "terraform-cfapi.cloudflareaccess.com"
+			auth_domain                        = "terraform-cfapi.cloudflareaccess.com"
+			is_ui_read_only                    = false
+			session_duration                   = "12h"
+			warp_auth_session_duration         = "24h"
+			user_seat_expiration_inactive_time = "730h"
+			auto_redirect_to_identity          = false
+
+			login_design {
+				background_color = "#FFFFFF"
+				text_color       = "#000000"
+				logo_path        = "https://example.com/logo.png"
+				header_text      = "My header text"
+				footer_text      = "My footer text"
+			}
+		}
+		`, rnd, accountID)
+}
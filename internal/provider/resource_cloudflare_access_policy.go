@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -13,6 +15,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// accessPolicyConfig extends the Access Policy with the fields that aren't
+// available in cloudflare-go yet.
+type accessPolicyConfig struct {
+	cloudflare.AccessPolicy
+	IsolationRequired *bool `json:"isolation_required,omitempty"`
+}
+
 func resourceCloudflareAccessPolicy() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccessPolicySchema(),
@@ -23,6 +32,7 @@ func resourceCloudflareAccessPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessPolicyImport,
 		},
+		CustomizeDiff: resourceCloudflareAccessPolicyValidateApprovalGroups,
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Access Policy resource. Access Policies are
 			used in conjunction with Access Applications to restrict access to
@@ -67,12 +77,7 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
-		accessPolicy, err = client.AccessPolicy(ctx, identifier.Value, appID, d.Id())
-	} else {
-		accessPolicy, err = client.ZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
-	}
+	accessPolicy, err := getAccessPolicy(ctx, client, identifier, appID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -111,14 +116,16 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		d.Set("approval_required", accessPolicy.ApprovalRequired)
 	}
 
-	if len(accessPolicy.ApprovalGroups) != 0 {
-		approvalGroups := make([]map[string]interface{}, 0, len(accessPolicy.ApprovalGroups))
-		for _, apiApprovalGroup := range accessPolicy.ApprovalGroups {
-			approvalGroups = append(approvalGroups, apiAccessPolicyApprovalGroupToSchema(apiApprovalGroup))
-		}
-		if err := d.Set("approval_group", approvalGroups); err != nil {
-			return diag.FromErr(fmt.Errorf("failed to set approval_group attribute: %w", err))
-		}
+	approvalGroups := make([]map[string]interface{}, 0, len(accessPolicy.ApprovalGroups))
+	for _, apiApprovalGroup := range accessPolicy.ApprovalGroups {
+		approvalGroups = append(approvalGroups, apiAccessPolicyApprovalGroupToSchema(apiApprovalGroup))
+	}
+	if err := d.Set("approval_group", approvalGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set approval_group attribute: %w", err))
+	}
+
+	if accessPolicy.IsolationRequired != nil {
+		d.Set("isolation_required", accessPolicy.IsolationRequired)
 	}
 
 	return nil
@@ -127,13 +134,14 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	appID := d.Get("application_id").(string)
-	newAccessPolicy := cloudflare.AccessPolicy{
+	newAccessPolicy := accessPolicyConfig{AccessPolicy: cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
 		Precedence: d.Get("precedence").(int),
 		Decision:   d.Get("decision").(string),
-	}
+	}}
 
-	newAccessPolicy = appendConditionalAccessPolicyFields(newAccessPolicy, d)
+	newAccessPolicy.AccessPolicy = appendConditionalAccessPolicyFields(newAccessPolicy.AccessPolicy, d)
+	newAccessPolicy.IsolationRequired = cloudflare.BoolPtr(d.Get("isolation_required").(bool))
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Policy from struct: %+v", newAccessPolicy))
 
@@ -142,12 +150,7 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
-		accessPolicy, err = client.CreateAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
-	} else {
-		accessPolicy, err = client.CreateZoneLevelAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
-	}
+	accessPolicy, err := writeAccessPolicy(ctx, client, identifier, appID, http.MethodPost, newAccessPolicy)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Access Policy for ID %q: %w", accessPolicy.ID, err))
 	}
@@ -160,14 +163,15 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	appID := d.Get("application_id").(string)
-	updatedAccessPolicy := cloudflare.AccessPolicy{
+	updatedAccessPolicy := accessPolicyConfig{AccessPolicy: cloudflare.AccessPolicy{
 		Name:       d.Get("name").(string),
 		Precedence: d.Get("precedence").(int),
 		Decision:   d.Get("decision").(string),
 		ID:         d.Id(),
-	}
+	}}
 
-	updatedAccessPolicy = appendConditionalAccessPolicyFields(updatedAccessPolicy, d)
+	updatedAccessPolicy.AccessPolicy = appendConditionalAccessPolicyFields(updatedAccessPolicy.AccessPolicy, d)
+	updatedAccessPolicy.IsolationRequired = cloudflare.BoolPtr(d.Get("isolation_required").(bool))
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Policy from struct: %+v", updatedAccessPolicy))
 
@@ -176,12 +180,7 @@ func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
-		accessPolicy, err = client.UpdateAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
-	} else {
-		accessPolicy, err = client.UpdateZoneLevelAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
-	}
+	accessPolicy, err := writeAccessPolicy(ctx, client, identifier, appID, http.MethodPut, updatedAccessPolicy)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Access Policy for ID %q: %w", d.Id(), err))
 	}
@@ -287,3 +286,55 @@ func appendConditionalAccessPolicyFields(policy cloudflare.AccessPolicy, d *sche
 
 	return policy
 }
+
+// resourceCloudflareAccessPolicyValidateApprovalGroups ensures approval
+// groups are only configured on policies that require approval, as the API
+// otherwise silently ignores them.
+func resourceCloudflareAccessPolicyValidateApprovalGroups(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	approvalGroups := d.Get("approval_group").([]interface{})
+	if len(approvalGroups) > 0 && d.NewValueKnown("approval_required") && !d.Get("approval_required").(bool) {
+		return errors.New("approval_group can only be set when approval_required is true")
+	}
+
+	return nil
+}
+
+func accessPolicyURI(identifier *AccessIdentifier, applicationID string) string {
+	return fmt.Sprintf("/%ss/%s/access/apps/%s/policies", identifier.Type, identifier.Value, applicationID)
+}
+
+func getAccessPolicy(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, applicationID, policyID string) (accessPolicyConfig, error) {
+	var policy accessPolicyConfig
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", accessPolicyURI(identifier, applicationID), policyID), nil, nil)
+	if err != nil {
+		return policy, err
+	}
+
+	if err := json.Unmarshal(res, &policy); err != nil {
+		return policy, fmt.Errorf("error unmarshalling Access Policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// writeAccessPolicy creates (POST) or updates (PUT) an Access Policy.
+func writeAccessPolicy(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, applicationID, method string, policy accessPolicyConfig) (accessPolicyConfig, error) {
+	uri := accessPolicyURI(identifier, applicationID)
+	if method == http.MethodPut {
+		uri = fmt.Sprintf("%s/%s", uri, policy.ID)
+	}
+
+	var result accessPolicyConfig
+
+	res, err := client.Raw(ctx, method, uri, policy, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Access Policy: %w", err)
+	}
+
+	return result, nil
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -812,6 +813,100 @@ func testAccessPolicyApprovalGroupConfig(resourceID, zone, accountID string) str
   `, resourceID, zone, accountID)
 }
 
+func TestAccCloudflareAccessPolicy_ApprovalGroupWithoutApprovalRequired(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccessPolicyApprovalGroupWithoutApprovalRequiredConfig(rnd, zone, accountID),
+				ExpectError: regexp.MustCompile("approval_group can only be set when approval_required is true"),
+			},
+		},
+	})
+}
+
+func testAccessPolicyApprovalGroupWithoutApprovalRequiredConfig(resourceID, zone, accountID string) string {
+	return fmt.Sprintf(`
+    resource "cloudflare_access_application" "%[1]s" {
+      name       = "%[1]s"
+      account_id = "%[3]s"
+      domain     = "%[1]s.%[2]s"
+    }
+
+    resource "cloudflare_access_policy" "%[1]s" {
+      application_id = "${cloudflare_access_application.%[1]s.id}"
+      name           = "%[1]s"
+      account_id     = "%[3]s"
+      decision       = "allow"
+      precedence     = "1"
+
+      include {
+        email = ["a@example.com", "b@example.com"]
+      }
+
+      approval_group {
+        email_addresses  = ["test1@example.com"]
+        approvals_needed = "1"
+      }
+    }
+  `, resourceID, zone, accountID)
+}
+
+func TestAccCloudflareAccessPolicy_IsolationRequired(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_access_policy." + rnd
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccessPolicyIsolationRequiredConfig(rnd, zone, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "isolation_required", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccessPolicyIsolationRequiredConfig(resourceID, zone, accountID string) string {
+	return fmt.Sprintf(`
+    resource "cloudflare_access_application" "%[1]s" {
+      name       = "%[1]s"
+      account_id = "%[3]s"
+      domain     = "%[1]s.%[2]s"
+    }
+
+    resource "cloudflare_access_policy" "%[1]s" {
+      application_id     = "${cloudflare_access_application.%[1]s.id}"
+      name               = "%[1]s"
+      account_id         = "%[3]s"
+      decision           = "allow"
+      precedence         = "1"
+      isolation_required = "true"
+
+      include {
+        email = ["a@example.com", "b@example.com"]
+      }
+    }
+  `, resourceID, zone, accountID)
+}
+
 func TestAccCloudflareAccessPolicy_ExternalEvaluation(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_access_policy." + rnd
@@ -8,6 +8,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,6 +23,7 @@ func resourceCloudflareAccessServiceToken() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessServiceTokenImport,
 		},
+		CustomizeDiff: resourceCloudflareAccessServiceTokenCustomizeDiff,
 		Description: heredoc.Doc(`
 			Access Service Tokens are used for service-to-service communication
 			when an application is behind Cloudflare Access.
@@ -51,35 +53,6 @@ func resourceCloudflareAccessServiceTokenRead(ctx context.Context, d *schema.Res
 	}
 	for _, token := range serviceTokens {
 		if token.ID == d.Id() {
-			zoneID := d.Get("zone_id").(string)
-			accountID := d.Get("account_id").(string)
-			mindays := d.Get("min_days_for_renewal").(int)
-			if mindays > 0 {
-				expires_at := d.Get("expires_at").(string)
-
-				if expires_at != "" {
-					expected_expiration_date, _ := time.Parse(time.RFC3339, expires_at)
-
-					expiration_date := time.Now().Add(time.Duration(mindays) * 24 * time.Hour)
-
-					if expiration_date.After(expected_expiration_date) {
-						var refreshedToken cloudflare.AccessServiceTokenRefreshResponse
-						var err error
-
-						if accountID != "" {
-							refreshedToken, err = client.RefreshAccessServiceToken(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
-						} else {
-							refreshedToken, err = client.RefreshAccessServiceToken(ctx, cloudflare.ZoneIdentifier(zoneID), d.Id())
-						}
-
-						if err != nil {
-							return diag.FromErr(fmt.Errorf("failed to automatically refresh token %q: %w", d.Id(), err))
-						}
-
-						token.ExpiresAt = refreshedToken.ExpiresAt
-					}
-				}
-			}
 			d.Set("name", token.Name)
 			d.Set("client_id", token.ClientID)
 			d.Set("expires_at", token.ExpiresAt.Format(time.RFC3339))
@@ -128,17 +101,42 @@ func resourceCloudflareAccessServiceTokenUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var serviceToken cloudflare.AccessServiceTokenUpdateResponse
-	if identifier.Type == AccountType {
-		serviceToken, err = client.UpdateAccessServiceToken(ctx, identifier.Value, d.Id(), tokenName)
-	} else {
-		serviceToken, err = client.UpdateZoneLevelAccessServiceToken(ctx, identifier.Value, d.Id(), tokenName)
-	}
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating access service token: %w", err))
+	if d.HasChange("name") {
+		var serviceToken cloudflare.AccessServiceTokenUpdateResponse
+		if identifier.Type == AccountType {
+			serviceToken, err = client.UpdateAccessServiceToken(ctx, identifier.Value, d.Id(), tokenName)
+		} else {
+			serviceToken, err = client.UpdateZoneLevelAccessServiceToken(ctx, identifier.Value, d.Id(), tokenName)
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating access service token: %w", err))
+		}
+
+		d.Set("name", serviceToken.Name)
 	}
 
-	d.Set("name", serviceToken.Name)
+	// A pending client secret means the token is due for renewal. The
+	// expiration is extended first and the secret then rotated, which keeps
+	// both the token ID and the client ID.
+	if d.HasChange("client_secret") {
+		rc := cloudflare.AccountIdentifier(identifier.Value)
+		if identifier.Type == ZoneType {
+			rc = cloudflare.ZoneIdentifier(identifier.Value)
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Renewing access service token %q as it expires in less than %d days", d.Id(), d.Get("min_days_for_renewal").(int)))
+
+		if _, err := client.RefreshAccessServiceToken(ctx, rc, d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to refresh access service token %q: %w", d.Id(), err))
+		}
+
+		rotatedToken, err := client.RotateAccessServiceToken(ctx, rc, d.Id())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to rotate access service token %q: %w", d.Id(), err))
+		}
+
+		d.Set("client_secret", rotatedToken.ClientSecret)
+	}
 
 	return resourceCloudflareAccessServiceTokenRead(ctx, d, meta)
 }
@@ -179,3 +177,30 @@ func resourceCloudflareAccessServiceTokenImport(ctx context.Context, d *schema.R
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceCloudflareAccessServiceTokenCustomizeDiff plans the renewal of the
+// token, and the rotation of its client secret, once it expires within
+// `min_days_for_renewal` days.
+func resourceCloudflareAccessServiceTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	minDays := d.Get("min_days_for_renewal").(int)
+	expiresAt := d.Get("expires_at").(string)
+	if d.Id() == "" || minDays <= 0 || expiresAt == "" {
+		return nil
+	}
+
+	expirationDate, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to parse expiration date %q of access service token: %w", expiresAt, err)
+	}
+
+	if time.Now().AddDate(0, 0, minDays).After(expirationDate) {
+		tflog.Info(ctx, fmt.Sprintf("Access service token %q expires on %s and will be renewed", d.Id(), expiresAt))
+
+		if err := d.SetNewComputed("client_secret"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("expires_at")
+	}
+
+	return nil
+}
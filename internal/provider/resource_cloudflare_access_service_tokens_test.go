@@ -113,65 +113,62 @@ func TestAccCloudflareAccessServiceTokenUpdate(t *testing.T) {
 	})
 }
 
-// func TestAccCloudflareAccessServiceTokenUpdateWithExpiration(t *testing.T) {
-// 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
-// 	// Service Tokens endpoint does not yet support the API tokens and it
-// 	// results in misleading state error messages.
-// 	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
-// 		defer func(apiToken string) {
-// 			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
-// 		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
-// 		os.Setenv("CLOUDFLARE_API_TOKEN", "")
-// 	}
-
-// 	rnd := generateRandomResourceName()
-// 	var initialState terraform.ResourceState
-
-// 	name := fmt.Sprintf("cloudflare_access_service_token.tf-acc-%s", rnd)
-// 	resourceName := strings.Split(name, ".")[1]
-// 	expirationTime := 365
-
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:  func() { testAccPreCheck(t) },
-// 		ProviderFactories: providerFactories,
-// 		Steps: []resource.TestStep{
-// 			{
-// 				Config: testCloudflareAccessServiceTokenBasicConfig(resourceName, resourceName, AccessIdentifier{Type: ZoneType, Value: zoneID}, expirationTime),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					testAccCheckCloudflareAccessServiceTokenSaved(name, &initialState),
-// 					resource.TestCheckResourceAttr(name, "min_days_for_renewal", strconv.Itoa(expirationTime)),
-// 				),
-// 				//Expiration of 365 will always force a new resource as long as the tokens expire in 365 days in cloudflare
-// 				ExpectNonEmptyPlan: true,
-// 			},
-// 			{
-// 				Config: testCloudflareAccessServiceTokenBasicConfig(resourceName, resourceName, AccessIdentifier{Type: ZoneType, Value: zoneID}, expirationTime),
-// 				Check: resource.ComposeTestCheckFunc(
-// 					resource.TestCheckResourceAttr(name, "min_days_for_renewal", strconv.Itoa(expirationTime)),
-// 					testAccCheckCloudflareAccessServiceTokenRenewed(name, &initialState),
-// 				),
-// 				ExpectNonEmptyPlan: true,
-// 			},
-// 		},
-// 	})
-// }
-
-// func testAccCheckCloudflareAccessServiceTokenSaved(n string, resourceState *terraform.ResourceState) resource.TestCheckFunc {
-// 	return func(s *terraform.State) error {
-// 		rs, ok := s.RootModule().Resources[n]
-// 		if !ok {
-// 			return fmt.Errorf("not found: %s", n)
-// 		}
-
-// 		if rs.Primary.ID == "" {
-// 			return fmt.Errorf("No Access Token ID is set")
-// 		}
-
-// 		*resourceState = *rs
-
-// 		return nil
-// 	}
-// }
+func TestAccCloudflareAccessServiceTokenUpdateWithExpiration(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// Service Tokens endpoint does not yet support the API tokens and it
+	// results in misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	var initialState terraform.ResourceState
+
+	name := fmt.Sprintf("cloudflare_access_service_token.tf-acc-%s", rnd)
+	resourceName := strings.Split(name, ".")[1]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudflareAccessServiceTokenBasicConfig(resourceName, resourceName, AccessIdentifier{Type: AccountType, Value: accountID}, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareAccessServiceTokenSaved(name, &initialState),
+					resource.TestCheckResourceAttr(name, "min_days_for_renewal", "0"),
+				),
+			},
+			{
+				// Tokens are valid for a year so a threshold above that always
+				// triggers a renewal, including right after being renewed.
+				Config: testCloudflareAccessServiceTokenBasicConfig(resourceName, resourceName, AccessIdentifier{Type: AccountType, Value: accountID}, 366),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "min_days_for_renewal", "366"),
+					testAccCheckCloudflareAccessServiceTokenRenewed(name, &initialState),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareAccessServiceTokenSaved(n string, resourceState *terraform.ResourceState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Access Token ID is set")
+		}
+
+		resourceState.Type = rs.Type
+		resourceState.Primary = rs.Primary.DeepCopy()
+
+		return nil
+	}
+}
 
 func testAccCheckCloudflareAccessServiceTokenRenewed(n string, oldResourceState *terraform.ResourceState) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -190,6 +187,14 @@ func testAccCheckCloudflareAccessServiceTokenRenewed(n string, oldResourceState
 			}
 		}
 
+		if rs.Primary.ID != oldResourceState.Primary.ID {
+			return fmt.Errorf("Access Token ID has changed from %s to %s", oldResourceState.Primary.ID, rs.Primary.ID)
+		}
+
+		if rs.Primary.Attributes["client_id"] != oldResourceState.Primary.Attributes["client_id"] {
+			return fmt.Errorf("resource attribute 'client_id' has changed. Expected it to be kept when renewing")
+		}
+
 		return nil
 	}
 }
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessTag is the representation of a tag used to group Access
+// Applications in the App Launcher.
+type accessTag struct {
+	Name     string `json:"name"`
+	AppCount int    `json:"app_count,omitempty"`
+}
+
+func resourceCloudflareAccessTag() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAccessTagSchema(),
+		CreateContext: resourceCloudflareAccessTagCreate,
+		ReadContext:   resourceCloudflareAccessTagRead,
+		DeleteContext: resourceCloudflareAccessTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAccessTagImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to create tags that can be assigned to
+			Access Applications to group them in the App Launcher.
+		`),
+	}
+}
+
+func resourceCloudflareAccessTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tag := accessTag{
+		Name: d.Get("name").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Tag %s", tag.Name))
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/access/tags", accountID), tag, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Access Tag %q: %w", tag.Name, err))
+	}
+
+	d.SetId(tag.Name)
+
+	return resourceCloudflareAccessTagRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/access/tags/%s", accountID, url.PathEscape(d.Id())), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Access Tag %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Access Tag %q: %w", d.Id(), err))
+	}
+
+	var tag accessTag
+	if err := json.Unmarshal(res, &tag); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Access Tag: %w", err))
+	}
+
+	d.Set("name", tag.Name)
+	d.Set("app_count", tag.AppCount)
+
+	return nil
+}
+
+func resourceCloudflareAccessTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Access Tag %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/access/tags/%s", accountID, url.PathEscape(d.Id())), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Access Tag %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAccessTagImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tagName\"", d.Id())
+	}
+
+	accountID, tagName := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(tagName)
+
+	resourceCloudflareAccessTagRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
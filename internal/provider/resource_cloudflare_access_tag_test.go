@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareAccessTag_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_access_tag." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessTagConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttr(resourceName, "name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "app_count", "0"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessTagConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_tag" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+`, rnd, accountID)
+}
+
+func TestCloudflareAccessTagReadEscapesName(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/accounts/"+accountID+"/access/tags/engineering%2Fdev%20tools" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, `{"name": "engineering/dev tools", "app_count": 2}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessTagSchema(), map[string]interface{}{
+		"account_id": accountID,
+	})
+	d.SetId("engineering/dev tools")
+
+	if diags := resourceCloudflareAccessTagRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get("app_count").(int); got != 2 {
+		t.Errorf("expected the tag to be read, got app_count %d", got)
+	}
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -17,6 +19,18 @@ const (
 	accountTypeEnterprise = "enterprise"
 )
 
+// account extends cloudflare.Account with the settings which aren't
+// supported by the client library yet.
+type account struct {
+	cloudflare.Account
+	Settings *accountSettings `json:"settings,omitempty"`
+}
+
+type accountSettings struct {
+	EnforceTwoFactor            bool `json:"enforce_twofactor"`
+	UseAccountCustomNSByDefault bool `json:"use_account_custom_ns_by_default"`
+}
+
 func resourceCloudflareAccount() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccountSchema(),
@@ -29,34 +43,87 @@ func resourceCloudflareAccount() *schema.Resource {
 		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Account resource. Account is the basic resource for
-			working with Cloudflare zones, teams and users.
+			working with Cloudflare zones, teams and users. Creating and deleting
+			accounts requires the Tenant entitlement, existing accounts can be
+			imported and updated without it.
 		`),
 	}
 }
 
+func buildAccountSettings(d *schema.ResourceData) *accountSettings {
+	settings := &accountSettings{
+		EnforceTwoFactor:            d.Get("settings.0.enforce_twofactor").(bool),
+		UseAccountCustomNSByDefault: d.Get("settings.0.use_account_custom_ns_by_default").(bool),
+	}
+
+	// The deprecated top level `enforce_twofactor` is used when it's
+	// configured instead of the settings.
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		if _, ok := d.GetOk("settings"); !ok {
+			settings.EnforceTwoFactor = d.Get("enforce_twofactor").(bool)
+		}
+	} else if value := rawConfig.GetAttr("enforce_twofactor"); !value.IsNull() && value.IsKnown() {
+		settings.EnforceTwoFactor = value.True()
+	}
+
+	return settings
+}
+
+func getAccount(ctx context.Context, client *cloudflare.API, accountID string) (account, error) {
+	result, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s", accountID), nil, nil)
+	if err != nil {
+		return account{}, err
+	}
+
+	var acc account
+	if err := json.Unmarshal(result, &acc); err != nil {
+		return account{}, fmt.Errorf("error unmarshalling account: %w", err)
+	}
+
+	return acc, nil
+}
+
+func updateAccount(ctx context.Context, client *cloudflare.API, accountID string, acc account) error {
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s", accountID), acc, nil)
+	return err
+}
+
 func resourceCloudflareAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountName := d.Get("name").(string)
 	accountType := d.Get("type").(string)
-	twoFactor := d.Get("enforce_twofactor").(bool)
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Account: name %s", accountName))
 
-	account := cloudflare.Account{
+	acc, err := client.CreateAccount(ctx, cloudflare.Account{
 		Name: accountName,
 		Type: accountType,
-		Settings: &cloudflare.AccountSettings{
-			EnforceTwoFactor: twoFactor,
-		},
-	}
-	acc, err := client.CreateAccount(ctx, account)
-
+	})
 	if err != nil {
+		var authenticationError *cloudflare.AuthenticationError
+		var authorizationError *cloudflare.AuthorizationError
+		if errors.As(err, &authenticationError) || errors.As(err, &authorizationError) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("creating account %q requires tenant permissions", accountName),
+				Detail:   "Accounts can only be created by users and API tokens of a Cloudflare Tenant. Existing accounts can be imported by their account ID instead.",
+			}}
+		}
 		return diag.FromErr(fmt.Errorf("error creating account %q: %w", accountName, err))
 	}
 
 	d.SetId(acc.ID)
 
+	// The settings are ignored when creating an account, so they are applied
+	// with a separate update.
+	settings := buildAccountSettings(d)
+	if settings.EnforceTwoFactor || settings.UseAccountCustomNSByDefault {
+		if err := updateAccount(ctx, client, acc.ID, account{Account: cloudflare.Account{Name: accountName}, Settings: settings}); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating settings of account %q: %w", acc.ID, err))
+		}
+	}
+
 	return resourceCloudflareAccountRead(ctx, d, meta)
 }
 
@@ -64,7 +131,7 @@ func resourceCloudflareAccountRead(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*cloudflare.API)
 	accountID := d.Id()
 
-	foundAcc, _, err := client.Account(ctx, accountID)
+	foundAcc, err := getAccount(ctx, client, accountID)
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -79,7 +146,13 @@ func resourceCloudflareAccountRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("name", foundAcc.Name)
 	d.Set("type", foundAcc.Type)
-	d.Set("enforce_twofactor", foundAcc.Settings.EnforceTwoFactor)
+	if foundAcc.Settings != nil {
+		d.Set("enforce_twofactor", foundAcc.Settings.EnforceTwoFactor)
+		d.Set("settings", []map[string]interface{}{{
+			"enforce_twofactor":                foundAcc.Settings.EnforceTwoFactor,
+			"use_account_custom_ns_by_default": foundAcc.Settings.UseAccountCustomNSByDefault,
+		}})
+	}
 
 	return nil
 }
@@ -88,21 +161,14 @@ func resourceCloudflareAccountUpdate(ctx context.Context, d *schema.ResourceData
 	client := meta.(*cloudflare.API)
 	accountID := d.Id()
 
-	accountName := d.Get("name").(string)
-	twoFactor := d.Get("enforce_twofactor").(bool)
-
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Account: id %s", accountID))
 
-	updatedAcc := cloudflare.Account{
-		Name: accountName,
-		Settings: &cloudflare.AccountSettings{
-			EnforceTwoFactor: twoFactor,
-		},
+	updatedAcc := account{
+		Account:  cloudflare.Account{Name: d.Get("name").(string)},
+		Settings: buildAccountSettings(d),
 	}
 
-	_, err := client.UpdateAccount(ctx, accountID, updatedAcc)
-	if err != nil {
-		tflog.Error(ctx, fmt.Sprintf("%#v", err))
+	if err := updateAccount(ctx, client, accountID, updatedAcc); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Account %q: %w", d.Id(), err))
 	}
 
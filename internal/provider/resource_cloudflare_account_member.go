@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -20,6 +22,7 @@ func resourceCloudflareAccountMember() *schema.Resource {
 		ReadContext:   resourceCloudflareAccountMemberRead,
 		UpdateContext: resourceCloudflareAccountMemberUpdate,
 		DeleteContext: resourceCloudflareAccountMemberDelete,
+		CustomizeDiff: resourceCloudflareAccountMemberCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccountMemberImport,
 		},
@@ -50,14 +53,18 @@ func resourceCloudflareAccountMemberRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	var memberIDs []string
+	var memberIDs, memberRoleNames []string
 	for _, role := range member.Roles {
 		memberIDs = append(memberIDs, role.ID)
+		memberRoleNames = append(memberRoleNames, role.Name)
 	}
 
 	d.Set("account_id", accountID)
 	d.Set("email_address", member.User.Email)
 	d.Set("role_ids", memberIDs)
+	if d.Get("role_names").(*schema.Set).Len() > 0 {
+		d.Set("role_names", memberRoleNames)
+	}
 	d.Set("status", member.Status)
 	d.SetId(d.Id())
 
@@ -147,15 +154,16 @@ func resourceCloudflareAccountMemberUpdate(ctx context.Context, d *schema.Resour
 func resourceCloudflareAccountMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*cloudflare.API)
 
-	// split the id so we can lookup the account member
-	idAttr := strings.SplitN(d.Id(), "/", 2)
+	// split the id so we can lookup the account member, the "account/"
+	// prefix is optional.
+	idAttr := strings.SplitN(strings.TrimPrefix(d.Id(), "account/"), "/", 2)
 	var accountID string
 	var accountMemberID string
 	if len(idAttr) == 2 {
 		accountID = idAttr[0]
 		accountMemberID = idAttr[1]
 	} else {
-		return nil, fmt.Errorf("invalid id %q specified, should be in format \"accountID/accountMemberID\" for import", d.Id())
+		return nil, fmt.Errorf("invalid id %q specified, should be in format \"account/accountID/accountMemberID\" for import", d.Id())
 	}
 
 	member, err := client.AccountMember(ctx, accountID, accountMemberID)
@@ -178,3 +186,88 @@ func resourceCloudflareAccountMemberImport(ctx context.Context, d *schema.Resour
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resolveAccountMemberRoleNames returns the IDs of the account roles with the
+// given names.
+func resolveAccountMemberRoleNames(ctx context.Context, client *cloudflare.API, accountID string, roleNames []string) ([]string, error) {
+	roles, err := listAccountRoles(ctx, client, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing roles of account %q: %w", accountID, err)
+	}
+
+	roleIDs := make(map[string]string, len(roles))
+	for _, role := range roles {
+		roleIDs[role.Name] = role.ID
+	}
+
+	var ids []string
+	for _, name := range roleNames {
+		id, ok := roleIDs[name]
+		if !ok {
+			return nil, fmt.Errorf("account %q has no role named %q", accountID, name)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// listAccountRoles returns all roles of an account. AccountRoles only returns
+// the first page, so pages are requested until one is empty. A page repeating
+// roles means the API ignored the page parameter and returned all of them at
+// once.
+func listAccountRoles(ctx context.Context, client *cloudflare.API, accountID string) ([]cloudflare.AccountRole, error) {
+	const perPage = 50
+
+	var roles []cloudflare.AccountRole
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		uri := fmt.Sprintf("/accounts/%s/roles?page=%d&per_page=%d", accountID, page, perPage)
+		res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageRoles []cloudflare.AccountRole
+		if err := json.Unmarshal(res, &pageRoles); err != nil {
+			return nil, fmt.Errorf("error unmarshalling account roles: %w", err)
+		}
+
+		if len(pageRoles) == 0 || seen[pageRoles[0].ID] {
+			return roles, nil
+		}
+
+		for _, role := range pageRoles {
+			seen[role.ID] = true
+		}
+		roles = append(roles, pageRoles...)
+	}
+}
+
+// resourceCloudflareAccountMemberCustomizeDiff resolves `role_names` to the
+// role IDs which are assigned to the member, so the plan shows the roles
+// which will change and unknown names are rejected before anything is
+// applied.
+func resourceCloudflareAccountMemberCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	roleNames := d.Get("role_names").(*schema.Set)
+	if roleNames.Len() == 0 && d.NewValueKnown("role_names") {
+		return nil
+	}
+
+	if !d.NewValueKnown("role_names") || !d.NewValueKnown("account_id") {
+		return d.SetNewComputed("role_ids")
+	}
+
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = client.AccountID
+	}
+
+	roleIDs, err := resolveAccountMemberRoleNames(ctx, client, accountID, expandInterfaceToStringList(roleNames.List()))
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("role_ids", roleIDs)
+}
@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareAccountMemberBasic(t *testing.T) {
@@ -35,8 +43,15 @@ func TestAccCloudflareAccountMemberBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "email_address", fmt.Sprintf("%s@example.com", rnd)),
 					resource.TestCheckResourceAttr(name, "role_ids.#", "1"),
 					resource.TestCheckResourceAttr(name, "role_ids.0", "05784afa30c1afe1440e79d9351c7430"),
+					resource.TestCheckResourceAttr(name, "status", "pending"),
 				),
 			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("account/%s/", accountID),
+				ImportStateVerify:   true,
+			},
 		},
 	})
 }
@@ -49,3 +64,82 @@ func testCloudflareAccountMemberBasicConfig(resourceID, emailAddress, accountID
     role_ids = [ "05784afa30c1afe1440e79d9351c7430" ]
   }`, resourceID, emailAddress, accountID)
 }
+
+func TestCloudflareAccountMemberRoleNames(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const memberPath = "/accounts/" + accountID + "/members"
+
+	var invitedRoles []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		member := `{"id": "4536bcfad5faccb111b47003c79917fa", "status": "pending", "user": {"email": "user@example.com"}, "roles": [{"id": "05784afa30c1afe1440e79d9351c7430", "name": "Administrator"}]}`
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/"+accountID+"/roles":
+			switch r.URL.Query().Get("page") {
+			case "1":
+				testAPIResult(w, `[{"id": "05784afa30c1afe1440e79d9351c7430", "name": "Administrator"}]`)
+			case "2":
+				testAPIResult(w, `[{"id": "e58cefd75d7adae0b761796c28815e5c", "name": "Billing"}]`)
+			default:
+				testAPIResult(w, `[]`)
+			}
+			return
+		case r.Method == http.MethodPost && r.URL.Path == memberPath:
+			var invitation cloudflare.AccountMemberInvitation
+			if err := json.NewDecoder(r.Body).Decode(&invitation); err != nil {
+				t.Fatal(err)
+			}
+			invitedRoles = invitation.Roles
+		case r.Method == http.MethodGet && r.URL.Path == memberPath+"/4536bcfad5faccb111b47003c79917fa":
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, member)
+	})
+
+	ctx := context.Background()
+	r := resourceCloudflareAccountMember()
+
+	_, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":    accountID,
+		"email_address": "user@example.com",
+		"role_names":    []interface{}{"Administrator", "Owner"},
+	}), client)
+	if err == nil || !strings.Contains(err.Error(), `no role named "Owner"`) {
+		t.Errorf("expected an unknown role name to be rejected, got %v", err)
+	}
+
+	roleIDs, err := resolveAccountMemberRoleNames(ctx, client, accountID, []string{"Billing"})
+	if err != nil || !reflect.DeepEqual(roleIDs, []string{"e58cefd75d7adae0b761796c28815e5c"}) {
+		t.Errorf("expected roles on later pages to be resolved, got %v: %v", roleIDs, err)
+	}
+
+	diff, err := r.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":    accountID,
+		"email_address": "user@example.com",
+		"role_names":    []interface{}{"Administrator"},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(nil, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceCloudflareAccountMemberCreate(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !reflect.DeepEqual(invitedRoles, []string{"05784afa30c1afe1440e79d9351c7430"}) {
+		t.Errorf("expected the member to be invited with the resolved role ID, got %v", invitedRoles)
+	}
+	if got := d.Get("status").(string); got != "pending" {
+		t.Errorf("expected status %q, got %q", "pending", got)
+	}
+	if got := expandInterfaceToStringList(d.Get("role_names").(*schema.Set).List()); !reflect.DeepEqual(got, []string{"Administrator"}) {
+		t.Errorf("expected role names to be kept, got %v", got)
+	}
+}
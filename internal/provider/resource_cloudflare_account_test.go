@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareAccount_Basic(t *testing.T) {
@@ -41,10 +46,32 @@ func TestAccCloudflareAccount_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "enforce_twofactor", "true"),
 				),
 			},
+			{
+				Config: testAccCheckCloudflareAccountWithSettings(rnd, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.enforce_twofactor", "false"),
+					resource.TestCheckResourceAttr(name, "settings.0.use_account_custom_ns_by_default", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccCheckCloudflareAccountWithSettings(rnd, name string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_account" "%[1]s" {
+	  name = "%[2]s"
+	  settings {
+	    use_account_custom_ns_by_default = true
+	  }
+  }`, rnd, name)
+}
+
 func testAccCheckCloudflareAccountName(rnd, name string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_account" "%[1]s" {
@@ -99,3 +126,61 @@ func testAccCheckCloudflareAccountWith2FA(rnd, name string) string {
 	  enforce_twofactor = true
   }`, rnd, name)
 }
+
+func TestCloudflareAccountCreateRequiresTenant(t *testing.T) {
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		testAPIError(w, http.StatusForbidden, 10000, "Authentication error")
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccountSchema(), map[string]interface{}{
+		"name": "example",
+	})
+	diags := resourceCloudflareAccountCreate(context.Background(), d, client)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "requires tenant permissions") {
+		t.Errorf("expected a tenant permissions error, got %v", diags)
+	}
+}
+
+func TestCloudflareAccountCreateAppliesSettings(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	var updates []account
+	settings := `{"enforce_twofactor": false, "use_account_custom_ns_by_default": false}`
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts":
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/"+accountID:
+			var update account
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+			updates = append(updates, update)
+			encoded, _ := json.Marshal(update.Settings)
+			settings = string(encoded)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/"+accountID:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, fmt.Sprintf(`{"id": %q, "name": "example", "type": "standard", "settings": %s}`, accountID, settings))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccountSchema(), map[string]interface{}{
+		"name": "example",
+		"settings": []interface{}{map[string]interface{}{
+			"use_account_custom_ns_by_default": true,
+		}},
+	})
+	if diags := resourceCloudflareAccountCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(updates) != 1 || updates[0].Settings == nil || !updates[0].Settings.UseAccountCustomNSByDefault {
+		t.Errorf("expected the settings to be applied after creating the account, got %+v", updates)
+	}
+	if !d.Get("settings.0.use_account_custom_ns_by_default").(bool) || d.Get("settings.0.enforce_twofactor").(bool) {
+		t.Errorf("expected the settings to be read back, got %v", d.Get("settings"))
+	}
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addressMapCantDeleteErrorCode is returned by the API when attempting to
+// delete a Cloudflare managed address map or an enabled one with memberships.
+const addressMapCantDeleteErrorCode = 1002
+
+// addressMap is the representation of a BYOIP address map.
+type addressMap struct {
+	ID           string                 `json:"id,omitempty"`
+	Description  *string                `json:"description,omitempty"`
+	DefaultSNI   *string                `json:"default_sni,omitempty"`
+	Enabled      *bool                  `json:"enabled,omitempty"`
+	CanDelete    bool                   `json:"can_delete,omitempty"`
+	CanModifyIPs bool                   `json:"can_modify_ips,omitempty"`
+	IPs          []addressMapIP         `json:"ips,omitempty"`
+	Memberships  []addressMapMembership `json:"memberships,omitempty"`
+}
+
+type addressMapIP struct {
+	IP string `json:"ip"`
+}
+
+type addressMapMembership struct {
+	Identifier string `json:"identifier"`
+	Kind       string `json:"kind"`
+}
+
+func resourceCloudflareAddressMap() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAddressMapSchema(),
+		CreateContext: resourceCloudflareAddressMapCreate,
+		ReadContext:   resourceCloudflareAddressMapRead,
+		UpdateContext: resourceCloudflareAddressMapUpdate,
+		DeleteContext: resourceCloudflareAddressMapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAddressMapImport,
+		},
+		Description: heredoc.Doc(`
+			Provides the ability to manage IP addresses that can be used by
+			DNS records when they are proxied.
+		`),
+	}
+}
+
+func resourceCloudflareAddressMapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	description := d.Get("description").(string)
+	enabled := d.Get("enabled").(bool)
+	addrMap := addressMap{
+		Description: &description,
+		Enabled:     &enabled,
+		IPs:         expandAddressMapIPs(d.Get("ips").(*schema.Set)),
+		Memberships: expandAddressMapMemberships(d.Get("memberships").(*schema.Set)),
+	}
+	if defaultSNI, ok := d.GetOk("default_sni"); ok {
+		sni := defaultSNI.(string)
+		addrMap.DefaultSNI = &sni
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Address Map for account %s", accountID))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/addressing/address_maps", accountID), addrMap, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Address Map: %w", err))
+	}
+
+	var created addressMap
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Address Map: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareAddressMapRead(ctx, d, meta)
+}
+
+func resourceCloudflareAddressMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, addressMapURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Address Map %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Address Map %q: %w", d.Id(), err))
+	}
+
+	var addrMap addressMap
+	if err := json.Unmarshal(res, &addrMap); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Address Map: %w", err))
+	}
+
+	if addrMap.Description != nil {
+		d.Set("description", *addrMap.Description)
+	}
+	if addrMap.DefaultSNI != nil {
+		d.Set("default_sni", *addrMap.DefaultSNI)
+	}
+	if addrMap.Enabled != nil {
+		d.Set("enabled", *addrMap.Enabled)
+	}
+	d.Set("can_delete", addrMap.CanDelete)
+	d.Set("can_modify_ips", addrMap.CanModifyIPs)
+
+	ips := make([]interface{}, 0, len(addrMap.IPs))
+	for _, ip := range addrMap.IPs {
+		ips = append(ips, map[string]interface{}{"ip": ip.IP})
+	}
+	d.Set("ips", ips)
+
+	memberships := make([]interface{}, 0, len(addrMap.Memberships))
+	for _, membership := range addrMap.Memberships {
+		memberships = append(memberships, map[string]interface{}{
+			"identifier": membership.Identifier,
+			"kind":       membership.Kind,
+		})
+	}
+	d.Set("memberships", memberships)
+
+	return nil
+}
+
+func resourceCloudflareAddressMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if d.HasChanges("description", "default_sni", "enabled") {
+		description := d.Get("description").(string)
+		defaultSNI := d.Get("default_sni").(string)
+		enabled := d.Get("enabled").(bool)
+
+		tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Address Map %s", d.Id()))
+
+		_, err := client.Raw(ctx, http.MethodPatch, addressMapURI(accountID, d.Id()), addressMap{
+			Description: &description,
+			DefaultSNI:  &defaultSNI,
+			Enabled:     &enabled,
+		}, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Address Map %q: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("ips") {
+		o, n := d.GetChange("ips")
+		oldIPs, newIPs := o.(*schema.Set), n.(*schema.Set)
+
+		for _, ip := range expandAddressMapIPs(oldIPs.Difference(newIPs)) {
+			tflog.Debug(ctx, fmt.Sprintf("Removing IP %s from Address Map %s", ip.IP, d.Id()))
+
+			if _, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("%s/ips/%s", addressMapURI(accountID, d.Id()), ip.IP), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing IP %q from Address Map %q: %w", ip.IP, d.Id(), err))
+			}
+		}
+
+		for _, ip := range expandAddressMapIPs(newIPs.Difference(oldIPs)) {
+			tflog.Debug(ctx, fmt.Sprintf("Adding IP %s to Address Map %s", ip.IP, d.Id()))
+
+			if _, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("%s/ips/%s", addressMapURI(accountID, d.Id()), ip.IP), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding IP %q to Address Map %q: %w", ip.IP, d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("memberships") {
+		o, n := d.GetChange("memberships")
+		oldMemberships, newMemberships := o.(*schema.Set), n.(*schema.Set)
+
+		for _, membership := range expandAddressMapMemberships(oldMemberships.Difference(newMemberships)) {
+			tflog.Debug(ctx, fmt.Sprintf("Removing %s %s from Address Map %s", membership.Kind, membership.Identifier, d.Id()))
+
+			if _, err := client.Raw(ctx, http.MethodDelete, addressMapMembershipURI(accountID, d.Id(), membership), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing %s %q from Address Map %q: %w", membership.Kind, membership.Identifier, d.Id(), err))
+			}
+		}
+
+		for _, membership := range expandAddressMapMemberships(newMemberships.Difference(oldMemberships)) {
+			tflog.Debug(ctx, fmt.Sprintf("Adding %s %s to Address Map %s", membership.Kind, membership.Identifier, d.Id()))
+
+			if _, err := client.Raw(ctx, http.MethodPut, addressMapMembershipURI(accountID, d.Id(), membership), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding %s %q to Address Map %q: %w", membership.Kind, membership.Identifier, d.Id(), err))
+			}
+		}
+	}
+
+	return resourceCloudflareAddressMapRead(ctx, d, meta)
+}
+
+func resourceCloudflareAddressMapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	// an enabled address map that still has memberships can't be deleted so
+	// it is disabled beforehand.
+	if d.Get("enabled").(bool) {
+		tflog.Debug(ctx, fmt.Sprintf("Disabling Cloudflare Address Map %s before deletion", d.Id()))
+
+		enabled := false
+		if _, err := client.Raw(ctx, http.MethodPatch, addressMapURI(accountID, d.Id()), addressMap{Enabled: &enabled}, nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error disabling Address Map %q before deletion: %w", d.Id(), err))
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Address Map %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, addressMapURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var requestError *cloudflare.RequestError
+		if errors.As(err, &requestError) && requestError.InternalErrorCodeIs(addressMapCantDeleteErrorCode) {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Address Map %q can't be deleted", d.Id()),
+				Detail:   fmt.Sprintf("Cloudflare managed Address Maps (can_delete = false) and enabled Address Maps with memberships can't be deleted. Remove the memberships and set enabled = false before destroying the resource: %s", err),
+			}}
+		}
+		return diag.FromErr(fmt.Errorf("error deleting Address Map %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAddressMapImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/addressMapID\"", d.Id())
+	}
+
+	accountID, addressMapID := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.SetId(addressMapID)
+
+	resourceCloudflareAddressMapRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func addressMapURI(accountID, addressMapID string) string {
+	return fmt.Sprintf("/accounts/%s/addressing/address_maps/%s", accountID, addressMapID)
+}
+
+func addressMapMembershipURI(accountID, addressMapID string, membership addressMapMembership) string {
+	return fmt.Sprintf("%s/%ss/%s", addressMapURI(accountID, addressMapID), membership.Kind, membership.Identifier)
+}
+
+func expandAddressMapIPs(set *schema.Set) []addressMapIP {
+	ips := make([]addressMapIP, 0, set.Len())
+	for _, v := range set.List() {
+		ips = append(ips, addressMapIP{IP: v.(map[string]interface{})["ip"].(string)})
+	}
+	return ips
+}
+
+func expandAddressMapMemberships(set *schema.Set) []addressMapMembership {
+	memberships := make([]addressMapMembership, 0, set.Len())
+	for _, v := range set.List() {
+		membership := v.(map[string]interface{})
+		memberships = append(memberships, addressMapMembership{
+			Identifier: membership["identifier"].(string),
+			Kind:       membership["kind"].(string),
+		})
+	}
+	return memberships
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareAddressMap_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_address_map." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAddressMapConfig(rnd, accountID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttr(resourceName, "description", rnd),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ips.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "memberships.#", "0"),
+				),
+			},
+			{
+				Config: testAccCloudflareAddressMapMembershipsConfig(rnd, accountID, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "memberships.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "memberships.*", map[string]string{
+						"identifier": zoneID,
+						"kind":       "zone",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "memberships.*", map[string]string{
+						"identifier": accountID,
+						"kind":       "account",
+					}),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareAddressMapConfig(rnd, accountID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_address_map" "%[1]s" {
+  account_id  = "%[2]s"
+  description = "%[1]s"
+  enabled     = %[3]t
+}
+`, rnd, accountID, enabled)
+}
+
+func testAccCloudflareAddressMapMembershipsConfig(rnd, accountID, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_address_map" "%[1]s" {
+  account_id  = "%[2]s"
+  description = "%[1]s"
+  enabled     = true
+
+  memberships {
+    identifier = "%[3]s"
+    kind       = "zone"
+  }
+
+  memberships {
+    identifier = "%[2]s"
+    kind       = "account"
+  }
+}
+`, rnd, accountID, zoneID)
+}
+
+func TestCloudflareAddressMapDelete(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const addressMapID = "055817b111884e0227e1be16a0be6ee0"
+
+	testCases := map[string]struct {
+		status  int
+		code    int
+		message string
+		summary string
+	}{
+		"can't delete": {
+			status:  http.StatusBadRequest,
+			code:    addressMapCantDeleteErrorCode,
+			message: "Address map can't be deleted",
+			summary: `Address Map "` + addressMapID + `" can't be deleted`,
+		},
+		"other error": {
+			status:  http.StatusForbidden,
+			code:    10000,
+			message: "Authentication error",
+			summary: `error deleting Address Map "` + addressMapID + `"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				testAPIError(w, tc.status, tc.code, tc.message)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareAddressMapSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"enabled":    false,
+			})
+			d.SetId(addressMapID)
+
+			diags := resourceCloudflareAddressMapDelete(context.Background(), d, client)
+			if !diags.HasError() {
+				t.Fatal("expected an error deleting the address map")
+			}
+			if !strings.Contains(diags[0].Summary, tc.summary) || !strings.Contains(diags[0].Summary+diags[0].Detail, tc.message) {
+				t.Errorf("expected the API error to be surfaced, got %q: %q", diags[0].Summary, diags[0].Detail)
+			}
+		})
+	}
+}
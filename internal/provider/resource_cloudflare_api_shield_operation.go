@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiShieldOperation is the representation of an operation registered in
+// API Shield endpoint management.
+type apiShieldOperation struct {
+	OperationID string `json:"operation_id,omitempty"`
+	Method      string `json:"method"`
+	Host        string `json:"host"`
+	Endpoint    string `json:"endpoint"`
+}
+
+func resourceCloudflareAPIShieldOperation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldOperationSchema(),
+		CreateContext: resourceCloudflareAPIShieldOperationCreate,
+		ReadContext:   resourceCloudflareAPIShieldOperationRead,
+		DeleteContext: resourceCloudflareAPIShieldOperationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldOperationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage an operation in API Shield Endpoint Management.
+		`),
+	}
+}
+
+func resourceCloudflareAPIShieldOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	operation := apiShieldOperation{
+		Method:   d.Get("method").(string),
+		Host:     d.Get("host").(string),
+		Endpoint: d.Get("endpoint").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating API Shield operation %s %s%s", operation.Method, operation.Host, operation.Endpoint))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/api_gateway/operations", zoneID), []apiShieldOperation{operation}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield operation: %w", err))
+	}
+
+	var created []apiShieldOperation
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield operations: %w", err))
+	}
+
+	if len(created) != 1 {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield operation: expected 1 operation, got %d", len(created)))
+	}
+
+	d.SetId(created[0].OperationID)
+
+	return resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/api_gateway/operations/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield operation %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch API Shield operation %q: %w", d.Id(), err))
+	}
+
+	var operation apiShieldOperation
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield operation: %w", err))
+	}
+
+	d.Set("method", operation.Method)
+	d.Set("host", operation.Host)
+	d.Set("endpoint", operation.Endpoint)
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting API Shield operation %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/api_gateway/operations/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete API Shield operation %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/operationID\"", d.Id())
+	}
+
+	zoneID, operationID := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(operationID)
+
+	resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAPIShieldOperation_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceID := "cloudflare_api_shield_operation." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldOperation(rnd, zoneID, "GET", domain, "/example/path"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceID, "method", "GET"),
+					resource.TestCheckResourceAttr(resourceID, "host", domain),
+					resource.TestCheckResourceAttr(resourceID, "endpoint", "/example/path"),
+					resource.TestCheckResourceAttrSet(resourceID, "id"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldOperation(rnd, zoneID, "POST", domain, "/example/{var1}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "method", "POST"),
+					resource.TestCheckResourceAttr(resourceID, "endpoint", "/example/{var1}"),
+				),
+			},
+			{
+				ResourceName:        resourceID,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldOperation(rnd, zoneID, method, host, endpoint string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_operation" "%[1]s" {
+  zone_id  = "%[2]s"
+  method   = "%[3]s"
+  host     = "%[4]s"
+  endpoint = "%[5]s"
+}
+`, rnd, zoneID, method, host, endpoint)
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiShieldUserSchema is the representation of a schema uploaded to API
+// Shield schema validation.
+type apiShieldUserSchema struct {
+	SchemaID          string `json:"schema_id"`
+	Name              string `json:"name"`
+	Kind              string `json:"kind"`
+	Source            string `json:"source,omitempty"`
+	ValidationEnabled bool   `json:"validation_enabled"`
+}
+
+// apiShieldUserSchemaUpload is the result of uploading a new schema.
+type apiShieldUserSchemaUpload struct {
+	Schema        apiShieldUserSchema `json:"schema"`
+	UploadDetails struct {
+		Warnings []struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"warnings"`
+	} `json:"upload_details"`
+}
+
+func resourceCloudflareAPIShieldUserSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldUserSchemaSchema(),
+		CreateContext: resourceCloudflareAPIShieldUserSchemaCreate,
+		ReadContext:   resourceCloudflareAPIShieldUserSchemaRead,
+		UpdateContext: resourceCloudflareAPIShieldUserSchemaUpdate,
+		DeleteContext: resourceCloudflareAPIShieldUserSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldUserSchemaImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage a schema in API Shield Schema Validation 2.0.
+		`),
+	}
+}
+
+func resourceCloudflareAPIShieldUserSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	name := d.Get("name").(string)
+
+	var buf bytes.Buffer
+	mpw := multipart.NewWriter(&buf)
+
+	fields := map[string]string{
+		"name":               name,
+		"kind":               d.Get("kind").(string),
+		"validation_enabled": strconv.FormatBool(d.Get("validation_enabled").(bool)),
+	}
+	for field, value := range fields {
+		if err := mpw.WriteField(field, value); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to build API Shield schema upload: %w", err))
+		}
+	}
+
+	fw, err := mpw.CreateFormFile("file", name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to build API Shield schema upload: %w", err))
+	}
+	if _, err := fw.Write([]byte(d.Get("source").(string))); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to build API Shield schema upload: %w", err))
+	}
+	if err := mpw.Close(); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to build API Shield schema upload: %w", err))
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", mpw.FormDataContentType())
+
+	tflog.Debug(ctx, fmt.Sprintf("Uploading API Shield schema %s", name))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/api_gateway/user_schemas", zoneID), buf.Bytes(), headers)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield schema %q: %w", name, err))
+	}
+
+	var upload apiShieldUserSchemaUpload
+	if err := json.Unmarshal(res, &upload); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield schema: %w", err))
+	}
+
+	d.SetId(upload.Schema.SchemaID)
+
+	diags := resourceCloudflareAPIShieldUserSchemaRead(ctx, d, meta)
+	for _, warning := range upload.UploadDetails.Warnings {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("API Shield schema %q was uploaded with warnings", name),
+			Detail:   warning.Message,
+		})
+	}
+
+	return diags
+}
+
+func resourceCloudflareAPIShieldUserSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s?omit_source=false", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield schema %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch API Shield schema %q: %w", d.Id(), err))
+	}
+
+	var userSchema apiShieldUserSchema
+	if err := json.Unmarshal(res, &userSchema); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling API Shield schema: %w", err))
+	}
+
+	d.Set("name", userSchema.Name)
+	d.Set("kind", userSchema.Kind)
+	d.Set("validation_enabled", userSchema.ValidationEnabled)
+
+	// the API may reformat the uploaded schema so the source is only read
+	// back when it isn't known, such as during import.
+	if _, ok := d.GetOk("source"); !ok {
+		d.Set("source", userSchema.Source)
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldUserSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := setAPIShieldUserSchemaValidation(ctx, client, zoneID, d.Id(), d.Get("validation_enabled").(bool)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update API Shield schema %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareAPIShieldUserSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldUserSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	// a schema that is actively used for validation can't be removed so
+	// validation is disabled first.
+	if d.Get("validation_enabled").(bool) {
+		tflog.Debug(ctx, fmt.Sprintf("Disabling validation for API Shield schema %s before deletion", d.Id()))
+
+		if err := setAPIShieldUserSchemaValidation(ctx, client, zoneID, d.Id(), false); err != nil {
+			var notFoundError *cloudflare.NotFoundError
+			if errors.As(err, &notFoundError) {
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("failed to disable validation for API Shield schema %q: %w", d.Id(), err))
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting API Shield schema %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to delete API Shield schema %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldUserSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/schemaID\"", d.Id())
+	}
+
+	zoneID, schemaID := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(schemaID)
+
+	resourceCloudflareAPIShieldUserSchemaRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setAPIShieldUserSchemaValidation(ctx context.Context, client *cloudflare.API, zoneID, schemaID string, enabled bool) error {
+	body := map[string]bool{"validation_enabled": enabled}
+	_, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/api_gateway/user_schemas/%s", zoneID, schemaID), body, nil)
+	return err
+}
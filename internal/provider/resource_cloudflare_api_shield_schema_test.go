@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAPIShieldSchema_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceID := "cloudflare_api_shield_schema." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, domain, "/users", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceID, "name", rnd),
+					resource.TestCheckResourceAttr(resourceID, "kind", "openapi_v3"),
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceID, "source"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, domain, "/users", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, domain, "/accounts", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceID,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldSchema(rnd, zoneID, domain, path string, validationEnabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_schema" "%[1]s" {
+  zone_id            = "%[2]s"
+  name               = "%[1]s"
+  validation_enabled = %[5]t
+  source             = jsonencode({
+    openapi = "3.0.0"
+    info    = { title = "%[1]s", version = "1.0" }
+    servers = [{ url = "https://%[3]s" }]
+    paths   = {
+      "%[4]s" = {
+        get = {
+          responses = { "200" = { description = "OK" } }
+        }
+      }
+    }
+  })
+}
+`, rnd, zoneID, domain, path, validationEnabled)
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -88,9 +90,17 @@ func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.SetId(t.ID)
-	d.Set("status", t.Status)
 	d.Set("value", t.Value)
 
+	// Tokens are always created active.
+	if status := d.Get("status").(string); status != t.Status {
+		token := buildAPIToken(d)
+		token.Status = status
+		if _, err := client.UpdateAPIToken(ctx, t.ID, token); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating status of Cloudflare API Token %q: %w", name, err))
+		}
+	}
+
 	return resourceCloudflareApiTokenRead(ctx, d, meta)
 }
 
@@ -159,8 +169,19 @@ func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData,
 			permissionGroups = append(permissionGroups, v.ID)
 		}
 
+		// Nested resources are returned as objects and stored as JSON, the
+		// same as `jsonencode` produces.
+		resources := map[string]interface{}{}
+		for k, v := range p.Resources {
+			if value, ok := v.(string); ok {
+				resources[k] = value
+			} else {
+				resources[k] = canonicalAPITokenPolicyResource(v)
+			}
+		}
+
 		policies = append(policies, map[string]interface{}{
-			"resources":         p.Resources,
+			"resources":         resources,
 			"permission_groups": permissionGroups,
 			"effect":            p.Effect,
 		})
@@ -209,6 +230,7 @@ func resourceCloudflareApiTokenUpdate(ctx context.Context, d *schema.ResourceDat
 	tokenID := d.Id()
 
 	t := buildAPIToken(d)
+	t.Status = d.Get("status").(string)
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare API Token: name %s", name))
 
@@ -233,3 +255,79 @@ func resourceCloudflareApiTokenDelete(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+// canonicalAPITokenPolicyResource returns the value of a policy resource with
+// nested resources encoded as JSON with sorted keys.
+func canonicalAPITokenPolicyResource(value interface{}) string {
+	if v, ok := value.(string); ok {
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &obj); err != nil {
+			return v
+		}
+		value = obj
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(encoded)
+}
+
+// hashAPITokenPolicy hashes a policy in a canonical form, with the
+// permission groups and resources sorted, so the normalisation done by the
+// API doesn't cause a diff.
+func hashAPITokenPolicy(v interface{}) int {
+	policy := v.(map[string]interface{})
+
+	var permissionGroups []string
+	switch groups := policy["permission_groups"].(type) {
+	case *schema.Set:
+		permissionGroups = expandInterfaceToStringList(groups.List())
+	case []interface{}:
+		permissionGroups = expandInterfaceToStringList(groups)
+	case []string:
+		permissionGroups = append(permissionGroups, groups...)
+	}
+	sort.Strings(permissionGroups)
+
+	resources, _ := policy["resources"].(map[string]interface{})
+	resourceKeys := make([]string, 0, len(resources))
+	for k := range resources {
+		resourceKeys = append(resourceKeys, k)
+	}
+	sort.Strings(resourceKeys)
+
+	var buf strings.Builder
+	effect, _ := policy["effect"].(string)
+	if effect == "" {
+		effect = "allow"
+	}
+	buf.WriteString(effect + ";")
+	buf.WriteString(strings.Join(permissionGroups, ",") + ";")
+	for _, k := range resourceKeys {
+		buf.WriteString(k + "=" + canonicalAPITokenPolicyResource(resources[k]) + ";")
+	}
+
+	return schema.HashString(buf.String())
+}
+
+func suppressEquivalentAPITokenTimestamp(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339Nano, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339Nano, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+// suppressExpiredAPITokenStatus ignores the status of tokens which expired,
+// as they can't be made active again without changing `expires_on`.
+func suppressExpiredAPITokenStatus(k, old, new string, d *schema.ResourceData) bool {
+	return old == "expired" && !d.HasChange("expires_on")
+}
@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccAPIToken_Basic(t *testing.T) {
@@ -247,24 +255,43 @@ func TestAccAPIToken_TokenTTL(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_api_token." + rnd
 	permissionID := "82e64a83756745bbbb1c9c2701bf816b" // DNS read
+	var value string
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: providerFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCloudflareAPITokenWithTTL(rnd, permissionID),
+				Config: testAccCloudflareAPITokenWithTTL(rnd, permissionID, "2032-01-01T00:00:00Z"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "name", rnd),
 					resource.TestCheckResourceAttr(name, "not_before", "2018-07-01T05:20:00Z"),
 					resource.TestCheckResourceAttr(name, "expires_on", "2032-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+					resource.TestCheckResourceAttrWith(name, "value", func(v string) error {
+						value = v
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccCloudflareAPITokenWithTTL(rnd, permissionID, "2033-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "expires_on", "2033-01-01T00:00:00Z"),
+					// Updating the expiry doesn't roll the token.
+					resource.TestCheckResourceAttrWith(name, "value", func(v string) error {
+						if v != value {
+							return fmt.Errorf("expected the token value to be kept")
+						}
+						return nil
+					}),
 				),
 			},
 		},
 	})
 }
 
-func testAccCloudflareAPITokenWithTTL(rnd string, permissionID string) string {
+func testAccCloudflareAPITokenWithTTL(rnd, permissionID, expiresOn string) string {
 	return fmt.Sprintf(`
 	resource "cloudflare_api_token" "%[1]s" {
 		name = "%[1]s"
@@ -276,7 +303,140 @@ func testAccCloudflareAPITokenWithTTL(rnd string, permissionID string) string {
 		}
 
 		not_before = "2018-07-01T05:20:00Z"
-		expires_on = "2032-01-01T00:00:00Z"
+		expires_on = "%[3]s"
+	}
+`, rnd, permissionID, expiresOn)
+}
+
+func TestHashAPITokenPolicy(t *testing.T) {
+	policy := map[string]interface{}{
+		"effect":            "allow",
+		"permission_groups": []interface{}{"82e64a83756745bbbb1c9c2701bf816b", "c8fed203ed3043cba015a93ad1616f1f"},
+		"resources":         map[string]interface{}{"com.cloudflare.api.account.f037e56e89293a057740de681ac9abbe": `{"com.cloudflare.api.account.zone.*":"*"}`},
+	}
+
+	testCases := map[string]struct {
+		policy   map[string]interface{}
+		expected bool
+	}{
+		"permission groups order": {
+			policy: map[string]interface{}{
+				"effect":            "allow",
+				"permission_groups": []interface{}{"c8fed203ed3043cba015a93ad1616f1f", "82e64a83756745bbbb1c9c2701bf816b"},
+				"resources":         policy["resources"],
+			},
+			expected: true,
+		},
+		"nested resources formatting": {
+			policy: map[string]interface{}{
+				"effect":            "allow",
+				"permission_groups": policy["permission_groups"],
+				"resources":         map[string]interface{}{"com.cloudflare.api.account.f037e56e89293a057740de681ac9abbe": `{ "com.cloudflare.api.account.zone.*": "*" }`},
+			},
+			expected: true,
+		},
+		"effect": {
+			policy: map[string]interface{}{
+				"effect":            "deny",
+				"permission_groups": policy["permission_groups"],
+				"resources":         policy["resources"],
+			},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := hashAPITokenPolicy(tc.policy) == hashAPITokenPolicy(policy); got != tc.expected {
+				t.Errorf("expected equal hashes to be %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudflareApiTokenUpdateExpiry(t *testing.T) {
+	const tokenID = "ed17574386854bf78a67040be0a770b0"
+
+	var requests []string
+	var update cloudflare.APIToken
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/user/tokens/"+tokenID:
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/user/tokens/"+tokenID:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		// The API returns permission groups in its own order and nested
+		// resources as objects.
+		testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "name": "example",
+  "status": "active",
+  "issued_on": "2023-01-01T00:00:00Z",
+  "modified_on": "2023-02-01T00:00:00Z",
+  "expires_on": "2033-01-01T00:00:00Z",
+  "policies": [{
+    "id": "f267e341f3dd4697bd3b9f71dd96247f",
+    "effect": "allow",
+    "permission_groups": [{"id": "c8fed203ed3043cba015a93ad1616f1f"}, {"id": "82e64a83756745bbbb1c9c2701bf816b"}],
+    "resources": {"com.cloudflare.api.account.f037e56e89293a057740de681ac9abbe": {"com.cloudflare.api.account.zone.*": "*"}}
+  }]
+}`, tokenID))
+	})
+
+	config := func(expiresOn string) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "example",
+			"policy": []interface{}{map[string]interface{}{
+				"permission_groups": []interface{}{"82e64a83756745bbbb1c9c2701bf816b", "c8fed203ed3043cba015a93ad1616f1f"},
+				"resources":         map[string]interface{}{"com.cloudflare.api.account.f037e56e89293a057740de681ac9abbe": `{"com.cloudflare.api.account.zone.*":"*"}`},
+			}},
+			"expires_on": expiresOn,
+		}
+	}
+
+	ctx := context.Background()
+	r := resourceCloudflareApiToken()
+	d := schema.TestResourceDataRaw(t, r.Schema, config("2032-01-01T00:00:00Z"))
+	d.SetId(tokenID)
+	d.Set("value", "secret")
+
+	diff, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config("2033-01-01T00:00:00Z")), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(r.Schema).Data(d.State(), diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceCloudflareApiTokenUpdate(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{"PUT /user/tokens/" + tokenID, "GET /user/tokens/" + tokenID}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+	if update.ExpiresOn == nil || !update.ExpiresOn.Equal(time.Date(2033, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the new expiry to be sent, got %v", update.ExpiresOn)
+	}
+	if got := d.Get("value").(string); got != "secret" {
+		t.Errorf("expected the token value to be kept, got %q", got)
+	}
+
+	diff, err = r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config("2033-01-01T00:00:00Z")), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("expected no diff after reading the token, got %v", diff.Attributes)
 	}
-`, rnd, permissionID)
 }
@@ -19,6 +19,7 @@ func resourceCloudflareArgo() *schema.Resource {
 		ReadContext:   resourceCloudflareArgoRead,
 		UpdateContext: resourceCloudflareArgoUpdate,
 		DeleteContext: resourceCloudflareArgoDelete,
+		CustomizeDiff: resourceCloudflareArgoCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareArgoImport,
 		},
@@ -93,14 +94,21 @@ func resourceCloudflareArgoDelete(ctx context.Context, d *schema.ResourceData, m
 
 	tflog.Debug(ctx, fmt.Sprintf("Resetting Argo values to 'off'"))
 
-	_, smartRoutingErr := client.UpdateArgoSmartRouting(ctx, zoneID, "off")
-	if smartRoutingErr != nil {
-		return diag.FromErr(errors.Wrap(smartRoutingErr, "failed to update smart routing setting"))
+	// Settings are only reset when they're managed by this resource, so
+	// moving them to `cloudflare_argo_smart_routing` and
+	// `cloudflare_tiered_cache` doesn't turn them off.
+	if d.Get("smart_routing").(string) != "" {
+		_, smartRoutingErr := client.UpdateArgoSmartRouting(ctx, zoneID, "off")
+		if smartRoutingErr != nil {
+			return diag.FromErr(errors.Wrap(smartRoutingErr, "failed to update smart routing setting"))
+		}
 	}
 
-	_, tieredCachingErr := client.UpdateArgoTieredCaching(ctx, zoneID, "off")
-	if tieredCachingErr != nil {
-		return diag.FromErr(errors.Wrap(tieredCachingErr, "failed to update tiered caching setting"))
+	if d.Get("tiered_caching").(string) != "" {
+		_, tieredCachingErr := client.UpdateArgoTieredCaching(ctx, zoneID, "off")
+		if tieredCachingErr != nil {
+			return diag.FromErr(errors.Wrap(tieredCachingErr, "failed to update tiered caching setting"))
+		}
 	}
 
 	return nil
@@ -117,3 +125,11 @@ func resourceCloudflareArgoImport(ctx context.Context, d *schema.ResourceData, m
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func resourceCloudflareArgoCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("smart_routing").(string) != "" && d.NewValueKnown("zone_id") {
+		noteArgoSmartRoutingManager(ctx, meta.(*cloudflare.API), d.Get("zone_id").(string), "cloudflare_argo")
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// argoSmartRoutingManager identifies a zone planned by a client.
+type argoSmartRoutingManager struct {
+	client *cloudflare.API
+	zoneID string
+}
+
+// argoSmartRoutingManagers records which resource types plan the smart
+// routing setting of a zone, to note when both `cloudflare_argo` and
+// `cloudflare_argo_smart_routing` manage the same zone.
+var argoSmartRoutingManagers sync.Map
+
+// noteArgoSmartRoutingManager records that resourceType manages the smart
+// routing setting of the zone and notes when another resource type already
+// does. It reports whether both resource types manage the zone.
+func noteArgoSmartRoutingManager(ctx context.Context, client *cloudflare.API, zoneID, resourceType string) bool {
+	key := argoSmartRoutingManager{client: client, zoneID: zoneID}
+	existing, loaded := argoSmartRoutingManagers.LoadOrStore(key, resourceType)
+	if !loaded || existing.(string) == resourceType {
+		return false
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("Smart routing of zone %q is managed by both %s and %s, which will overwrite each other. Remove smart_routing from cloudflare_argo.", zoneID, existing, resourceType))
+	return true
+}
+
+func resourceCloudflareArgoSmartRouting() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareArgoSmartRoutingSchema(),
+		CreateContext: resourceCloudflareArgoSmartRoutingUpdate,
+		ReadContext:   resourceCloudflareArgoSmartRoutingRead,
+		UpdateContext: resourceCloudflareArgoSmartRoutingUpdate,
+		DeleteContext: resourceCloudflareArgoSmartRoutingDelete,
+		CustomizeDiff: resourceCloudflareArgoSmartRoutingCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareArgoSmartRoutingImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages Cloudflare Argo Smart Routing for
+			a zone. Destroying the resource turns smart routing off.
+		`),
+	}
+}
+
+func resourceCloudflareArgoSmartRoutingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	smartRouting, err := client.ArgoSmartRouting(ctx, zoneID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Zone %s no longer exists", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error retrieving smart routing setting: %w", err))
+	}
+
+	d.Set("value", smartRouting.Value)
+
+	return nil
+}
+
+func resourceCloudflareArgoSmartRoutingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	smartRouting, err := client.UpdateArgoSmartRouting(ctx, zoneID, d.Get("value").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating smart routing setting: %w", err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Argo Smart Routing set to: %s", smartRouting.Value))
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareArgoSmartRoutingRead(ctx, d, meta)
+}
+
+func resourceCloudflareArgoSmartRoutingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	_, err := client.UpdateArgoSmartRouting(ctx, zoneID, "off")
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting smart routing setting: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareArgoSmartRoutingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareArgoSmartRoutingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudflareArgoSmartRoutingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("zone_id") {
+		noteArgoSmartRoutingManager(ctx, meta.(*cloudflare.API), d.Get("zone_id").(string), "cloudflare_argo_smart_routing")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareArgoSmartRouting_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_argo_smart_routing.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareArgoSmartRoutingConfig(rnd, zoneID, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "value", "on"),
+				),
+			},
+			{
+				Config: testAccCloudflareArgoSmartRoutingConfig(rnd, zoneID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "off"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareArgoSmartRoutingConfig(resourceName, zoneID, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_argo_smart_routing" "%[1]s" {
+  zone_id = "%[2]s"
+  value   = "%[3]s"
+}`, resourceName, zoneID, value)
+}
+
+func TestCloudflareArgoSmartRoutingDelete(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	value := "on"
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/argo/smart_routing" || r.Method != http.MethodPatch {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		value = "off"
+		testAPIResult(w, `{"id": "smart_routing", "value": "off", "editable": true}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareArgoSmartRoutingSchema(), map[string]interface{}{
+		"zone_id": zoneID,
+		"value":   "on",
+	})
+	d.SetId(zoneID)
+
+	if diags := resourceCloudflareArgoSmartRoutingDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if value != "off" {
+		t.Errorf("expected smart routing to be turned off on destroy, got %q", value)
+	}
+}
+
+func TestCloudflareArgoSmartRoutingReadZoneNotFound(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		testAPIError(w, http.StatusNotFound, 1001, "Invalid zone identifier")
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareArgoSmartRoutingSchema(), map[string]interface{}{
+		"zone_id": zoneID,
+		"value":   "on",
+	})
+	d.SetId(zoneID)
+
+	if diags := resourceCloudflareArgoSmartRoutingRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be removed from state, got ID %q", d.Id())
+	}
+}
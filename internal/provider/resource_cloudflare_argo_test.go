@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareArgoOnlySetTieredCaching(t *testing.T) {
@@ -68,3 +72,67 @@ func testAccCheckCloudflareArgoFullConfig(zoneID, name string) string {
     smart_routing  = "on"
   }`, zoneID, name)
 }
+
+func TestCloudflareArgoDeleteKeepsUnmanagedSettings(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	var requests []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests = append(requests, r.URL.Path)
+		testAPIResult(w, `{"id": "smart_routing", "value": "off", "editable": true}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareArgoSchema(), map[string]interface{}{
+		"zone_id":       zoneID,
+		"smart_routing": "on",
+	})
+
+	if diags := resourceCloudflareArgoDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{"/zones/" + zoneID + "/argo/smart_routing"}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected only smart routing to be reset, got requests to %v", requests)
+	}
+
+	requests = nil
+	d = schema.TestResourceDataRaw(t, resourceCloudflareArgoSchema(), map[string]interface{}{
+		"zone_id":        zoneID,
+		"tiered_caching": "on",
+	})
+
+	if diags := resourceCloudflareArgoDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected = []string{"/zones/" + zoneID + "/argo/tiered_caching"}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected only tiered caching to be reset, got requests to %v", requests)
+	}
+}
+
+func TestNoteArgoSmartRoutingManager(t *testing.T) {
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+	})
+	ctx := context.Background()
+
+	if noteArgoSmartRoutingManager(ctx, client, "0da42c8d2132a9ddaf714f9e7c920711", "cloudflare_argo") {
+		t.Error("expected no note for the first resource managing the zone")
+	}
+	if noteArgoSmartRoutingManager(ctx, client, "0da42c8d2132a9ddaf714f9e7c920711", "cloudflare_argo") {
+		t.Error("expected no note for the same resource type managing the zone")
+	}
+	if noteArgoSmartRoutingManager(ctx, client, "023e105f4ecef8ad9ca31a8372d0c353", "cloudflare_argo_smart_routing") {
+		t.Error("expected no note for resources managing different zones")
+	}
+	if !noteArgoSmartRoutingManager(ctx, client, "0da42c8d2132a9ddaf714f9e7c920711", "cloudflare_argo_smart_routing") {
+		t.Error("expected a note when both resource types manage the same zone")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
@@ -42,7 +43,7 @@ func resourceCloudflareAuthenticatedOriginPullsCreate(ctx context.Context, d *sc
 		isEnabled = enabledVal.(bool)
 	}
 	switch {
-	case hostname != "" && aopCert != "":
+	case hostname != "":
 		// Per Hostname AOP
 		conf := []cloudflare.PerHostnameAuthenticatedOriginPullsConfig{{
 			CertID:   aopCert,
@@ -82,31 +83,48 @@ func resourceCloudflareAuthenticatedOriginPullsRead(ctx context.Context, d *sche
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
 
-	if hostname != "" && aopCert != "" {
+	switch {
+	case hostname != "":
 		// Per Hostname AOP
 		res, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, hostname)
 		if err != nil {
+			var notFoundError *cloudflare.NotFoundError
+			if errors.As(err, &notFoundError) {
+				tflog.Warn(ctx, fmt.Sprintf("Removing Per-Hostname Authenticated Origin Pulls for hostname %q from state because it's not found in API", hostname))
+				d.SetId("")
+				return nil
+			}
 			return diag.FromErr(errors.Wrap(err, "failed to get Per-Hostname Authenticated Origin Pulls setting"))
 		}
 		d.Set("enabled", res.Enabled)
-	} else if aopCert != "" {
+		d.Set("status", res.Status)
+		d.Set("cert_status", res.CertStatus)
+		if res.CertID != "" {
+			d.Set("authenticated_origin_pulls_certificate", res.CertID)
+		}
+	case aopCert != "":
 		// Per Zone AOP
 		res, err := client.GetPerZoneAuthenticatedOriginPullsStatus(ctx, zoneID)
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, "failed to get Per-Zone Authenticated Origin Pulls setting"))
 		}
 		d.Set("enabled", res.Enabled)
-	} else {
+		d.Set("status", "")
+
+		cert, err := client.GetPerZoneAuthenticatedOriginPullsCertificateDetails(ctx, zoneID, aopCert)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "failed to get Per-Zone Authenticated Origin Pulls certificate"))
+		}
+		d.Set("cert_status", cert.Status)
+	default:
 		// Global AOP
 		res, err := client.GetAuthenticatedOriginPullsStatus(ctx, zoneID)
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, "failed to get Global Authenticated Origin Pulls setting"))
 		}
-		if res.Value == "on" {
-			d.Set("enabled", true)
-		} else {
-			d.Set("enabled", false)
-		}
+		d.Set("enabled", res.Value == "on")
+		d.Set("status", "")
+		d.Set("cert_status", "")
 	}
 	return nil
 }
@@ -117,8 +135,8 @@ func resourceCloudflareAuthenticatedOriginPullsDelete(ctx context.Context, d *sc
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
 
-	if hostname != "" && aopCert != "" {
-		// Per Hostname AOP
+	if hostname != "" {
+		// Per Hostname AOP, only the hostname of the resource is disabled
 		conf := []cloudflare.PerHostnameAuthenticatedOriginPullsConfig{{
 			CertID:   aopCert,
 			Hostname: hostname,
@@ -126,7 +144,7 @@ func resourceCloudflareAuthenticatedOriginPullsDelete(ctx context.Context, d *sc
 		}}
 		_, err := client.EditPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, conf)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error disabling Per-Hostname Authenticated Origin Pulls resource on zone %q: %w", zoneID, err))
+			return diag.FromErr(fmt.Errorf("error disabling Per-Hostname Authenticated Origin Pulls resource on zone %q for hostname %s: %w", zoneID, hostname, err))
 		}
 	} else if aopCert != "" {
 		// Per Zone AOP
@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareAuthenticatedOriginPullsGlobal(t *testing.T) {
@@ -68,6 +73,8 @@ func TestAccCloudflareAuthenticatedOriginPullsPerHostname(t *testing.T) {
 				Config: testAccCheckCloudflareAuthenticatedOriginPullsConfig(zoneID, rnd, "per-hostname", hostname),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					resource.TestCheckResourceAttrSet(name, "cert_status"),
 				),
 			},
 		},
@@ -112,3 +119,126 @@ func testAccCheckCloudflareAuthenticatedOriginPullsConfig(zoneID, name, aopType,
 		  enabled = true
 		}`, name, zoneID, aopType, name+"."+hostname)
 }
+
+func TestCloudflareAuthenticatedOriginPullsModes(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const certID = "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60"
+
+	testCases := map[string]struct {
+		config     map[string]interface{}
+		responses  map[string]string
+		expected   []string
+		status     string
+		certStatus string
+	}{
+		"zone-level": {
+			config: map[string]interface{}{"zone_id": zoneID, "enabled": true},
+			responses: map[string]string{
+				"/zones/" + zoneID + "/settings/tls_client_auth": `{"id": "tls_client_auth", "value": "on"}`,
+			},
+			expected: []string{
+				"PATCH /zones/" + zoneID + "/settings/tls_client_auth",
+				"GET /zones/" + zoneID + "/settings/tls_client_auth",
+				"PATCH /zones/" + zoneID + "/settings/tls_client_auth",
+			},
+		},
+		"zone-level with certificate": {
+			config: map[string]interface{}{"zone_id": zoneID, "authenticated_origin_pulls_certificate": certID, "enabled": true},
+			responses: map[string]string{
+				"/zones/" + zoneID + "/origin_tls_client_auth/settings":  `{"enabled": true}`,
+				"/zones/" + zoneID + "/origin_tls_client_auth/" + certID: `{"id": "` + certID + `", "status": "active"}`,
+			},
+			expected: []string{
+				"PUT /zones/" + zoneID + "/origin_tls_client_auth/settings",
+				"GET /zones/" + zoneID + "/origin_tls_client_auth/settings",
+				"GET /zones/" + zoneID + "/origin_tls_client_auth/" + certID,
+				"PUT /zones/" + zoneID + "/origin_tls_client_auth/settings",
+			},
+			certStatus: "active",
+		},
+		"per-hostname with certificate": {
+			config: map[string]interface{}{"zone_id": zoneID, "authenticated_origin_pulls_certificate": certID, "hostname": "app.example.com", "enabled": true},
+			responses: map[string]string{
+				"/zones/" + zoneID + "/origin_tls_client_auth/hostnames":                 `[]`,
+				"/zones/" + zoneID + "/origin_tls_client_auth/hostnames/app.example.com": `{"hostname": "app.example.com", "cert_id": "` + certID + `", "enabled": true, "status": "active", "cert_status": "active"}`,
+			},
+			expected: []string{
+				"PUT /zones/" + zoneID + "/origin_tls_client_auth/hostnames",
+				"GET /zones/" + zoneID + "/origin_tls_client_auth/hostnames/app.example.com",
+				"PUT /zones/" + zoneID + "/origin_tls_client_auth/hostnames",
+			},
+			status:     "active",
+			certStatus: "active",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				result, ok := tc.responses[r.URL.Path]
+				if !ok {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				testAPIResult(w, result)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareAuthenticatedOriginPullsSchema(), tc.config)
+			if diags := resourceCloudflareAuthenticatedOriginPullsCreate(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !d.Get("enabled").(bool) {
+				t.Error("expected Authenticated Origin Pulls to be enabled")
+			}
+			if got := d.Get("status").(string); got != tc.status {
+				t.Errorf("expected status %q, got %q", tc.status, got)
+			}
+			if got := d.Get("cert_status").(string); got != tc.certStatus {
+				t.Errorf("expected cert_status %q, got %q", tc.certStatus, got)
+			}
+
+			if diags := resourceCloudflareAuthenticatedOriginPullsDelete(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !reflect.DeepEqual(requests, tc.expected) {
+				t.Errorf("expected requests %v, got %v", tc.expected, requests)
+			}
+		})
+	}
+}
+
+func TestCloudflareAuthenticatedOriginPullsPerHostnameDeleteOnlyDisablesHostname(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	var body string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/zones/"+zoneID+"/origin_tls_client_auth/hostnames" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		encoded, _ := io.ReadAll(r.Body)
+		body = string(encoded)
+		testAPIResult(w, `[]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAuthenticatedOriginPullsSchema(), map[string]interface{}{
+		"zone_id":                                zoneID,
+		"authenticated_origin_pulls_certificate": "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60",
+		"hostname":                               "app.example.com",
+		"enabled":                                true,
+	})
+	if diags := resourceCloudflareAuthenticatedOriginPullsDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"config":[{"hostname":"app.example.com","cert_id":"2458ce5a-0c35-4c7f-82c7-8e9487d3ff60","enabled":false}]}`
+	if body != expected {
+		t.Errorf("expected only the hostname to be disabled with %s, got %s", expected, body)
+	}
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// botManagement is the representation of the Bot Management settings of a
+// zone. Which fields are populated depends on the plan of the zone so
+// every field is optional.
+type botManagement struct {
+	EnableJS                     *bool   `json:"enable_js,omitempty"`
+	FightMode                    *bool   `json:"fight_mode,omitempty"`
+	SBFMDefinitelyAutomated      *string `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated          *string `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots             *string `json:"sbfm_verified_bots,omitempty"`
+	SBFMStaticResourceProtection *bool   `json:"sbfm_static_resource_protection,omitempty"`
+	OptimizeWordpress            *bool   `json:"optimize_wordpress,omitempty"`
+	SuppressSessionScore         *bool   `json:"suppress_session_score,omitempty"`
+	AutoUpdateModel              *bool   `json:"auto_update_model,omitempty"`
+	UsingLatestModel             *bool   `json:"using_latest_model,omitempty"`
+}
+
+func resourceCloudflareBotManagement() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareBotManagementSchema(),
+		CreateContext: resourceCloudflareBotManagementUpdate,
+		ReadContext:   resourceCloudflareBotManagementRead,
+		UpdateContext: resourceCloudflareBotManagementUpdate,
+		DeleteContext: resourceCloudflareBotManagementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareBotManagementImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to configure Bot Management.
+
+			Specifically, this resource can be used to manage:
+
+			- **Bot Fight Mode**
+			- **Super Bot Fight Mode**
+			- **Bot Management for Enterprise**
+		`),
+	}
+}
+
+func resourceCloudflareBotManagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Id()
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/bot_management", zoneID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading bot management for zone %q: %w", zoneID, err))
+	}
+
+	var bm botManagement
+	if err := json.Unmarshal(res, &bm); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling bot management: %w", err))
+	}
+
+	d.Set("zone_id", zoneID)
+
+	// fields that aren't available to the zone's plan are returned as null
+	// and are left as they are in state to avoid a perpetual diff.
+	for key, value := range map[string]interface{}{
+		"enable_js":                       bm.EnableJS,
+		"fight_mode":                      bm.FightMode,
+		"sbfm_definitely_automated":       bm.SBFMDefinitelyAutomated,
+		"sbfm_likely_automated":           bm.SBFMLikelyAutomated,
+		"sbfm_verified_bots":              bm.SBFMVerifiedBots,
+		"sbfm_static_resource_protection": bm.SBFMStaticResourceProtection,
+		"optimize_wordpress":              bm.OptimizeWordpress,
+		"suppress_session_score":          bm.SuppressSessionScore,
+		"auto_update_model":               bm.AutoUpdateModel,
+		"using_latest_model":              bm.UsingLatestModel,
+	} {
+		switch v := value.(type) {
+		case *bool:
+			if v != nil {
+				d.Set(key, *v)
+			}
+		case *string:
+			if v != nil {
+				d.Set(key, *v)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareBotManagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	bm := buildBotManagement(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating bot management for zone %s", zoneID))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/bot_management", zoneID), bm, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating bot management for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareBotManagementRead(ctx, d, meta)
+}
+
+func resourceCloudflareBotManagementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Bot Management settings can't be deleted, instead simply removing from terraform management without changing the existing settings",
+	}}
+}
+
+func resourceCloudflareBotManagementImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareBotManagementRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildBotManagement only includes the fields that are set in the
+// configuration so that settings unsupported by the zone's plan aren't sent.
+func buildBotManagement(d *schema.ResourceData) botManagement {
+	bm := botManagement{}
+
+	boolValue := func(key string) *bool {
+		if v, ok := d.GetOkExists(key); ok {
+			b := v.(bool)
+			return &b
+		}
+		return nil
+	}
+	stringValue := func(key string) *string {
+		if v, ok := d.GetOk(key); ok {
+			s := v.(string)
+			return &s
+		}
+		return nil
+	}
+
+	bm.EnableJS = boolValue("enable_js")
+	bm.FightMode = boolValue("fight_mode")
+	bm.SBFMDefinitelyAutomated = stringValue("sbfm_definitely_automated")
+	bm.SBFMLikelyAutomated = stringValue("sbfm_likely_automated")
+	bm.SBFMVerifiedBots = stringValue("sbfm_verified_bots")
+	bm.SBFMStaticResourceProtection = boolValue("sbfm_static_resource_protection")
+	bm.OptimizeWordpress = boolValue("optimize_wordpress")
+	bm.SuppressSessionScore = boolValue("suppress_session_score")
+	bm.AutoUpdateModel = boolValue("auto_update_model")
+
+	return bm
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareBotManagement_SBFM(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_bot_management." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, "managed_challenge"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "enable_js", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sbfm_definitely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(resourceName, "sbfm_likely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(resourceName, "sbfm_verified_bots", "allow"),
+					resource.TestCheckResourceAttr(resourceName, "sbfm_static_resource_protection", "false"),
+					resource.TestCheckResourceAttr(resourceName, "optimize_wordpress", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sbfm_definitely_automated", "block"),
+					resource.TestCheckResourceAttr(resourceName, "sbfm_likely_automated", "block"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, action string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_bot_management" "%[1]s" {
+  zone_id                         = "%[2]s"
+  enable_js                       = true
+  sbfm_definitely_automated       = "%[3]s"
+  sbfm_likely_automated           = "%[3]s"
+  sbfm_verified_bots              = "allow"
+  sbfm_static_resource_protection = false
+  optimize_wordpress              = true
+}
+`, rnd, zoneID, action)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -19,9 +21,14 @@ func resourceCloudflareBYOIPPrefix() *schema.Resource {
 		ReadContext:   resourceCloudflareBYOIPPrefixRead,
 		UpdateContext: resourceCloudflareBYOIPPrefixUpdate,
 		DeleteContext: resourceCloudflareBYOIPPrefixDelete,
+		CustomizeDiff: resourceCloudflareBYOIPPrefixCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareBYOIPPrefixImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides the ability to manage Bring-Your-Own-IP prefixes (BYOIP)
 			which are used with or without Magic Transit.
@@ -68,6 +75,8 @@ func resourceCloudflareBYOIPPrefixRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.Set("description", prefix.Description)
+	d.Set("on_demand_enabled", prefix.OnDemandEnabled)
+	d.Set("on_demand_locked", prefix.OnDemandLocked)
 
 	advertisementStatus, err := client.GetAdvertisementStatus(ctx, accountID, d.Id())
 	if err != nil {
@@ -75,6 +84,9 @@ func resourceCloudflareBYOIPPrefixRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	d.Set("advertisement", stringFromBool(advertisementStatus.Advertised))
+	if advertisementStatus.AdvertisedModifiedAt != nil {
+		d.Set("advertised_modified_at", advertisementStatus.AdvertisedModifiedAt.Format(time.RFC3339Nano))
+	}
 
 	return nil
 }
@@ -90,9 +102,62 @@ func resourceCloudflareBYOIPPrefixUpdate(ctx context.Context, d *schema.Resource
 	}
 
 	if _, ok := d.GetOk("advertisement"); ok && d.HasChange("advertisement") {
-		if _, err := client.UpdateAdvertisementStatus(ctx, accountID, d.Id(), boolFromString(d.Get("advertisement").(string))); err != nil {
+		advertised := boolFromString(d.Get("advertisement").(string))
+		if _, err := client.UpdateAdvertisementStatus(ctx, accountID, d.Id(), advertised); err != nil {
 			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("cannot update prefix advertisement status for %q", d.Id())))
 		}
+
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+		if err := waitForBYOIPPrefixAdvertisement(ctx, client, accountID, d.Id(), advertised, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// waitForBYOIPPrefixAdvertisement polls the advertisement status of a prefix
+// until the requested status is reflected, as updates are applied
+// asynchronously.
+func waitForBYOIPPrefixAdvertisement(ctx context.Context, client *cloudflare.API, accountID, prefixID string, advertised bool, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		status, err := client.GetAdvertisementStatus(ctx, accountID, prefixID)
+		if err != nil {
+			return resource.NonRetryableError(errors.Wrap(err, fmt.Sprintf("error reading advertisement status of IP prefix for %q", prefixID)))
+		}
+
+		if status.Advertised != advertised {
+			return resource.RetryableError(fmt.Errorf("advertisement of IP prefix %q is not yet %s", prefixID, stringFromBool(advertised)))
+		}
+
+		return nil
+	})
+}
+
+// resourceCloudflareBYOIPPrefixCustomizeDiff rejects changes to the
+// advertisement of prefixes which are locked to their current status.
+func resourceCloudflareBYOIPPrefixCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("advertisement") || !d.NewValueKnown("advertisement") || d.Get("advertisement").(string) == "" {
+		return nil
+	}
+	if !d.NewValueKnown("account_id") || !d.NewValueKnown("prefix_id") {
+		return nil
+	}
+
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	prefixID := d.Get("prefix_id").(string)
+
+	prefix, err := client.GetPrefix(ctx, accountID, prefixID)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("error reading IP prefix information for %q", prefixID))
+	}
+
+	if prefix.OnDemandLocked && prefix.Advertised != boolFromString(d.Get("advertisement").(string)) {
+		return fmt.Errorf("advertisement of IP prefix %q can't be changed as it is locked (`on_demand_locked`), contact Cloudflare to unlock it", prefixID)
 	}
 
 	return nil
@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareBYOIPPrefix(t *testing.T) {
@@ -48,3 +55,92 @@ func testAccCheckCloudflareBYOIPPrefixConfig(prefixID, description, name string)
 	  description = "%[2]s"
   }`, prefixID, description, name)
 }
+
+// testCloudflareBYOIPPrefixServer returns an API which applies advertisement
+// updates after the status has been read a number of times.
+func testCloudflareBYOIPPrefixServer(t *testing.T, locked bool, delay int) (*cloudflare.API, *int) {
+	const prefixPath = "/accounts/f037e56e89293a057740de681ac9abbe/addressing/prefixes/2af39739cc4e3b5910c918468bb89828"
+
+	advertised, requested := false, false
+	pending, updates := 0, 0
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == prefixPath:
+			testAPIResult(w, fmt.Sprintf(`{"id": "2af39739cc4e3b5910c918468bb89828", "cidr": "192.0.2.0/24", "description": "example", "on_demand_enabled": true, "on_demand_locked": %t, "advertised": %t}`, locked, advertised))
+			return
+		case r.Method == http.MethodPatch && r.URL.Path == prefixPath+"/bgp/status":
+			var body cloudflare.AdvertisementStatusUpdateRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			requested, pending = body.Advertised, delay
+			updates++
+		case r.Method == http.MethodGet && r.URL.Path == prefixPath+"/bgp/status":
+			if pending > 0 {
+				pending--
+			} else {
+				advertised = requested
+			}
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, fmt.Sprintf(`{"advertised": %t, "advertised_modified_at": "2023-01-02T03:04:05Z"}`, advertised))
+	})
+
+	return client, &updates
+}
+
+func TestCloudflareBYOIPPrefixWaitsForAdvertisement(t *testing.T) {
+	client, updates := testCloudflareBYOIPPrefixServer(t, false, 2)
+
+	r := resourceCloudflareBYOIPPrefix()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"account_id":    "f037e56e89293a057740de681ac9abbe",
+		"prefix_id":     "2af39739cc4e3b5910c918468bb89828",
+		"advertisement": "on",
+	})
+
+	if diags := resourceCloudflareBYOIPPrefixCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if *updates != 1 {
+		t.Errorf("expected the advertisement to be updated once, got %d updates", *updates)
+	}
+	if got := d.Get("advertisement").(string); got != "on" {
+		t.Errorf("expected the requested advertisement to be read after the update, got %q", got)
+	}
+	if got := d.Get("advertised_modified_at").(string); got != "2023-01-02T03:04:05Z" {
+		t.Errorf("expected advertised_modified_at to be set, got %q", got)
+	}
+	if !d.Get("on_demand_enabled").(bool) || d.Get("on_demand_locked").(bool) {
+		t.Errorf("expected the on demand status to be read, got enabled %t and locked %t", d.Get("on_demand_enabled"), d.Get("on_demand_locked"))
+	}
+}
+
+func TestCloudflareBYOIPPrefixCustomizeDiffRejectsLockedPrefix(t *testing.T) {
+	client, updates := testCloudflareBYOIPPrefixServer(t, true, 0)
+
+	config := map[string]interface{}{
+		"account_id":    "f037e56e89293a057740de681ac9abbe",
+		"prefix_id":     "2af39739cc4e3b5910c918468bb89828",
+		"advertisement": "on",
+	}
+
+	_, err := resourceCloudflareBYOIPPrefix().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err == nil || !strings.Contains(err.Error(), "locked") {
+		t.Errorf("expected the advertisement change to be rejected, got %v", err)
+	}
+
+	config["advertisement"] = "off"
+	if _, err := resourceCloudflareBYOIPPrefix().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client); err != nil {
+		t.Errorf("expected the current advertisement to be accepted, got %v", err)
+	}
+
+	if *updates != 0 {
+		t.Errorf("expected no updates, got %d", *updates)
+	}
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cacheReserveNotEntitledErrorCode is returned by the API when the zone
+// doesn't have the entitlement required to use Cache Reserve.
+const cacheReserveNotEntitledErrorCode = 1142
+
+// cacheReserve is the representation of the Cache Reserve zone setting.
+type cacheReserve struct {
+	ID    string `json:"id,omitempty"`
+	Value string `json:"value"`
+}
+
+func resourceCloudflareCacheReserve() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareCacheReserveSchema(),
+		ReadContext:   resourceCloudflareCacheReserveRead,
+		CreateContext: resourceCloudflareCacheReserveUpdate,
+		UpdateContext: resourceCloudflareCacheReserveUpdate,
+		DeleteContext: resourceCloudflareCacheReserveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareCacheReserveImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Cache Reserve resource. Cache Reserve can
+			increase cache lifetimes by automatically storing all cacheable
+			files in Cloudflare's persistent object storage buckets.
+
+			Note: Using Cache Reserve without Tiered Cache is not recommended.
+		`),
+	}
+}
+
+func resourceCloudflareCacheReserveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/cache/cache_reserve", zoneID), nil, nil)
+	if err != nil {
+		if isCacheReserveNotEntitledError(err) {
+			return cacheReserveNotEntitledDiagnostic(zoneID)
+		}
+		return diag.FromErr(fmt.Errorf("error reading Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	var setting cacheReserve
+	if err := json.Unmarshal(res, &setting); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Cache Reserve: %w", err))
+	}
+
+	d.SetId(zoneID)
+	d.Set("enabled", setting.Value == "on")
+
+	return nil
+}
+
+func resourceCloudflareCacheReserveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	value := "off"
+	if d.Get("enabled").(bool) {
+		value = "on"
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting Cache Reserve to %q for zone %s", value, zoneID))
+
+	if err := setCacheReserve(ctx, client, zoneID, value); err != nil {
+		if isCacheReserveNotEntitledError(err) {
+			return cacheReserveNotEntitledDiagnostic(zoneID)
+		}
+		return diag.FromErr(fmt.Errorf("error updating Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareCacheReserveRead(ctx, d, meta)
+}
+
+func resourceCloudflareCacheReserveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Disabling Cache Reserve for zone %s", zoneID))
+
+	if err := setCacheReserve(ctx, client, zoneID, "off"); err != nil {
+		if isCacheReserveNotEntitledError(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error disabling Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareCacheReserveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareCacheReserveRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setCacheReserve(ctx context.Context, client *cloudflare.API, zoneID, value string) error {
+	_, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/cache/cache_reserve", zoneID), cacheReserve{Value: value}, nil)
+	return err
+}
+
+// isCacheReserveNotEntitledError reports whether the API rejected the request
+// because the zone isn't eligible for Cache Reserve.
+func isCacheReserveNotEntitledError(err error) bool {
+	var requestError *cloudflare.RequestError
+	if errors.As(err, &requestError) {
+		return sliceContainsInt(requestError.ErrorCodes(), cacheReserveNotEntitledErrorCode)
+	}
+
+	var authorizationError *cloudflare.AuthorizationError
+	if errors.As(err, &authorizationError) {
+		return sliceContainsInt(authorizationError.ErrorCodes(), cacheReserveNotEntitledErrorCode)
+	}
+
+	return false
+}
+
+func cacheReserveNotEntitledDiagnostic(zoneID string) diag.Diagnostics {
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Cache Reserve is not available for zone %q", zoneID),
+		Detail:   "The zone isn't entitled to use Cache Reserve. Purchase Cache Reserve for the zone in the Cloudflare dashboard (Caching > Cache Reserve) before managing it with Terraform.",
+	}}
+}
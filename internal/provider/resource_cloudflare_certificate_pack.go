@@ -2,8 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"reflect"
+	"net/http"
 	"strings"
 	"time"
 
@@ -16,6 +17,27 @@ import (
 	"github.com/pkg/errors"
 )
 
+// certificatePack extends cloudflare.CertificatePack with the status of the
+// pack as a whole, which the library doesn't support yet.
+type certificatePack struct {
+	cloudflare.CertificatePack
+	Status string `json:"status"`
+}
+
+func getCertificatePack(ctx context.Context, client *cloudflare.API, zoneID, certificatePackID string) (certificatePack, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/ssl/certificate_packs/%s", zoneID, certificatePackID), nil, nil)
+	if err != nil {
+		return certificatePack{}, err
+	}
+
+	var pack certificatePack
+	if err := json.Unmarshal(res, &pack); err != nil {
+		return certificatePack{}, fmt.Errorf("error unmarshalling certificate pack: %w", err)
+	}
+
+	return pack, nil
+}
+
 func resourceCloudflareCertificatePack() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareCertificatePackSchema(),
@@ -25,6 +47,9 @@ func resourceCloudflareCertificatePack() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCertificatePackImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Certificate Pack resource that is used to
 			provision managed TLS certificates.
@@ -70,70 +95,88 @@ func resourceCloudflareCertificatePackCreate(ctx context.Context, d *schema.Reso
 		certificatePackID = certPackResponse.ID
 	}
 
+	// The ID is set before waiting so that a pack which doesn't become active
+	// is still tracked in the state.
+	d.SetId(certificatePackID)
+
 	if d.Get("wait_for_active_status").(bool) {
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
-			certificatePack, err := client.CertificatePack(ctx, zoneID, certificatePackID)
+			certificatePack, err := getCertificatePack(ctx, client, zoneID, certificatePackID)
 			if err != nil {
 				return resource.NonRetryableError(errors.Wrap(err, "failed to fetch certificate pack"))
 			}
-			if len(certificatePack.Certificates) == 0 {
-				return resource.RetryableError(fmt.Errorf("certificate list in response is empty"))
-			}
-			for _, certificate := range certificatePack.Certificates {
-				if certificate.Status != "active" {
-					return resource.RetryableError(fmt.Errorf("expected all certificates in certificate pack to be active state but certificate %s was in state %s", certificate.ID, certificate.Status))
-				}
+			if certificatePack.Status != "active" {
+				return resource.RetryableError(certificatePackStatusError(certificatePack))
 			}
 			return nil
 		})
 
 		if err != nil {
-			return diag.FromErr(err)
+			diags := resourceCloudflareCertificatePackRead(ctx, d, meta)
+			return append(diags, diag.FromErr(err)...)
 		}
 	}
 
-	d.SetId(certificatePackID)
-
 	return resourceCloudflareCertificatePackRead(ctx, d, meta)
 }
 
+// certificatePackStatusError describes why a certificate pack isn't active
+// yet, including any validation errors returned for it.
+func certificatePackStatusError(pack certificatePack) error {
+	if len(pack.ValidationErrors) == 0 {
+		return fmt.Errorf("expected certificate pack %s to be in active state but it was in state %s", pack.ID, pack.Status)
+	}
+
+	messages := make([]string, 0, len(pack.ValidationErrors))
+	for _, e := range pack.ValidationErrors {
+		messages = append(messages, e.Message)
+	}
+
+	return fmt.Errorf("expected certificate pack %s to be in active state but it was in state %s with validation errors: %s", pack.ID, pack.Status, strings.Join(messages, "; "))
+}
+
 func resourceCloudflareCertificatePackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
 
-	certificatePack, err := client.CertificatePack(ctx, zoneID, d.Id())
+	certificatePack, err := getCertificatePack(ctx, client, zoneID, d.Id())
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "failed to fetch certificate pack"))
 	}
 
 	d.Set("type", certificatePack.Type)
 	d.Set("hosts", expandStringListToSet(certificatePack.Hosts))
+	d.Set("validation_errors", flattenCertificatePackValidationErrors(certificatePack.ValidationErrors))
+	d.Set("validation_records", flattenCertificatePackValidationRecords(certificatePack.ValidationRecords))
 
-	if !reflect.ValueOf(certificatePack.ValidationErrors).IsNil() {
-		errors := []map[string]interface{}{}
-		for _, e := range certificatePack.ValidationErrors {
-			errors = append(errors, map[string]interface{}{"message": e.Message})
-		}
-		d.Set("validation_errors", errors)
+	return nil
+}
+
+func flattenCertificatePackValidationErrors(validationErrors []cloudflare.SSLValidationError) []map[string]interface{} {
+	errors := []map[string]interface{}{}
+	for _, e := range validationErrors {
+		errors = append(errors, map[string]interface{}{"message": e.Message})
 	}
-	if !reflect.ValueOf(certificatePack.ValidationRecords).IsNil() {
-		records := []map[string]interface{}{}
-		for _, e := range certificatePack.ValidationRecords {
-			records = append(records,
-				map[string]interface{}{
-					"cname_name":   e.CnameName,
-					"cname_target": e.CnameTarget,
-					"txt_name":     e.TxtName,
-					"txt_value":    e.TxtValue,
-					"http_body":    e.HTTPBody,
-					"http_url":     e.HTTPUrl,
-					"emails":       e.Emails,
-				})
-		}
-		d.Set("validation_records", records)
+
+	return errors
+}
+
+func flattenCertificatePackValidationRecords(validationRecords []cloudflare.SSLValidationRecord) []map[string]interface{} {
+	records := []map[string]interface{}{}
+	for _, e := range validationRecords {
+		records = append(records,
+			map[string]interface{}{
+				"cname_name":   e.CnameName,
+				"cname_target": e.CnameTarget,
+				"txt_name":     e.TxtName,
+				"txt_value":    e.TxtValue,
+				"http_body":    e.HTTPBody,
+				"http_url":     e.HTTPUrl,
+				"emails":       e.Emails,
+			})
 	}
 
-	return nil
+	return records
 }
 
 func resourceCloudflareCertificatePackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func init() {
@@ -174,3 +178,62 @@ resource "cloudflare_certificate_pack" "%[3]s" {
   wait_for_active_status = true
 }`, zoneID, domain, rnd, certType)
 }
+
+func TestCloudflareCertificatePackWaitForActiveStatusTimeout(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const certificatePackID = "3822ff90-ea29-44df-9e55-21300bb9419b"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/"+zoneID+"/ssl/certificate_packs/order":
+			testAPIResult(w, fmt.Sprintf(`{"id": %q, "status": "initializing"}`, certificatePackID))
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/"+zoneID+"/ssl/certificate_packs/"+certificatePackID:
+			testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "type": "advanced",
+  "hosts": ["example.com"],
+  "status": "pending_validation",
+  "validation_records": [{"txt_name": "_acme-challenge.example.com", "txt_value": "ZC78W2pzkYUC2MAHiR1x2eGLKN6jOPE5Z4iPfGLUM0s"}],
+  "validation_errors": [{"message": "TXT record not found at _acme-challenge.example.com"}]
+}`, certificatePackID))
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := resourceCloudflareCertificatePack()
+	// The wait excludes the last minute of the timeout, leaving a second.
+	r.Timeouts = &schema.ResourceTimeout{Create: schema.DefaultTimeout(61 * time.Second)}
+	d := r.Data(nil)
+	d.Set("zone_id", zoneID)
+	d.Set("type", "advanced")
+	d.Set("hosts", []interface{}{"example.com"})
+	d.Set("validation_method", "txt")
+	d.Set("validity_days", 90)
+	d.Set("certificate_authority", "lets_encrypt")
+	d.Set("wait_for_active_status", true)
+
+	diags := resourceCloudflareCertificatePackCreate(context.Background(), d, client)
+	if !diags.HasError() {
+		t.Fatal("expected an error for a certificate pack that never becomes active")
+	}
+
+	var summaries []string
+	for _, diagnostic := range diags {
+		summaries = append(summaries, diagnostic.Summary)
+	}
+	if summary := strings.Join(summaries, "\n"); !strings.Contains(summary, "pending_validation") || !strings.Contains(summary, "TXT record not found at _acme-challenge.example.com") {
+		t.Errorf("expected the error to include the status and validation errors, got %q", summary)
+	}
+
+	if d.Id() != certificatePackID {
+		t.Errorf("expected the certificate pack to be kept in state, got ID %q", d.Id())
+	}
+	if got := d.Get("validation_records.0.txt_name").(string); got != "_acme-challenge.example.com" {
+		t.Errorf("expected validation records to be set, got %q", got)
+	}
+	if got := d.Get("validation_errors.0.message").(string); got != "TXT record not found at _acme-challenge.example.com" {
+		t.Errorf("expected validation errors to be set, got %q", got)
+	}
+}
@@ -26,6 +26,10 @@ func resourceCloudflareCustomHostname() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCustomHostnameImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare custom hostname (also known as SSL for SaaS) resource.
 		`),
@@ -45,6 +49,8 @@ func resourceCloudflareCustomHostnameRead(ctx context.Context, d *schema.Resourc
 	d.Set("hostname", customHostname.Hostname)
 	d.Set("custom_origin_server", customHostname.CustomOriginServer)
 	d.Set("custom_origin_sni", customHostname.CustomOriginSNI)
+	d.Set("status", customHostname.Status)
+	d.Set("custom_metadata", flattenCustomHostnameCustomMetadata(customHostname.CustomMetadata))
 	var sslConfig []map[string]interface{}
 
 	if !reflect.ValueOf(customHostname.SSL).IsNil() {
@@ -138,27 +144,15 @@ func resourceCloudflareCustomHostnameCreate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(errors.Wrap(err, "failed to create custom hostname certificate"))
 	}
 
-	hostnameID := newCertificate.Result.ID
+	// The ID is set before waiting so that a hostname which doesn't reach the
+	// expected status is still tracked in the state.
+	d.SetId(newCertificate.Result.ID)
 
-	if d.Get("wait_for_ssl_pending_validation").(bool) {
-		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
-			customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
-			tflog.Debug(ctx, fmt.Sprintf("custom hostname ssl status %s", customHostname.SSL.Status))
-			if err != nil {
-				return resource.NonRetryableError(errors.Wrap(err, "failed to fetch custom hostname"))
-			}
-			if customHostname.SSL != nil && customHostname.SSL.Status != "pending_validation" {
-				return resource.RetryableError(fmt.Errorf("hostname ssl sub-object is not yet in pending_validation status"))
-			}
-			return nil
-		})
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	if err := waitForCustomHostnameSSLStatus(ctx, d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags := resourceCloudflareCustomHostnameRead(ctx, d, meta)
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	d.SetId(newCertificate.Result.ID)
-
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
@@ -173,9 +167,83 @@ func resourceCloudflareCustomHostnameUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(errors.Wrap(err, "failed to update custom hostname certificate"))
 	}
 
+	// Changing the validation method or type of the certificate restarts its
+	// validation.
+	if d.HasChanges("ssl.0.method", "ssl.0.type") {
+		if err := waitForCustomHostnameSSLStatus(ctx, d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			diags := resourceCloudflareCustomHostnameRead(ctx, d, meta)
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
+// waitForCustomHostnameSSLStatus polls the custom hostname until its
+// certificate reaches the status requested by `wait_for_ssl_pending_validation`
+// or `wait_for_active`, if any.
+func waitForCustomHostnameSSLStatus(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, timeout time.Duration) error {
+	var expected []string
+	switch {
+	case d.Get("wait_for_active").(bool):
+		expected = []string{"active"}
+	case d.Get("wait_for_ssl_pending_validation").(bool):
+		expected = []string{"pending_validation", "active"}
+	default:
+		return nil
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	hostnameID := d.Id()
+
+	return resource.RetryContext(ctx, timeout-time.Minute, func() *resource.RetryError {
+		customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
+		if err != nil {
+			return resource.NonRetryableError(errors.Wrap(err, "failed to fetch custom hostname"))
+		}
+		if customHostname.SSL == nil {
+			return nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("custom hostname ssl status %s", customHostname.SSL.Status))
+		if !contains(expected, customHostname.SSL.Status) {
+			return resource.RetryableError(customHostnameSSLStatusError(customHostname.SSL, expected[0]))
+		}
+		return nil
+	})
+}
+
+// customHostnameSSLStatusError describes why the certificate of a custom
+// hostname hasn't reached the expected status, including any validation
+// errors returned for it.
+func customHostnameSSLStatusError(ssl *cloudflare.CustomHostnameSSL, expected string) error {
+	if len(ssl.ValidationErrors) == 0 {
+		return fmt.Errorf("hostname ssl sub-object is not yet in %s status, current status is %s", expected, ssl.Status)
+	}
+
+	messages := make([]string, 0, len(ssl.ValidationErrors))
+	for _, e := range ssl.ValidationErrors {
+		messages = append(messages, e.Message)
+	}
+
+	return fmt.Errorf("hostname ssl sub-object is not yet in %s status, current status is %s with validation errors: %s", expected, ssl.Status, strings.Join(messages, "; "))
+}
+
+// flattenCustomHostnameCustomMetadata converts the custom metadata to the
+// string values supported by the schema.
+func flattenCustomHostnameCustomMetadata(metadata *cloudflare.CustomMetadata) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+
+	flattened := make(map[string]interface{}, len(*metadata))
+	for key, value := range *metadata {
+		flattened[key] = fmt.Sprint(value)
+	}
+
+	return flattened
+}
+
 func resourceCloudflareCustomHostnameImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 
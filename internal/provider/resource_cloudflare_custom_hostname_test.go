@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -436,6 +438,7 @@ func TestAccCloudflareCustomHostname_Import(t *testing.T) {
 					"ssl.0.type",
 					"ssl.0.wildcard",
 					"wait_for_ssl_pending_validation",
+					"wait_for_active",
 				},
 			},
 		},
@@ -526,3 +529,87 @@ func testAccCheckCloudflareCustomHostnameWithCustomMetadata(zoneID, rnd, domain
 	}
 	`, zoneID, rnd, domain)
 }
+
+func TestCloudflareCustomHostnameWaitForActive(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const hostnameID = "0d89c70d-ad9f-4843-b99f-6cc0252067e9"
+
+	polls := 0
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/"+zoneID+"/custom_hostnames":
+			testAPIResult(w, fmt.Sprintf(`{"id": %q, "hostname": "app.example.com"}`, hostnameID))
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/"+zoneID+"/custom_hostnames/"+hostnameID:
+			polls++
+			status := "pending_validation"
+			if polls > 1 {
+				status = "active"
+			}
+			testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "hostname": "app.example.com",
+  "status": "active",
+  "custom_origin_server": "origin.example.com",
+  "custom_metadata": {"customer_id": "12345", "security_tag": "low"},
+  "ssl": {
+    "status": %q,
+    "method": "txt",
+    "type": "dv",
+    "validation_records": [{"txt_name": "_acme-challenge.app.example.com", "txt_value": "0Jv0GH6uTkIt0pzENkqcTcoVqjQWL32jxOw7SJfPM1Q"}]
+  }
+}`, hostnameID, status))
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareCustomHostnameSchema(), map[string]interface{}{
+		"zone_id":              zoneID,
+		"hostname":             "app.example.com",
+		"custom_origin_server": "origin.example.com",
+		"custom_metadata": map[string]interface{}{
+			"customer_id":  "12345",
+			"security_tag": "low",
+		},
+		"ssl": []interface{}{map[string]interface{}{
+			"method": "txt",
+		}},
+		"wait_for_active": true,
+	})
+
+	if diags := resourceCloudflareCustomHostnameCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if polls < 2 {
+		t.Errorf("expected the hostname to be polled until active, got %d requests", polls)
+	}
+	if got := d.Get("ssl.0.status").(string); got != "active" {
+		t.Errorf("expected ssl.0.status to be active, got %q", got)
+	}
+	if got := d.Get("ssl.0.validation_records.0.txt_name").(string); got != "_acme-challenge.app.example.com" {
+		t.Errorf("expected validation records to be set, got %q", got)
+	}
+	if got := d.Get("custom_origin_server").(string); got != "origin.example.com" {
+		t.Errorf("expected custom_origin_server to be kept, got %q", got)
+	}
+	if got := d.Get("custom_metadata.customer_id").(string); got != "12345" {
+		t.Errorf("expected custom_metadata to be read, got %q", got)
+	}
+}
+
+func TestCustomHostnameSSLStatusError(t *testing.T) {
+	err := customHostnameSSLStatusError(&cloudflare.CustomHostnameSSL{
+		Status: "pending_validation",
+		ValidationErrors: []cloudflare.SSLValidationError{
+			{Message: "TXT record not found"},
+			{Message: "CAA record prevents issuance"},
+		},
+	}, "active")
+
+	expected := "hostname ssl sub-object is not yet in active status, current status is pending_validation with validation errors: TXT record not found; CAA record prevents issuance"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
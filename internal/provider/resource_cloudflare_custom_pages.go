@@ -19,6 +19,7 @@ func resourceCloudflareCustomPages() *schema.Resource {
 		ReadContext:   resourceCloudflareCustomPagesRead,
 		UpdateContext: resourceCloudflareCustomPagesUpdate,
 		DeleteContext: resourceCloudflareCustomPagesDelete,
+		CustomizeDiff: resourceCloudflareCustomPagesCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCustomPagesImport,
 		},
@@ -51,18 +52,17 @@ func resourceCloudflareCustomPagesRead(ctx context.Context, d *schema.ResourceDa
 
 	page, err := client.CustomPage(ctx, &pageOptions, pageType)
 	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			log.Printf("[INFO] removing custom page configuration for '%s' as it no longer exists", pageType)
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
-	// If the `page.State` comes back as "default", it's safe to assume we
-	// don't need to keep the ID managed anymore as it will be relying on
-	// Cloudflare's default pages.
-	if page.State == "default" {
-		log.Printf("[INFO] removing custom page configuration for '%s' as it is marked as being in the default state", pageType)
-		d.SetId("")
-		return nil
-	}
-
+	// A page in the "default" state has been reset to Cloudflare's default
+	// page, which is kept in state so that it shows as drift.
 	checksum := stringChecksum(fmt.Sprintf("%s/%s", identifier, page.ID))
 	d.SetId(checksum)
 
@@ -88,7 +88,10 @@ func resourceCloudflareCustomPagesUpdate(ctx context.Context, d *schema.Resource
 	pageType := d.Get("type").(string)
 	customPageParameters := cloudflare.CustomPageParameters{
 		URL:   d.Get("url").(string),
-		State: "customized",
+		State: d.Get("state").(string),
+	}
+	if customPageParameters.State == "default" {
+		customPageParameters.URL = nil
 	}
 	_, err := client.UpdateCustomPage(ctx, &pageOptions, pageType, customPageParameters)
 	if err != nil {
@@ -125,8 +128,8 @@ func resourceCloudflareCustomPagesDelete(ctx context.Context, d *schema.Resource
 
 func resourceCloudflareCustomPagesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 3)
-	if len(attributes) != 3 {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"requestType/ID/pageType\"", d.Id())
+	if len(attributes) != 3 || (attributes[0] != "account" && attributes[0] != "zone") {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/pageType\" or \"zone/zoneID/pageType\"", d.Id())
 	}
 	requestType, identifier, pageType := attributes[0], attributes[1], attributes[2]
 
@@ -141,7 +144,30 @@ func resourceCloudflareCustomPagesImport(ctx context.Context, d *schema.Resource
 	checksum := stringChecksum(fmt.Sprintf("%s/%s", identifier, pageType))
 	d.SetId(checksum)
 
-	resourceCloudflareCustomPagesRead(ctx, d, meta)
+	if diags := resourceCloudflareCustomPagesRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("failed to read '%s' custom page: %s", pageType, diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("custom page '%s' of %s %q not found", pageType, requestType, identifier)
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceCloudflareCustomPagesCustomizeDiff rejects types of custom pages
+// which can only be managed on zones when used with an account.
+func resourceCloudflareCustomPagesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("account_id") || d.Get("account_id").(string) == "" {
+		return nil
+	}
+
+	pageType := d.Get("type").(string)
+	for _, t := range customPageAccountTypes {
+		if strings.EqualFold(t, pageType) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("custom page type %q can only be managed on zones, use `zone_id` instead of `account_id` or one of the account level types: %s", pageType, strings.Join(customPageAccountTypes, ", "))
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCloudflareCustomPagesCustomizeDiff(t *testing.T) {
+	testCases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"account page": {
+			config: map[string]interface{}{"account_id": "f037e56e89293a057740de681ac9abbe", "type": "waf_block"},
+		},
+		"zone only page on a zone": {
+			config: map[string]interface{}{"zone_id": "0da42c8d2132a9ddaf714f9e7c920711", "type": "always_online"},
+		},
+		"zone only page on an account": {
+			config: map[string]interface{}{"account_id": "f037e56e89293a057740de681ac9abbe", "type": "always_online"},
+			err:    "can only be managed on zones",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.config["url"] = "https://example.com/page.html"
+
+			_, err := resourceCloudflareCustomPages().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCloudflareCustomPagesImport(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	state := "customized"
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/custom_pages/waf_block" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		url := `"https://example.com/block.html"`
+		if state == "default" {
+			url = "null"
+		}
+		testAPIResult(w, fmt.Sprintf(`{"id": "waf_block", "state": %q, "url": %s}`, state, url))
+	})
+
+	d := resourceCloudflareCustomPages().Data(nil)
+	d.SetId("account/" + accountID + "/waf_block")
+
+	imported, err := resourceCloudflareCustomPagesImport(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d = imported[0]
+	if d.Get("account_id").(string) != accountID || d.Get("type").(string) != "waf_block" {
+		t.Errorf("expected account %q and type %q, got %q and %q", accountID, "waf_block", d.Get("account_id"), d.Get("type"))
+	}
+	if d.Get("url").(string) != "https://example.com/block.html" || d.Get("state").(string) != "customized" {
+		t.Errorf("expected the customized page to be read, got url %q in state %q", d.Get("url"), d.Get("state"))
+	}
+
+	// A page reset to default outside of Terraform stays in state as drift.
+	state = "default"
+	if diags := resourceCloudflareCustomPagesRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() == "" || d.Get("state").(string) != "default" {
+		t.Errorf("expected the default state to be kept, got ID %q in state %q", d.Id(), d.Get("state"))
+	}
+
+	for _, id := range []string{"waf_block", "user/" + accountID + "/waf_block"} {
+		d := resourceCloudflareCustomPages().Data(nil)
+		d.SetId(id)
+		if _, err := resourceCloudflareCustomPagesImport(context.Background(), d, client); err == nil {
+			t.Errorf("expected import of %q to fail", id)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -16,6 +17,43 @@ import (
 	"github.com/pkg/errors"
 )
 
+// customSSLOptions extends cloudflare.ZoneCustomSSLOptions with the key
+// restriction policy, which the library doesn't support yet.
+type customSSLOptions struct {
+	cloudflare.ZoneCustomSSLOptions
+	Policy string `json:"policy,omitempty"`
+}
+
+// customSSL extends cloudflare.ZoneCustomSSL with the key restriction policy.
+type customSSL struct {
+	cloudflare.ZoneCustomSSL
+	Policy string `json:"policy"`
+}
+
+func customSSLURI(zoneID, certID string) string {
+	if certID == "" {
+		return fmt.Sprintf("/zones/%s/custom_certificates", zoneID)
+	}
+
+	return fmt.Sprintf("/zones/%s/custom_certificates/%s", zoneID, certID)
+}
+
+// writeCustomSSL creates (POST) or updates (PATCH) a custom certificate and
+// returns the resulting certificate.
+func writeCustomSSL(ctx context.Context, client *cloudflare.API, method, uri string, options customSSLOptions) (customSSL, error) {
+	res, err := client.Raw(ctx, method, uri, options, nil)
+	if err != nil {
+		return customSSL{}, err
+	}
+
+	var cert customSSL
+	if err := json.Unmarshal(res, &cert); err != nil {
+		return customSSL{}, fmt.Errorf("error unmarshalling custom ssl cert: %w", err)
+	}
+
+	return cert, nil
+}
+
 func resourceCloudflareCustomSsl() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCloudflareCustomSslCreate,
@@ -51,7 +89,7 @@ func resourceCloudflareCustomSslCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("failed to create custom ssl cert: %w", err))
 	}
 
-	res, err := client.CreateSSL(ctx, zoneID, zcso)
+	res, err := writeCustomSSL(ctx, client, http.MethodPost, customSSLURI(zoneID, ""), zcso)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to create custom ssl cert: %w", err))
 	}
@@ -87,10 +125,6 @@ func resourceCloudflareCustomSslUpdate(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
 	certID := d.Id()
-	var uErr error
-	var reErr error
-	var updateErr = false
-	var reprioritizeErr = false
 	tflog.Debug(ctx, fmt.Sprintf("zone ID: %s", zoneID))
 
 	if d.HasChange("custom_ssl_options") {
@@ -99,35 +133,32 @@ func resourceCloudflareCustomSslUpdate(ctx context.Context, d *schema.ResourceDa
 			return diag.FromErr(fmt.Errorf("failed to update custom ssl cert: %w", err))
 		}
 
-		res, uErr := client.UpdateSSL(ctx, zoneID, certID, zcso)
-		if uErr != nil {
-			tflog.Debug(ctx, fmt.Sprintf("Failed to update custom ssl cert: %s", uErr))
-			updateErr = true
-		} else {
-			tflog.Debug(ctx, fmt.Sprintf("Custom SSL set to: %s", res.ID))
-			if res.ID != certID {
-				d.SetId(res.ID)
-			}
+		// The type of a certificate can't be changed, it's only sent on create.
+		zcso.Type = ""
+
+		res, err := writeCustomSSL(ctx, client, http.MethodPatch, customSSLURI(zoneID, certID), zcso)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to update custom ssl cert: %w", err))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Custom SSL set to: %s", res.ID))
+		if res.ID != "" && res.ID != certID {
+			d.SetId(res.ID)
 		}
 	}
 
 	if d.HasChange("custom_ssl_priority") {
 		zcsp, err := expandToZoneCustomSSLPriority(ctx, d)
 		if err != nil {
-			tflog.Debug(ctx, fmt.Sprintf("Failed to update custom ssl cert: %s", err))
+			return diag.FromErr(fmt.Errorf("failed to reprioritize custom ssl cert: %w", err))
 		}
 
-		resList, reErr := client.ReprioritizeSSL(ctx, zoneID, zcsp)
+		resList, err := client.ReprioritizeSSL(ctx, zoneID, zcsp)
 		if err != nil {
-			tflog.Debug(ctx, fmt.Sprintf("Failed to update / reprioritize custom ssl cert: %s", reErr))
-			reprioritizeErr = true
-		} else {
-			tflog.Debug(ctx, fmt.Sprintf("Custom SSL reprioritized to: %#v", resList))
+			return diag.FromErr(fmt.Errorf("failed to reprioritize custom ssl cert: %w", err))
 		}
-	}
 
-	if updateErr && reprioritizeErr {
-		return diag.Errorf("failed to update and reprioritize custom ssl cert: %s, %s", uErr, reErr)
+		tflog.Debug(ctx, fmt.Sprintf("Custom SSL reprioritized to: %#v", resList))
 	}
 
 	return resourceCloudflareCustomSslRead(ctx, d, meta)
@@ -139,17 +170,27 @@ func resourceCloudflareCustomSslRead(ctx context.Context, d *schema.ResourceData
 	certID := d.Id()
 
 	// update all possible schema attributes with fields from api response
-	record, err := client.SSLDetails(ctx, zoneID, certID)
+	res, err := client.Raw(ctx, http.MethodGet, customSSLURI(zoneID, certID), nil, nil)
 	if err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Removing record from state because it's not found in API"))
 		d.SetId("")
 		return nil
 	}
+
+	var record customSSL
+	if err := json.Unmarshal(res, &record); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling custom ssl cert: %w", err))
+	}
+
+	// The certificate and private key aren't returned by the API so the
+	// values from the state are kept.
 	zcso, err := expandToZoneCustomSSLOptions(ctx, d)
 	if err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Problem setting zone options not read from state %s", err))
 	}
 	zcso.BundleMethod = record.BundleMethod
+	zcso.GeoRestrictions = &cloudflare.ZoneCustomSSLGeoRestrictions{Label: record.GeoRestrictions.Label}
+	zcso.Policy = record.Policy
 	customSslOpts := flattenCustomSSLOptions(zcso)
 
 	d.SetId(record.ID)
@@ -234,7 +275,7 @@ func expandToZoneCustomSSLPriority(ctx context.Context, d *schema.ResourceData)
 	return mtSlice, nil
 }
 
-func expandToZoneCustomSSLOptions(ctx context.Context, d *schema.ResourceData) (cloudflare.ZoneCustomSSLOptions, error) {
+func expandToZoneCustomSSLOptions(ctx context.Context, d *schema.ResourceData) (customSSLOptions, error) {
 	data, dataOk := d.GetOk("custom_ssl_options")
 	tflog.Debug(ctx, fmt.Sprintf("Custom SSL options found in config: %#v", data))
 
@@ -244,6 +285,10 @@ func expandToZoneCustomSSLOptions(ctx context.Context, d *schema.ResourceData) (
 			for id, value := range cert.(map[string]interface{}) {
 				var newValue interface{}
 				if id == "geo_restrictions" {
+					// Omitted so that it doesn't conflict with a policy.
+					if value.(string) == "" {
+						continue
+					}
 					newValue = cloudflare.ZoneCustomSSLGeoRestrictions{
 						Label: value.(string),
 					}
@@ -255,7 +300,7 @@ func expandToZoneCustomSSLOptions(ctx context.Context, d *schema.ResourceData) (
 		}
 	}
 
-	zcso := cloudflare.ZoneCustomSSLOptions{}
+	zcso := customSSLOptions{}
 	zcsoJSON, err := json.Marshal(newData)
 	if err != nil {
 		return zcso, fmt.Errorf("Failed to create custom ssl options: %w", err)
@@ -269,12 +314,13 @@ func expandToZoneCustomSSLOptions(ctx context.Context, d *schema.ResourceData) (
 	return zcso, nil
 }
 
-func flattenCustomSSLOptions(sslopt cloudflare.ZoneCustomSSLOptions) map[string]interface{} {
+func flattenCustomSSLOptions(sslopt customSSLOptions) map[string]interface{} {
 	data := map[string]interface{}{
 		"certificate":   sslopt.Certificate,
 		"private_key":   sslopt.PrivateKey,
 		"bundle_method": sslopt.BundleMethod,
 		"type":          sslopt.Type,
+		"policy":        sslopt.Policy,
 	}
 
 	if sslopt.GeoRestrictions != nil && sslopt.GeoRestrictions.Label != "" && sslopt.GeoRestrictions.Label != "custom" {
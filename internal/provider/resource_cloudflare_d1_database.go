@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// d1Database is the representation of a D1 database in the Cloudflare API.
+type d1Database struct {
+	UUID    string `json:"uuid,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+func resourceCloudflareD1Database() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareD1DatabaseSchema(),
+		CreateContext: resourceCloudflareD1DatabaseCreate,
+		ReadContext:   resourceCloudflareD1DatabaseRead,
+		DeleteContext: resourceCloudflareD1DatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareD1DatabaseImport,
+		},
+		Description: heredoc.Doc(`
+			The [D1 Database](https://developers.cloudflare.com/d1/) resource allows you to manage Cloudflare D1 databases.
+		`),
+	}
+}
+
+func resourceCloudflareD1DatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	database := d1Database{Name: d.Get("name").(string)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare D1 Database from struct: %+v", database))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/d1/database", accountID), database, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating D1 database %q: %w", database.Name, err))
+	}
+
+	var created d1Database
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling D1 database: %w", err))
+	}
+
+	d.SetId(created.UUID)
+
+	return resourceCloudflareD1DatabaseRead(ctx, d, meta)
+}
+
+func resourceCloudflareD1DatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/d1/database/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("D1 database %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading D1 database %q: %w", d.Id(), err))
+	}
+
+	var database d1Database
+	if err := json.Unmarshal(res, &database); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling D1 database: %w", err))
+	}
+
+	d.Set("name", database.Name)
+	d.Set("version", database.Version)
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare D1 Database: %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/d1/database/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("error deleting D1 database %q: %w", d.Id(), err))
+		}
+		tflog.Info(ctx, fmt.Sprintf("D1 database %s was already deleted", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/databaseID\"", d.Id())
+	}
+
+	accountID, databaseID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare D1 Database: id %s for account %s", databaseID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(databaseID)
+
+	resourceCloudflareD1DatabaseRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -12,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// deviceManagedNetworkDuplicateNameErrorCode is returned by the API when
+// another managed network of the account already uses the same name.
+const deviceManagedNetworkDuplicateNameErrorCode = 2047
+
 func resourceCloudflareDeviceManagedNetworks() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareDeviceManagedNetworksSchema(),
@@ -68,6 +72,10 @@ func resourceCloudflareDeviceManagedNetworksCreate(ctx context.Context, d *schem
 	managedNetwork, err := client.CreateDeviceManagedNetwork(ctx, identifier, params)
 
 	if err != nil {
+		var requestError *cloudflare.RequestError
+		if errors.As(err, &requestError) && requestError.InternalErrorCodeIs(deviceManagedNetworkDuplicateNameErrorCode) {
+			return diag.Errorf("a Device Managed Network named %q already exists in this account. Names must be unique, choose a different name or import the existing network", params.Name)
+		}
 		return diag.FromErr(fmt.Errorf("error creating Device Managed Network with provided config: %w", err))
 	}
 
@@ -110,7 +118,7 @@ func resourceCloudflareDeviceManagedNetworksDelete(ctx context.Context, d *schem
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Device Managed Network using ID: %s", d.Id()))
 
 	if _, err := client.DeleteManagedNetworks(ctx, identifier, d.Id()); err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting DLP Profile for ID %q: %w", d.Id(), err))
+		return diag.FromErr(fmt.Errorf("error deleting Device Managed Network for ID %q: %w", d.Id(), err))
 	}
 
 	resourceCloudflareDeviceManagedNetworksRead(ctx, d, meta)
@@ -133,20 +141,30 @@ func resourceCloudflareDeviceManagedNetworksImport(ctx context.Context, d *schem
 	return []*schema.ResourceData{d}, nil
 }
 
+// parseDeviceManagedNetworksIDImport accepts both the
+// "account/accountID/networkID" and the legacy "accountID/networkID" import
+// formats.
 func parseDeviceManagedNetworksIDImport(id string) (string, string, error) {
-	attributes := strings.SplitN(id, "/", 2)
+	attributes := strings.Split(id, "/")
 
-	if len(attributes) != 2 {
-		return "", "", fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/networkID\"", id)
+	switch {
+	case len(attributes) == 3 && attributes[0] == "account" && attributes[1] != "" && attributes[2] != "":
+		return attributes[1], attributes[2], nil
+	case len(attributes) == 2 && attributes[0] != "" && attributes[1] != "":
+		return attributes[0], attributes[1], nil
 	}
 
-	return attributes[0], attributes[1], nil
+	return "", "", fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/networkID\"", id)
 }
 
 func convertDeviceManagedNetworkConfigToSchema(input *cloudflare.Config) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
 	m := map[string]interface{}{
-		"sha256":       &input.Sha256,
-		"tls_sockaddr": &input.TlsSockAddr,
+		"sha256":       input.Sha256,
+		"tls_sockaddr": input.TlsSockAddr,
 	}
 	return []interface{}{m}
 }
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareDeviceManagedNetworks(t *testing.T) {
@@ -27,10 +32,119 @@ func TestAccCloudflareDeviceManagedNetworks(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "config.0.sha256", "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"),
 				),
 			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("account/%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDeviceManagedNetworks_DuplicateName(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareDeviceManagedNetworksDuplicateName(accountID, rnd),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("a Device Managed Network named %q already exists", rnd)),
+			},
 		},
 	})
 }
 
+func TestCloudflareDeviceManagedNetworksCreateDuplicateName(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	testCases := map[string]struct {
+		code     int
+		message  string
+		expected string
+	}{
+		"duplicate name": {
+			code:     deviceManagedNetworkDuplicateNameErrorCode,
+			message:  "network name must be unique",
+			expected: `a Device Managed Network named "office" already exists in this account`,
+		},
+		"other error mentioning a duplicate": {
+			code:     1000,
+			message:  "duplicate tls_sockaddr",
+			expected: "error creating Device Managed Network with provided config",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/accounts/"+accountID+"/devices/networks" {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				testAPIError(w, http.StatusBadRequest, tc.code, tc.message)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareDeviceManagedNetworksSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"name":       "office",
+				"type":       "tls",
+				"config": []interface{}{map[string]interface{}{
+					"tls_sockaddr": "foobar:1234",
+					"sha256":       "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c",
+				}},
+			})
+
+			diags := resourceCloudflareDeviceManagedNetworksCreate(context.Background(), d, client)
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(diags[0].Summary, tc.expected) {
+				t.Errorf("expected %q in the error, got %q", tc.expected, diags[0].Summary)
+			}
+		})
+	}
+}
+
+func TestParseDeviceManagedNetworksIDImport(t *testing.T) {
+	testCases := map[string]struct {
+		id                string
+		expectedAccountID string
+		expectedNetworkID string
+		expectErr         bool
+	}{
+		"account prefixed":  {id: "account/abc/123", expectedAccountID: "abc", expectedNetworkID: "123"},
+		"legacy":            {id: "abc/123", expectedAccountID: "abc", expectedNetworkID: "123"},
+		"missing network":   {id: "account/abc/", expectErr: true},
+		"wrong prefix":      {id: "zone/abc/123", expectErr: true},
+		"network ID only":   {id: "123", expectErr: true},
+		"too many segments": {id: "account/abc/123/456", expectErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			accountID, networkID, err := parseDeviceManagedNetworksIDImport(tc.id)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected error for %q", tc.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if accountID != tc.expectedAccountID || networkID != tc.expectedNetworkID {
+				t.Errorf("expected %q/%q, got %q/%q", tc.expectedAccountID, tc.expectedNetworkID, accountID, networkID)
+			}
+		})
+	}
+}
+
 func testAccCloudflareDeviceManagedNetworks(accountID, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_device_managed_networks" "%[1]s" {
@@ -44,3 +158,28 @@ resource "cloudflare_device_managed_networks" "%[1]s" {
 }
 `, rnd, accountID)
 }
+
+func testAccCloudflareDeviceManagedNetworksDuplicateName(accountID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_device_managed_networks" "%[1]s" {
+  account_id                = "%[2]s"
+  name                      = "%[1]s"
+  type                      = "tls"
+  config {
+	tls_sockaddr = "foobar:1234"
+	sha256 = "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+  }
+}
+
+resource "cloudflare_device_managed_networks" "%[1]s_duplicate" {
+  account_id                = "%[2]s"
+  name                      = "%[1]s"
+  type                      = "tls"
+  config {
+	tls_sockaddr = "foobar:5678"
+	sha256 = "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+  }
+  depends_on = [cloudflare_device_managed_networks.%[1]s]
+}
+`, rnd, accountID)
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -18,8 +20,39 @@ const (
 	crowdstrike = "crowdstrike_s2s"
 	uptycs      = "uptycs"
 	intune      = "intune"
+	kolide      = "kolide"
+	tanium      = "tanium_s2s"
+	sentinelone = "sentinelone_s2s"
 )
 
+// devicePostureIntegrationConfigFields lists the config attributes each
+// integration type requires and the ones it optionally accepts.
+var devicePostureIntegrationConfigFields = map[string]struct {
+	required []string
+	optional []string
+}{
+	ws1:         {required: []string{"client_id", "client_secret", "auth_url", "api_url"}},
+	crowdstrike: {required: []string{"client_id", "client_secret", "customer_id", "api_url"}},
+	uptycs:      {required: []string{"client_key", "client_secret", "customer_id"}, optional: []string{"api_url"}},
+	intune:      {required: []string{"client_id", "client_secret", "customer_id"}},
+	kolide:      {required: []string{"client_id", "client_secret"}},
+	tanium:      {required: []string{"api_url", "client_secret"}, optional: []string{"access_client_id", "access_client_secret"}},
+	sentinelone: {required: []string{"api_url", "client_secret"}},
+}
+
+// devicePostureIntegrationConfig extends the integration config with the
+// credentials that aren't available in cloudflare-go yet.
+type devicePostureIntegrationConfig struct {
+	cloudflare.DevicePostureIntegrationConfig
+	AccessClientID     string `json:"access_client_id,omitempty"`
+	AccessClientSecret string `json:"access_client_secret,omitempty"`
+}
+
+type devicePostureIntegration struct {
+	cloudflare.DevicePostureIntegration
+	Config devicePostureIntegrationConfig `json:"config,omitempty"`
+}
+
 func resourceCloudflareDevicePostureIntegration() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareDevicePostureIntegrationSchema(),
@@ -30,6 +63,7 @@ func resourceCloudflareDevicePostureIntegration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareDevicePostureIntegrationImport,
 		},
+		CustomizeDiff: resourceCloudflareDevicePostureIntegrationValidateConfig,
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Device Posture Integration resource. Device
 			posture integrations configure third-party data providers for device
@@ -42,42 +76,47 @@ func resourceCloudflareDevicePostureIntegrationCreate(ctx context.Context, d *sc
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	newDevicePostureIntegration := cloudflare.DevicePostureIntegration{
-		Name:     d.Get("name").(string),
-		Type:     d.Get("type").(string),
-		Interval: d.Get("interval").(string),
+	newDevicePostureIntegration := devicePostureIntegration{
+		DevicePostureIntegration: cloudflare.DevicePostureIntegration{
+			Name:     d.Get("name").(string),
+			Type:     d.Get("type").(string),
+			Interval: d.Get("interval").(string),
+		},
 	}
 
 	err := setDevicePostureIntegrationConfig(&newDevicePostureIntegration, d)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Device Posture integration with provided config: %w", err))
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device Posture Integration from struct: %+v\n", newDevicePostureIntegration))
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device Posture Integration of type %s", newDevicePostureIntegration.Type))
 
-	// The API does not return the client_secret so it must be stored in the state func on resource create.
-	savedSecret := newDevicePostureIntegration.Config.ClientSecret
+	// The API does not return the secrets so they must be stored in the state func on resource create.
+	savedSecrets := newDevicePostureIntegration.Config
 
-	newDevicePostureIntegration, err = client.CreateDevicePostureIntegration(ctx, accountID, newDevicePostureIntegration)
+	newDevicePostureIntegration, err = writeDevicePostureIntegration(ctx, client, accountID, http.MethodPost, newDevicePostureIntegration)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Device Posture Rule for account %q: %w %+v", accountID, err, newDevicePostureIntegration))
+		return diag.FromErr(fmt.Errorf("error creating Device Posture Integration for account %q: %w", accountID, err))
 	}
 
 	d.SetId(newDevicePostureIntegration.IntegrationID)
 
-	return diag.FromErr(devicePostureIntegrationReadHelper(ctx, d, meta, savedSecret))
+	return diag.FromErr(devicePostureIntegrationReadHelper(ctx, d, meta, savedSecrets))
 }
 
 func resourceCloudflareDevicePostureIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Client secret is always read from the local state.
-	secret, _ := d.Get("config.0.client_secret").(string)
-	return diag.FromErr(devicePostureIntegrationReadHelper(ctx, d, meta, secret))
+	// Secrets are always read from the local state.
+	var secrets devicePostureIntegrationConfig
+	secrets.ClientSecret, _ = d.Get("config.0.client_secret").(string)
+	secrets.ClientKey, _ = d.Get("config.0.client_key").(string)
+	secrets.AccessClientSecret, _ = d.Get("config.0.access_client_secret").(string)
+	return diag.FromErr(devicePostureIntegrationReadHelper(ctx, d, meta, secrets))
 }
 
-func devicePostureIntegrationReadHelper(ctx context.Context, d *schema.ResourceData, meta interface{}, secret string) error {
+func devicePostureIntegrationReadHelper(ctx context.Context, d *schema.ResourceData, meta interface{}, secrets devicePostureIntegrationConfig) error {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	devicePostureIntegration, err := client.DevicePostureIntegration(ctx, accountID, d.Id())
+	devicePostureIntegration, err := getDevicePostureIntegration(ctx, client, accountID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -88,7 +127,9 @@ func devicePostureIntegrationReadHelper(ctx context.Context, d *schema.ResourceD
 		return fmt.Errorf("error finding device posture integration %q: %w", d.Id(), err)
 	}
 
-	devicePostureIntegration.Config.ClientSecret = secret
+	devicePostureIntegration.Config.ClientSecret = secrets.ClientSecret
+	devicePostureIntegration.Config.ClientKey = secrets.ClientKey
+	devicePostureIntegration.Config.AccessClientSecret = secrets.AccessClientSecret
 	d.Set("name", devicePostureIntegration.Name)
 	d.Set("type", devicePostureIntegration.Type)
 	d.Set("interval", devicePostureIntegration.Interval)
@@ -101,21 +142,23 @@ func resourceCloudflareDevicePostureIntegrationUpdate(ctx context.Context, d *sc
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	updatedDevicePostureIntegration := cloudflare.DevicePostureIntegration{
-		IntegrationID: d.Id(),
-		Name:          d.Get("name").(string),
-		Type:          d.Get("type").(string),
-		Interval:      d.Get("interval").(string),
+	updatedDevicePostureIntegration := devicePostureIntegration{
+		DevicePostureIntegration: cloudflare.DevicePostureIntegration{
+			IntegrationID: d.Id(),
+			Name:          d.Get("name").(string),
+			Type:          d.Get("type").(string),
+			Interval:      d.Get("interval").(string),
+		},
 	}
 
 	err := setDevicePostureIntegrationConfig(&updatedDevicePostureIntegration, d)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Device Posture Rule with provided match input: %w", err))
+		return diag.FromErr(fmt.Errorf("error updating Device Posture Integration with provided config: %w", err))
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare device posture integration from struct: %+v", updatedDevicePostureIntegration))
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare device posture integration %s of type %s", d.Id(), updatedDevicePostureIntegration.Type))
 
-	devicePostureIntegration, err := client.UpdateDevicePostureIntegration(ctx, accountID, updatedDevicePostureIntegration)
+	devicePostureIntegration, err := writeDevicePostureIntegration(ctx, client, accountID, http.MethodPatch, updatedDevicePostureIntegration)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating device posture integration for account %q: %w", accountID, err))
 	}
@@ -163,75 +206,123 @@ func resourceCloudflareDevicePostureIntegrationImport(ctx context.Context, d *sc
 	return []*schema.ResourceData{d}, nil
 }
 
-func setDevicePostureIntegrationConfig(integration *cloudflare.DevicePostureIntegration, d *schema.ResourceData) error {
+// resourceCloudflareDevicePostureIntegrationValidateConfig ensures the
+// credentials required by the integration type are set so a missing one
+// fails at plan time rather than with a 400 from the API.
+func resourceCloudflareDevicePostureIntegrationValidateConfig(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	integrationType := d.Get("type").(string)
+	fields, ok := devicePostureIntegrationConfigFields[integrationType]
+	if !ok {
+		return nil
+	}
+
+	var missing []string
+	for _, field := range fields.required {
+		key := fmt.Sprintf("config.0.%s", field)
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		if value, _ := d.Get(key).(string); value == "" {
+			missing = append(missing, field)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("device posture integrations of type %q require the following config attributes: %s", integrationType, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func setDevicePostureIntegrationConfig(integration *devicePostureIntegration, d *schema.ResourceData) error {
 	if _, ok := d.GetOk("config"); ok {
-		config := cloudflare.DevicePostureIntegrationConfig{}
-		switch integration.Type {
-		case ws1:
-			if config.ClientID, ok = d.Get("config.0.client_id").(string); !ok {
-				return fmt.Errorf("client_id has to be of type string")
-			}
-			if config.ClientSecret, ok = d.Get("config.0.client_secret").(string); !ok {
-				return fmt.Errorf("client_secret has to be of type string")
-			}
-			if config.AuthUrl, ok = d.Get("config.0.auth_url").(string); !ok {
-				return fmt.Errorf("auth_url has to be of type string")
-			}
-			if config.ApiUrl, ok = d.Get("config.0.api_url").(string); !ok {
-				return fmt.Errorf("api_url has to be of type string")
-			}
-			integration.Config = config
-		case crowdstrike:
-			if config.ClientID, ok = d.Get("config.0.client_id").(string); !ok {
-				return fmt.Errorf("client_id has to be of type string")
-			}
-			if config.ClientSecret, ok = d.Get("config.0.client_secret").(string); !ok {
-				return fmt.Errorf("client_secret has to be of type string")
-			}
-			if config.CustomerID, ok = d.Get("config.0.customer_id").(string); !ok {
-				return fmt.Errorf("customer_id has to be of type string")
-			}
-			if config.ApiUrl, ok = d.Get("config.0.api_url").(string); !ok {
-				return fmt.Errorf("api_url has to be of type string")
-			}
-			integration.Config = config
-		case uptycs:
-			if config.ClientKey, ok = d.Get("config.0.client_key").(string); !ok {
-				return fmt.Errorf("client_id has to be of type string")
-			}
-			if config.ClientSecret, ok = d.Get("config.0.client_secret").(string); !ok {
-				return fmt.Errorf("client_secret has to be of type string")
-			}
-			if config.CustomerID, ok = d.Get("config.0.customer_id").(string); !ok {
-				return fmt.Errorf("customer_id has to be of type string")
-			}
-			integration.Config = config
-		case intune:
-			if config.ClientID, ok = d.Get("config.0.client_id").(string); !ok {
-				return fmt.Errorf("client_id has to be of type string")
-			}
-			if config.ClientSecret, ok = d.Get("config.0.client_secret").(string); !ok {
-				return fmt.Errorf("client_secret has to be of type string")
+		fields, ok := devicePostureIntegrationConfigFields[integration.Type]
+		if !ok {
+			return fmt.Errorf("unsupported integration type:%s", integration.Type)
+		}
+
+		config := devicePostureIntegrationConfig{}
+		for _, field := range append(fields.required, fields.optional...) {
+			value, ok := d.Get(fmt.Sprintf("config.0.%s", field)).(string)
+			if !ok {
+				return fmt.Errorf("%s has to be of type string", field)
 			}
-			if config.CustomerID, ok = d.Get("config.0.customer_id").(string); !ok {
-				return fmt.Errorf("customer_id has to be of type string")
+
+			switch field {
+			case "client_id":
+				config.ClientID = value
+			case "client_secret":
+				config.ClientSecret = value
+			case "auth_url":
+				config.AuthUrl = value
+			case "api_url":
+				config.ApiUrl = value
+			case "client_key":
+				config.ClientKey = value
+			case "customer_id":
+				config.CustomerID = value
+			case "access_client_id":
+				config.AccessClientID = value
+			case "access_client_secret":
+				config.AccessClientSecret = value
 			}
-			integration.Config = config
-		default:
-			return fmt.Errorf("unsupported integration type:%s", integration.Type)
 		}
+		integration.Config = config
 	}
 	return nil
 }
 
-func convertIntegrationConfigToSchema(input cloudflare.DevicePostureIntegrationConfig) []interface{} {
+func convertIntegrationConfigToSchema(input devicePostureIntegrationConfig) []interface{} {
 	m := map[string]interface{}{
-		"client_id":     input.ClientID,
-		"client_secret": input.ClientSecret,
-		"auth_url":      input.AuthUrl,
-		"api_url":       input.ApiUrl,
-		"client_key":    input.ClientKey,
-		"customer_id":   input.CustomerID,
+		"client_id":            input.ClientID,
+		"client_secret":        input.ClientSecret,
+		"auth_url":             input.AuthUrl,
+		"api_url":              input.ApiUrl,
+		"client_key":           input.ClientKey,
+		"customer_id":          input.CustomerID,
+		"access_client_id":     input.AccessClientID,
+		"access_client_secret": input.AccessClientSecret,
 	}
 	return []interface{}{m}
 }
+
+func getDevicePostureIntegration(ctx context.Context, client *cloudflare.API, accountID, integrationID string) (devicePostureIntegration, error) {
+	var integration devicePostureIntegration
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/devices/posture/integration/%s", accountID, integrationID), nil, nil)
+	if err != nil {
+		return integration, err
+	}
+
+	if err := json.Unmarshal(res, &integration); err != nil {
+		return integration, fmt.Errorf("error unmarshalling device posture integration: %w", err)
+	}
+
+	return integration, nil
+}
+
+// writeDevicePostureIntegration creates (POST) or updates (PATCH) a device
+// posture integration.
+func writeDevicePostureIntegration(ctx context.Context, client *cloudflare.API, accountID, method string, integration devicePostureIntegration) (devicePostureIntegration, error) {
+	uri := fmt.Sprintf("/accounts/%s/devices/posture/integration", accountID)
+	if method == http.MethodPatch {
+		uri = fmt.Sprintf("%s/%s", uri, integration.IntegrationID)
+	}
+
+	var result devicePostureIntegration
+
+	res, err := client.Raw(ctx, method, uri, integration, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling device posture integration: %w", err)
+	}
+
+	return result, nil
+}
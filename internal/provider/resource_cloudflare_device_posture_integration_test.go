@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -68,6 +69,46 @@ resource "cloudflare_device_posture_integration" "%[1]s" {
 `, rnd, accountID, clientID, clientSecret, apiURL, authURL)
 }
 
+func TestAccCloudflareDevicePostureIntegration_MissingCredentials(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareDevicePostureIntegrationTanium(rnd, accountID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(`device posture integrations of type "tanium_s2s" require the following config attributes: client_secret`)),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDevicePostureIntegrationTanium(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_device_posture_integration" "%[1]s" {
+	account_id = "%[2]s"
+	name       = "%[1]s"
+	type       = "tanium_s2s"
+	interval   = "24h"
+	config {
+		api_url          = "https://tanium.example.com/plugin/products/gateway/graphql"
+		access_client_id = "client-id.access"
+	}
+}
+`, rnd, accountID)
+}
+
 func testAccCheckCloudflareDevicePostureIntegrationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
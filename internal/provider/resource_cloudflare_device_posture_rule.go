@@ -5,15 +5,63 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// devicePostureRuleInputFields lists the input attributes that are relevant
+// to each device posture rule type. Attributes not listed for a type are
+// omitted from the request payload.
+var devicePostureRuleInputFields = map[string][]string{
+	"serial_number":         {"id"},
+	"unique_client_id":      {"id"},
+	"file":                  {"path", "exists", "thumbprint", "sha256"},
+	"application":           {"path", "thumbprint", "sha256", "running"},
+	"gateway":               {},
+	"warp":                  {},
+	"domain_joined":         {"domain"},
+	"os_version":            {"version", "operator", "os_distro_name", "os_distro_revision"},
+	"disk_encryption":       {"require_all"},
+	"firewall":              {"enabled"},
+	"workspace_one":         {"connection_id", "compliance_status"},
+	"crowdstrike_s2s":       {"connection_id", "operator", "os", "overall", "sensor_config", "version", "version_operator"},
+	"intune":                {"connection_id", "compliance_status"},
+	"client_certificate":    {"certificate_id", "cn"},
+	"client_certificate_v2": {"certificate_id", "cn", "check_private_key", "extended_key_usage"},
+	"sentinelone_s2s":       {"connection_id", "active_threats", "infected", "is_active", "network_status", "operator"},
+	"tanium_s2s":            {"connection_id", "eid_last_seen", "risk_level", "score_operator", "total_score", "operator"},
+}
+
+// devicePostureRuleInput extends the rule input with the attributes that
+// aren't available in cloudflare-go yet.
+type devicePostureRuleInput struct {
+	cloudflare.DevicePostureRuleInput
+	CertificateID    string   `json:"certificate_id,omitempty"`
+	CommonName       string   `json:"cn,omitempty"`
+	CheckPrivateKey  *bool    `json:"check_private_key,omitempty"`
+	ExtendedKeyUsage []string `json:"extended_key_usage,omitempty"`
+	ActiveThreats    *int     `json:"activeThreats,omitempty"`
+	Infected         *bool    `json:"infected,omitempty"`
+	IsActive         *bool    `json:"isActive,omitempty"`
+	NetworkStatus    string   `json:"network_status,omitempty"`
+	EidLastSeen      string   `json:"eid_last_seen,omitempty"`
+	RiskLevel        string   `json:"risk_level,omitempty"`
+	ScoreOperator    string   `json:"scoreOperator,omitempty"`
+	TotalScore       *int     `json:"total_score,omitempty"`
+}
+
+type devicePostureRule struct {
+	cloudflare.DevicePostureRule
+	Input devicePostureRuleInput `json:"input,omitempty"`
+}
+
 func resourceCloudflareDevicePostureRule() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareDevicePostureRuleSchema(),
@@ -34,13 +82,13 @@ func resourceCloudflareDevicePostureRuleCreate(ctx context.Context, d *schema.Re
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	newDevicePostureRule := cloudflare.DevicePostureRule{
+	newDevicePostureRule := devicePostureRule{DevicePostureRule: cloudflare.DevicePostureRule{
 		Name:        d.Get("name").(string),
 		Type:        d.Get("type").(string),
 		Description: d.Get("description").(string),
 		Schedule:    d.Get("schedule").(string),
 		Expiration:  d.Get("expiration").(string),
-	}
+	}}
 
 	err := setDevicePostureRuleMatch(&newDevicePostureRule, d)
 	if err != nil {
@@ -50,7 +98,7 @@ func resourceCloudflareDevicePostureRuleCreate(ctx context.Context, d *schema.Re
 	setDevicePostureRuleInput(&newDevicePostureRule, d)
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device Posture Rule from struct: %+v", newDevicePostureRule))
 
-	rule, err := client.CreateDevicePostureRule(ctx, accountID, newDevicePostureRule)
+	rule, err := writeDevicePostureRule(ctx, client, accountID, http.MethodPost, newDevicePostureRule)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Device Posture Rule for account %q: %w", accountID, err))
 	}
@@ -64,7 +112,7 @@ func resourceCloudflareDevicePostureRuleRead(ctx context.Context, d *schema.Reso
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	devicePostureRule, err := client.DevicePostureRule(ctx, accountID, d.Id())
+	devicePostureRule, err := getDevicePostureRule(ctx, client, accountID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -90,14 +138,14 @@ func resourceCloudflareDevicePostureRuleUpdate(ctx context.Context, d *schema.Re
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	updatedDevicePostureRule := cloudflare.DevicePostureRule{
+	updatedDevicePostureRule := devicePostureRule{DevicePostureRule: cloudflare.DevicePostureRule{
 		ID:          d.Id(),
 		Name:        d.Get("name").(string),
 		Type:        d.Get("type").(string),
 		Description: d.Get("description").(string),
 		Schedule:    d.Get("schedule").(string),
 		Expiration:  d.Get("expiration").(string),
-	}
+	}}
 
 	err := setDevicePostureRuleMatch(&updatedDevicePostureRule, d)
 	if err != nil {
@@ -107,7 +155,7 @@ func resourceCloudflareDevicePostureRuleUpdate(ctx context.Context, d *schema.Re
 	setDevicePostureRuleInput(&updatedDevicePostureRule, d)
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Device Posture Rule from struct: %+v", updatedDevicePostureRule))
 
-	devicePostureRule, err := client.UpdateDevicePostureRule(ctx, accountID, updatedDevicePostureRule)
+	devicePostureRule, err := writeDevicePostureRule(ctx, client, accountID, http.MethodPut, updatedDevicePostureRule)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Device Posture Rule for account %q: %w", accountID, err))
 	}
@@ -155,71 +203,162 @@ func resourceCloudflareDevicePostureRuleImport(ctx context.Context, d *schema.Re
 	return []*schema.ResourceData{d}, nil
 }
 
-func setDevicePostureRuleInput(rule *cloudflare.DevicePostureRule, d *schema.ResourceData) {
+func setDevicePostureRuleInput(rule *devicePostureRule, d *schema.ResourceData) {
 	if _, ok := d.GetOk("input"); ok {
-		input := cloudflare.DevicePostureRuleInput{}
-		if inputID, ok := d.GetOk("input.0.id"); ok {
+		relevantFields, knownType := devicePostureRuleInputFields[rule.Type]
+		isRelevant := func(key string) bool {
+			if !knownType {
+				return true
+			}
+			for _, field := range relevantFields {
+				if field == key {
+					return true
+				}
+			}
+			return false
+		}
+		// getInput behaves like d.GetOk on the input block but ignores
+		// attributes that don't apply to the rule type.
+		getInput := func(key string) (interface{}, bool) {
+			if !isRelevant(key) {
+				return nil, false
+			}
+			return d.GetOk("input.0." + key)
+		}
+		// getInputInt is getInput for integers, where 0 is a meaningful
+		// value which is sent when it's configured.
+		getInputInt := func(key string) (int, bool) {
+			if !isRelevant(key) {
+				return 0, false
+			}
+			if devicePostureRuleInputConfigured(d.GetRawConfig(), key) {
+				return d.Get("input.0." + key).(int), true
+			}
+			value, ok := d.GetOk("input.0." + key)
+			if !ok {
+				return 0, false
+			}
+			return value.(int), true
+		}
+
+		input := devicePostureRuleInput{}
+		if inputID, ok := getInput("id"); ok {
 			input.ID = inputID.(string)
 		}
-		if p, ok := d.GetOk("input.0.path"); ok {
+		if p, ok := getInput("path"); ok {
 			input.Path = p.(string)
 		}
-		if exists, ok := d.GetOk("input.0.exists"); ok {
+		if exists, ok := getInput("exists"); ok {
 			input.Exists = exists.(bool)
 		}
-		if tp, ok := d.GetOk("input.0.thumbprint"); ok {
+		if tp, ok := getInput("thumbprint"); ok {
 			input.Thumbprint = tp.(string)
 		}
-		if s, ok := d.GetOk("input.0.sha256"); ok {
+		if s, ok := getInput("sha256"); ok {
 			input.Sha256 = s.(string)
 		}
-		if running, ok := d.GetOk("input.0.running"); ok {
+		if running, ok := getInput("running"); ok {
 			input.Running = running.(bool)
 		}
-		if require_all, ok := d.GetOk("input.0.require_all"); ok {
+		if require_all, ok := getInput("require_all"); ok {
 			input.RequireAll = require_all.(bool)
 		}
-		if enabled, ok := d.GetOk("input.0.enabled"); ok {
+		if enabled, ok := getInput("enabled"); ok {
 			input.Enabled = enabled.(bool)
 		}
-		if version, ok := d.GetOk("input.0.version"); ok {
+		if version, ok := getInput("version"); ok {
 			input.Version = version.(string)
 		}
-		if operator, ok := d.GetOk("input.0.operator"); ok {
+		if operator, ok := getInput("operator"); ok {
 			input.Operator = operator.(string)
 		}
-		if domain, ok := d.GetOk("input.0.domain"); ok {
+		if domain, ok := getInput("domain"); ok {
 			input.Domain = domain.(string)
 		}
-		if complianceStatus, ok := d.GetOk("input.0.compliance_status"); ok {
+		if complianceStatus, ok := getInput("compliance_status"); ok {
 			input.ComplianceStatus = complianceStatus.(string)
 		}
-		if connectionID, ok := d.GetOk("input.0.connection_id"); ok {
+		if connectionID, ok := getInput("connection_id"); ok {
 			input.ConnectionID = connectionID.(string)
 		}
-		if osDistroName, ok := d.GetOk("input.0.os_distro_name"); ok {
+		if osDistroName, ok := getInput("os_distro_name"); ok {
 			input.OsDistroName = osDistroName.(string)
 		}
-		if osDistroRevision, ok := d.GetOk("input.0.os_distro_revision"); ok {
+		if osDistroRevision, ok := getInput("os_distro_revision"); ok {
 			input.OsDistroRevision = osDistroRevision.(string)
 		}
-		if os, ok := d.GetOk("input.0.os"); ok {
+		if os, ok := getInput("os"); ok {
 			input.Os = os.(string)
 		}
-		if overall, ok := d.GetOk("input.0.overall"); ok {
+		if overall, ok := getInput("overall"); ok {
 			input.Overall = overall.(string)
 		}
-		if sensorConfig, ok := d.GetOk("input.0.sensor_config"); ok {
+		if sensorConfig, ok := getInput("sensor_config"); ok {
 			input.SensorConfig = sensorConfig.(string)
 		}
-		if versionOperator, ok := d.GetOk("input.0.version_operator"); ok {
+		if versionOperator, ok := getInput("version_operator"); ok {
 			input.VersionOperator = versionOperator.(string)
 		}
+		if certificateID, ok := getInput("certificate_id"); ok {
+			input.CertificateID = certificateID.(string)
+		}
+		if cn, ok := getInput("cn"); ok {
+			input.CommonName = cn.(string)
+		}
+		if extendedKeyUsage, ok := getInput("extended_key_usage"); ok {
+			input.ExtendedKeyUsage = expandInterfaceToStringList(extendedKeyUsage.(*schema.Set).List())
+		}
+		if activeThreats, ok := getInputInt("active_threats"); ok {
+			input.ActiveThreats = cloudflare.IntPtr(activeThreats)
+		}
+		if networkStatus, ok := getInput("network_status"); ok {
+			input.NetworkStatus = networkStatus.(string)
+		}
+		if eidLastSeen, ok := getInput("eid_last_seen"); ok {
+			input.EidLastSeen = eidLastSeen.(string)
+		}
+		if riskLevel, ok := getInput("risk_level"); ok {
+			input.RiskLevel = riskLevel.(string)
+		}
+		if scoreOperator, ok := getInput("score_operator"); ok {
+			input.ScoreOperator = scoreOperator.(string)
+		}
+		if totalScore, ok := getInputInt("total_score"); ok {
+			input.TotalScore = cloudflare.IntPtr(totalScore)
+		}
+
+		// false is a meaningful value for these checks so they are always
+		// sent for the rule types they apply to.
+		if isRelevant("check_private_key") {
+			input.CheckPrivateKey = cloudflare.BoolPtr(d.Get("input.0.check_private_key").(bool))
+		}
+		if isRelevant("infected") {
+			input.Infected = cloudflare.BoolPtr(d.Get("input.0.infected").(bool))
+		}
+		if isRelevant("is_active") {
+			input.IsActive = cloudflare.BoolPtr(d.Get("input.0.is_active").(bool))
+		}
+
 		rule.Input = input
 	}
 }
 
-func setDevicePostureRuleMatch(rule *cloudflare.DevicePostureRule, d *schema.ResourceData) error {
+// devicePostureRuleInputConfigured reports whether the input attribute key is
+// set in the raw configuration of a rule.
+func devicePostureRuleInputConfigured(rawConfig cty.Value, key string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("input") {
+		return false
+	}
+	input := rawConfig.GetAttr("input")
+	if input.IsNull() || !input.IsKnown() || input.LengthInt() == 0 {
+		return false
+	}
+	value := input.Index(cty.NumberIntVal(0)).GetAttr(key)
+
+	return !value.IsNull() && value.IsKnown()
+}
+
+func setDevicePostureRuleMatch(rule *devicePostureRule, d *schema.ResourceData) error {
 	if _, ok := d.GetOk("match"); ok {
 		match := d.Get("match").([]interface{})
 		for _, v := range match {
@@ -250,16 +389,21 @@ func convertMatchToSchema(matches []cloudflare.DevicePostureRuleMatch) []map[str
 	return matchSchema
 }
 
-func convertInputToSchema(input cloudflare.DevicePostureRuleInput) []map[string]interface{} {
+// convertInputToSchema only includes the input attributes returned by the
+// API so that attributes of other rule types are left unset.
+func convertInputToSchema(input devicePostureRuleInput) []map[string]interface{} {
 	m := map[string]interface{}{
+		"exists":      input.Exists,
+		"running":     input.Running,
+		"require_all": input.RequireAll,
+		"enabled":     input.Enabled,
+	}
+
+	for key, value := range map[string]string{
 		"id":                 input.ID,
 		"path":               input.Path,
-		"exists":             input.Exists,
 		"thumbprint":         input.Thumbprint,
 		"sha256":             input.Sha256,
-		"running":            input.Running,
-		"require_all":        input.RequireAll,
-		"enabled":            input.Enabled,
 		"version":            input.Version,
 		"os_distro_name":     input.OsDistroName,
 		"os_distro_revision": input.OsDistroRevision,
@@ -271,7 +415,73 @@ func convertInputToSchema(input cloudflare.DevicePostureRuleInput) []map[string]
 		"overall":            input.Overall,
 		"sensor_config":      input.SensorConfig,
 		"version_operator":   input.VersionOperator,
+		"certificate_id":     input.CertificateID,
+		"cn":                 input.CommonName,
+		"network_status":     input.NetworkStatus,
+		"eid_last_seen":      input.EidLastSeen,
+		"risk_level":         input.RiskLevel,
+		"score_operator":     input.ScoreOperator,
+	} {
+		if value != "" {
+			m[key] = value
+		}
+	}
+
+	if len(input.ExtendedKeyUsage) > 0 {
+		m["extended_key_usage"] = input.ExtendedKeyUsage
+	}
+	if input.CheckPrivateKey != nil {
+		m["check_private_key"] = *input.CheckPrivateKey
+	}
+	if input.ActiveThreats != nil {
+		m["active_threats"] = *input.ActiveThreats
+	}
+	if input.Infected != nil {
+		m["infected"] = *input.Infected
+	}
+	if input.IsActive != nil {
+		m["is_active"] = *input.IsActive
+	}
+	if input.TotalScore != nil {
+		m["total_score"] = *input.TotalScore
 	}
 
 	return []map[string]interface{}{m}
 }
+
+func getDevicePostureRule(ctx context.Context, client *cloudflare.API, accountID, ruleID string) (devicePostureRule, error) {
+	var rule devicePostureRule
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/devices/posture/%s", accountID, ruleID), nil, nil)
+	if err != nil {
+		return rule, err
+	}
+
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return rule, fmt.Errorf("error unmarshalling Device Posture Rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// writeDevicePostureRule creates (POST) or updates (PUT) a device posture
+// rule.
+func writeDevicePostureRule(ctx context.Context, client *cloudflare.API, accountID, method string, rule devicePostureRule) (devicePostureRule, error) {
+	uri := fmt.Sprintf("/accounts/%s/devices/posture", accountID)
+	if method == http.MethodPut {
+		uri = fmt.Sprintf("%s/%s", uri, rule.ID)
+	}
+
+	var result devicePostureRule
+
+	res, err := client.Raw(ctx, method, uri, rule, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Device Posture Rule: %w", err)
+	}
+
+	return result, nil
+}
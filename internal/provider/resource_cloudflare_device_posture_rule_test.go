@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -334,6 +338,146 @@ resource "cloudflare_device_posture_rule" "%[1]s" {
 `, rnd, accountID)
 }
 
+func TestSetDevicePostureRuleInputOmitsIrrelevantFields(t *testing.T) {
+	testCases := map[string]struct {
+		ruleType string
+		input    map[string]interface{}
+		expected string
+	}{
+		"client certificate v2": {
+			ruleType: "client_certificate_v2",
+			input: map[string]interface{}{
+				"certificate_id":     "5ee7c2ec-1d5e-4b64-a5b2-9b2f2b0d4f5e",
+				"cn":                 "example.com",
+				"extended_key_usage": []interface{}{"clientAuth"},
+				"domain":             "example.com",
+				"infected":           true,
+			},
+			expected: `{"certificate_id":"5ee7c2ec-1d5e-4b64-a5b2-9b2f2b0d4f5e","cn":"example.com","check_private_key":false,"extended_key_usage":["clientAuth"]}`,
+		},
+		"sentinelone": {
+			ruleType: "sentinelone_s2s",
+			input: map[string]interface{}{
+				"connection_id":  "bc7cbfbb-600a-42e4-8a23-d3c8ebd2fd4b",
+				"active_threats": 1,
+				"operator":       "<",
+				"network_status": "connected",
+				"cn":             "example.com",
+			},
+			expected: `{"operator":"\u003c","connection_id":"bc7cbfbb-600a-42e4-8a23-d3c8ebd2fd4b","activeThreats":1,"infected":false,"isActive":false,"network_status":"connected"}`,
+		},
+		"tanium": {
+			ruleType: "tanium_s2s",
+			input: map[string]interface{}{
+				"connection_id":  "bc7cbfbb-600a-42e4-8a23-d3c8ebd2fd4b",
+				"risk_level":     "low",
+				"score_operator": ">",
+				"total_score":    50,
+				"is_active":      true,
+			},
+			expected: `{"connection_id":"bc7cbfbb-600a-42e4-8a23-d3c8ebd2fd4b","risk_level":"low","scoreOperator":"\u003e","total_score":50}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudflareDevicePostureRuleSchema(), map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"type":       tc.ruleType,
+				"input":      []interface{}{tc.input},
+			})
+
+			rule := devicePostureRule{DevicePostureRule: cloudflare.DevicePostureRule{Type: tc.ruleType}}
+			setDevicePostureRuleInput(&rule, d)
+
+			payload, err := json.Marshal(rule.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(payload) != tc.expected {
+				t.Errorf("expected input payload %s, got %s", tc.expected, payload)
+			}
+		})
+	}
+}
+
+func TestSetDevicePostureRuleInputSendsConfiguredZeros(t *testing.T) {
+	testCases := map[string]struct {
+		ruleType string
+		input    map[string]interface{}
+		expected string
+	}{
+		"sentinelone without active threats": {
+			ruleType: "sentinelone_s2s",
+			input: map[string]interface{}{
+				"connection_id":  "bc7cbfbb-600a-42e4-8a23-d3c8ebd2fd4b",
+				"active_threats": 0,
+				"operator":       "==",
+			},
+			expected: `"activeThreats":0`,
+		},
+		"tanium with a zero score": {
+			ruleType: "tanium_s2s",
+			input: map[string]interface{}{
+				"connection_id":  "bc7cbfbb-600a-42e4-8a23-d3c8ebd2fd4b",
+				"score_operator": ">",
+				"total_score":    0,
+			},
+			expected: `"total_score":0`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"type":       tc.ruleType,
+				"input":      []interface{}{tc.input},
+			}
+
+			r := resourceCloudflareDevicePostureRule()
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+			d.SetId("0f0bd4fa-4a5c-4d6c-b6e3-2a2c6d7aa3f5")
+
+			state := d.State()
+			config, err := json.Marshal(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			state.RawConfig, err = ctyjson.Unmarshal(config, r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rule := devicePostureRule{DevicePostureRule: cloudflare.DevicePostureRule{Type: tc.ruleType}}
+			setDevicePostureRuleInput(&rule, r.Data(state))
+
+			payload, err := json.Marshal(rule.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(payload), tc.expected) {
+				t.Errorf("expected input payload to contain %s, got %s", tc.expected, payload)
+			}
+		})
+	}
+}
+
+func TestConvertDevicePostureRuleInputToSchemaOmitsAbsentFields(t *testing.T) {
+	input := devicePostureRuleInput{CertificateID: "5ee7c2ec-1d5e-4b64-a5b2-9b2f2b0d4f5e"}
+	input.Enabled = true
+
+	m := convertInputToSchema(input)[0]
+	if m["certificate_id"] != "5ee7c2ec-1d5e-4b64-a5b2-9b2f2b0d4f5e" {
+		t.Errorf("expected certificate_id to be set, got %v", m["certificate_id"])
+	}
+	for _, key := range []string{"cn", "domain", "path", "active_threats", "infected"} {
+		if _, ok := m[key]; ok {
+			t.Errorf("expected %s to be absent, got %v", key, m[key])
+		}
+	}
+}
+
 func testAccCheckCloudflareDevicePostureRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -11,6 +13,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// deviceSettingsPolicy extends cloudflare.DeviceSettingsPolicy with the DEX
+// settings that aren't available in cloudflare-go yet.
+type deviceSettingsPolicy struct {
+	cloudflare.DeviceSettingsPolicy
+	DEXTests *bool `json:"dex_tests,omitempty"`
+}
+
+// deviceSettingsPolicyRequest extends cloudflare.DeviceSettingsPolicyRequest
+// with the DEX settings.
+type deviceSettingsPolicyRequest struct {
+	cloudflare.DeviceSettingsPolicyRequest
+	DEXTests *bool `json:"dex_tests,omitempty"`
+}
+
 func resourceCloudflareDeviceSettingsPolicy() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareDeviceSettingsPolicySchema(),
@@ -42,15 +58,15 @@ func resourceCloudflareDeviceSettingsPolicyCreate(ctx context.Context, d *schema
 		return diag.FromErr(fmt.Errorf("error creating Cloudflare device settings policy request: %q: %w", accountID, err))
 	}
 
-	policy, err := client.CreateDeviceSettingsPolicy(ctx, accountID, req)
+	policy, err := writeDeviceSettingsPolicy(ctx, client, http.MethodPost, accountID, "", req)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Cloudflare device settings policy %q: %w", accountID, err))
 	}
 
-	if policy.Result.PolicyID == nil {
+	if policy.PolicyID == nil {
 		return diag.FromErr(fmt.Errorf("error creating Cloudflare device settings policy: returned policyID was missing after creating policy for account: %q", accountID))
 	}
-	d.SetId(fmt.Sprintf("%s/%s", accountID, *policy.Result.PolicyID))
+	d.SetId(fmt.Sprintf("%s/%s", accountID, *policy.PolicyID))
 	return resourceCloudflareDeviceSettingsPolicyRead(ctx, d, meta)
 }
 
@@ -66,12 +82,7 @@ func resourceCloudflareDeviceSettingsPolicyUpdate(ctx context.Context, d *schema
 		return diag.FromErr(fmt.Errorf("error creating Cloudflare device settings policy request: %q: %w", accountID, err))
 	}
 
-	if policyID == "" {
-		_, err = client.UpdateDefaultDeviceSettingsPolicy(ctx, accountID, req)
-	} else {
-		_, err = client.UpdateDeviceSettingsPolicy(ctx, accountID, policyID, req)
-	}
-	if err != nil {
+	if _, err := writeDeviceSettingsPolicy(ctx, client, http.MethodPatch, accountID, policyID, req); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Cloudflare device settings policy %q: %w", accountID, err))
 	}
 
@@ -83,72 +94,70 @@ func resourceCloudflareDeviceSettingsPolicyRead(ctx context.Context, d *schema.R
 	accountID := d.Get("account_id").(string)
 	_, policyID := parseDevicePolicyID(d.Id())
 
-	var policy cloudflare.DeviceSettingsPolicyResponse
-	var err error
-	if policyID == "" {
-		policy, err = client.GetDefaultDeviceSettingsPolicy(ctx, accountID)
-	} else {
-		policy, err = client.GetDeviceSettingsPolicy(ctx, accountID, policyID)
-	}
-
+	policy, err := getDeviceSettingsPolicy(ctx, client, accountID, policyID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error reading device settings policy %q %s: %w", accountID, policyID, err))
 	}
 
-	if err := d.Set("disable_auto_fallback", policy.Result.DisableAutoFallback); err != nil {
+	if err := d.Set("disable_auto_fallback", policy.DisableAutoFallback); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing disable_auto_fallback"))
 	}
-	if err := d.Set("captive_portal", policy.Result.CaptivePortal); err != nil {
+	if err := d.Set("captive_portal", policy.CaptivePortal); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing captive_portal"))
 	}
-	if err := d.Set("allow_mode_switch", policy.Result.AllowModeSwitch); err != nil {
+	if err := d.Set("allow_mode_switch", policy.AllowModeSwitch); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing allow_mode_switch"))
 	}
-	if err := d.Set("switch_locked", policy.Result.SwitchLocked); err != nil {
+	if err := d.Set("switch_locked", policy.SwitchLocked); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing switch_locked"))
 	}
-	if err := d.Set("allow_updates", policy.Result.AllowUpdates); err != nil {
+	if err := d.Set("allow_updates", policy.AllowUpdates); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing allow_updates"))
 	}
-	if err := d.Set("auto_connect", policy.Result.AutoConnect); err != nil {
+	if err := d.Set("auto_connect", policy.AutoConnect); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing auto_connect"))
 	}
-	if err := d.Set("allowed_to_leave", policy.Result.AllowedToLeave); err != nil {
+	if err := d.Set("allowed_to_leave", policy.AllowedToLeave); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing allowed_to_leave"))
 	}
-	if err := d.Set("support_url", policy.Result.SupportURL); err != nil {
+	if err := d.Set("support_url", policy.SupportURL); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing support_url"))
 	}
-	if err := d.Set("default", policy.Result.Default); err != nil {
+	if err := d.Set("default", policy.Default); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting default"))
 	}
-	if err := d.Set("service_mode_v2_mode", policy.Result.ServiceModeV2.Mode); err != nil {
+	if err := d.Set("service_mode_v2_mode", policy.ServiceModeV2.Mode); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting service_mode_v2_mode"))
 	}
-	if err := d.Set("service_mode_v2_port", policy.Result.ServiceModeV2.Port); err != nil {
+	if err := d.Set("service_mode_v2_port", policy.ServiceModeV2.Port); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting service_mode_v2_port"))
 	}
 	// ignore setting forbidden fields for default policies
-	if policy.Result.Name != nil {
-		if err := d.Set("name", policy.Result.Name); err != nil {
+	if policy.Name != nil {
+		if err := d.Set("name", policy.Name); err != nil {
 			return diag.FromErr(fmt.Errorf("error parsing name"))
 		}
 	}
-	if policy.Result.Precedence != nil {
-		if err := d.Set("precedence", apiToProviderRulePrecedence(uint64(*policy.Result.Precedence), d.Get("name").(string))); err != nil {
+	if policy.Precedence != nil {
+		if err := d.Set("precedence", apiToProviderRulePrecedence(uint64(*policy.Precedence), d.Get("name").(string))); err != nil {
 			return diag.FromErr(fmt.Errorf("error parsing precedence"))
 		}
 	}
-	if policy.Result.Match != nil {
-		if err := d.Set("match", policy.Result.Match); err != nil {
+	if policy.Match != nil {
+		if err := d.Set("match", policy.Match); err != nil {
 			return diag.FromErr(fmt.Errorf("error parsing match"))
 		}
 	}
-	if policy.Result.Enabled != nil {
-		if err := d.Set("enabled", policy.Result.Enabled); err != nil {
+	if policy.Enabled != nil {
+		if err := d.Set("enabled", policy.Enabled); err != nil {
 			return diag.FromErr(fmt.Errorf("error parsing enabled"))
 		}
 	}
+	if policy.DEXTests != nil {
+		if err := d.Set("dex_tests", policy.DEXTests); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing dex_tests"))
+		}
+	}
 
 	return nil
 }
@@ -195,10 +204,10 @@ func resourceCloudflareDeviceSettingsPolicyDelete(ctx context.Context, d *schema
 	return nil
 }
 
-func buildDeviceSettingsPolicyRequest(d *schema.ResourceData) (cloudflare.DeviceSettingsPolicyRequest, error) {
+func buildDeviceSettingsPolicyRequest(d *schema.ResourceData) (deviceSettingsPolicyRequest, error) {
 	defaultPolicy := (d.Get("default").(bool) || d.Id() == d.Get("account_id").(string))
 
-	req := cloudflare.DeviceSettingsPolicyRequest{
+	req := deviceSettingsPolicyRequest{DeviceSettingsPolicyRequest: cloudflare.DeviceSettingsPolicyRequest{
 		DisableAutoFallback: cloudflare.BoolPtr(d.Get("disable_auto_fallback").(bool)),
 		CaptivePortal:       cloudflare.IntPtr(d.Get("captive_portal").(int)),
 		AllowModeSwitch:     cloudflare.BoolPtr(d.Get("allow_mode_switch").(bool)),
@@ -211,6 +220,10 @@ func buildDeviceSettingsPolicyRequest(d *schema.ResourceData) (cloudflare.Device
 			Mode: d.Get("service_mode_v2_mode").(string),
 			Port: d.Get("service_mode_v2_port").(int),
 		},
+	}}
+
+	if dexTests, ok := d.GetOkExists("dex_tests"); ok {
+		req.DEXTests = cloudflare.BoolPtr(dexTests.(bool))
 	}
 
 	name := d.Get("name").(string)
@@ -259,3 +272,43 @@ func parseDeviceSettingsIDImport(id string) (string, string, error) {
 
 	return attributes[0], attributes[1], nil
 }
+
+// deviceSettingsPolicyURI returns the URI of a settings policy, or of the
+// default policy of the account when policyID is empty.
+func deviceSettingsPolicyURI(accountID, policyID string) string {
+	if policyID == "" {
+		return fmt.Sprintf("/accounts/%s/devices/policy", accountID)
+	}
+
+	return fmt.Sprintf("/accounts/%s/devices/policy/%s", accountID, policyID)
+}
+
+func getDeviceSettingsPolicy(ctx context.Context, client *cloudflare.API, accountID, policyID string) (deviceSettingsPolicy, error) {
+	res, err := client.Raw(ctx, http.MethodGet, deviceSettingsPolicyURI(accountID, policyID), nil, nil)
+	if err != nil {
+		return deviceSettingsPolicy{}, err
+	}
+
+	var policy deviceSettingsPolicy
+	if err := json.Unmarshal(res, &policy); err != nil {
+		return deviceSettingsPolicy{}, fmt.Errorf("error unmarshalling device settings policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// writeDeviceSettingsPolicy creates (with POST) or updates a settings policy
+// and returns the resulting policy.
+func writeDeviceSettingsPolicy(ctx context.Context, client *cloudflare.API, method, accountID, policyID string, req deviceSettingsPolicyRequest) (deviceSettingsPolicy, error) {
+	res, err := client.Raw(ctx, method, deviceSettingsPolicyURI(accountID, policyID), req, nil)
+	if err != nil {
+		return deviceSettingsPolicy{}, err
+	}
+
+	var policy deviceSettingsPolicy
+	if err := json.Unmarshal(res, &policy); err != nil {
+		return deviceSettingsPolicy{}, fmt.Errorf("error unmarshalling device settings policy: %w", err)
+	}
+
+	return policy, nil
+}
@@ -2,13 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -166,3 +169,55 @@ func testAccCheckCloudflareDeviceSettingsPolicyDestroy(s *terraform.State) error
 
 	return nil
 }
+
+func TestCloudflareDeviceSettingsPolicyDEXTests(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const policyID = "a842fa8a-a583-482e-9cd9-eb43362949fd"
+
+	var created map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/"+accountID+"/devices/policy":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, `{"policy_id": "`+policyID+`"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/"+accountID+"/devices/policy/"+policyID:
+			testAPIResult(w, `{
+  "policy_id": "`+policyID+`",
+  "name": "example",
+  "match": "identity.email == \"foo@example.com\"",
+  "precedence": 10,
+  "enabled": true,
+  "captive_portal": 180,
+  "service_mode_v2": {"mode": "warp"},
+  "dex_tests": false
+}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareDeviceSettingsPolicySchema(), map[string]interface{}{
+		"account_id": accountID,
+		"name":       "example",
+		"match":      `identity.email == "foo@example.com"`,
+		"precedence": 10,
+		"dex_tests":  false,
+	})
+
+	if diags := resourceCloudflareDeviceSettingsPolicyCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if value, ok := created["dex_tests"]; !ok || value != false {
+		t.Errorf("expected dex_tests to be sent as false, got %#v", created["dex_tests"])
+	}
+	if d.Id() != accountID+"/"+policyID {
+		t.Errorf("expected ID %q, got %q", accountID+"/"+policyID, d.Id())
+	}
+	if d.Get("dex_tests").(bool) {
+		t.Error("expected dex_tests to be read as false")
+	}
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dexTestReferencedErrorCode is returned when deleting a test which is still
+// used by a DEX dashboard view.
+const dexTestReferencedErrorCode = 1704
+
+// dexTest is a Digital Experience Monitoring synthetic test run by WARP
+// clients.
+type dexTest struct {
+	TestID      string      `json:"test_id,omitempty"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Interval    string      `json:"interval"`
+	Enabled     bool        `json:"enabled"`
+	Data        dexTestData `json:"data"`
+	Updated     string      `json:"updated,omitempty"`
+	Created     string      `json:"created,omitempty"`
+}
+
+// dexTestData holds the target of a DEX test.
+type dexTestData struct {
+	Kind   string `json:"kind"`
+	Host   string `json:"host,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Method string `json:"method,omitempty"`
+}
+
+// dexTestIntervalMinutesRegex matches intervals returned as a bare number of
+// minutes by some API responses.
+var dexTestIntervalMinutesRegex = regexp.MustCompile(`^\d+$`)
+
+func resourceCloudflareDEXTest() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareDEXTestSchema(),
+		CreateContext: resourceCloudflareDEXTestCreate,
+		ReadContext:   resourceCloudflareDEXTestRead,
+		UpdateContext: resourceCloudflareDEXTestUpdate,
+		DeleteContext: resourceCloudflareDEXTestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDEXTestImport,
+		},
+		CustomizeDiff: resourceCloudflareDEXTestValidateData,
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Device Digital Experience Monitoring (DEX)
+			test resource. DEX tests are synthetic HTTP or traceroute tests
+			run from WARP clients to measure connectivity to an application.
+		`),
+	}
+}
+
+func resourceCloudflareDEXTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Cloudflare Device DEX Test for Id: %+v", d.Id()))
+
+	test, err := getDEXTest(ctx, client, accountID, d.Id())
+
+	var notFoundError *cloudflare.NotFoundError
+	if errors.As(err, &notFoundError) {
+		tflog.Info(ctx, fmt.Sprintf("Device DEX Test %s no longer exists", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Device DEX Test: %w", err))
+	}
+
+	d.Set("name", test.Name)
+	d.Set("description", test.Description)
+	d.Set("enabled", test.Enabled)
+	d.Set("interval", flattenDEXTestInterval(d.Get("interval").(string), test.Interval))
+	d.Set("data", []interface{}{map[string]interface{}{
+		"kind":   test.Data.Kind,
+		"host":   test.Data.Host,
+		"url":    test.Data.URL,
+		"method": test.Data.Method,
+	}})
+	d.Set("updated", test.Updated)
+	d.Set("created", test.Created)
+
+	return nil
+}
+
+func resourceCloudflareDEXTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	test := buildDEXTest(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device DEX Test with params: %+v", test))
+
+	created, err := writeDEXTest(ctx, client, accountID, http.MethodPost, test)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Device DEX Test with provided config: %w", err))
+	}
+
+	d.SetId(created.TestID)
+
+	return resourceCloudflareDEXTestRead(ctx, d, meta)
+}
+
+func resourceCloudflareDEXTestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	test := buildDEXTest(d)
+	test.TestID = d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Device DEX Test with params: %+v", test))
+
+	if _, err := writeDEXTest(ctx, client, accountID, http.MethodPut, test); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Device DEX Test for ID %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareDEXTestRead(ctx, d, meta)
+}
+
+// resourceCloudflareDEXTestDelete removes the test. Tests that are still
+// referenced by a DEX dashboard view can't be deleted by the API, so they are
+// disabled to stop them running on devices and removed from state with a
+// warning instead.
+func resourceCloudflareDEXTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Device DEX Test using ID: %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/dex/devices/dex_tests/%s", accountID, d.Id()), nil, nil)
+	if err == nil {
+		return nil
+	}
+
+	var notFoundError *cloudflare.NotFoundError
+	if errors.As(err, &notFoundError) {
+		return nil
+	}
+
+	if isDEXTestReferencedError(err) {
+		detail := fmt.Sprintf("The test has been disabled and removed from the Terraform state. Remove it from the DEX dashboard view to delete it: %s", err)
+
+		test := buildDEXTest(d)
+		test.TestID = d.Id()
+		test.Enabled = false
+		if _, disableErr := writeDEXTest(ctx, client, accountID, http.MethodPut, test); disableErr != nil {
+			detail = fmt.Sprintf("The test has been removed from the Terraform state but couldn't be disabled (%s) and may still run on devices. Remove it from the DEX dashboard view to delete it: %s", disableErr, err)
+		}
+
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Device DEX Test %q is referenced by a dashboard view and can't be deleted", d.Id()),
+			Detail:   detail,
+		}}
+	}
+
+	return diag.FromErr(fmt.Errorf("error deleting Device DEX Test for ID %q: %w", d.Id(), err))
+}
+
+func resourceCloudflareDEXTestImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID, testID, err := parseDEXTestIDImport(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Device DEX Test: id %s for account %s", testID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(testID)
+
+	resourceCloudflareDEXTestRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareDEXTestValidateData rejects a request method or URL on
+// traceroute tests at plan time.
+func resourceCloudflareDEXTestValidateData(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("data.0.kind").(string) == "traceroute" && d.Get("data.0.method").(string) != "" && d.HasChange("data.0.method") {
+		return fmt.Errorf("data.0.method is only applicable to http tests")
+	}
+
+	if d.Get("data.0.kind").(string) == "traceroute" && d.Get("data.0.url").(string) != "" {
+		return fmt.Errorf("data.0.url is only applicable to http tests, use data.0.host instead")
+	}
+
+	return nil
+}
+
+// parseDEXTestIDImport accepts both the "account/accountID/testID" and the
+// "accountID/testID" import formats.
+func parseDEXTestIDImport(id string) (string, string, error) {
+	attributes := strings.Split(id, "/")
+
+	switch {
+	case len(attributes) == 3 && attributes[0] == "account" && attributes[1] != "" && attributes[2] != "":
+		return attributes[1], attributes[2], nil
+	case len(attributes) == 2 && attributes[0] != "" && attributes[1] != "":
+		return attributes[0], attributes[1], nil
+	}
+
+	return "", "", fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/testID\"", id)
+}
+
+func buildDEXTest(d *schema.ResourceData) dexTest {
+	test := dexTest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Interval:    d.Get("interval").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Data: dexTestData{
+			Kind: d.Get("data.0.kind").(string),
+			Host: d.Get("data.0.host").(string),
+			URL:  d.Get("data.0.url").(string),
+		},
+	}
+
+	if test.Data.Kind == "http" {
+		test.Data.Method = d.Get("data.0.method").(string)
+		if test.Data.Method == "" {
+			test.Data.Method = http.MethodGet
+		}
+	}
+
+	return test
+}
+
+// parseDEXTestInterval parses an interval either as a duration or as a bare
+// number of minutes.
+func parseDEXTestInterval(interval string) (time.Duration, error) {
+	if dexTestIntervalMinutesRegex.MatchString(interval) {
+		interval += "m"
+	}
+
+	return time.ParseDuration(interval)
+}
+
+// flattenDEXTestInterval returns the interval to store in state, keeping the
+// configured representation when it is equivalent to the API value.
+func flattenDEXTestInterval(current, remote string) string {
+	remoteDuration, err := parseDEXTestInterval(remote)
+	if err != nil {
+		return remote
+	}
+
+	if currentDuration, err := parseDEXTestInterval(current); err == nil && currentDuration == remoteDuration {
+		return current
+	}
+
+	if dexTestIntervalMinutesRegex.MatchString(remote) {
+		return remote + "m"
+	}
+
+	return remote
+}
+
+func validateDEXTestInterval(v interface{}, k string) (warnings []string, errors []error) {
+	interval, err := parseDEXTestInterval(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as \"30m\" or \"1h\": %w", k, err))
+		return
+	}
+
+	if interval <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration", k))
+	}
+
+	return
+}
+
+func suppressEquivalentDEXTestInterval(k, old, new string, d *schema.ResourceData) bool {
+	oldInterval, err := parseDEXTestInterval(old)
+	if err != nil {
+		return false
+	}
+
+	newInterval, err := parseDEXTestInterval(new)
+	if err != nil {
+		return false
+	}
+
+	return oldInterval == newInterval
+}
+
+// isDEXTestReferencedError reports whether the API refused to delete a test
+// because a dashboard view still uses it.
+func isDEXTestReferencedError(err error) bool {
+	var requestError *cloudflare.RequestError
+	return errors.As(err, &requestError) && requestError.InternalErrorCodeIs(dexTestReferencedErrorCode)
+}
+
+func getDEXTest(ctx context.Context, client *cloudflare.API, accountID, testID string) (dexTest, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/dex/devices/dex_tests/%s", accountID, testID), nil, nil)
+	if err != nil {
+		return dexTest{}, err
+	}
+
+	var test dexTest
+	if err := json.Unmarshal(res, &test); err != nil {
+		return dexTest{}, fmt.Errorf("error unmarshalling Device DEX Test: %w", err)
+	}
+
+	return test, nil
+}
+
+func writeDEXTest(ctx context.Context, client *cloudflare.API, accountID, method string, test dexTest) (dexTest, error) {
+	uri := fmt.Sprintf("/accounts/%s/dex/devices/dex_tests", accountID)
+	if method != http.MethodPost {
+		uri = fmt.Sprintf("%s/%s", uri, test.TestID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, test, nil)
+	if err != nil {
+		return dexTest{}, err
+	}
+
+	var written dexTest
+	if err := json.Unmarshal(res, &written); err != nil {
+		return dexTest{}, fmt.Errorf("error unmarshalling Device DEX Test: %w", err)
+	}
+
+	return written, nil
+}
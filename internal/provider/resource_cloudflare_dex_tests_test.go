@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareDEXTest_HTTP(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dex_test.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDEXTestHTTP(accountID, rnd, "30m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "description", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "interval", "30m"),
+					resource.TestCheckResourceAttr(name, "data.0.kind", "http"),
+					resource.TestCheckResourceAttr(name, "data.0.host", "https://dash.cloudflare.com"),
+					resource.TestCheckResourceAttr(name, "data.0.method", "GET"),
+				),
+			},
+			{
+				Config: testAccCloudflareDEXTestHTTP(accountID, rnd, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "interval", "1h"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("account/%s/", accountID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"interval"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDEXTest_Traceroute(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dex_test.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDEXTestTraceroute(accountID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "data.0.kind", "traceroute"),
+					resource.TestCheckResourceAttr(name, "data.0.host", "1.1.1.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDEXTest_TracerouteMethod(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareDEXTestTracerouteMethod(accountID, rnd),
+				ExpectError: regexp.MustCompile("data.0.method is only applicable to http tests"),
+			},
+		},
+	})
+}
+
+func TestFlattenDEXTestInterval(t *testing.T) {
+	testCases := map[string]struct {
+		current  string
+		remote   string
+		expected string
+	}{
+		"identical":                 {current: "30m", remote: "30m", expected: "30m"},
+		"minutes as string":         {current: "30m", remote: "30", expected: "30m"},
+		"equivalent duration":       {current: "1h", remote: "0h60m0s", expected: "1h"},
+		"changed remotely":          {current: "30m", remote: "15", expected: "15m"},
+		"import without state":      {current: "", remote: "45", expected: "45m"},
+		"unparseable remote value":  {current: "30m", remote: "weekly", expected: "weekly"},
+		"changed remotely duration": {current: "30m", remote: "1h0m0s", expected: "1h0m0s"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := flattenDEXTestInterval(tc.current, tc.remote); got != tc.expected {
+				t.Errorf("expected interval %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudflareDEXTestDeleteReferencedByView(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const testID = "372e67954025e0ba6aaa6d586b9e0b59"
+
+	testCases := map[string]struct {
+		code     int
+		message  string
+		severity diag.Severity
+		expected string
+		disabled bool
+	}{
+		"referenced by a view": {code: dexTestReferencedErrorCode, message: "test is used by view \"Home office\"", severity: diag.Warning, expected: "is referenced by a dashboard view and can't be deleted", disabled: true},
+		"other error":          {code: 1000, message: "invalid view of the test", severity: diag.Error, expected: "error deleting Device DEX Test"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var disabled map[string]interface{}
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/accounts/"+accountID+"/dex/devices/dex_tests/"+testID {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				switch r.Method {
+				case http.MethodDelete:
+					testAPIError(w, http.StatusBadRequest, tc.code, tc.message)
+				case http.MethodPut:
+					if err := json.NewDecoder(r.Body).Decode(&disabled); err != nil {
+						t.Fatal(err)
+					}
+					testAPIResult(w, `{"test_id": "`+testID+`", "enabled": false}`)
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareDEXTestSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"name":       "example",
+				"interval":   "30m",
+				"data":       []interface{}{map[string]interface{}{"kind": "http", "url": "https://example.com"}},
+			})
+			d.SetId(testID)
+
+			diags := resourceCloudflareDEXTestDelete(context.Background(), d, client)
+			if len(diags) != 1 || diags[0].Severity != tc.severity || !strings.Contains(diags[0].Summary, tc.expected) {
+				t.Fatalf("expected a diagnostic containing %q, got %v", tc.expected, diags)
+			}
+			if tc.disabled {
+				data, _ := disabled["data"].(map[string]interface{})
+				if disabled["enabled"] != false || data["url"] != "https://example.com" {
+					t.Errorf("expected the test to be disabled, got %v", disabled)
+				}
+				if !strings.Contains(diags[0].Detail, "Home office") {
+					t.Errorf("expected the warning to name the view, got %q", diags[0].Detail)
+				}
+			}
+		})
+	}
+}
+
+func testAccCloudflareDEXTestHTTP(accountID, rnd, interval string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dex_test" "%[2]s" {
+  account_id  = "%[1]s"
+  name        = "%[2]s"
+  description = "%[2]s"
+  interval    = "%[3]s"
+  enabled     = true
+
+  data {
+    kind   = "http"
+    host   = "https://dash.cloudflare.com"
+    method = "GET"
+  }
+}
+`, accountID, rnd, interval)
+}
+
+func testAccCloudflareDEXTestTraceroute(accountID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dex_test" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  interval   = "30m"
+  enabled    = false
+
+  data {
+    kind = "traceroute"
+    host = "1.1.1.1"
+  }
+}
+`, accountID, rnd)
+}
+
+func testAccCloudflareDEXTestTracerouteMethod(accountID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dex_test" "%[2]s" {
+  account_id = "%[1]s"
+  name       = "%[2]s"
+  interval   = "30m"
+
+  data {
+    kind   = "traceroute"
+    host   = "1.1.1.1"
+    method = "GET"
+  }
+}
+`, accountID, rnd)
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -14,6 +16,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// dlpProfileConfig extends the DLP profile with the fields that aren't
+// available in cloudflare-go yet.
+type dlpProfileConfig struct {
+	cloudflare.DLPProfile
+	AllowedMatchCount int `json:"allowed_match_count"`
+}
+
 func resourceCloudflareDLPProfile() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareDLPProfileSchema(),
@@ -88,8 +97,8 @@ func dlpEntryToAPI(entryType string, entryMap map[string]interface{}) cloudflare
 func resourceCloudflareDLPProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	identifier := cloudflare.AccountIdentifier(d.Get("account_id").(string))
-	dlpProfile, err := client.GetDLPProfile(ctx, identifier, d.Id())
+	accountID := d.Get("account_id").(string)
+	dlpProfile, err := getDLPProfile(ctx, client, accountID, d.Id())
 	var notFoundError *cloudflare.NotFoundError
 	if errors.As(err, &notFoundError) {
 		tflog.Info(ctx, fmt.Sprintf("DLP Profile %s no longer exists", d.Id()))
@@ -105,6 +114,10 @@ func resourceCloudflareDLPProfileRead(ctx context.Context, d *schema.ResourceDat
 	if dlpProfile.Description != "" {
 		d.Set("description", dlpProfile.Description)
 	}
+	d.Set("allowed_match_count", dlpProfile.AllowedMatchCount)
+	if dlpProfile.Type == DLPProfileTypePredefined {
+		d.Set("profile_id", dlpProfile.ID)
+	}
 	entries := make([]interface{}, 0, len(dlpProfile.Entries))
 	for _, entry := range dlpProfile.Entries {
 		entries = append(entries, dlpEntryToSchema(entry))
@@ -118,16 +131,42 @@ func resourceCloudflareDLPProfileRead(ctx context.Context, d *schema.ResourceDat
 
 func resourceCloudflareDLPProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
-	identifier := cloudflare.AccountIdentifier(d.Get("account_id").(string))
+	accountID := d.Get("account_id").(string)
 
-	newDLPProfile := cloudflare.DLPProfile{
-		Name:        d.Get("name").(string),
-		Type:        d.Get("type").(string),
-		Description: d.Get("description").(string),
+	newDLPProfile := dlpProfileConfig{
+		DLPProfile: cloudflare.DLPProfile{
+			Name:        d.Get("name").(string),
+			Type:        d.Get("type").(string),
+			Description: d.Get("description").(string),
+		},
+		AllowedMatchCount: d.Get("allowed_match_count").(int),
 	}
 
+	// Predefined profiles always exist for an account, "creating" one
+	// adopts the existing profile and applies the configured entries.
+	profileID := d.Get("profile_id").(string)
 	if newDLPProfile.Type == DLPProfileTypePredefined {
-		return diag.FromErr(fmt.Errorf("predefined DLP profiles cannot be created and must be imported"))
+		if profileID == "" {
+			return diag.FromErr(fmt.Errorf("profile_id must be set to the ID of the predefined DLP Profile %q", newDLPProfile.Name))
+		}
+
+		profile, err := getDLPProfile(ctx, client, accountID, profileID)
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("no predefined DLP Profile with ID %q", profileID))
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading DLP profile for ID %q: %w", profileID, err))
+		}
+		if profile.Type != DLPProfileTypePredefined {
+			return diag.FromErr(fmt.Errorf("DLP Profile %q is a %s profile, not a predefined one", profileID, profile.Type))
+		}
+
+		d.SetId(profile.ID)
+		return resourceCloudflareDLPProfileUpdate(ctx, d, meta)
+	}
+	if profileID != "" {
+		return diag.FromErr(fmt.Errorf("profile_id can only be set for predefined DLP Profiles"))
 	}
 
 	if entries, ok := d.GetOk("entry"); ok {
@@ -136,10 +175,9 @@ func resourceCloudflareDLPProfileCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	dlpProfiles, err := client.CreateDLPProfiles(ctx, identifier, cloudflare.CreateDLPProfilesParams{
-		Profiles: []cloudflare.DLPProfile{newDLPProfile},
-		Type:     newDLPProfile.Type,
-	})
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare DLP Profile from struct: %+v", newDLPProfile))
+
+	dlpProfiles, err := createDLPProfiles(ctx, client, accountID, newDLPProfile)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating DLP Profile for name %s: %w", newDLPProfile.Name, err))
 	}
@@ -154,26 +192,44 @@ func resourceCloudflareDLPProfileCreate(ctx context.Context, d *schema.ResourceD
 func resourceCloudflareDLPProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	updatedDLPProfile := cloudflare.DLPProfile{
-		ID:   d.Id(),
-		Name: d.Get("name").(string),
-		Type: d.Get("type").(string),
+	updatedDLPProfile := dlpProfileConfig{
+		DLPProfile: cloudflare.DLPProfile{
+			ID:   d.Id(),
+			Name: d.Get("name").(string),
+			Type: d.Get("type").(string),
+		},
+		AllowedMatchCount: d.Get("allowed_match_count").(int),
 	}
 	updatedDLPProfile.Description, _ = d.Get("description").(string)
+
+	// Changing any attribute of an entry replaces its element in the set and
+	// drops the computed ID, and entries of predefined profiles are only
+	// known by name, so configured entries are matched to the existing ones
+	// by name to update them in place rather than recreating them.
+	accountID := d.Get("account_id").(string)
+	existingDLPProfile, err := getDLPProfile(ctx, client, accountID, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading DLP profile for ID %q: %w", d.Id(), err))
+	}
+
+	entryIDs := make(map[string]string)
+	for _, entry := range existingDLPProfile.Entries {
+		entryIDs[entry.Name] = entry.ID
+	}
+
 	if entries, ok := d.GetOk("entry"); ok {
 		for _, entry := range entries.(*schema.Set).List() {
-			updatedDLPProfile.Entries = append(updatedDLPProfile.Entries, dlpEntryToAPI(updatedDLPProfile.Type, entry.(map[string]interface{})))
+			apiEntry := dlpEntryToAPI(updatedDLPProfile.Type, entry.(map[string]interface{}))
+			if apiEntry.ID == "" {
+				apiEntry.ID = entryIDs[apiEntry.Name]
+			}
+			updatedDLPProfile.Entries = append(updatedDLPProfile.Entries, apiEntry)
 		}
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare DLP Profile from struct: %+v", updatedDLPProfile))
 
-	identifier := cloudflare.AccountIdentifier(d.Get("account_id").(string))
-	dlpProfile, err := client.UpdateDLPProfile(ctx, identifier, cloudflare.UpdateDLPProfileParams{
-		ProfileID: updatedDLPProfile.ID,
-		Profile:   updatedDLPProfile,
-		Type:      updatedDLPProfile.Type,
-	})
+	dlpProfile, err := updateDLPProfile(ctx, client, accountID, updatedDLPProfile)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating DLP profile for ID %q: %w", d.Id(), err))
 	}
@@ -189,9 +245,14 @@ func resourceCloudflareDLPProfileDelete(ctx context.Context, d *schema.ResourceD
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare DLP Profile using ID: %s", d.Id()))
 
 	profileType, _ := d.Get("type").(string)
-	if profileType != DLPProfileTypeCustom {
-		return diag.FromErr(fmt.Errorf("error deleting DLP Profile: can only delete custom profiles"))
+	if profileType == DLPProfileTypePredefined {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Predefined DLP Profiles cannot be deleted",
+			Detail:   fmt.Sprintf("DLP Profile %q has been removed from the Terraform state but its entries remain configured in Cloudflare.", d.Id()),
+		}}
 	}
+
 	identifier := cloudflare.AccountIdentifier(d.Get("account_id").(string))
 	if err := client.DeleteDLPProfile(ctx, identifier, d.Id()); err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting DLP Profile for ID %q: %w", d.Id(), err))
@@ -220,3 +281,52 @@ func resourceCloudflareDLPProfileImport(ctx context.Context, d *schema.ResourceD
 	resourceCloudflareDLPProfileRead(ctx, d, meta)
 	return []*schema.ResourceData{d}, nil
 }
+
+func getDLPProfile(ctx context.Context, client *cloudflare.API, accountID, profileID string) (dlpProfileConfig, error) {
+	var profile dlpProfileConfig
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/dlp/profiles/%s", accountID, profileID), nil, nil)
+	if err != nil {
+		return profile, err
+	}
+
+	if err := json.Unmarshal(res, &profile); err != nil {
+		return profile, fmt.Errorf("error unmarshalling DLP Profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+func createDLPProfiles(ctx context.Context, client *cloudflare.API, accountID string, profiles ...dlpProfileConfig) ([]dlpProfileConfig, error) {
+	var result []dlpProfileConfig
+
+	body := struct {
+		Profiles []dlpProfileConfig `json:"profiles"`
+	}{Profiles: profiles}
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/dlp/profiles/%s", accountID, DLPProfileTypeCustom), body, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling DLP Profiles: %w", err)
+	}
+
+	return result, nil
+}
+
+func updateDLPProfile(ctx context.Context, client *cloudflare.API, accountID string, profile dlpProfileConfig) (dlpProfileConfig, error) {
+	var result dlpProfileConfig
+
+	res, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/dlp/profiles/%s/%s", accountID, profile.Type, profile.ID), profile, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling DLP Profile: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareDLPProfile_Custom(t *testing.T) {
@@ -117,3 +121,109 @@ resource "cloudflare_dlp_profile" "%[1]s" {
 }
 `, rnd, description, accountID)
 }
+
+func TestAccCloudflareDLPProfile_Custom_UpdateEntry(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_dlp_profile.%s", rnd)
+	var entryID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDLPProfileConfigCustomWithAllowedMatchCount(accountID, rnd, "^4[0-9]", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "allowed_match_count", "0"),
+					resource.TestCheckResourceAttr(name, "entry.0.pattern.0.regex", "^4[0-9]"),
+					testAccCaptureResourceAttr(name, "entry.0.id", &entryID),
+				),
+			},
+			{
+				Config: testAccCloudflareDLPProfileConfigCustomWithAllowedMatchCount(accountID, rnd, "^4[0-9]{3}", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "allowed_match_count", "5"),
+					resource.TestCheckResourceAttr(name, "entry.#", "1"),
+					resource.TestCheckResourceAttr(name, "entry.0.pattern.0.regex", "^4[0-9]{3}"),
+					resource.TestCheckResourceAttrPtr(name, "entry.0.id", &entryID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDLPProfileConfigCustomWithAllowedMatchCount(accountID, rnd, regex string, allowedMatchCount int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dlp_profile" "%[1]s" {
+  account_id          = "%[2]s"
+  name                = "%[1]s"
+  type                = "custom"
+  allowed_match_count = %[4]d
+  entry {
+	name = "%[1]s_entry1"
+	enabled = true
+	pattern {
+		regex = "%[3]s"
+		validation = "luhn"
+	}
+  }
+}
+`, rnd, accountID, regex, allowedMatchCount)
+}
+
+func TestCloudflareDLPProfileCreateAdoptsPredefinedProfileByID(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const profileID = "c8932cc4-3312-4152-8041-f3f257122dc4"
+	const profile = `{
+  "id": "` + profileID + `",
+  "name": "Credit Cards",
+  "type": "predefined",
+  "allowed_match_count": 0,
+  "entries": [
+    {"id": "d8fcfc9c-773c-405e-8426-21ecbb67ba93", "name": "Mastercard Card Number", "enabled": true},
+    {"id": "5c1ad2bf-c34a-4cd2-8b53-d87eb9e3e4b8", "name": "Union Pay Card Number", "enabled": false}
+  ]
+}`
+
+	var sent dlpProfileConfig
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/"+accountID+"/dlp/profiles/"+profileID:
+			testAPIResult(w, profile)
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/"+accountID+"/dlp/profiles/predefined/"+profileID:
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, profile)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareDLPProfileSchema(), map[string]interface{}{
+		"account_id": accountID,
+		"name":       "Credit Cards",
+		"type":       "predefined",
+		"profile_id": profileID,
+		"entry": []interface{}{
+			map[string]interface{}{"name": "Mastercard Card Number", "enabled": true},
+		},
+	})
+
+	if diags := resourceCloudflareDLPProfileCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != profileID {
+		t.Errorf("expected the predefined profile %q to be adopted, got %q", profileID, d.Id())
+	}
+	if len(sent.Entries) != 1 || sent.Entries[0].ID != "d8fcfc9c-773c-405e-8426-21ecbb67ba93" {
+		t.Errorf("expected the configured entry to be matched to the existing one, got %+v", sent.Entries)
+	}
+	if got := d.Get("profile_id").(string); got != profileID {
+		t.Errorf("expected profile_id to be %q, got %q", profileID, got)
+	}
+}
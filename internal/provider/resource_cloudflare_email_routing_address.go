@@ -2,23 +2,36 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// emailRoutingAddressInUseErrorCode is the error code of the API when
+// deleting a destination address which is used by routing rules.
+const emailRoutingAddressInUseErrorCode = 2020
+
 func resourceCloudflareEmailRoutingAddress() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareEmailRoutingAddressSchema(),
 		ReadContext:   resourceCloudflareEmailRoutingAddressRead,
 		CreateContext: resourceCloudflareEmailRoutingAddressCreate,
 		DeleteContext: resourceCloudflareEmailRoutingAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingAddressImport,
+		},
 		Description: heredoc.Doc(`
-			Provides a resource for managing Email Routing Addresses.
+			Provides a resource for managing Email Routing Addresses. Creating
+			the resource sends a verification email to the address, which
+			can be used in routing rules once it has been verified.
 		`),
 	}
 }
@@ -28,12 +41,18 @@ func resourceCloudflareEmailRoutingAddressRead(ctx context.Context, d *schema.Re
 	accountID := d.Get("account_id").(string)
 
 	res, err := client.GetEmailRoutingDestinationAddress(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
-
 	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Email routing destination address %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(fmt.Errorf("error getting email routing destination address %q: %w", d.Id(), err))
 	}
 
 	d.SetId(res.Tag)
+	d.Set("tag", res.Tag)
 	d.Set("email", res.Email)
 	if res.Verified != nil {
 		d.Set("verified", res.Verified.Format(time.RFC3339Nano))
@@ -64,10 +83,35 @@ func resourceCloudflareEmailRoutingAddressDelete(ctx context.Context, d *schema.
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	_, err := client.DeleteEmailRoutingDestinationAddress(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	// The client replaces errors deleting addresses with a missing account
+	// error, which hides why the API rejected the request.
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/email/routing/addresses/%s", accountID, d.Id()), nil, nil)
 	if err != nil {
+		var requestError *cloudflare.RequestError
+		if errors.As(err, &requestError) && requestError.InternalErrorCodeIs(emailRoutingAddressInUseErrorCode) {
+			return diag.Diagnostics{diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Email routing destination address %q can't be deleted", d.Get("email").(string)),
+				Detail:   fmt.Sprintf("Destination addresses which are used by routing rules or the catch all rule can't be deleted. Remove the address from the rules before destroying the resource: %s", err),
+			}}
+		}
 		return diag.FromErr(fmt.Errorf("error deleting email routing destination address %q, %w", d.Id(), err))
 	}
 
 	return nil
 }
+
+func resourceCloudflareEmailRoutingAddressImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/destinationAddressIdentifier\"", d.Id())
+	}
+	accountID, addressID := attributes[0], attributes[1]
+
+	d.SetId(addressID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareEmailRoutingAddressRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
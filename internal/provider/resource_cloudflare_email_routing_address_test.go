@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func testEmailRoutingAddressConfig(resourceID, accountID, email string) string {
@@ -33,6 +37,63 @@ func TestAccTestEmailRoutingAddress(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "account_id", accountID),
 				),
 			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
 		},
 	})
 }
+
+func TestCloudflareEmailRoutingAddressDelete(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	testCases := map[string]struct {
+		status  int
+		code    int
+		message string
+		summary string
+	}{
+		"in use": {
+			status:  http.StatusConflict,
+			code:    emailRoutingAddressInUseErrorCode,
+			message: "Destination address is in use by a rule",
+			summary: `Email routing destination address "user@example.com" can't be deleted`,
+		},
+		"other error": {
+			status:  http.StatusBadRequest,
+			code:    1000,
+			message: "Invalid request",
+			summary: `error deleting email routing destination address "ea95132c15732412d22c1476fa83f27a"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != "/accounts/"+accountID+"/email/routing/addresses/ea95132c15732412d22c1476fa83f27a" {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				testAPIError(w, tc.status, tc.code, tc.message)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareEmailRoutingAddressSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"email":      "user@example.com",
+			})
+			d.SetId("ea95132c15732412d22c1476fa83f27a")
+
+			diags := resourceCloudflareEmailRoutingAddressDelete(context.Background(), d, client)
+			if !diags.HasError() {
+				t.Fatal("expected an error deleting the address")
+			}
+			if !strings.Contains(diags[0].Summary, tc.summary) || !strings.Contains(diags[0].Summary+diags[0].Detail, tc.message) {
+				t.Errorf("expected the API error to be surfaced, got %q: %q", diags[0].Summary, diags[0].Detail)
+			}
+		})
+	}
+}
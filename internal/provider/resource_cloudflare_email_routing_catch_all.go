@@ -17,8 +17,13 @@ func resourceCloudflareEmailRoutingCatchAll() *schema.Resource {
 		CreateContext: resourceCloudflareEmailRoutingCatchAllUpdate,
 		UpdateContext: resourceCloudflareEmailRoutingCatchAllUpdate,
 		DeleteContext: resourceCloudflareEmailRoutingCatchAllDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareEmailRoutingCatchAllImport,
+		},
 		Description: heredoc.Doc(`
 			Provides a resource for managing Email Routing Addresses catch all behaviour.
+			Each zone has a single catch all rule which is disabled when the
+			resource is destroyed.
 		`),
 	}
 }
@@ -27,14 +32,32 @@ func resourceCloudflareEmailRoutingCatchAllRead(ctx context.Context, d *schema.R
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
 
-	res, err := client.GetEmailRoutingCatchAllRule(ctx, cloudflare.AccountIdentifier(zoneID))
+	res, err := client.GetEmailRoutingCatchAllRule(ctx, cloudflare.ZoneIdentifier(zoneID))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error reading email routing catch all rule %q: %w", d.Id(), err))
 	}
 
 	d.SetId(res.Tag)
+	d.Set("tag", res.Tag)
 	d.Set("name", res.Name)
-	d.Set("enabled", res.Enabled)
+	d.Set("enabled", cloudflare.Bool(res.Enabled))
+
+	var matchers []map[string]interface{}
+	for _, matcher := range res.Matchers {
+		matchers = append(matchers, map[string]interface{}{
+			"type": matcher.Type,
+		})
+	}
+	d.Set("matcher", matchers)
+
+	var actions []map[string]interface{}
+	for _, action := range res.Actions {
+		actions = append(actions, map[string]interface{}{
+			"type":  action.Type,
+			"value": action.Value,
+		})
+	}
+	d.Set("action", actions)
 
 	return nil
 }
@@ -70,8 +93,16 @@ func resourceCloudflareEmailRoutingCatchAllDelete(ctx context.Context, d *schema
 
 	_, err := client.UpdateEmailRoutingCatchAllRule(ctx, cloudflare.ZoneIdentifier(zoneID), deleteParams)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error delete email routing catch all rule %q: %w", d.Id(), err))
+		return diag.FromErr(fmt.Errorf("error disabling email routing catch all rule %q: %w", d.Id(), err))
 	}
 
 	return nil
 }
+
+func resourceCloudflareEmailRoutingCatchAllImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
+
+	resourceCloudflareEmailRoutingCatchAllRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
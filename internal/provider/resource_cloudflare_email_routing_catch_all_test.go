@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func testEmailRoutingRuleCatchAllConfig(resourceID, zoneID string, enabled bool) string {
@@ -50,6 +55,49 @@ func TestAccTestEmailRoutingCatchAll(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "action.0.value.0", "destinationaddress@example.net"),
 				),
 			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     zoneID,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
+
+func TestCloudflareEmailRoutingCatchAllDeleteDisablesRule(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	var updated cloudflare.EmailRoutingCatchAllRule
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/zones/"+zoneID+"/email/routing/rules/catch_all" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+			t.Fatal(err)
+		}
+		testAPIResult(w, `{"tag": "a7e6fb77503c41d8a7f3113c6918f10c", "enabled": false}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareEmailRoutingCatchAllSchema(), map[string]interface{}{
+		"zone_id": zoneID,
+		"name":    "catch all",
+		"enabled": true,
+		"matcher": []interface{}{map[string]interface{}{"type": "all"}},
+		"action":  []interface{}{map[string]interface{}{"type": "drop", "value": []interface{}{}}},
+	})
+	d.SetId("a7e6fb77503c41d8a7f3113c6918f10c")
+
+	if diags := resourceCloudflareEmailRoutingCatchAllDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if cloudflare.Bool(updated.Enabled) {
+		t.Error("expected the catch all rule to be disabled")
+	}
+	if len(updated.Matchers) != 1 || updated.Matchers[0].Type != "all" {
+		t.Errorf("expected the catch all matcher to be kept, got %v", updated.Matchers)
+	}
+}
@@ -33,7 +33,7 @@ func resourceCloudflareFallbackDomain() *schema.Resource {
 func resourceCloudflareFallbackDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
-	_, policyID := parseDevicePolicyID(d.Get("policy_id").(string))
+	policyID := devicePolicyIDFromAttribute(accountID, d.Get("policy_id").(string))
 
 	var domain []cloudflare.FallbackDomain
 	var err error
@@ -46,6 +46,8 @@ func resourceCloudflareFallbackDomainRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("error finding Fallback Domains: %w", err))
 	}
 
+	d.SetId(fallbackDomainID(accountID, policyID))
+
 	if err := d.Set("domains", flattenFallbackDomains(domain)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting domains attribute: %w", err))
 	}
@@ -56,23 +58,23 @@ func resourceCloudflareFallbackDomainRead(ctx context.Context, d *schema.Resourc
 func resourceCloudflareFallbackDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
-	_, policyID := parseDevicePolicyID(d.Get("policy_id").(string))
+	policyID := devicePolicyIDFromAttribute(accountID, d.Get("policy_id").(string))
 
 	domainList := expandFallbackDomains(d.Get("domains").(*schema.Set))
 
 	var newFallbackDomains []cloudflare.FallbackDomain
 	var err error
 	if policyID == "" {
-		d.SetId(accountID)
 		newFallbackDomains, err = client.UpdateFallbackDomain(ctx, accountID, domainList)
 	} else {
-		d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
 		newFallbackDomains, err = client.UpdateFallbackDomainDeviceSettingsPolicy(ctx, accountID, policyID, domainList)
 	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Fallback Domains: %w", err))
 	}
 
+	d.SetId(fallbackDomainID(accountID, policyID))
+
 	if err := d.Set("domains", flattenFallbackDomains(newFallbackDomains)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting domain attribute: %w", err))
 	}
@@ -83,7 +85,7 @@ func resourceCloudflareFallbackDomainUpdate(ctx context.Context, d *schema.Resou
 func resourceCloudflareFallbackDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
-	_, policyID := parseDevicePolicyID(d.Get("policy_id").(string))
+	policyID := devicePolicyIDFromAttribute(accountID, d.Get("policy_id").(string))
 
 	var err error
 	if policyID == "" {
@@ -100,29 +102,34 @@ func resourceCloudflareFallbackDomainDelete(ctx context.Context, d *schema.Resou
 }
 
 func resourceCloudflareFallbackDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	accountID, policyID, err := parseDeviceSettingsIDImport(d.Id())
+	attributes, err := parseDevicePolicyImportID(d.Id(), 2, "account/accountID/policyID")
 	if err != nil {
 		return nil, err
 	}
 
-	if accountID == "" {
-		return nil, fmt.Errorf("must provide account ID")
-	}
+	accountID, policyID := attributes[0], attributes[1]
 
 	d.Set("account_id", accountID)
-	if policyID == "default" {
-		d.Set("policy_id", accountID)
-		d.SetId(accountID)
-	} else {
+	if policyID != "default" {
 		d.Set("policy_id", fmt.Sprintf("%s/%s", accountID, policyID))
-		d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
 	}
+	d.SetId(fallbackDomainID(accountID, devicePolicyIDFromAttribute(accountID, policyID)))
 
 	resourceCloudflareFallbackDomainRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// fallbackDomainID builds the resource ID for the fallback domains of either
+// the default (empty policyID) or a custom settings policy.
+func fallbackDomainID(accountID, policyID string) string {
+	if policyID == "" {
+		return accountID
+	}
+
+	return fmt.Sprintf("%s/%s", accountID, policyID)
+}
+
 // flattenFallbackDomains accepts the cloudflare.FallbackDomain struct and returns the
 // schema representation for use in Terraform state.
 func flattenFallbackDomains(domains []cloudflare.FallbackDomain) *schema.Set {
@@ -166,3 +173,50 @@ func parseDevicePolicyID(id string) (string, string) {
 
 	return attributes[0], attributes[1]
 }
+
+// devicePolicyIDFromAttribute returns the settings policy targeted by a
+// `policy_id` attribute. Both the ID of a `cloudflare_device_settings_policy`
+// resource (`<accountTag>/<policyID>`) and a bare policy ID are accepted. An
+// empty string is returned when the default policy is targeted.
+func devicePolicyIDFromAttribute(accountID, value string) string {
+	if _, policyID := parseDevicePolicyID(value); policyID != "" {
+		value = policyID
+	}
+
+	if value == accountID || value == "default" {
+		return ""
+	}
+
+	return value
+}
+
+// suppressEquivalentDevicePolicyID suppresses the diff between the two forms
+// of `policy_id` accepted for the same settings policy so that imported
+// resources, which store `<accountTag>/<policyID>`, aren't replaced when the
+// configuration uses a bare policy ID.
+func suppressEquivalentDevicePolicyID(k, old, new string, d *schema.ResourceData) bool {
+	accountID := d.Get("account_id").(string)
+
+	return devicePolicyIDFromAttribute(accountID, old) == devicePolicyIDFromAttribute(accountID, new)
+}
+
+// parseDevicePolicyImportID splits an import ID in the format
+// `account/<accountTag>/<policyID>[/<segment>...]` into exactly `parts`
+// attributes. The `account/` prefix is optional to remain compatible with
+// previously documented import IDs. The default policy is imported using
+// `default` as the policy ID.
+func parseDevicePolicyImportID(id string, parts int, format string) ([]string, error) {
+	attributes := strings.Split(strings.TrimPrefix(id, "account/"), "/")
+
+	if len(attributes) != parts {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"%s\"", id, format)
+	}
+
+	for _, attribute := range attributes {
+		if attribute == "" {
+			return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"%s\"", id, format)
+		}
+	}
+
+	return attributes, nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -122,6 +124,94 @@ func TestAccCloudflareFallbackDomain_WithAttachedPolicy(t *testing.T) {
 	})
 }
 
+func TestDevicePolicyIDFromAttribute(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected string
+	}{
+		"unset":                  {value: "", expected: ""},
+		"account ID":             {value: "f037e56e89293a057740de681ac9abbe", expected: ""},
+		"default":                {value: "default", expected: ""},
+		"settings policy ID":     {value: "f037e56e89293a057740de681ac9abbe/0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60", expected: "0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60"},
+		"bare policy ID":         {value: "0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60", expected: "0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60"},
+		"default policy account": {value: "f037e56e89293a057740de681ac9abbe/default", expected: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := devicePolicyIDFromAttribute("f037e56e89293a057740de681ac9abbe", tc.value); got != tc.expected {
+				t.Errorf("expected policy ID %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudflareFallbackDomainPolicyIDForms(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const policyID = "0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60"
+
+	r := resourceCloudflareFallbackDomain()
+	config := func(policyID string) map[string]interface{} {
+		return map[string]interface{}{
+			"account_id": accountID,
+			"policy_id":  policyID,
+			"domains":    []interface{}{map[string]interface{}{"suffix": "example.com"}},
+		}
+	}
+
+	// Importing stores the `<accountTag>/<policyID>` form.
+	d := schema.TestResourceDataRaw(t, r.Schema, config(accountID+"/"+policyID))
+	d.SetId(fallbackDomainID(accountID, policyID))
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config(policyID)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Errorf("expected a bare policy ID not to replace the resource, got %#v", diff.Attributes["policy_id"])
+	}
+
+	diff, err = r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config("5b2d3ce8-2b1c-4a8e-8b55-3a2d1c0e9f71")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Error("expected another policy to replace the resource")
+	}
+}
+
+func TestParseDevicePolicyImportID(t *testing.T) {
+	testCases := map[string]struct {
+		id       string
+		parts    int
+		expected []string
+		err      bool
+	}{
+		"prefixed":             {id: "account/f037e56e89293a057740de681ac9abbe/default/exclude", parts: 3, expected: []string{"f037e56e89293a057740de681ac9abbe", "default", "exclude"}},
+		"legacy":               {id: "f037e56e89293a057740de681ac9abbe/0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60", parts: 2, expected: []string{"f037e56e89293a057740de681ac9abbe", "0f9a5c3e-1d2b-4c6a-9a2e-7d1b3c4e5f60"}},
+		"missing mode":         {id: "account/f037e56e89293a057740de681ac9abbe/default", parts: 3, err: true},
+		"empty policy segment": {id: "account/f037e56e89293a057740de681ac9abbe//include", parts: 3, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseDevicePolicyImportID(tc.id, tc.parts, "account/accountID/policyID/mode")
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error parsing %q, got %v", tc.id, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
 func testAccCloudflareDefaultFallbackDomain(rnd, accountID string, description string, suffix string, dns_server string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_fallback_domain" "%[1]s" {
@@ -28,6 +28,7 @@ func resourceCloudflareFilter() *schema.Resource {
 			e.g. Firewall Rules. See [what is a filter](https://developers.cloudflare.com/firewall/api/cf-filters/what-is-a-filter/)
 			for more details and available fields and operators.
 		`),
+		DeprecationMessage: "This resource is deprecated as Firewall Rules are migrated to custom rules, use the `cloudflare_ruleset` resource in the `http_request_firewall_custom` phase instead: " + firewallRulesMigrationGuideURL + ".",
 	}
 }
 
@@ -101,7 +102,12 @@ func resourceCloudflareFilterRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("expression", filter.Expression)
 	d.Set("ref", filter.Ref)
 
-	return nil
+	rs := getMigratedFirewallRules(ctx, client, zoneID)
+	if len(rs.Rules) == 0 {
+		return nil
+	}
+
+	return filterMigrationWarning(rs, d.Id(), getFirewallRulesUsingFilter(ctx, client, zoneID, d.Id()))
 }
 
 func resourceCloudflareFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -3,12 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func init() {
@@ -138,3 +142,59 @@ func testFilterWithHTMLEntityConfig(resourceID, zoneID, paused, description, exp
 		}
 		`, resourceID, zoneID, paused, description, expression)
 }
+
+func TestCloudflareFilterReadWarnsAboutMigration(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const migratedFilterID = "d6a5e8a0c8faee2b2487108b4fe6c1d5"
+	const otherFilterID = "b7ff25282d394be7b945e23c7106ce8a"
+
+	requests := make(map[string]int)
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/zones/" + zoneID + "/filters/" + migratedFilterID:
+			testAPIResult(w, `{"id": "`+migratedFilterID+`", "expression": "(ip.src eq 192.0.2.1)"}`)
+		case "/zones/" + zoneID + "/filters/" + otherFilterID:
+			testAPIResult(w, `{"id": "`+otherFilterID+`", "expression": "(ip.src eq 192.0.2.2)"}`)
+		case "/zones/" + zoneID + "/rulesets/phases/http_request_firewall_custom/entrypoint":
+			// The migrated rule was edited since, so its expression no longer
+			// matches the one of the filter.
+			testAPIResult(w, `{"id": "4814384a9e5d4991b9815dcfc25d2f1f", "phase": "http_request_firewall_custom", "rules": [{"id": "6c3a2b6f5d1f4b7c9e0a1b2c3d4e5f60", "ref": "372e67954025e0ba6aaa6d586b9e0b60", "action": "block", "expression": "(ip.src in {192.0.2.1 192.0.2.2})"}]}`)
+		case "/zones/" + zoneID + "/firewall/rules":
+			testAPIResultWithInfo(w, `[{"id": "372e67954025e0ba6aaa6d586b9e0b60", "action": "block", "filter": {"id": "`+migratedFilterID+`"}}, {"id": "f2d427378e7542acb295380d352e2ebd", "action": "block", "filter": {"id": "`+otherFilterID+`"}}]`, `{"page": 1, "per_page": 50, "count": 2, "total_count": 2, "total_pages": 1}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	read := func(filterID string) diag.Diagnostics {
+		d := schema.TestResourceDataRaw(t, resourceCloudflareFilterSchema(), map[string]interface{}{
+			"zone_id": zoneID,
+		})
+		d.SetId(filterID)
+
+		diags := resourceCloudflareFilterRead(context.Background(), d, client)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return diags
+	}
+
+	if diags := read(migratedFilterID); len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "6c3a2b6f5d1f4b7c9e0a1b2c3d4e5f60") {
+		t.Errorf("expected a warning naming the migrated rule, got %v", diags)
+	}
+	if diags := read(otherFilterID); len(diags) != 0 {
+		t.Errorf("expected no warnings for a filter not used by a migrated rule, got %v", diags)
+	}
+
+	for _, path := range []string{
+		"/zones/" + zoneID + "/rulesets/phases/http_request_firewall_custom/entrypoint",
+		"/zones/" + zoneID + "/firewall/rules",
+	} {
+		if requests[path] != 1 {
+			t.Errorf("expected %s to be requested once, got %d", path, requests[path])
+		}
+	}
+}
@@ -32,6 +32,7 @@ func resourceCloudflareFirewallRule() *schema.Resource {
 			Filter expressions needs to be created first before using Firewall
 			Rule.
 		`),
+		DeprecationMessage: "This resource is deprecated as Firewall Rules are migrated to custom rules, use the `cloudflare_ruleset` resource in the `http_request_firewall_custom` phase instead: " + firewallRulesMigrationGuideURL + ".",
 	}
 }
 
@@ -119,7 +120,7 @@ func resourceCloudflareFirewallRuleRead(ctx context.Context, d *schema.ResourceD
 	d.Set("filter_id", firewallRule.Filter.ID)
 	d.Set("products", products)
 
-	return nil
+	return firewallRuleMigrationWarning(getMigratedFirewallRules(ctx, client, zoneID), zoneID, d.Id())
 }
 
 func resourceCloudflareFirewallRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -3,12 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func init() {
@@ -179,3 +183,63 @@ func testAccFirewallRuleWithUnicodeAndHTMLEntityConfig(resourceID, zoneID, pause
 		}
 		`, resourceID, zoneID, paused, description, expression, action, priority)
 }
+
+func TestCloudflareFirewallRuleReadWarnsAboutMigration(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const firewallRuleID = "372e67954025e0ba6aaa6d586b9e0b60"
+
+	testCases := map[string]struct {
+		entrypoint string
+		warning    bool
+	}{
+		"migrated": {
+			entrypoint: `{"id": "4814384a9e5d4991b9815dcfc25d2f1f", "phase": "http_request_firewall_custom", "rules": [{"id": "6c3a2b6f5d1f4b7c9e0a1b2c3d4e5f60", "ref": "` + firewallRuleID + `", "action": "block", "expression": "(ip.src eq 192.0.2.1)"}]}`,
+			warning:    true,
+		},
+		"not migrated": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/zones/" + zoneID + "/firewall/rules/" + firewallRuleID:
+					testAPIResult(w, `{"id": "`+firewallRuleID+`", "action": "block", "priority": 1, "filter": {"id": "d6a5e8a0c8faee2b2487108b4fe6c1d5", "expression": "(ip.src eq 192.0.2.1)"}}`)
+				case "/zones/" + zoneID + "/rulesets/phases/http_request_firewall_custom/entrypoint":
+					if !tc.warning {
+						testAPIError(w, http.StatusNotFound, 10003, "could not find entrypoint ruleset in the http_request_firewall_custom phase")
+						return
+					}
+					testAPIResult(w, tc.entrypoint)
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareFirewallRuleSchema(), map[string]interface{}{
+				"zone_id": zoneID,
+			})
+			d.SetId(firewallRuleID)
+
+			diags := resourceCloudflareFirewallRuleRead(context.Background(), d, client)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !tc.warning {
+				if len(diags) != 0 {
+					t.Errorf("expected no warnings, got %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "6c3a2b6f5d1f4b7c9e0a1b2c3d4e5f60") {
+				t.Errorf("expected a warning naming the migrated rule, got %v", diags)
+			}
+			if got := d.Get("filter_id").(string); got != "d6a5e8a0c8faee2b2487108b4fe6c1d5" {
+				t.Errorf("expected the Firewall Rule to be read, got filter %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gatewayAuditSSHSettings is the account level configuration used to
+// encrypt the commands logged by Gateway SSH proxy audit logging.
+type gatewayAuditSSHSettings struct {
+	PublicKey string `json:"public_key"`
+	SeedID    string `json:"seed_id,omitempty"`
+}
+
+func resourceCloudflareGatewayAuditSSHSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareGatewayAuditSSHSettingsSchema(),
+		CreateContext: resourceCloudflareGatewayAuditSSHSettingsUpdate,
+		ReadContext:   resourceCloudflareGatewayAuditSSHSettingsRead,
+		UpdateContext: resourceCloudflareGatewayAuditSSHSettingsUpdate,
+		DeleteContext: resourceCloudflareGatewayAuditSSHSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareGatewayAuditSSHSettingsImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Gateway Audit SSH settings resource. The
+			public key is used to encrypt SSH session commands logged by
+			Gateway.
+		`),
+	}
+}
+
+func resourceCloudflareGatewayAuditSSHSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/audit_ssh_settings", accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Gateway Audit SSH settings for account %q: %w", accountID, err))
+	}
+
+	var settings gatewayAuditSSHSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Gateway Audit SSH settings: %w", err))
+	}
+
+	d.Set("public_key", settings.PublicKey)
+	d.Set("seed_id", settings.SeedID)
+
+	return nil
+}
+
+func resourceCloudflareGatewayAuditSSHSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	settings := gatewayAuditSSHSettings{
+		PublicKey: d.Get("public_key").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Gateway Audit SSH settings for account %s", accountID))
+
+	if err := updateGatewayAuditSSHSettings(ctx, client, accountID, settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Gateway Audit SSH settings for account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareGatewayAuditSSHSettingsRead(ctx, d, meta)
+}
+
+// resourceCloudflareGatewayAuditSSHSettingsDelete clears the public key as
+// the settings themselves always exist for an account.
+func resourceCloudflareGatewayAuditSSHSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Clearing Cloudflare Gateway Audit SSH settings for account %s", accountID))
+
+	if err := updateGatewayAuditSSHSettings(ctx, client, accountID, gatewayAuditSSHSettings{}); err != nil {
+		return diag.FromErr(fmt.Errorf("error clearing Gateway Audit SSH settings for account %q: %w", accountID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareGatewayAuditSSHSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := d.Id()
+
+	d.SetId(accountID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareGatewayAuditSSHSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateGatewayAuditSSHSettings(ctx context.Context, client *cloudflare.API, accountID string, settings gatewayAuditSSHSettings) error {
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/gateway/audit_ssh_settings", accountID), settings, nil)
+	return err
+}
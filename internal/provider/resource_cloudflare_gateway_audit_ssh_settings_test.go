@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareGatewayAuditSSHSettings_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := "cloudflare_gateway_audit_ssh_settings." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareGatewayAuditSSHSettingsConfig(rnd, accountID, "1pyl6I1tL7xfJuFYVzXlUW8uXXlpxegHXBzGCBKaSFA="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", accountID),
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "public_key", "1pyl6I1tL7xfJuFYVzXlUW8uXXlpxegHXBzGCBKaSFA="),
+					resource.TestCheckResourceAttrSet(name, "seed_id"),
+				),
+			},
+			{
+				Config: testAccCloudflareGatewayAuditSSHSettingsConfig(rnd, accountID, "BqzDr8VGKNjd6a4Lz6uTIbjXXU9hEPoZfeEzKRWs1wA="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "public_key", "BqzDr8VGKNjd6a4Lz6uTIbjXXU9hEPoZfeEzKRWs1wA="),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareGatewayAuditSSHSettingsConfig(rnd, accountID, publicKey string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_gateway_audit_ssh_settings" "%[1]s" {
+  account_id = "%[2]s"
+  public_key = "%[3]s"
+}
+`, rnd, accountID, publicKey)
+}
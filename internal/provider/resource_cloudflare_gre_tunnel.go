@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -30,24 +32,23 @@ func resourceCloudflareGRETunnelCreate(ctx context.Context, d *schema.ResourceDa
 	accountID := d.Get("account_id").(string)
 	client := meta.(*cloudflare.API)
 
-	newTunnel, err := client.CreateMagicTransitGRETunnels(ctx, accountID, []cloudflare.MagicTransitGRETunnel{
-		GRETunnelFromResource(d),
-	})
+	newTunnel, err := createGRETunnel(ctx, client, accountID, GRETunnelFromResource(d))
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating GRE tunnel %s: %w", d.Get("name").(string), err))
 	}
 
-	d.SetId(newTunnel[0].ID)
+	d.SetId(newTunnel.ID)
 
 	return resourceCloudflareGRETunnelRead(ctx, d, meta)
 }
 
 func resourceCloudflareGRETunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	attributes := strings.SplitN(d.Id(), "/", 2)
+	// The "account/" prefix is optional.
+	attributes := strings.SplitN(strings.TrimPrefix(d.Id(), "account/"), "/", 2)
 
 	if len(attributes) != 2 {
-		return nil, errors.New(fmt.Sprintf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelID\"", d.Id()))
+		return nil, errors.New(fmt.Sprintf("invalid id (\"%s\") specified, should be in format \"account/accountID/tunnelID\"", d.Id()))
 	}
 
 	accountID, tunnelID := attributes[0], attributes[1]
@@ -59,6 +60,10 @@ func resourceCloudflareGRETunnelImport(ctx context.Context, d *schema.ResourceDa
 		return nil, errors.New("failed to read GRE Tunnel state")
 	}
 
+	if d.Id() == "" {
+		return nil, fmt.Errorf("GRE tunnel %q not found in account %q", tunnelID, accountID)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -66,9 +71,10 @@ func resourceCloudflareGRETunnelRead(ctx context.Context, d *schema.ResourceData
 	accountID := d.Get("account_id").(string)
 	client := meta.(*cloudflare.API)
 
-	tunnel, err := client.GetMagicTransitGRETunnel(ctx, accountID, d.Id())
+	tunnel, err := getGRETunnel(ctx, client, accountID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "GRE tunnel not found") {
+		var notFoundError *cloudflare.NotFoundError
+		if strings.Contains(err.Error(), "GRE tunnel not found") || errors.As(err, &notFoundError) {
 			tflog.Info(ctx, fmt.Sprintf("GRE tunnel %s not found", d.Id()))
 			d.SetId("")
 			return nil
@@ -82,9 +88,10 @@ func resourceCloudflareGRETunnelRead(ctx context.Context, d *schema.ResourceData
 	d.Set("interface_address", tunnel.InterfaceAddress)
 	d.Set("ttl", int(tunnel.TTL))
 	d.Set("mtu", int(tunnel.MTU))
-	d.Set("health_check_enabled", tunnel.HealthCheck.Enabled)
-	d.Set("health_check_target", tunnel.HealthCheck.Target)
-	d.Set("health_check_type", tunnel.HealthCheck.Type)
+
+	if err := setMagicTransitTunnelHealthCheck(d, tunnel.HealthCheck); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting health check of GRE tunnel ID %q: %w", d.Id(), err))
+	}
 
 	if len(tunnel.Description) > 0 {
 		d.Set("description", tunnel.Description)
@@ -97,7 +104,7 @@ func resourceCloudflareGRETunnelUpdate(ctx context.Context, d *schema.ResourceDa
 	accountID := d.Get("account_id").(string)
 	client := meta.(*cloudflare.API)
 
-	_, err := client.UpdateMagicTransitGRETunnel(ctx, accountID, d.Id(), GRETunnelFromResource(d))
+	err := updateGRETunnel(ctx, client, accountID, d.Id(), GRETunnelFromResource(d))
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating GRE tunnel %q", d.Id())))
 	}
@@ -119,13 +126,61 @@ func resourceCloudflareGRETunnelDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func GRETunnelFromResource(d *schema.ResourceData) cloudflare.MagicTransitGRETunnel {
-	tunnel := cloudflare.MagicTransitGRETunnel{
+// greTunnel extends cloudflare.MagicTransitGRETunnel with the rate and
+// direction of its health check.
+type greTunnel struct {
+	cloudflare.MagicTransitGRETunnel
+	HealthCheck *magicTransitTunnelHealthCheck `json:"health_check,omitempty"`
+}
+
+func getGRETunnel(ctx context.Context, client *cloudflare.API, accountID, tunnelID string) (greTunnel, error) {
+	result, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/gre_tunnels/%s", accountID, tunnelID), nil, nil)
+	if err != nil {
+		return greTunnel{}, err
+	}
+
+	var response struct {
+		GRETunnel greTunnel `json:"gre_tunnel"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return greTunnel{}, fmt.Errorf("error unmarshalling GRE tunnel: %w", err)
+	}
+
+	return response.GRETunnel, nil
+}
+
+func createGRETunnel(ctx context.Context, client *cloudflare.API, accountID string, tunnel greTunnel) (greTunnel, error) {
+	body := map[string]interface{}{"gre_tunnels": []greTunnel{tunnel}}
+	result, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/magic/gre_tunnels", accountID), body, nil)
+	if err != nil {
+		return greTunnel{}, err
+	}
+
+	var response struct {
+		GRETunnels []greTunnel `json:"gre_tunnels"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return greTunnel{}, fmt.Errorf("error unmarshalling GRE tunnels: %w", err)
+	}
+	if len(response.GRETunnels) == 0 {
+		return greTunnel{}, fmt.Errorf("no GRE tunnel was returned")
+	}
+
+	return response.GRETunnels[0], nil
+}
+
+func updateGRETunnel(ctx context.Context, client *cloudflare.API, accountID, tunnelID string, tunnel greTunnel) error {
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/magic/gre_tunnels/%s", accountID, tunnelID), tunnel, nil)
+	return err
+}
+
+func GRETunnelFromResource(d *schema.ResourceData) greTunnel {
+	tunnel := greTunnel{MagicTransitGRETunnel: cloudflare.MagicTransitGRETunnel{
 		Name:                  d.Get("name").(string),
 		CustomerGREEndpoint:   d.Get("customer_gre_endpoint").(string),
 		CloudflareGREEndpoint: d.Get("cloudflare_gre_endpoint").(string),
 		InterfaceAddress:      d.Get("interface_address").(string),
-	}
+	}}
 
 	description, descriptionOk := d.GetOk("description")
 	if descriptionOk {
@@ -142,35 +197,7 @@ func GRETunnelFromResource(d *schema.ResourceData) cloudflare.MagicTransitGRETun
 		tunnel.MTU = uint16(mtu.(int))
 	}
 
-	healthcheck := GRETunnelHealthcheckFromResource(d)
-	if healthcheck != nil {
-		tunnel.HealthCheck = healthcheck
-	}
+	tunnel.HealthCheck = expandMagicTransitTunnelHealthCheck(d)
 
 	return tunnel
 }
-
-func GRETunnelHealthcheckFromResource(d *schema.ResourceData) *cloudflare.MagicTransitGRETunnelHealthcheck {
-	healthcheck := cloudflare.MagicTransitGRETunnelHealthcheck{}
-
-	healthcheckEnabled, healthcheckEnabledOk := d.GetOk("health_check_enabled")
-	if healthcheckEnabledOk {
-		healthcheck.Enabled = healthcheckEnabled.(bool)
-	}
-
-	healthcheckTarget, healthcheckTargetOk := d.GetOk("health_check_target")
-	if healthcheckTargetOk {
-		healthcheck.Target = healthcheckTarget.(string)
-	}
-
-	healthcheckType, healthcheckTypeOk := d.GetOk("health_check_type")
-	if healthcheckTypeOk {
-		healthcheck.Type = healthcheckType.(string)
-	}
-
-	if healthcheckEnabledOk || healthcheckTargetOk || healthcheckTypeOk {
-		return &healthcheck
-	}
-
-	return nil
-}
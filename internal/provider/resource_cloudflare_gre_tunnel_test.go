@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
@@ -10,6 +12,7 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareGRETunnelExists(t *testing.T) {
@@ -180,3 +183,94 @@ func testAccCheckCloudflareGRETunnelMultiUpdate(ID, name, description, accountID
     health_check_type = "reply"
   }`, ID, name, description, accountID)
 }
+
+func TestCloudflareGRETunnelUpdateKeepsHealthCheckDirection(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const tunnelID = "c4a7362d577a6c3019a474fd6f485821"
+	const tunnelPath = "/accounts/" + accountID + "/magic/gre_tunnels/" + tunnelID
+
+	tunnel := map[string]interface{}{
+		"id":                      tunnelID,
+		"name":                    "tunnel",
+		"description":             "tunnel",
+		"customer_gre_endpoint":   "203.0.113.1",
+		"cloudflare_gre_endpoint": "162.159.64.41",
+		"interface_address":       "10.212.0.9/31",
+		"ttl":                     64,
+		"mtu":                     1476,
+		"health_check": map[string]interface{}{
+			"enabled":   true,
+			"target":    "203.0.113.1",
+			"type":      "reply",
+			"rate":      "mid",
+			"direction": "bidirectional",
+		},
+	}
+	var updates []map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == tunnelPath:
+			var update map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+			updates = append(updates, update)
+			tunnel["description"] = update["description"]
+		case r.Method == http.MethodGet && r.URL.Path == tunnelPath:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResultJSON(w, map[string]interface{}{"gre_tunnel": tunnel})
+	})
+
+	r := resourceCloudflareGRETunnel()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId("account/" + accountID + "/" + tunnelID)
+
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported[0].Id() != tunnelID || imported[0].Get("account_id").(string) != accountID {
+		t.Fatalf("expected tunnel %q of account %q to be imported, got %q of %q", tunnelID, accountID, imported[0].Id(), imported[0].Get("account_id"))
+	}
+	if got := imported[0].Get("health_check.0.direction").(string); got != "bidirectional" {
+		t.Errorf("expected the health check direction to be read, got %q", got)
+	}
+
+	state := imported[0].State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":              accountID,
+		"name":                    "tunnel",
+		"description":             "updated",
+		"customer_gre_endpoint":   "203.0.113.1",
+		"cloudflare_gre_endpoint": "162.159.64.41",
+		"interface_address":       "10.212.0.9/31",
+		"ttl":                     64,
+		"mtu":                     1476,
+		"health_check_enabled":    true,
+		"health_check_target":     "203.0.113.1",
+		"health_check_type":       "reply",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("expected a single update, got %d", len(updates))
+	}
+	healthCheck, _ := updates[0]["health_check"].(map[string]interface{})
+	if healthCheck["direction"] != "bidirectional" || healthCheck["rate"] != "mid" || healthCheck["type"] != "reply" {
+		t.Errorf("expected the health check to be kept, got %v", healthCheck)
+	}
+	if updates[0]["description"] != "updated" {
+		t.Errorf("expected the description to be updated, got %v", updates[0]["description"])
+	}
+}
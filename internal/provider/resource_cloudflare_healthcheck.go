@@ -22,6 +22,7 @@ func resourceCloudflareHealthcheck() *schema.Resource {
 		ReadContext:   resourceCloudflareHealthcheckRead,
 		UpdateContext: resourceCloudflareHealthcheckUpdate,
 		DeleteContext: resourceCloudflareHealthcheckDelete,
+		CustomizeDiff: resourceCloudflareHealthcheckCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareHealthcheckImport,
 		},
@@ -43,7 +44,8 @@ func resourceCloudflareHealthcheckRead(ctx context.Context, d *schema.ResourceDa
 
 	healthcheck, err := client.Healthcheck(ctx, zoneID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "object does not exist") {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) || strings.Contains(err.Error(), "object does not exist") {
 			tflog.Info(ctx, fmt.Sprintf("Healthcheck %s no longer exists", d.Id()))
 			d.SetId("")
 			return nil
@@ -53,19 +55,32 @@ func resourceCloudflareHealthcheckRead(ctx context.Context, d *schema.ResourceDa
 
 	switch healthcheck.Type {
 	case "TCP":
-		d.Set("method", healthcheck.TCPConfig.Method)
-		d.Set("port", int(healthcheck.TCPConfig.Port))
+		if healthcheck.TCPConfig != nil {
+			d.Set("method", healthcheck.TCPConfig.Method)
+			d.Set("port", int(healthcheck.TCPConfig.Port))
+		}
+
+		// The HTTP specific attributes don't apply to TCP health checks and
+		// are kept at their defaults, which matters when importing.
+		d.Set("path", "/")
+		d.Set("expected_codes", nil)
+		d.Set("expected_body", "")
+		d.Set("follow_redirects", false)
+		d.Set("allow_insecure", false)
+		d.Set("header", nil)
 	case "HTTP", "HTTPS":
-		d.Set("method", healthcheck.HTTPConfig.Method)
-		d.Set("port", int(healthcheck.HTTPConfig.Port))
-		d.Set("path", healthcheck.HTTPConfig.Path)
-		d.Set("expected_codes", healthcheck.HTTPConfig.ExpectedCodes)
-		d.Set("expected_body", healthcheck.HTTPConfig.ExpectedBody)
-		d.Set("follow_redirects", healthcheck.HTTPConfig.FollowRedirects)
-		d.Set("allow_insecure", healthcheck.HTTPConfig.AllowInsecure)
-
-		if err := d.Set("header", flattenHealthcheckHeader(healthcheck.HTTPConfig.Header)); err != nil {
-			tflog.Warn(ctx, fmt.Sprintf("Error setting header for standalone healthcheck %q: %s", d.Id(), err))
+		if healthcheck.HTTPConfig != nil {
+			d.Set("method", healthcheck.HTTPConfig.Method)
+			d.Set("port", int(healthcheck.HTTPConfig.Port))
+			d.Set("path", healthcheck.HTTPConfig.Path)
+			d.Set("expected_codes", healthcheck.HTTPConfig.ExpectedCodes)
+			d.Set("expected_body", healthcheck.HTTPConfig.ExpectedBody)
+			d.Set("follow_redirects", healthcheck.HTTPConfig.FollowRedirects)
+			d.Set("allow_insecure", healthcheck.HTTPConfig.AllowInsecure)
+
+			if err := d.Set("header", flattenHealthcheckHeader(healthcheck.HTTPConfig.Header)); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Error setting header for standalone healthcheck %q: %s", d.Id(), err))
+			}
 		}
 	}
 
@@ -159,11 +174,59 @@ func resourceCloudflareHealthcheckImport(ctx context.Context, d *schema.Resource
 	d.Set("zone_id", zoneID)
 	d.SetId(HealthcheckID)
 
-	resourceCloudflareHealthcheckRead(ctx, d, meta)
+	if diags := resourceCloudflareHealthcheckRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("failed to read healthcheck %q: %s", HealthcheckID, diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("healthcheck %q not found in zone %q", HealthcheckID, zoneID)
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// healthcheckMinimumIntervals are the shortest intervals (in seconds)
+// between health checks allowed for each zone plan.
+var healthcheckMinimumIntervals = map[string]int{
+	planIDPro:               60,
+	planIDProPlus:           60,
+	planIDBusiness:          15,
+	planIDPartnerPro:        60,
+	planIDPartnerBusiness:   15,
+	planIDEnterprise:        10,
+	planIDPartnerEnterprise: 10,
+}
+
+// resourceCloudflareHealthcheckCustomizeDiff rejects configurations which
+// the API refuses, so they are reported when planning instead of applying.
+func resourceCloudflareHealthcheckCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	healthcheckType := d.Get("type").(string)
+	if d.NewValueKnown("type") && healthcheckType == "TCP" {
+		for _, attribute := range []string{"expected_codes", "expected_body"} {
+			if _, ok := d.GetOk(attribute); ok && d.NewValueKnown(attribute) {
+				return fmt.Errorf("%s can only be set for HTTP and HTTPS healthchecks", attribute)
+			}
+		}
+	}
+
+	if !d.HasChange("interval") || !d.NewValueKnown("interval") || !d.NewValueKnown("zone_id") {
+		return nil
+	}
+
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("error reading zone %q: %w", zoneID, err)
+	}
+
+	interval := d.Get("interval").(int)
+	if minimum, ok := healthcheckMinimumIntervals[zone.Plan.LegacyID]; ok && interval < minimum {
+		return fmt.Errorf("interval must be at least %d seconds for zones on the %s plan, got %d", minimum, zone.Plan.LegacyID, interval)
+	}
+
+	return nil
+}
+
 func healthcheckSetStruct(d *schema.ResourceData) (cloudflare.Healthcheck, error) {
 	healthcheck := cloudflare.Healthcheck{
 		Name:                 d.Get("name").(string),
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -39,6 +41,12 @@ func TestAccCloudflareHealthcheckTCPExists(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "method", "connection_established"),
 				),
 			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
 		},
 	})
 }
@@ -115,6 +123,12 @@ func TestAccCloudflareHealthcheckHTTPExists(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "method", "GET"),
 				),
 			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
 		},
 	})
 }
@@ -191,3 +205,112 @@ func testAccCheckHealthcheckConfigMissingRequired(zoneID, ID string) string {
     description = "Example health check description"
   }`, zoneID, ID)
 }
+
+func TestCloudflareHealthcheckCustomizeDiff(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/zones/"+zoneID {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, fmt.Sprintf(`{"id": %q, "name": "example.com", "plan": {"legacy_id": "business"}}`, zoneID))
+	})
+
+	testCases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"http": {
+			config: map[string]interface{}{"type": "HTTP", "interval": 15, "expected_codes": []interface{}{"200"}, "expected_body": "ok"},
+		},
+		"tcp with expected codes": {
+			config: map[string]interface{}{"type": "TCP", "expected_codes": []interface{}{"200"}},
+			err:    "expected_codes can only be set for HTTP and HTTPS healthchecks",
+		},
+		"tcp with expected body": {
+			config: map[string]interface{}{"type": "TCP", "expected_body": "ok"},
+			err:    "expected_body can only be set for HTTP and HTTPS healthchecks",
+		},
+		"interval below plan minimum": {
+			config: map[string]interface{}{"type": "TCP", "interval": 10},
+			err:    "interval must be at least 15 seconds for zones on the business plan",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"zone_id": zoneID,
+				"name":    "example",
+				"address": "example.com",
+			}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := resourceCloudflareHealthcheck().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCloudflareHealthcheckImportTCP(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const healthcheckID = "699d98642c564d2e855e9661899b7252"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/zones/"+zoneID+"/healthchecks/"+healthcheckID {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "name": "example",
+  "address": "example.com",
+  "type": "TCP",
+  "interval": 60,
+  "created_on": "2023-01-01T00:00:00Z",
+  "modified_on": "2023-01-01T00:00:00Z",
+  "tcp_config": {"method": "connection_established", "port": 8080},
+  "http_config": null
+}`, healthcheckID))
+	})
+
+	d := resourceCloudflareHealthcheck().Data(nil)
+	d.SetId(zoneID + "/" + healthcheckID)
+
+	imported, err := resourceCloudflareHealthcheckImport(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d = imported[0]
+	if d.Id() != healthcheckID || d.Get("zone_id").(string) != zoneID {
+		t.Errorf("expected healthcheck %q of zone %q, got %q of zone %q", healthcheckID, zoneID, d.Id(), d.Get("zone_id"))
+	}
+	for attribute, expected := range map[string]interface{}{
+		"method":           "connection_established",
+		"port":             8080,
+		"path":             "/",
+		"follow_redirects": false,
+		"allow_insecure":   false,
+	} {
+		if got := d.Get(attribute); got != expected {
+			t.Errorf("expected %s to be %v, got %v", attribute, expected, got)
+		}
+	}
+	if _, ok := d.GetOk("expected_codes"); ok {
+		t.Errorf("expected no expected_codes for a TCP healthcheck, got %v", d.Get("expected_codes"))
+	}
+}
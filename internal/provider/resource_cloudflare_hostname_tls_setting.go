@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hostnameTLSSetting is the representation of a per-hostname TLS setting.
+// Value is kept raw as its type depends on the setting.
+type hostnameTLSSetting struct {
+	Hostname  string          `json:"hostname,omitempty"`
+	Value     json.RawMessage `json:"value"`
+	Status    string          `json:"status,omitempty"`
+	CreatedAt string          `json:"created_at,omitempty"`
+	UpdatedAt string          `json:"updated_at,omitempty"`
+}
+
+func resourceCloudflareHostnameTLSSetting() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareHostnameTLSSettingSchema(),
+		CreateContext: resourceCloudflareHostnameTLSSettingUpdate,
+		ReadContext:   resourceCloudflareHostnameTLSSettingRead,
+		UpdateContext: resourceCloudflareHostnameTLSSettingUpdate,
+		DeleteContext: resourceCloudflareHostnameTLSSettingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareHostnameTLSSettingImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare per-hostname TLS setting resource. Used to
+			set TLS settings for hostnames under the specified zone.
+		`),
+	}
+}
+
+func resourceCloudflareHostnameTLSSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	hostname := d.Get("hostname").(string)
+	setting := d.Get("setting").(string)
+
+	if err := setHostnameTLSSetting(ctx, client, zoneID, setting, hostname, d.Get("value").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating %s for hostname %q: %w", setting, hostname, err))
+	}
+
+	d.SetId(hostname)
+
+	return resourceCloudflareHostnameTLSSettingRead(ctx, d, meta)
+}
+
+func resourceCloudflareHostnameTLSSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	setting := d.Get("setting").(string)
+
+	result, found, err := getHostnameTLSSetting(ctx, client, zoneID, setting, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading %s for hostname %q: %w", setting, d.Id(), err))
+	}
+
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("%s is not set for hostname %s", setting, d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(result.Value, &value); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling %s value: %w", setting, err))
+	}
+
+	d.Set("hostname", result.Hostname)
+	d.Set("value", value)
+	d.Set("created_at", result.CreatedAt)
+	d.Set("updated_at", result.UpdatedAt)
+
+	return nil
+}
+
+func resourceCloudflareHostnameTLSSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	setting := d.Get("setting").(string)
+
+	if err := deleteHostnameTLSSetting(ctx, client, zoneID, setting, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting %s for hostname %q: %w", setting, d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareHostnameTLSSettingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/setting/hostname\"", d.Id())
+	}
+
+	zoneID, setting, hostname := attributes[0], attributes[1], attributes[2]
+
+	d.Set("zone_id", zoneID)
+	d.Set("setting", setting)
+	d.SetId(hostname)
+
+	resourceCloudflareHostnameTLSSettingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func hostnameTLSSettingURI(zoneID, setting, hostname string) string {
+	return fmt.Sprintf("/zones/%s/hostnames/settings/%s/%s", zoneID, setting, hostname)
+}
+
+// getHostnameTLSSetting fetches a TLS setting of a hostname. A setting that
+// hasn't been set for the hostname is reported as not found rather than as an
+// error.
+func getHostnameTLSSetting(ctx context.Context, client *cloudflare.API, zoneID, setting, hostname string) (hostnameTLSSetting, bool, error) {
+	var result hostnameTLSSetting
+
+	res, err := client.Raw(ctx, http.MethodGet, hostnameTLSSettingURI(zoneID, setting, hostname), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return result, false, nil
+		}
+		return result, false, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, false, fmt.Errorf("error unmarshalling hostname TLS setting: %w", err)
+	}
+
+	return result, true, nil
+}
+
+func setHostnameTLSSetting(ctx context.Context, client *cloudflare.API, zoneID, setting, hostname string, value interface{}) error {
+	body := map[string]interface{}{"value": value}
+	_, err := client.Raw(ctx, http.MethodPut, hostnameTLSSettingURI(zoneID, setting, hostname), body, nil)
+	return err
+}
+
+// deleteHostnameTLSSetting removes the TLS setting of a hostname so that it
+// inherits the zone default again.
+func deleteHostnameTLSSetting(ctx context.Context, client *cloudflare.API, zoneID, setting, hostname string) error {
+	_, err := client.Raw(ctx, http.MethodDelete, hostnameTLSSettingURI(zoneID, setting, hostname), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const hostnameTLSSettingCiphers = "ciphers"
+
+func resourceCloudflareHostnameTLSSettingCiphers() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareHostnameTLSSettingCiphersSchema(),
+		CreateContext: resourceCloudflareHostnameTLSSettingCiphersUpdate,
+		ReadContext:   resourceCloudflareHostnameTLSSettingCiphersRead,
+		UpdateContext: resourceCloudflareHostnameTLSSettingCiphersUpdate,
+		DeleteContext: resourceCloudflareHostnameTLSSettingCiphersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareHostnameTLSSettingCiphersImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare per-hostname TLS setting resource,
+			specifically for ciphers suites. Used to set ciphers suites for
+			hostnames under the specified zone.
+		`),
+	}
+}
+
+func resourceCloudflareHostnameTLSSettingCiphersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	hostname := d.Get("hostname").(string)
+
+	ciphers := expandInterfaceToStringList(d.Get("value"))
+	if err := setHostnameTLSSetting(ctx, client, zoneID, hostnameTLSSettingCiphers, hostname, ciphers); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating ciphers for hostname %q: %w", hostname, err))
+	}
+
+	d.SetId(hostname)
+
+	return resourceCloudflareHostnameTLSSettingCiphersRead(ctx, d, meta)
+}
+
+func resourceCloudflareHostnameTLSSettingCiphersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	result, found, err := getHostnameTLSSetting(ctx, client, zoneID, hostnameTLSSettingCiphers, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading ciphers for hostname %q: %w", d.Id(), err))
+	}
+
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("ciphers are not set for hostname %s", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	var ciphers []string
+	if err := json.Unmarshal(result.Value, &ciphers); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling ciphers value: %w", err))
+	}
+
+	d.Set("hostname", result.Hostname)
+	d.Set("value", ciphers)
+	d.Set("created_at", result.CreatedAt)
+	d.Set("updated_at", result.UpdatedAt)
+
+	return nil
+}
+
+func resourceCloudflareHostnameTLSSettingCiphersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := deleteHostnameTLSSetting(ctx, client, zoneID, hostnameTLSSettingCiphers, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting ciphers for hostname %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareHostnameTLSSettingCiphersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/hostname\"", d.Id())
+	}
+
+	zoneID, hostname := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(hostname)
+
+	resourceCloudflareHostnameTLSSettingCiphersRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareHostnameTLSSettingCiphers_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_hostname_tls_setting_ciphers." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	hostname := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareHostnameTLSSettingCiphersConfig(rnd, zoneID, hostname, `"ECDHE-RSA-AES128-GCM-SHA256", "AES128-GCM-SHA256"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "value.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value.0", "ECDHE-RSA-AES128-GCM-SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "value.1", "AES128-GCM-SHA256"),
+				),
+			},
+			{
+				Config: testAccCloudflareHostnameTLSSettingCiphersConfig(rnd, zoneID, hostname, `"ECDHE-RSA-AES128-GCM-SHA256"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value.#", "1"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareHostnameTLSSettingCiphersConfig(rnd, zoneID, hostname, ciphers string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hostname_tls_setting_ciphers" "%[1]s" {
+  zone_id  = "%[2]s"
+  hostname = "%[3]s"
+  value    = [%[4]s]
+}
+`, rnd, zoneID, hostname, ciphers)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareHostnameTLSSetting_MinTLSVersion(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_hostname_tls_setting." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	hostname := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareHostnameTLSSettingConfig(rnd, zoneID, hostname, "min_tls_version", "1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "setting", "min_tls_version"),
+					resource.TestCheckResourceAttr(resourceName, "value", "1.2"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareHostnameTLSSettingConfig(rnd, zoneID, hostname, "min_tls_version", "1.3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "1.3"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/min_tls_version/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareHostnameTLSSettingConfig(rnd, zoneID, hostname, setting, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hostname_tls_setting" "%[1]s" {
+  zone_id  = "%[2]s"
+  hostname = "%[3]s"
+  setting  = "%[4]s"
+  value    = "%[5]s"
+}
+`, rnd, zoneID, hostname, setting, value)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hyperdriveConfig is the representation of a Hyperdrive configuration in
+// the Cloudflare API.
+type hyperdriveConfig struct {
+	ID      string                   `json:"id,omitempty"`
+	Name    string                   `json:"name"`
+	Origin  hyperdriveConfigOrigin   `json:"origin"`
+	Caching *hyperdriveConfigCaching `json:"caching,omitempty"`
+}
+
+// hyperdriveConfigOrigin holds the origin database connection details. The
+// password and access client secret are write-only.
+type hyperdriveConfigOrigin struct {
+	Host               string `json:"host"`
+	Port               int    `json:"port,omitempty"`
+	Database           string `json:"database"`
+	User               string `json:"user"`
+	Password           string `json:"password,omitempty"`
+	Scheme             string `json:"scheme,omitempty"`
+	AccessClientID     string `json:"access_client_id,omitempty"`
+	AccessClientSecret string `json:"access_client_secret,omitempty"`
+}
+
+type hyperdriveConfigCaching struct {
+	Disabled             bool `json:"disabled"`
+	MaxAge               int  `json:"max_age,omitempty"`
+	StaleWhileRevalidate int  `json:"stale_while_revalidate,omitempty"`
+}
+
+func resourceCloudflareHyperdriveConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareHyperdriveConfigSchema(),
+		CreateContext: resourceCloudflareHyperdriveConfigCreate,
+		ReadContext:   resourceCloudflareHyperdriveConfigRead,
+		UpdateContext: resourceCloudflareHyperdriveConfigUpdate,
+		DeleteContext: resourceCloudflareHyperdriveConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareHyperdriveConfigImport,
+		},
+		Description: heredoc.Doc(`
+			The [Hyperdrive Config](https://developers.cloudflare.com/hyperdrive/) resource allows you to manage Cloudflare Hyperdrive Configs.
+		`),
+	}
+}
+
+func resourceCloudflareHyperdriveConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	config := buildHyperdriveConfig(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Hyperdrive Config: %s", config.Name))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/hyperdrive/configs", accountID), config, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Hyperdrive Config %q: %w", config.Name, err))
+	}
+
+	var created hyperdriveConfig
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Hyperdrive Config: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareHyperdriveConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareHyperdriveConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Hyperdrive Config %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Hyperdrive Config %q: %w", d.Id(), err))
+	}
+
+	var config hyperdriveConfig
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Hyperdrive Config: %w", err))
+	}
+
+	// the password and access client secret are never returned so the
+	// values in state are kept as is.
+	password := d.Get("origin.0.password").(string)
+	accessClientSecret := d.Get("origin.0.access_client_secret").(string)
+
+	d.Set("name", config.Name)
+	d.Set("origin", []map[string]interface{}{{
+		"host":                 config.Origin.Host,
+		"port":                 config.Origin.Port,
+		"database":             config.Origin.Database,
+		"user":                 config.Origin.User,
+		"password":             password,
+		"scheme":               config.Origin.Scheme,
+		"access_client_id":     config.Origin.AccessClientID,
+		"access_client_secret": accessClientSecret,
+	}})
+
+	if config.Caching != nil {
+		d.Set("caching", []map[string]interface{}{{
+			"disabled":               config.Caching.Disabled,
+			"max_age":                config.Caching.MaxAge,
+			"stale_while_revalidate": config.Caching.StaleWhileRevalidate,
+		}})
+	}
+
+	// the password is only missing from state once the config is imported.
+	if password == "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The origin password of Hyperdrive Config %q isn't known", d.Id()),
+			Detail:   "The origin password and access client secret of a Hyperdrive Config are never returned by the API, so they can't be imported and must be supplied in the configuration.",
+		}}
+	}
+
+	return nil
+}
+
+func resourceCloudflareHyperdriveConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	config := buildHyperdriveConfig(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Hyperdrive Config: %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", accountID, d.Id()), config, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Hyperdrive Config %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareHyperdriveConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareHyperdriveConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Hyperdrive Config: %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/hyperdrive/configs/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("error deleting Hyperdrive Config %q: %w", d.Id(), err))
+		}
+		tflog.Info(ctx, fmt.Sprintf("Hyperdrive Config %s was already deleted", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareHyperdriveConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/hyperdriveID\"", d.Id())
+	}
+
+	accountID, hyperdriveID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Hyperdrive Config: id %s for account %s", hyperdriveID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(hyperdriveID)
+
+	resourceCloudflareHyperdriveConfigRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildHyperdriveConfig(d *schema.ResourceData) hyperdriveConfig {
+	config := hyperdriveConfig{
+		Name: d.Get("name").(string),
+		Origin: hyperdriveConfigOrigin{
+			Host:               d.Get("origin.0.host").(string),
+			Port:               d.Get("origin.0.port").(int),
+			Database:           d.Get("origin.0.database").(string),
+			User:               d.Get("origin.0.user").(string),
+			Password:           d.Get("origin.0.password").(string),
+			Scheme:             d.Get("origin.0.scheme").(string),
+			AccessClientID:     d.Get("origin.0.access_client_id").(string),
+			AccessClientSecret: d.Get("origin.0.access_client_secret").(string),
+		},
+	}
+
+	if _, ok := d.GetOk("caching"); ok {
+		config.Caching = &hyperdriveConfigCaching{
+			Disabled:             d.Get("caching.0.disabled").(bool),
+			MaxAge:               d.Get("caching.0.max_age").(int),
+			StaleWhileRevalidate: d.Get("caching.0.stale_while_revalidate").(int),
+		}
+	}
+
+	return config
+}
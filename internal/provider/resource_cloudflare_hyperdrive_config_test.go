@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareHyperdriveConfig_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_hyperdrive_config." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	host := os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_HOSTNAME")
+	database := os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_NAME")
+	user := os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_USER")
+	password := os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_PASSWORD")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckHyperdriveOrigin(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareHyperdriveConfig(rnd, accountID, host, database, user, password, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.host", host),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.port", "5432"),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.database", database),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.user", user),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.password", password),
+					resource.TestCheckResourceAttr(resourceName, "origin.0.scheme", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "caching.0.disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "caching.0.max_age", "60"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareHyperdriveConfig(rnd, accountID, host, database, user, password, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "caching.0.max_age", "120"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"origin.0.password"},
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareHyperdriveConfig(rnd, accountID, host, database, user, password string, maxAge int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hyperdrive_config" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+
+  origin {
+    host     = "%[3]s"
+    port     = 5432
+    database = "%[4]s"
+    user     = "%[5]s"
+    password = "%[6]s"
+  }
+
+  caching {
+    max_age = %[7]d
+  }
+}
+`, rnd, accountID, host, database, user, password, maxAge)
+}
+
+func TestCloudflareHyperdriveConfigReadWarnsAboutImportedPassword(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const hyperdriveID = "b5e3d6c0a1b24f8e9c7d6a5b4c3d2e1f"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/"+accountID+"/hyperdrive/configs/"+hyperdriveID {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, `{
+  "id": "`+hyperdriveID+`",
+  "name": "example",
+  "origin": {"host": "db.example.com", "port": 5432, "database": "postgres", "user": "postgres", "scheme": "postgres"},
+  "caching": {"disabled": false}
+}`)
+	})
+
+	r := resourceCloudflareHyperdriveConfig()
+	d := r.Data(nil)
+	d.SetId(accountID + "/" + hyperdriveID)
+	if _, err := r.Importer.StateContext(context.Background(), d, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	diags := resourceCloudflareHyperdriveConfigRead(context.Background(), d, client)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning about the password, got %v", diags)
+	}
+	if got := d.Get("origin.0.port").(int); got != 5432 {
+		t.Errorf("expected the port to be 5432, got %d", got)
+	}
+}
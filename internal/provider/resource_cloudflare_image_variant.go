@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// imageVariantPublic is the built-in variant of every account, which can be
+// updated but not deleted.
+const imageVariantPublic = "public"
+
+type imageVariant struct {
+	ID                     string              `json:"id,omitempty"`
+	Options                imageVariantOptions `json:"options"`
+	NeverRequireSignedURLs bool                `json:"neverRequireSignedURLs"`
+}
+
+type imageVariantOptions struct {
+	Fit      string `json:"fit"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Metadata string `json:"metadata"`
+}
+
+type imageVariantResult struct {
+	Variant imageVariant `json:"variant"`
+}
+
+func resourceCloudflareImageVariant() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareImageVariantSchema(),
+		CreateContext: resourceCloudflareImageVariantCreate,
+		ReadContext:   resourceCloudflareImageVariantRead,
+		UpdateContext: resourceCloudflareImageVariantUpdate,
+		DeleteContext: resourceCloudflareImageVariantDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareImageVariantImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages Cloudflare Images variants, the
+			named sets of transformations applied to images. The built-in
+			` + "`public`" + ` variant can be managed too, it's updated instead
+			of created and is kept when the resource is destroyed.
+		`),
+	}
+}
+
+func imageVariantURI(accountID, variantID string) string {
+	uri := fmt.Sprintf("/accounts/%s/images/v1/variants", accountID)
+	if variantID != "" {
+		uri += "/" + variantID
+	}
+	return uri
+}
+
+func buildImageVariant(d *schema.ResourceData) imageVariant {
+	return imageVariant{
+		Options: imageVariantOptions{
+			Fit:      d.Get("options.0.fit").(string),
+			Width:    d.Get("options.0.width").(int),
+			Height:   d.Get("options.0.height").(int),
+			Metadata: d.Get("options.0.metadata").(string),
+		},
+		NeverRequireSignedURLs: d.Get("never_require_signed_urls").(bool),
+	}
+}
+
+func resourceCloudflareImageVariantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	variantID := d.Get("variant_id").(string)
+
+	variant := buildImageVariant(d)
+
+	var err error
+	if variantID == imageVariantPublic {
+		_, err = client.Raw(ctx, http.MethodPatch, imageVariantURI(accountID, variantID), variant, nil)
+	} else {
+		variant.ID = variantID
+		_, err = client.Raw(ctx, http.MethodPost, imageVariantURI(accountID, ""), variant, nil)
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Images variant %q: %w", variantID, err))
+	}
+
+	d.SetId(variantID)
+
+	return resourceCloudflareImageVariantRead(ctx, d, meta)
+}
+
+func resourceCloudflareImageVariantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, imageVariantURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Images variant %q from state because it's not found in API", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Images variant %q: %w", d.Id(), err))
+	}
+
+	var result imageVariantResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Images variant: %w", err))
+	}
+
+	d.Set("variant_id", d.Id())
+	d.Set("options", []map[string]interface{}{{
+		"fit":      result.Variant.Options.Fit,
+		"width":    result.Variant.Options.Width,
+		"height":   result.Variant.Options.Height,
+		"metadata": result.Variant.Options.Metadata,
+	}})
+	d.Set("never_require_signed_urls", result.Variant.NeverRequireSignedURLs)
+
+	return nil
+}
+
+func resourceCloudflareImageVariantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.Raw(ctx, http.MethodPatch, imageVariantURI(accountID, d.Id()), buildImageVariant(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Images variant %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareImageVariantRead(ctx, d, meta)
+}
+
+func resourceCloudflareImageVariantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if d.Id() == imageVariantPublic {
+		tflog.Info(ctx, "Keeping the built-in public Images variant, it can't be deleted")
+		return nil
+	}
+
+	_, err := client.Raw(ctx, http.MethodDelete, imageVariantURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Images variant %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareImageVariantImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/variantID\"", d.Id())
+	}
+	accountID, variantID := attributes[0], attributes[1]
+
+	d.SetId(variantID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareImageVariantRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
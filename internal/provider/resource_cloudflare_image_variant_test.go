@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareImageVariant_Basic(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_image_variant.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareImageVariantConfig(rnd, accountID, 1366),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "variant_id", rnd),
+					resource.TestCheckResourceAttr(name, "options.0.fit", "scale-down"),
+					resource.TestCheckResourceAttr(name, "options.0.width", "1366"),
+					resource.TestCheckResourceAttr(name, "options.0.height", "768"),
+					resource.TestCheckResourceAttr(name, "options.0.metadata", "none"),
+					resource.TestCheckResourceAttr(name, "never_require_signed_urls", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareImageVariantConfig(rnd, accountID, 1920),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "options.0.width", "1920"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareImageVariantConfig(resourceName, accountID string, width int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_image_variant" "%[1]s" {
+  account_id = "%[2]s"
+  variant_id = "%[1]s"
+
+  options {
+    fit      = "scale-down"
+    width    = %[3]d
+    height   = 768
+    metadata = "none"
+  }
+
+  never_require_signed_urls = true
+}`, resourceName, accountID, width)
+}
+
+func TestCloudflareImageVariantPublic(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	variantsPath := "/accounts/" + accountID + "/images/v1/variants"
+
+	testCases := map[string]struct {
+		variantID string
+		expected  []string
+	}{
+		"custom variant": {
+			variantID: "hero",
+			expected: []string{
+				"POST " + variantsPath,
+				"GET " + variantsPath + "/hero",
+				"DELETE " + variantsPath + "/hero",
+			},
+		},
+		"public variant": {
+			variantID: "public",
+			expected: []string{
+				"PATCH " + variantsPath + "/public",
+				"GET " + variantsPath + "/public",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.Path)
+				testAPIResult(w, fmt.Sprintf(`{"variant": {
+  "id": %q,
+  "options": {"fit": "scale-down", "width": 1366, "height": 768, "metadata": "none"},
+  "neverRequireSignedURLs": true
+}}`, tc.variantID))
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareImageVariantSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"variant_id": tc.variantID,
+				"options": []interface{}{map[string]interface{}{
+					"fit":      "scale-down",
+					"width":    1366,
+					"height":   768,
+					"metadata": "none",
+				}},
+				"never_require_signed_urls": true,
+			})
+			if diags := resourceCloudflareImageVariantCreate(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if diags := resourceCloudflareImageVariantDelete(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !reflect.DeepEqual(requests, tc.expected) {
+				t.Errorf("expected requests %v, got %v", tc.expected, requests)
+			}
+		})
+	}
+}
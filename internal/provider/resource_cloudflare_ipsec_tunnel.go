@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -33,15 +35,13 @@ func resourceCloudflareIPsecTunnelCreate(ctx context.Context, d *schema.Resource
 	accountID := d.Get("account_id").(string)
 	client := meta.(*cloudflare.API)
 
-	newTunnel, err := client.CreateMagicTransitIPsecTunnels(ctx, accountID, []cloudflare.MagicTransitIPsecTunnel{
-		IPsecTunnelFromResource(d),
-	})
+	newTunnel, err := createIPsecTunnel(ctx, client, accountID, IPsecTunnelFromResource(d))
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating IPSec tunnel %s: %w", d.Get("name").(string), err))
 	}
 
-	d.SetId(newTunnel[0].ID)
+	d.SetId(newTunnel.ID)
 
 	// If PSK is not specified, call generate PSK and populate the field
 	psk, pskOk := d.Get("psk").(string)
@@ -60,10 +60,11 @@ func resourceCloudflareIPsecTunnelCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareIPsecTunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	attributes := strings.SplitN(d.Id(), "/", 2)
+	// The "account/" prefix is optional.
+	attributes := strings.SplitN(strings.TrimPrefix(d.Id(), "account/"), "/", 2)
 
 	if len(attributes) != 2 {
-		return nil, errors.New(fmt.Sprintf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelID\"", d.Id()))
+		return nil, errors.New(fmt.Sprintf("invalid id (\"%s\") specified, should be in format \"account/accountID/tunnelID\"", d.Id()))
 	}
 
 	accountID, tunnelID := attributes[0], attributes[1]
@@ -75,6 +76,10 @@ func resourceCloudflareIPsecTunnelImport(ctx context.Context, d *schema.Resource
 		return nil, errors.New("failed to read IPSec Tunnel state")
 	}
 
+	if d.Id() == "" {
+		return nil, fmt.Errorf("IPsec tunnel %q not found in account %q", tunnelID, accountID)
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -82,9 +87,10 @@ func resourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceDa
 	accountID := d.Get("account_id").(string)
 	client := meta.(*cloudflare.API)
 
-	tunnel, err := client.GetMagicTransitIPsecTunnel(ctx, accountID, d.Id())
+	tunnel, err := getIPsecTunnel(ctx, client, accountID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "IPsec tunnel not found") {
+		var notFoundError *cloudflare.NotFoundError
+		if strings.Contains(err.Error(), "IPsec tunnel not found") || errors.As(err, &notFoundError) {
 			tflog.Info(ctx, fmt.Sprintf("IPsec tunnel %s not found", d.Id()))
 			d.SetId("")
 			return nil
@@ -96,15 +102,18 @@ func resourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("customer_endpoint", tunnel.CustomerEndpoint)
 	d.Set("cloudflare_endpoint", tunnel.CloudflareEndpoint)
 	d.Set("interface_address", tunnel.InterfaceAddress)
-	d.Set("health_check_enabled", tunnel.HealthCheck.Enabled)
-	d.Set("health_check_target", tunnel.HealthCheck.Target)
-	d.Set("health_check_type", tunnel.HealthCheck.Type)
 	d.Set("allow_null_cipher", tunnel.AllowNullCipher)
 
+	if err := setMagicTransitTunnelHealthCheck(d, tunnel.HealthCheck); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting health check of IPsec tunnel ID %q: %w", d.Id(), err))
+	}
+
 	// Set Remote Identities
-	d.Set("hex_id", tunnel.RemoteIdentities.HexID)
-	d.Set("fqdn_id", tunnel.RemoteIdentities.FQDNID)
-	d.Set("user_id", tunnel.RemoteIdentities.UserID)
+	if tunnel.RemoteIdentities != nil {
+		d.Set("hex_id", tunnel.RemoteIdentities.HexID)
+		d.Set("fqdn_id", tunnel.RemoteIdentities.FQDNID)
+		d.Set("user_id", tunnel.RemoteIdentities.UserID)
+	}
 	d.Set("remote_id", accountID+"_"+d.Id())
 
 	if len(tunnel.Description) > 0 {
@@ -117,7 +126,7 @@ func resourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceDa
 func resourceCloudflareIPsecTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	accountID := d.Get("account_id").(string)
 	client := meta.(*cloudflare.API)
-	_, err := client.UpdateMagicTransitIPsecTunnel(ctx, accountID, d.Id(), IPsecTunnelFromResource(d))
+	err := updateIPsecTunnel(ctx, client, accountID, d.Id(), IPsecTunnelFromResource(d))
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating IPsec tunnel %q", d.Id())))
 	}
@@ -152,13 +161,61 @@ func resourceCloudflareIPsecTunnelDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-func IPsecTunnelFromResource(d *schema.ResourceData) cloudflare.MagicTransitIPsecTunnel {
-	tunnel := cloudflare.MagicTransitIPsecTunnel{
+// ipsecTunnel extends cloudflare.MagicTransitIPsecTunnel with the rate and
+// direction of its health check.
+type ipsecTunnel struct {
+	cloudflare.MagicTransitIPsecTunnel
+	HealthCheck *magicTransitTunnelHealthCheck `json:"health_check,omitempty"`
+}
+
+func getIPsecTunnel(ctx context.Context, client *cloudflare.API, accountID, tunnelID string) (ipsecTunnel, error) {
+	result, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/ipsec_tunnels/%s", accountID, tunnelID), nil, nil)
+	if err != nil {
+		return ipsecTunnel{}, err
+	}
+
+	var response struct {
+		IPsecTunnel ipsecTunnel `json:"ipsec_tunnel"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return ipsecTunnel{}, fmt.Errorf("error unmarshalling IPsec tunnel: %w", err)
+	}
+
+	return response.IPsecTunnel, nil
+}
+
+func createIPsecTunnel(ctx context.Context, client *cloudflare.API, accountID string, tunnel ipsecTunnel) (ipsecTunnel, error) {
+	body := map[string]interface{}{"ipsec_tunnels": []ipsecTunnel{tunnel}}
+	result, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/magic/ipsec_tunnels", accountID), body, nil)
+	if err != nil {
+		return ipsecTunnel{}, err
+	}
+
+	var response struct {
+		IPsecTunnels []ipsecTunnel `json:"ipsec_tunnels"`
+	}
+	if err := json.Unmarshal(result, &response); err != nil {
+		return ipsecTunnel{}, fmt.Errorf("error unmarshalling IPsec tunnels: %w", err)
+	}
+	if len(response.IPsecTunnels) == 0 {
+		return ipsecTunnel{}, fmt.Errorf("no IPsec tunnel was returned")
+	}
+
+	return response.IPsecTunnels[0], nil
+}
+
+func updateIPsecTunnel(ctx context.Context, client *cloudflare.API, accountID, tunnelID string, tunnel ipsecTunnel) error {
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/magic/ipsec_tunnels/%s", accountID, tunnelID), tunnel, nil)
+	return err
+}
+
+func IPsecTunnelFromResource(d *schema.ResourceData) ipsecTunnel {
+	tunnel := ipsecTunnel{MagicTransitIPsecTunnel: cloudflare.MagicTransitIPsecTunnel{
 		Name:               d.Get("name").(string),
 		CustomerEndpoint:   d.Get("customer_endpoint").(string),
 		CloudflareEndpoint: d.Get("cloudflare_endpoint").(string),
 		InterfaceAddress:   d.Get("interface_address").(string),
-	}
+	}}
 
 	description, descriptionOk := d.GetOk("description")
 	if descriptionOk {
@@ -175,5 +232,7 @@ func IPsecTunnelFromResource(d *schema.ResourceData) cloudflare.MagicTransitIPse
 		tunnel.AllowNullCipher = allowNullCipher.(bool)
 	}
 
+	tunnel.HealthCheck = expandMagicTransitTunnelHealthCheck(d)
+
 	return tunnel
 }
@@ -134,6 +134,50 @@ func TestAccCloudflareIPsecTunnelUpdatePsk(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareIPsecTunnelHealthCheck(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_ipsec_tunnel.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	psk := "asdf1234"
+
+	var Tunnel cloudflare.MagicTransitIPsecTunnel
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareIPsecTunnelHealthCheck(rnd, rnd, accountID, psk),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareIPsecTunnelExists(name, &Tunnel),
+					resource.TestCheckResourceAttr(name, "health_check.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "health_check.0.target", "203.0.113.1"),
+					resource.TestCheckResourceAttr(name, "health_check.0.type", "reply"),
+					resource.TestCheckResourceAttr(name, "health_check.0.rate", "low"),
+					resource.TestCheckResourceAttr(name, "health_check.0.direction", "bidirectional"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareIPsecTunnelHealthCheck(rnd, rnd+"-updated", accountID, psk),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareIPsecTunnelExists(name, &Tunnel),
+					resource.TestCheckResourceAttr(name, "description", rnd+"-updated"),
+					resource.TestCheckResourceAttr(name, "health_check.0.direction", "bidirectional"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("account/%s/", accountID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"psk"},
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareIPsecTunnelSimple(ID, description, accountID, psk string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ipsec_tunnel" "%[1]s" {
@@ -150,3 +194,23 @@ func testAccCheckCloudflareIPsecTunnelSimple(ID, description, accountID, psk str
 	allow_null_cipher = false
   }`, ID, description, accountID, psk)
 }
+
+func testAccCheckCloudflareIPsecTunnelHealthCheck(ID, description, accountID, psk string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ipsec_tunnel" "%[1]s" {
+	account_id = "%[3]s"
+	name = "%[1]s"
+	customer_endpoint = "203.0.113.1"
+	cloudflare_endpoint = "162.159.64.41"
+	interface_address = "10.212.0.9/31"
+	description = "%[2]s"
+	psk = "%[4]s"
+	health_check {
+	  enabled = true
+	  target = "203.0.113.1"
+	  type = "reply"
+	  rate = "low"
+	  direction = "bidirectional"
+	}
+  }`, ID, description, accountID, psk)
+}
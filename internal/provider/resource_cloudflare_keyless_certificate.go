@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// keylessCertificate extends cloudflare.KeylessSSL with the tunnel used to
+// reach the key server, which the library doesn't support yet.
+type keylessCertificate struct {
+	cloudflare.KeylessSSL
+	Tunnel *keylessCertificateTunnel `json:"tunnel,omitempty"`
+}
+
+type keylessCertificateTunnel struct {
+	PrivateIP string `json:"private_ip"`
+	VnetID    string `json:"vnet_id"`
+}
+
+type keylessCertificateCreateRequest struct {
+	cloudflare.KeylessSSLCreateRequest
+	Tunnel *keylessCertificateTunnel `json:"tunnel,omitempty"`
+}
+
+// keylessCertificateUpdateRequest always sends the tunnel so that removing
+// it from the configuration clears it.
+type keylessCertificateUpdateRequest struct {
+	Host    string                    `json:"host"`
+	Name    string                    `json:"name,omitempty"`
+	Port    int                       `json:"port"`
+	Enabled *bool                     `json:"enabled,omitempty"`
+	Tunnel  *keylessCertificateTunnel `json:"tunnel"`
+}
+
+func resourceCloudflareKeylessCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareKeylessCertificateSchema(),
+		CreateContext: resourceCloudflareKeylessCertificateCreate,
+		ReadContext:   resourceCloudflareKeylessCertificateRead,
+		UpdateContext: resourceCloudflareKeylessCertificateUpdate,
+		DeleteContext: resourceCloudflareKeylessCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareKeylessCertificateImport,
+		},
+		Description: "Provides a Keyless certificate resource.",
+	}
+}
+
+func keylessCertificateURI(zoneID, keylessID string) string {
+	if keylessID == "" {
+		return fmt.Sprintf("/zones/%s/keyless_certificates", zoneID)
+	}
+
+	return fmt.Sprintf("/zones/%s/keyless_certificates/%s", zoneID, keylessID)
+}
+
+func expandKeylessCertificateTunnel(d *schema.ResourceData) *keylessCertificateTunnel {
+	if _, ok := d.GetOk("tunnel"); !ok {
+		return nil
+	}
+
+	return &keylessCertificateTunnel{
+		PrivateIP: d.Get("tunnel.0.private_ip").(string),
+		VnetID:    d.Get("tunnel.0.vnet_id").(string),
+	}
+}
+
+func flattenKeylessCertificateTunnel(tunnel *keylessCertificateTunnel) []interface{} {
+	if tunnel == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"private_ip": tunnel.PrivateIP,
+		"vnet_id":    tunnel.VnetID,
+	}}
+}
+
+// writeKeylessCertificate creates (POST) or updates (PATCH) a keyless
+// certificate and returns the resulting configuration.
+func writeKeylessCertificate(ctx context.Context, client *cloudflare.API, method, uri string, body interface{}) (keylessCertificate, error) {
+	res, err := client.Raw(ctx, method, uri, body, nil)
+	if err != nil {
+		return keylessCertificate{}, err
+	}
+
+	var keyless keylessCertificate
+	if err := json.Unmarshal(res, &keyless); err != nil {
+		return keylessCertificate{}, fmt.Errorf("error unmarshalling keyless certificate: %w", err)
+	}
+
+	return keyless, nil
+}
+
+func resourceCloudflareKeylessCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	request := keylessCertificateCreateRequest{
+		KeylessSSLCreateRequest: cloudflare.KeylessSSLCreateRequest{
+			Host:         d.Get("host").(string),
+			Port:         d.Get("port").(int),
+			Certificate:  d.Get("certificate").(string),
+			Name:         d.Get("name").(string),
+			BundleMethod: d.Get("bundle_method").(string),
+		},
+		Tunnel: expandKeylessCertificateTunnel(d),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare keyless certificate for host %q", request.Host))
+
+	keyless, err := writeKeylessCertificate(ctx, client, http.MethodPost, keylessCertificateURI(zoneID, ""), request)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating keyless certificate: %w", err))
+	}
+
+	d.SetId(keyless.ID)
+
+	// New configurations are enabled, so a disabled one needs an update.
+	if !d.Get("enabled").(bool) {
+		return resourceCloudflareKeylessCertificateUpdate(ctx, d, meta)
+	}
+
+	return resourceCloudflareKeylessCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareKeylessCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, keylessCertificateURI(zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing keyless certificate %q from state because it's not found in API", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error getting keyless certificate %q: %w", d.Id(), err))
+	}
+
+	var keyless keylessCertificate
+	if err := json.Unmarshal(res, &keyless); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling keyless certificate: %w", err))
+	}
+
+	d.Set("host", keyless.Host)
+	d.Set("port", keyless.Port)
+	d.Set("name", keyless.Name)
+	d.Set("enabled", keyless.Enabled)
+	d.Set("status", keyless.Status)
+	d.Set("tunnel", flattenKeylessCertificateTunnel(keyless.Tunnel))
+
+	return nil
+}
+
+func resourceCloudflareKeylessCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	enabled := d.Get("enabled").(bool)
+	request := keylessCertificateUpdateRequest{
+		Host:    d.Get("host").(string),
+		Name:    d.Get("name").(string),
+		Port:    d.Get("port").(int),
+		Enabled: &enabled,
+		Tunnel:  expandKeylessCertificateTunnel(d),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare keyless certificate %q", d.Id()))
+
+	if _, err := writeKeylessCertificate(ctx, client, http.MethodPatch, keylessCertificateURI(zoneID, d.Id()), request); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating keyless certificate %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareKeylessCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareKeylessCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if err := client.DeleteKeylessSSL(ctx, zoneID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting keyless certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareKeylessCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/keylessCertificateID\"", d.Id())
+	}
+	zoneID, keylessID := attributes[0], attributes[1]
+
+	d.SetId(keylessID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareKeylessCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
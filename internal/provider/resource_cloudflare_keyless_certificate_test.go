@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareKeylessCertificate_UpdateInPlace(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	certificate := os.Getenv("CLOUDFLARE_KEYLESS_CERTIFICATE")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_keyless_certificate.%s", rnd)
+	var keylessID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckKeylessCertificate(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareKeylessCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareKeylessCertificate(rnd, zoneID, certificate, "keyless."+domain, 24008, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "host", "keyless."+domain),
+					resource.TestCheckResourceAttr(name, "port", "24008"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "status", "active"),
+					testAccCheckCloudflareKeylessCertificateID(name, &keylessID),
+				),
+			},
+			{
+				Config: testAccCloudflareKeylessCertificate(rnd, zoneID, certificate, "keyless2."+domain, 2408, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "host", "keyless2."+domain),
+					resource.TestCheckResourceAttr(name, "port", "2408"),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					testAccCheckCloudflareKeylessCertificateID(name, &keylessID),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "bundle_method"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareKeylessCertificate(resourceName, zoneID, certificate, host string, port int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_keyless_certificate" "%[1]s" {
+  zone_id     = "%[2]s"
+  name        = "%[1]s"
+  certificate = %[3]q
+  host        = "%[4]s"
+  port        = %[5]d
+  enabled     = %[6]t
+}`, resourceName, zoneID, certificate, host, port, enabled)
+}
+
+// testAccCheckCloudflareKeylessCertificateID records the ID of the keyless
+// certificate on the first call and fails if it changes afterwards.
+func testAccCheckCloudflareKeylessCertificateID(name string, keylessID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if *keylessID == "" {
+			*keylessID = rs.Primary.ID
+		} else if rs.Primary.ID != *keylessID {
+			return fmt.Errorf("expected keyless certificate to be updated in place, ID changed from %q to %q", *keylessID, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudflareKeylessCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_keyless_certificate" {
+			continue
+		}
+
+		keyless, err := client.KeylessSSL(context.Background(), rs.Primary.Attributes["zone_id"], rs.Primary.ID)
+		if err == nil && keyless.Status != "deleted" {
+			return fmt.Errorf("keyless certificate %q still exists", rs.Primary.ID)
+		}
+
+		var notFoundError *cloudflare.NotFoundError
+		if err != nil && !errors.As(err, &notFoundError) {
+			return fmt.Errorf("error reading keyless certificate: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func TestCloudflareKeylessCertificateCreateWithTunnel(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const keylessID = "4d2844d2ce78891c34d0b6c0535a291e"
+
+	var created, updated map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/"+zoneID+"/keyless_certificates":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &created); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, fmt.Sprintf(`{"id": %q, "enabled": true, "status": "active"}`, keylessID))
+		case r.Method == http.MethodPatch && r.URL.Path == "/zones/"+zoneID+"/keyless_certificates/"+keylessID:
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &updated); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, fmt.Sprintf(`{"id": %q, "enabled": false, "status": "active"}`, keylessID))
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/"+zoneID+"/keyless_certificates/"+keylessID:
+			testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "name": "example",
+  "host": "keyless.example.com",
+  "port": 24008,
+  "enabled": false,
+  "status": "active",
+  "tunnel": {"private_ip": "10.0.0.1", "vnet_id": "7365377a-85a4-4390-9480-531ef7dc7a3c"}
+}`, keylessID))
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareKeylessCertificateSchema(), map[string]interface{}{
+		"zone_id":     zoneID,
+		"name":        "example",
+		"certificate": "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+		"host":        "keyless.example.com",
+		"port":        24008,
+		"enabled":     false,
+		"tunnel": []interface{}{map[string]interface{}{
+			"private_ip": "10.0.0.1",
+			"vnet_id":    "7365377a-85a4-4390-9480-531ef7dc7a3c",
+		}},
+	})
+
+	if diags := resourceCloudflareKeylessCertificateCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !strings.HasPrefix(fmt.Sprint(created["certificate"]), "-----BEGIN CERTIFICATE-----") {
+		t.Errorf("expected the certificate to be sent on create, got %v", created["certificate"])
+	}
+	if created["bundle_method"] != "ubiquitous" {
+		t.Errorf("expected the default bundle_method to be sent, got %v", created["bundle_method"])
+	}
+	tunnel, ok := created["tunnel"].(map[string]interface{})
+	if !ok || tunnel["private_ip"] != "10.0.0.1" {
+		t.Errorf("expected the tunnel to be sent on create, got %v", created["tunnel"])
+	}
+	if updated["enabled"] != false {
+		t.Errorf("expected the configuration to be disabled after create, got %v", updated["enabled"])
+	}
+
+	if d.Id() != keylessID {
+		t.Errorf("expected ID %q, got %q", keylessID, d.Id())
+	}
+	if got := d.Get("status").(string); got != "active" {
+		t.Errorf("expected status to be active, got %q", got)
+	}
+	if got := d.Get("tunnel.0.vnet_id").(string); got != "7365377a-85a4-4390-9480-531ef7dc7a3c" {
+		t.Errorf("expected tunnel.0.vnet_id to be read, got %q", got)
+	}
+}
+
+func TestCloudflareKeylessCertificateImportKeepsCertificate(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const keylessID = "4d2844d2ce78891c34d0b6c0535a291e"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/zones/"+zoneID+"/keyless_certificates/"+keylessID {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, fmt.Sprintf(`{"id": %q, "name": "example", "host": "keyless.example.com", "port": 24008, "enabled": true, "status": "active"}`, keylessID))
+	})
+
+	r := resourceCloudflareKeylessCertificate()
+	d := r.Data(nil)
+	d.SetId(zoneID + "/" + keylessID)
+	if _, err := r.Importer.StateContext(context.Background(), d, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":     zoneID,
+		"name":        "example",
+		"certificate": "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+		"host":        "keyless.example.com",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff != nil && diff.RequiresNew() {
+		t.Errorf("expected the imported keyless certificate not to be replaced, got %v", diff)
+	}
+}
@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
+// listItemsBatchSize is the maximum number of items sent in a single list
+// items request.
+const listItemsBatchSize = 1000
+
 func resourceCloudflareList() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareListSchema(),
@@ -47,12 +53,9 @@ func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if itemData, ok := d.GetOk("item"); ok {
 		items := buildListItemsCreateRequest(itemData.(*schema.Set).List())
-		_, err = client.CreateListItems(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListCreateItemsParams{
-			ID:    d.Id(),
-			Items: items,
-		})
+		err = writeListItems(ctx, client, accountID, d.Id(), items, false, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+			return append(resourceCloudflareListReadItems(ctx, d, client), diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))...)
 		}
 	}
 
@@ -71,6 +74,7 @@ func resourceCloudflareListImport(ctx context.Context, d *schema.ResourceData, m
 	d.Set("account_id", accountID)
 
 	resourceCloudflareListRead(ctx, d, meta)
+	resourceCloudflareListReadItems(ctx, d, meta.(*cloudflare.API))
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -93,6 +97,18 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("description", list.Description)
 	d.Set("kind", list.Kind)
 
+	// Items are only tracked when they're managed inline so that lists
+	// populated by `cloudflare_list_item` resources don't show a diff.
+	if d.Get("item").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	return resourceCloudflareListReadItems(ctx, d, client)
+}
+
+func resourceCloudflareListReadItems(ctx context.Context, d *schema.ResourceData, client *cloudflare.API) diag.Diagnostics {
+	accountID := d.Get("account_id").(string)
+
 	items, err := client.ListListItems(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListListItemsParams{
 		ID: d.Id(),
 	})
@@ -112,31 +128,7 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 			value["ip"] = *i.IP
 		}
 		if i.Redirect != nil {
-			optBoolToString := func(b *bool) string {
-				if b != nil {
-					switch *b {
-					case true:
-						return "enabled"
-					case false:
-						return "disabled"
-					}
-				}
-				return ""
-			}
-			statusCode := 0
-			if i.Redirect.StatusCode != nil {
-				statusCode = *i.Redirect.StatusCode
-			}
-
-			value["redirect"] = []map[string]interface{}{{
-				"source_url":            i.Redirect.SourceUrl,
-				"include_subdomains":    optBoolToString(i.Redirect.IncludeSubdomains),
-				"target_url":            i.Redirect.TargetUrl,
-				"status_code":           statusCode,
-				"preserve_query_string": optBoolToString(i.Redirect.PreserveQueryString),
-				"subpath_matching":      optBoolToString(i.Redirect.SubpathMatching),
-				"preserve_path_suffix":  optBoolToString(i.Redirect.PreservePathSuffix),
-			}}
+			value["redirect"] = []map[string]interface{}{flattenListItemRedirect(i.Redirect)}
 		}
 
 		item["value"] = []map[string]interface{}{value}
@@ -162,14 +154,14 @@ func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating List description")))
 	}
 
-	if itemData, ok := d.GetOk("item"); ok {
+	// Removing every `item` block replaces the items with none, a list which
+	// never had inline items is left to `cloudflare_list_item` resources.
+	oldItemData, itemData := d.GetChange("item")
+	if itemData.(*schema.Set).Len() > 0 || oldItemData.(*schema.Set).Len() > 0 {
 		items := buildListItemsCreateRequest(itemData.(*schema.Set).List())
-		_, err = client.ReplaceListItems(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListReplaceItemsParams{
-			ID:    d.Id(),
-			Items: items,
-		})
+		err = writeListItems(ctx, client, accountID, d.Id(), items, true, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+			return append(resourceCloudflareListReadItems(ctx, d, client), diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))...)
 		}
 	}
 
@@ -214,46 +206,7 @@ func buildListItemsCreateRequest(items []interface{}) []cloudflare.ListItemCreat
 		}
 
 		if r != nil {
-			sourceUrl := r["source_url"].(string)
-			targetUrl := r["target_url"].(string)
-
-			var includeSubdomains *bool = nil
-			var subpathMatching *bool = nil
-			var statusCode *int = nil
-			var preserveQueryString *bool = nil
-			var preservePathSuffix *bool = nil
-
-			stringToOptBool := func(s string) *bool {
-				switch s {
-				case "enabled":
-					return cloudflare.BoolPtr(true)
-				case "disabled":
-					return cloudflare.BoolPtr(false)
-				default:
-					return nil
-				}
-			}
-
-			includeSubdomains = stringToOptBool(r["include_subdomains"].(string))
-			subpathMatching = stringToOptBool(r["subpath_matching"].(string))
-
-			vint := r["status_code"].(int)
-			if vint != 0 {
-				statusCode = cloudflare.IntPtr(vint)
-			}
-
-			preserveQueryString = stringToOptBool(r["preserve_query_string"].(string))
-			preservePathSuffix = stringToOptBool(r["preserve_path_suffix"].(string))
-
-			redirect = &cloudflare.Redirect{
-				SourceUrl:           sourceUrl,
-				IncludeSubdomains:   includeSubdomains,
-				TargetUrl:           targetUrl,
-				StatusCode:          statusCode,
-				PreserveQueryString: preserveQueryString,
-				SubpathMatching:     subpathMatching,
-				PreservePathSuffix:  preservePathSuffix,
-			}
+			redirect = buildListItemRedirect(r)
 		}
 
 		listItems = append(listItems, cloudflare.ListItemCreateRequest{
@@ -265,3 +218,145 @@ func buildListItemsCreateRequest(items []interface{}) []cloudflare.ListItemCreat
 
 	return listItems
 }
+
+func buildListItemRedirect(r map[string]interface{}) *cloudflare.Redirect {
+	var statusCode *int = nil
+
+	stringToOptBool := func(s string) *bool {
+		switch s {
+		case "enabled":
+			return cloudflare.BoolPtr(true)
+		case "disabled":
+			return cloudflare.BoolPtr(false)
+		default:
+			return nil
+		}
+	}
+
+	vint := r["status_code"].(int)
+	if vint != 0 {
+		statusCode = cloudflare.IntPtr(vint)
+	}
+
+	return &cloudflare.Redirect{
+		SourceUrl:           r["source_url"].(string),
+		IncludeSubdomains:   stringToOptBool(r["include_subdomains"].(string)),
+		TargetUrl:           r["target_url"].(string),
+		StatusCode:          statusCode,
+		PreserveQueryString: stringToOptBool(r["preserve_query_string"].(string)),
+		SubpathMatching:     stringToOptBool(r["subpath_matching"].(string)),
+		PreservePathSuffix:  stringToOptBool(r["preserve_path_suffix"].(string)),
+	}
+}
+
+func flattenListItemRedirect(redirect *cloudflare.Redirect) map[string]interface{} {
+	optBoolToString := func(b *bool) string {
+		if b != nil {
+			switch *b {
+			case true:
+				return "enabled"
+			case false:
+				return "disabled"
+			}
+		}
+		return ""
+	}
+
+	statusCode := 0
+	if redirect.StatusCode != nil {
+		statusCode = *redirect.StatusCode
+	}
+
+	return map[string]interface{}{
+		"source_url":            redirect.SourceUrl,
+		"include_subdomains":    optBoolToString(redirect.IncludeSubdomains),
+		"target_url":            redirect.TargetUrl,
+		"status_code":           statusCode,
+		"preserve_query_string": optBoolToString(redirect.PreserveQueryString),
+		"subpath_matching":      optBoolToString(redirect.SubpathMatching),
+		"preserve_path_suffix":  optBoolToString(redirect.PreservePathSuffix),
+	}
+}
+
+// writeListItems sends items to the list in batches the API accepts, waiting
+// for each bulk operation to complete before sending the next. When replace is
+// set the first batch replaces the existing items and the rest are appended,
+// replacing them with no items removes all items of the list.
+//
+// Batches which were written are kept when a later one fails, so the error
+// says how many items the list holds and callers read the items back into
+// state.
+func writeListItems(ctx context.Context, client *cloudflare.API, accountID, listID string, items []cloudflare.ListItemCreateRequest, replace bool, timeout time.Duration) error {
+	if replace && len(items) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Removing all items of List %s", listID))
+
+		res, err := client.ReplaceListItemsAsync(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListReplaceItemsParams{
+			ID:    listID,
+			Items: []cloudflare.ListItemCreateRequest{},
+		})
+		if err != nil {
+			return err
+		}
+		return pollListBulkOperation(ctx, client, accountID, res.Result.OperationID, timeout)
+	}
+
+	for start := 0; start < len(items); start += listItemsBatchSize {
+		end := start + listItemsBatchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		batch := items[start:end]
+
+		tflog.Debug(ctx, fmt.Sprintf("Writing items %d to %d of %d for List %s", start+1, end, len(items), listID))
+
+		var res cloudflare.ListItemCreateResponse
+		var err error
+		if replace && start == 0 {
+			res, err = client.ReplaceListItemsAsync(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListReplaceItemsParams{
+				ID:    listID,
+				Items: batch,
+			})
+		} else {
+			res, err = client.CreateListItemsAsync(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListCreateItemsParams{
+				ID:    listID,
+				Items: batch,
+			})
+		}
+		if err == nil {
+			err = pollListBulkOperation(ctx, client, accountID, res.Result.OperationID, timeout)
+		}
+		if err != nil {
+			if start > 0 {
+				return fmt.Errorf("only the first %d of %d items were written to List %s: %w", start, len(items), listID, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pollListBulkOperation waits for an asynchronous list items operation to
+// complete, returning the error reported by the API if it fails.
+func pollListBulkOperation(ctx context.Context, client *cloudflare.API, accountID, operationID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		operation, err := client.GetListBulkOperation(ctx, cloudflare.AccountIdentifier(accountID), operationID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error fetching list bulk operation %s: %w", operationID, err))
+		}
+
+		switch operation.Status {
+		case "completed":
+			return nil
+		case "pending", "running":
+			return resource.RetryableError(fmt.Errorf("list bulk operation %s is %s", operationID, operation.Status))
+		case "failed":
+			if operation.Error == "" {
+				return resource.NonRetryableError(fmt.Errorf("list bulk operation %s failed", operationID))
+			}
+			return resource.NonRetryableError(fmt.Errorf("list bulk operation %s failed: %s", operationID, operation.Error))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("list bulk operation %s has unexpected status %q", operationID, operation.Status))
+		}
+	})
+}
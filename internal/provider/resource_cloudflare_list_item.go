@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// listItem is a single item of a list. cloudflare.ListItem doesn't support
+// hostname or ASN items so items are (de)serialised here instead.
+type listItem struct {
+	ID       string               `json:"id,omitempty"`
+	IP       *string              `json:"ip,omitempty"`
+	Redirect *cloudflare.Redirect `json:"redirect,omitempty"`
+	Hostname *listItemHostname    `json:"hostname,omitempty"`
+	ASN      *int                 `json:"asn,omitempty"`
+	Comment  string               `json:"comment,omitempty"`
+}
+
+type listItemHostname struct {
+	URLHostname string `json:"url_hostname"`
+}
+
+// listItemsOperation is the response from the asynchronous list items
+// endpoints.
+type listItemsOperation struct {
+	OperationID string `json:"operation_id"`
+}
+
+func resourceCloudflareListItem() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareListItemSchema(),
+		CreateContext: resourceCloudflareListItemCreate,
+		ReadContext:   resourceCloudflareListItemRead,
+		DeleteContext: resourceCloudflareListItemDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareListItemImport,
+		},
+		Description: heredoc.Doc(`
+			Provides individual list items (IPs, Redirects, ASNs, Hostnames)
+			to be used in Edge Rules Engine across all zones within the same
+			account. The parent ` + "`cloudflare_list`" + ` must not define
+			any inline ` + "`item`" + ` blocks.
+		`),
+	}
+}
+
+func resourceCloudflareListItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	item := buildListItem(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare List Item from struct: %+v", item))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/rules/lists/%s/items", accountID, listID), []listItem{item}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating List Item: %w", err))
+	}
+
+	var operation listItemsOperation
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling List Item operation: %w", err))
+	}
+
+	if err := pollListBulkOperation(ctx, client, accountID, operation.OperationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating List Item: %w", err))
+	}
+
+	// the API only returns the operation so the item has to be looked up to
+	// find its ID.
+	created, err := findListItem(ctx, client, accountID, listID, item)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareListItemRead(ctx, d, meta)
+}
+
+func resourceCloudflareListItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rules/lists/%s/items/%s", accountID, listID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("List Item %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading List Item with ID %q: %w", d.Id(), err))
+	}
+
+	var item listItem
+	if err := json.Unmarshal(res, &item); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling List Item: %w", err))
+	}
+
+	if item.IP != nil {
+		d.Set("ip", *item.IP)
+	}
+
+	if item.ASN != nil {
+		d.Set("asn", *item.ASN)
+	}
+
+	if item.Hostname != nil {
+		d.Set("hostname", []map[string]interface{}{{
+			"url_hostname": item.Hostname.URLHostname,
+		}})
+	}
+
+	if item.Redirect != nil {
+		d.Set("redirect", []map[string]interface{}{flattenListItemRedirect(item.Redirect)})
+	}
+
+	d.Set("comment", item.Comment)
+
+	return nil
+}
+
+func resourceCloudflareListItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	listID := d.Get("list_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare List Item: %s", d.Id()))
+
+	res, err := client.DeleteListItemsAsync(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListDeleteItemsParams{
+		ID: listID,
+		Items: cloudflare.ListItemDeleteRequest{
+			Items: []cloudflare.ListItemDeleteItemRequest{{ID: d.Id()}},
+		},
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting List Item with ID %q: %w", d.Id(), err))
+	}
+
+	if err := pollListBulkOperation(ctx, client, accountID, res.Result.OperationID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting List Item with ID %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareListItemImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/listID/itemID\"", d.Id())
+	}
+
+	accountID, listID, itemID := attributes[0], attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare List Item: id %s for list %s in account %s", itemID, listID, accountID))
+
+	d.Set("account_id", accountID)
+	d.Set("list_id", listID)
+	d.SetId(itemID)
+
+	resourceCloudflareListItemRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildListItem(d *schema.ResourceData) listItem {
+	item := listItem{
+		Comment: d.Get("comment").(string),
+	}
+
+	if ip, ok := d.GetOk("ip"); ok {
+		item.IP = cloudflare.StringPtr(ip.(string))
+	}
+
+	if asn, ok := d.GetOk("asn"); ok {
+		item.ASN = cloudflare.IntPtr(asn.(int))
+	}
+
+	if hostname, ok := d.GetOk("hostname"); ok {
+		item.Hostname = &listItemHostname{
+			URLHostname: hostname.([]interface{})[0].(map[string]interface{})["url_hostname"].(string),
+		}
+	}
+
+	if redirect, ok := d.GetOk("redirect"); ok {
+		item.Redirect = buildListItemRedirect(redirect.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return item
+}
+
+// findListItem searches the list for an item with the same value as item.
+func findListItem(ctx context.Context, client *cloudflare.API, accountID, listID string, item listItem) (listItem, error) {
+	var search string
+	switch {
+	case item.IP != nil:
+		search = *item.IP
+	case item.ASN != nil:
+		search = strconv.Itoa(*item.ASN)
+	case item.Hostname != nil:
+		search = item.Hostname.URLHostname
+	case item.Redirect != nil:
+		search = item.Redirect.SourceUrl
+	}
+
+	params := url.Values{}
+	params.Set("search", search)
+	params.Set("per_page", "500")
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rules/lists/%s/items?%s", accountID, listID, params.Encode()), nil, nil)
+	if err != nil {
+		return listItem{}, fmt.Errorf("error searching List Items: %w", err)
+	}
+
+	var items []listItem
+	if err := json.Unmarshal(res, &items); err != nil {
+		return listItem{}, fmt.Errorf("error unmarshalling List Items: %w", err)
+	}
+
+	for _, i := range items {
+		switch {
+		case item.IP != nil && i.IP != nil && *i.IP == *item.IP,
+			item.ASN != nil && i.ASN != nil && *i.ASN == *item.ASN,
+			item.Hostname != nil && i.Hostname != nil && i.Hostname.URLHostname == item.Hostname.URLHostname,
+			item.Redirect != nil && i.Redirect != nil && i.Redirect.SourceUrl == item.Redirect.SourceUrl:
+			return i, nil
+		}
+	}
+
+	return listItem{}, fmt.Errorf("unable to find created List Item %q", search)
+}
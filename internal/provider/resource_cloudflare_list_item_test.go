@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareListItem_IP(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	listName := fmt.Sprintf("cloudflare_list.%s", rnd)
+	name := fmt.Sprintf("cloudflare_list_item.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareIPListItem(rnd, accountID, "192.0.2.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.0"),
+					resource.TestCheckResourceAttr(name, "comment", rnd),
+					resource.TestCheckResourceAttrPair(name, "list_id", listName, "id"),
+					resource.TestCheckNoResourceAttr(listName, "item.#"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareIPListItem(rnd, accountID, "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ip", "192.0.2.1"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCloudflareListItemImportStateIdFunc(name, accountID),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareListItem_Redirect(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list_item.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRedirectListItem(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "redirect.#", "1"),
+					resource.TestCheckResourceAttr(name, "redirect.0.source_url", "example.com/"),
+					resource.TestCheckResourceAttr(name, "redirect.0.target_url", "https://example1.com"),
+					resource.TestCheckResourceAttr(name, "redirect.0.status_code", "301"),
+					resource.TestCheckResourceAttr(name, "redirect.0.include_subdomains", "enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareListItemImportStateIdFunc(name, accountID string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", accountID, rs.Primary.Attributes["list_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckCloudflareIPListItem(ID, accountID, ip string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_list" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "list with items managed separately"
+  kind        = "ip"
+}
+
+resource "cloudflare_list_item" "%[1]s" {
+  account_id = "%[2]s"
+  list_id    = cloudflare_list.%[1]s.id
+  ip         = "%[3]s"
+  comment    = "%[1]s"
+}`, ID, accountID, ip)
+}
+
+func testAccCheckCloudflareRedirectListItem(ID, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_list" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "list with items managed separately"
+  kind        = "redirect"
+}
+
+resource "cloudflare_list_item" "%[1]s" {
+  account_id = "%[2]s"
+  list_id    = cloudflare_list.%[1]s.id
+
+  redirect {
+    source_url         = "example.com/"
+    target_url         = "https://example1.com"
+    status_code        = 301
+    include_subdomains = "enabled"
+  }
+}`, ID, accountID)
+}
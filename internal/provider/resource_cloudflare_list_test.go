@@ -2,15 +2,192 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestWriteListItemsBatches(t *testing.T) {
+	var requests []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/bulk_operations/") {
+			testAPIResult(w, `{"id":"op","status":"completed"}`)
+			return
+		}
+
+		var items []cloudflare.ListItemCreateRequest
+		json.NewDecoder(r.Body).Decode(&items)
+		requests = append(requests, fmt.Sprintf("%s %d", r.Method, len(items)))
+
+		testAPIResult(w, `{"operation_id":"op"}`)
+	})
+
+	items := make([]cloudflare.ListItemCreateRequest, 2500)
+	for i := range items {
+		items[i].IP = cloudflare.StringPtr(fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+
+	if err := writeListItems(context.Background(), client, "account", "list", items, true, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{"PUT 1000", "POST 1000", "POST 500"}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestWriteListItemsSurfacesOperationError(t *testing.T) {
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/bulk_operations/") {
+			testAPIResult(w, `{"id":"op","status":"failed","error":"invalid IP address: 10.0.0.256"}`)
+			return
+		}
+
+		testAPIResult(w, `{"operation_id":"op"}`)
+	})
+
+	items := []cloudflare.ListItemCreateRequest{{IP: cloudflare.StringPtr("10.0.0.256")}}
+
+	err := writeListItems(context.Background(), client, "account", "list", items, false, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "invalid IP address: 10.0.0.256") {
+		t.Fatalf("expected the operation error to be returned, got %v", err)
+	}
+}
+
+func TestWriteListItemsReportsPartialWrite(t *testing.T) {
+	var writes int
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/bulk_operations/") {
+			if strings.HasSuffix(r.URL.Path, "/op-1") {
+				testAPIResult(w, `{"id":"op-1","status":"completed"}`)
+				return
+			}
+			testAPIResult(w, `{"id":"op-2","status":"failed","error":"too many items"}`)
+			return
+		}
+
+		writes++
+		testAPIResult(w, fmt.Sprintf(`{"operation_id":"op-%d"}`, writes))
+	})
+
+	items := make([]cloudflare.ListItemCreateRequest, 1500)
+	for i := range items {
+		items[i].IP = cloudflare.StringPtr(fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+
+	err := writeListItems(context.Background(), client, "account", "list", items, true, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "only the first 1000 of 1500 items were written to List list") || !strings.Contains(err.Error(), "too many items") {
+		t.Fatalf("expected the partial write to be reported, got %v", err)
+	}
+}
+
+func TestCloudflareListUpdateReadsItemsAfterFailedWrite(t *testing.T) {
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/account/rules/lists/list":
+			testAPIResult(w, `{"id":"list","name":"list","kind":"ip"}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/account/rules/lists/list/items":
+			testAPIResult(w, `{"operation_id":"op"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account/rules/lists/bulk_operations/op":
+			testAPIResult(w, `{"id":"op","status":"failed","error":"invalid IP address: 10.0.0.256"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account/rules/lists/list/items":
+			testAPIResultWithInfo(w, `[{"id":"item","ip":"10.0.0.1"}]`, `{"cursors":{}}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareListSchema(), map[string]interface{}{
+		"account_id": "account",
+		"name":       "list",
+		"kind":       "ip",
+		"item": []interface{}{
+			map[string]interface{}{"value": []interface{}{map[string]interface{}{"ip": "10.0.0.2"}}},
+			map[string]interface{}{"value": []interface{}{map[string]interface{}{"ip": "10.0.0.256"}}},
+		},
+	})
+	d.SetId("list")
+
+	diags := resourceCloudflareListUpdate(context.Background(), d, client)
+	if !diags.HasError() || !strings.Contains(diags[len(diags)-1].Summary, "invalid IP address: 10.0.0.256") {
+		t.Fatalf("expected the write error to be returned, got %v", diags)
+	}
+
+	items := d.Get("item").(*schema.Set).List()
+	if len(items) != 1 || items[0].(map[string]interface{})["value"].([]interface{})[0].(map[string]interface{})["ip"] != "10.0.0.1" {
+		t.Errorf("expected the items of the list to be read back, got %v", items)
+	}
+}
+
+func TestCloudflareListUpdateRemovesAllItems(t *testing.T) {
+	var replaced []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/account/rules/lists/list":
+			testAPIResult(w, `{"id":"list","name":"list","kind":"ip"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account/rules/lists/list":
+			testAPIResult(w, `{"id":"list","name":"list","kind":"ip"}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/account/rules/lists/list/items":
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			replaced = append(replaced, string(body))
+			testAPIResult(w, `{"operation_id":"op"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/account/rules/lists/bulk_operations/op":
+			testAPIResult(w, `{"id":"op","status":"completed"}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := resourceCloudflareList()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"account_id": "account",
+		"name":       "list",
+		"kind":       "ip",
+		"item": []interface{}{
+			map[string]interface{}{"value": []interface{}{map[string]interface{}{"ip": "10.0.0.1"}}},
+		},
+	})
+	d.SetId("list")
+
+	state := d.State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": "account",
+		"name":       "list",
+		"kind":       "ip",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(replaced) != 1 || replaced[0] != "[]" {
+		t.Errorf("expected the items to be replaced with none, got %v", replaced)
+	}
+	if got := newState.Attributes["item.#"]; got != "0" {
+		t.Errorf("expected no items in state, got %q", got)
+	}
+}
+
 func TestAccCloudflareList_Exists(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
 	// endpoint does not yet support the API tokens.
@@ -358,6 +358,10 @@ func flattenSessionAffinityAttrs(attrs *cloudflare.SessionAffinityAttributes) ma
 }
 
 func flattenAdaptiveRouting(properties *cloudflare.AdaptiveRouting) *schema.Set {
+	if properties == nil {
+		return schema.NewSet(schema.HashResource(loadBalancerAdaptiveRoutingElem), []interface{}{})
+	}
+
 	flattened := []interface{}{
 		map[string]interface{}{
 			"failover_across_pools": bool(properties.FailoverAcrossPools != nil && *properties.FailoverAcrossPools),
@@ -367,6 +371,10 @@ func flattenAdaptiveRouting(properties *cloudflare.AdaptiveRouting) *schema.Set
 }
 
 func flattenLocationStrategy(properties *cloudflare.LocationStrategy) *schema.Set {
+	if properties == nil {
+		return schema.NewSet(schema.HashResource(loadBalancerLocationStrategyElem), []interface{}{})
+	}
+
 	flattened := []interface{}{
 		map[string]interface{}{
 			"prefer_ecs": properties.PreferECS,
@@ -377,6 +385,10 @@ func flattenLocationStrategy(properties *cloudflare.LocationStrategy) *schema.Se
 }
 
 func flattenRandomSteering(properties *cloudflare.RandomSteering) *schema.Set {
+	if properties == nil {
+		return schema.NewSet(schema.HashResource(loadBalancerRandomSteeringElem), []interface{}{})
+	}
+
 	poolWeights := make(map[string]interface{})
 	for poolID, poolWeight := range properties.PoolWeights {
 		poolWeights[poolID] = poolWeight
@@ -473,8 +485,8 @@ func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule)
 				om["session_affinity"] = o.Persistence
 				m["overrides"] = []interface{}{om}
 			}
-			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_ttl", idx)); ok {
-				om["session_affinity"] = o.PersistenceTTL
+			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_ttl", idx)); ok && o.PersistenceTTL != nil {
+				om["session_affinity_ttl"] = int(*o.PersistenceTTL)
 				m["overrides"] = []interface{}{om}
 			}
 			if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.ttl", idx)); ok {
@@ -649,11 +661,15 @@ func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
 					for k, v := range l[0].(map[string]interface{}) {
 						switch k {
 						case "prefer_ecs":
-							lsOverride.PreferECS = v.(string)
-							lbr.Overrides.LocationStrategy = lsOverride
+							if v.(string) != "" {
+								lsOverride.PreferECS = v.(string)
+								lbr.Overrides.LocationStrategy = lsOverride
+							}
 						case "mode":
-							lsOverride.Mode = v.(string)
-							lbr.Overrides.LocationStrategy = lsOverride
+							if v.(string) != "" {
+								lsOverride.Mode = v.(string)
+								lbr.Overrides.LocationStrategy = lsOverride
+							}
 						}
 					}
 				}
@@ -669,11 +685,15 @@ func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
 							for poolID, poolWeight := range v.(map[string]interface{}) {
 								poolWeights[poolID] = poolWeight.(float64)
 							}
-							rsOverride.PoolWeights = poolWeights
-							lbr.Overrides.RandomSteering = rsOverride
+							if len(poolWeights) > 0 {
+								rsOverride.PoolWeights = poolWeights
+								lbr.Overrides.RandomSteering = rsOverride
+							}
 						case "default_weight":
-							rsOverride.DefaultWeight = v.(float64)
-							lbr.Overrides.RandomSteering = rsOverride
+							if v.(float64) != 0 {
+								rsOverride.DefaultWeight = v.(float64)
+								lbr.Overrides.RandomSteering = rsOverride
+							}
 						}
 					}
 				}
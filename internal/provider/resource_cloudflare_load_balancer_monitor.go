@@ -2,8 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-
+	"net/http"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -14,6 +15,34 @@ import (
 	"github.com/pkg/errors"
 )
 
+// loadBalancerMonitor is a load balancer monitor as exchanged with the API.
+// Unlike cloudflare.LoadBalancerMonitor, HTTP specific fields are omitted when
+// empty and the health thresholds are included.
+type loadBalancerMonitor struct {
+	ID              string              `json:"id,omitempty"`
+	CreatedOn       *time.Time          `json:"created_on,omitempty"`
+	ModifiedOn      *time.Time          `json:"modified_on,omitempty"`
+	Type            string              `json:"type"`
+	Description     string              `json:"description"`
+	Method          string              `json:"method,omitempty"`
+	Path            string              `json:"path,omitempty"`
+	Header          map[string][]string `json:"header,omitempty"`
+	Timeout         int                 `json:"timeout"`
+	Retries         int                 `json:"retries"`
+	Interval        int                 `json:"interval"`
+	ConsecutiveUp   int                 `json:"consecutive_up,omitempty"`
+	ConsecutiveDown int                 `json:"consecutive_down,omitempty"`
+	Port            uint16              `json:"port,omitempty"`
+	ExpectedBody    string              `json:"expected_body,omitempty"`
+	ExpectedCodes   string              `json:"expected_codes,omitempty"`
+	FollowRedirects bool                `json:"follow_redirects,omitempty"`
+	AllowInsecure   bool                `json:"allow_insecure,omitempty"`
+	ProbeZone       string              `json:"probe_zone,omitempty"`
+}
+
+// loadBalancerMonitorHTTPFields are only valid for http and https monitors.
+var loadBalancerMonitorHTTPFields = []string{"allow_insecure", "expected_body", "expected_codes", "follow_redirects", "header", "probe_zone"}
+
 func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareLoadBalancerMonitorSchema(),
@@ -24,11 +53,12 @@ func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceCloudflareLoadBalancerMonitorValidateType,
 		Description: heredoc.Doc(`
 			If Cloudflare's Load Balancing to load-balance across multiple
 			origin servers or data centers, you configure one of these Monitors
-			to actively check the availability of those servers over HTTP(S) or
-			TCP.
+			to actively check the availability of those servers over HTTP(S),
+			TCP, UDP, ICMP or SMTP.
 		`),
 	}
 }
@@ -36,68 +66,7 @@ func resourceCloudflareLoadBalancerMonitor() *schema.Resource {
 func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
-		Timeout:  d.Get("timeout").(int),
-		Type:     d.Get("type").(string),
-		Interval: d.Get("interval").(int),
-		Retries:  d.Get("retries").(int),
-	}
-
-	if description, ok := d.GetOk("description"); ok {
-		loadBalancerMonitor.Description = description.(string)
-	}
-
-	if port, ok := d.GetOk("port"); ok {
-		loadBalancerMonitor.Port = uint16(port.(int))
-	}
-
-	switch loadBalancerMonitor.Type {
-	case "tcp":
-		if method, ok := d.GetOk("method"); ok {
-			loadBalancerMonitor.Method = method.(string)
-		} else {
-			loadBalancerMonitor.Method = "connection_established"
-		}
-	case "http", "https":
-		if allowInsecure, ok := d.GetOk("allow_insecure"); ok {
-			loadBalancerMonitor.AllowInsecure = allowInsecure.(bool)
-		}
-
-		expectedBody := d.Get("expected_body")
-		loadBalancerMonitor.ExpectedBody = expectedBody.(string)
-
-		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
-			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
-		} else {
-			return diag.FromErr(fmt.Errorf("expected_codes must be set"))
-		}
-
-		if followRedirects, ok := d.GetOk("follow_redirects"); ok {
-			loadBalancerMonitor.FollowRedirects = followRedirects.(bool)
-		}
-
-		if method, ok := d.GetOk("method"); ok {
-			loadBalancerMonitor.Method = method.(string)
-		} else {
-			loadBalancerMonitor.Method = "GET"
-		}
-
-		if header, ok := d.GetOk("header"); ok {
-			loadBalancerMonitor.Header = expandLoadBalancerMonitorHeader(header)
-		}
-
-		if path, ok := d.GetOk("path"); ok {
-			loadBalancerMonitor.Path = path.(string)
-		} else {
-			loadBalancerMonitor.Path = "/"
-		}
-
-		if probeZone, ok := d.GetOk("probe_zone"); ok {
-			loadBalancerMonitor.ProbeZone = probeZone.(string)
-		} else {
-			loadBalancerMonitor.ProbeZone = ""
-		}
-	}
+	loadBalancerMonitor := buildLoadBalancerMonitor(d)
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
 
@@ -105,7 +74,7 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *sch
 	if accountID == "" {
 		accountID = client.AccountID
 	}
-	r, err := client.CreateLoadBalancerMonitor(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateLoadBalancerMonitorParams{LoadBalancerMonitor: loadBalancerMonitor})
+	r, err := writeLoadBalancerMonitor(ctx, client, accountID, http.MethodPost, loadBalancerMonitor)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer monitor"))
 	}
@@ -124,12 +93,36 @@ func resourceCloudflareLoadBalancerPoolMonitorCreate(ctx context.Context, d *sch
 func resourceCloudflareLoadBalancerPoolMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerMonitor := cloudflare.LoadBalancerMonitor{
-		ID:       d.Id(),
-		Timeout:  d.Get("timeout").(int),
-		Type:     d.Get("type").(string),
-		Interval: d.Get("interval").(int),
-		Retries:  d.Get("retries").(int),
+	loadBalancerMonitor := buildLoadBalancerMonitor(d)
+	loadBalancerMonitor.ID = d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Update Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = client.AccountID
+	}
+	_, err := writeLoadBalancerMonitor(ctx, client, accountID, http.MethodPut, loadBalancerMonitor)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error modifying load balancer monitor"))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Cloudflare Load Balancer Monitor %q was modified", d.Id()))
+
+	return resourceCloudflareLoadBalancerPoolMonitorRead(ctx, d, meta)
+}
+
+// buildLoadBalancerMonitor builds the monitor to send to the API. HTTP
+// specific fields are only populated for http and https monitors as the API
+// rejects them for the other types.
+func buildLoadBalancerMonitor(d *schema.ResourceData) loadBalancerMonitor {
+	loadBalancerMonitor := loadBalancerMonitor{
+		Timeout:         d.Get("timeout").(int),
+		Type:            d.Get("type").(string),
+		Interval:        d.Get("interval").(int),
+		Retries:         d.Get("retries").(int),
+		ConsecutiveUp:   d.Get("consecutive_up").(int),
+		ConsecutiveDown: d.Get("consecutive_down").(int),
 	}
 
 	if description, ok := d.GetOk("description"); ok {
@@ -148,30 +141,16 @@ func resourceCloudflareLoadBalancerPoolMonitorUpdate(ctx context.Context, d *sch
 			loadBalancerMonitor.Method = "connection_established"
 		}
 	case "http", "https":
-		if allowInsecure, ok := d.GetOk("allow_insecure"); ok {
-			loadBalancerMonitor.AllowInsecure = allowInsecure.(bool)
-		}
-
-		if expectedBody, ok := d.GetOk("expected_body"); ok {
-			loadBalancerMonitor.ExpectedBody = expectedBody.(string)
-		} else {
-			loadBalancerMonitor.ExpectedBody = ""
-		}
-
-		if expectedCodes, ok := d.GetOk("expected_codes"); ok {
-			loadBalancerMonitor.ExpectedCodes = expectedCodes.(string)
-		} else {
-			return diag.FromErr(fmt.Errorf("expected_codes must be set"))
-		}
+		loadBalancerMonitor.AllowInsecure = d.Get("allow_insecure").(bool)
+		loadBalancerMonitor.ExpectedBody = d.Get("expected_body").(string)
+		loadBalancerMonitor.ExpectedCodes = d.Get("expected_codes").(string)
+		loadBalancerMonitor.FollowRedirects = d.Get("follow_redirects").(bool)
+		loadBalancerMonitor.ProbeZone = d.Get("probe_zone").(string)
 
 		if header, ok := d.GetOk("header"); ok {
 			loadBalancerMonitor.Header = expandLoadBalancerMonitorHeader(header)
 		}
 
-		if followRedirects, ok := d.GetOk("follow_redirects"); ok {
-			loadBalancerMonitor.FollowRedirects = followRedirects.(bool)
-		}
-
 		if method, ok := d.GetOk("method"); ok {
 			loadBalancerMonitor.Method = method.(string)
 		} else {
@@ -183,28 +162,40 @@ func resourceCloudflareLoadBalancerPoolMonitorUpdate(ctx context.Context, d *sch
 		} else {
 			loadBalancerMonitor.Path = "/"
 		}
-
-		if probeZone, ok := d.GetOk("probe_zone"); ok {
-			loadBalancerMonitor.ProbeZone = probeZone.(string)
-		} else {
-			loadBalancerMonitor.ProbeZone = ""
-		}
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Update Cloudflare Load Balancer Monitor from struct: %+v", loadBalancerMonitor))
+	return loadBalancerMonitor
+}
 
-	accountID := d.Get("account_id").(string)
-	if accountID == "" {
-		accountID = client.AccountID
-	}
-	_, err := client.UpdateLoadBalancerMonitor(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.UpdateLoadBalancerMonitorParams{LoadBalancerMonitor: loadBalancerMonitor})
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, "error modifying load balancer monitor"))
+// resourceCloudflareLoadBalancerMonitorValidateType rejects fields that don't
+// apply to the configured monitor type at plan time.
+func resourceCloudflareLoadBalancerMonitorValidateType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	monitorType := d.Get("type").(string)
+
+	switch monitorType {
+	case "http", "https":
+		if d.NewValueKnown("expected_codes") && d.Get("expected_codes").(string) == "" {
+			return fmt.Errorf("expected_codes must be set for %s monitors", monitorType)
+		}
+	default:
+		for _, field := range loadBalancerMonitorHTTPFields {
+			if v, ok := d.GetOk(field); ok {
+				if b, isBool := v.(bool); isBool && !b {
+					continue
+				}
+				return fmt.Errorf("%s is only valid for http and https monitors, not %s", field, monitorType)
+			}
+		}
 	}
 
-	tflog.Info(ctx, fmt.Sprintf("Cloudflare Load Balancer Monitor %q was modified", d.Id()))
+	switch monitorType {
+	case "tcp", "udp_icmp":
+		if d.NewValueKnown("port") && d.Get("port").(int) == 0 {
+			return fmt.Errorf("port must be set for %s monitors", monitorType)
+		}
+	}
 
-	return resourceCloudflareLoadBalancerPoolMonitorRead(ctx, d, meta)
+	return nil
 }
 
 func expandLoadBalancerMonitorHeader(cfgSet interface{}) map[string][]string {
@@ -224,7 +215,7 @@ func resourceCloudflareLoadBalancerPoolMonitorRead(ctx context.Context, d *schem
 	if accountID == "" {
 		accountID = client.AccountID
 	}
-	loadBalancerMonitor, err := client.GetLoadBalancerMonitor(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	loadBalancerMonitor, err := getLoadBalancerMonitor(ctx, client, accountID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -256,6 +247,8 @@ func resourceCloudflareLoadBalancerPoolMonitorRead(ctx context.Context, d *schem
 	d.Set("method", loadBalancerMonitor.Method)
 	d.Set("port", int(loadBalancerMonitor.Port))
 	d.Set("retries", loadBalancerMonitor.Retries)
+	d.Set("consecutive_up", loadBalancerMonitor.ConsecutiveUp)
+	d.Set("consecutive_down", loadBalancerMonitor.ConsecutiveDown)
 	d.Set("timeout", loadBalancerMonitor.Timeout)
 	d.Set("type", loadBalancerMonitor.Type)
 	d.Set("created_on", loadBalancerMonitor.CreatedOn.Format(time.RFC3339Nano))
@@ -276,6 +269,49 @@ func flattenLoadBalancerMonitorHeader(header map[string][]string) *schema.Set {
 	return schema.NewSet(HashByMapKey("header"), flattened)
 }
 
+func loadBalancerMonitorURI(accountID string) string {
+	if accountID == "" {
+		return "/user/load_balancers/monitors"
+	}
+
+	return fmt.Sprintf("/accounts/%s/load_balancers/monitors", accountID)
+}
+
+func getLoadBalancerMonitor(ctx context.Context, client *cloudflare.API, accountID, monitorID string) (loadBalancerMonitor, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", loadBalancerMonitorURI(accountID), monitorID), nil, nil)
+	if err != nil {
+		return loadBalancerMonitor{}, err
+	}
+
+	var monitor loadBalancerMonitor
+	if err := json.Unmarshal(res, &monitor); err != nil {
+		return loadBalancerMonitor{}, fmt.Errorf("error unmarshalling load balancer monitor: %w", err)
+	}
+
+	return monitor, nil
+}
+
+// writeLoadBalancerMonitor creates (POST) or updates (PUT) a load balancer
+// monitor.
+func writeLoadBalancerMonitor(ctx context.Context, client *cloudflare.API, accountID, method string, monitor loadBalancerMonitor) (loadBalancerMonitor, error) {
+	uri := loadBalancerMonitorURI(accountID)
+	if method != http.MethodPost {
+		uri = fmt.Sprintf("%s/%s", uri, monitor.ID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, monitor, nil)
+	if err != nil {
+		return loadBalancerMonitor{}, err
+	}
+
+	var result loadBalancerMonitor
+	if err := json.Unmarshal(res, &result); err != nil {
+		return loadBalancerMonitor{}, fmt.Errorf("error unmarshalling load balancer monitor: %w", err)
+	}
+
+	return result, nil
+}
+
 func resourceCloudflareLoadBalancerPoolMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
@@ -12,6 +15,7 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -202,6 +206,160 @@ func TestAccCloudflareLoadBalancerMonitor_NoRequired(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancerMonitor_SmartChecks(t *testing.T) {
+	var loadBalancerMonitor cloudflare.LoadBalancerMonitor
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_load_balancer_monitor.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLoadBalancerMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerMonitorConfigSmartChecks(rnd, 3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerMonitorExists(name, &loadBalancerMonitor),
+					resource.TestCheckResourceAttr(name, "type", "icmp_ping"),
+					resource.TestCheckResourceAttr(name, "consecutive_up", "3"),
+					resource.TestCheckResourceAttr(name, "consecutive_down", "2"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareLoadBalancerMonitorConfigSmartChecks(rnd, 1, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerMonitorExists(name, &loadBalancerMonitor),
+					resource.TestCheckResourceAttr(name, "consecutive_up", "1"),
+					resource.TestCheckResourceAttr(name, "consecutive_down", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareLoadBalancerMonitor_TypeValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareLoadBalancerMonitorConfigICMPWithExpectedCodes(),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("expected_codes is only valid for http and https monitors, not icmp_ping")),
+			},
+			{
+				Config:      testAccCheckCloudflareLoadBalancerMonitorConfigTCPWithoutPort(),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("port must be set for tcp monitors")),
+			},
+		},
+	})
+}
+
+func TestLoadBalancerMonitorRoundTrip(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const monitorID = "f1aba936b94213e5b8dca0c0dbf1f9cc"
+
+	testCases := map[string]struct {
+		config       map[string]interface{}
+		sentFields   []string
+		absentFields []string
+		state        map[string]interface{}
+	}{
+		"http": {
+			config: map[string]interface{}{
+				"type":           "http",
+				"expected_codes": "2xx",
+				"expected_body":  "alive",
+				"probe_zone":     "example.com",
+			},
+			sentFields: []string{"expected_codes", "expected_body", "probe_zone", "method", "path"},
+			state:      map[string]interface{}{"method": "GET", "path": "/", "expected_codes": "2xx", "probe_zone": "example.com"},
+		},
+		"https": {
+			config: map[string]interface{}{
+				"type":             "https",
+				"expected_codes":   "200",
+				"follow_redirects": true,
+				"allow_insecure":   true,
+			},
+			sentFields: []string{"expected_codes", "follow_redirects", "allow_insecure"},
+			state:      map[string]interface{}{"follow_redirects": true, "allow_insecure": true},
+		},
+		"tcp": {
+			config:       map[string]interface{}{"type": "tcp", "port": 8080},
+			sentFields:   []string{"port", "method"},
+			absentFields: []string{"expected_codes", "expected_body", "path", "header", "follow_redirects", "allow_insecure", "probe_zone"},
+			state:        map[string]interface{}{"method": "connection_established", "port": 8080},
+		},
+		"udp_icmp": {
+			config:       map[string]interface{}{"type": "udp_icmp", "port": 53, "consecutive_down": 3},
+			sentFields:   []string{"port", "consecutive_down"},
+			absentFields: []string{"expected_codes", "expected_body", "method", "path", "header", "follow_redirects", "allow_insecure", "probe_zone"},
+			state:        map[string]interface{}{"port": 53, "consecutive_down": 3},
+		},
+		"icmp_ping": {
+			config:       map[string]interface{}{"type": "icmp_ping", "consecutive_up": 2},
+			sentFields:   []string{"consecutive_up"},
+			absentFields: []string{"expected_codes", "expected_body", "method", "path", "header", "port", "follow_redirects", "allow_insecure", "probe_zone"},
+			state:        map[string]interface{}{"consecutive_up": 2, "consecutive_down": 1},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var sent map[string]interface{}
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/accounts/"+accountID+"/load_balancers/monitors":
+					body, _ := io.ReadAll(r.Body)
+					if err := json.Unmarshal(body, &sent); err != nil {
+						t.Fatal(err)
+					}
+					testAPIResult(w, fmt.Sprintf(`{"id": "%s"}`, monitorID))
+				case r.Method == http.MethodGet && r.URL.Path == "/accounts/"+accountID+"/load_balancers/monitors/"+monitorID:
+					result := map[string]interface{}{
+						"id":          monitorID,
+						"created_on":  "2014-01-01T05:20:00.12345Z",
+						"modified_on": "2014-01-01T05:20:00.12345Z",
+					}
+					for k, v := range sent {
+						result[k] = v
+					}
+					testAPIResultJSON(w, result)
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			config := map[string]interface{}{"account_id": accountID}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, resourceCloudflareLoadBalancerMonitorSchema(), config)
+
+			if diags := resourceCloudflareLoadBalancerPoolMonitorCreate(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			for _, field := range tc.sentFields {
+				if _, ok := sent[field]; !ok {
+					t.Errorf("expected %s to be sent, got %v", field, sent)
+				}
+			}
+			for _, field := range tc.absentFields {
+				if v, ok := sent[field]; ok {
+					t.Errorf("expected %s not to be sent for %s monitors, got %v", field, name, v)
+				}
+			}
+			for field, expected := range tc.state {
+				if got := d.Get(field); got != expected {
+					t.Errorf("expected %s to be %v in state, got %v", field, expected, got)
+				}
+			}
+		})
+	}
+}
+
 func TestAccCloudflareLoadBalancerMonitor_Update(t *testing.T) {
 	var loadBalancerMonitor cloudflare.LoadBalancerMonitor
 	var initialId string
@@ -482,6 +640,35 @@ resource "cloudflare_load_balancer_monitor" "%s" {
 }`, resourceName)
 }
 
+func testAccCheckCloudflareLoadBalancerMonitorConfigSmartChecks(resourceName string, consecutiveUp, consecutiveDown int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_monitor" "%[1]s" {
+  type             = "icmp_ping"
+  timeout          = 2
+  interval         = 60
+  retries          = 2
+  consecutive_up   = %[2]d
+  consecutive_down = %[3]d
+  description      = "test setup smart checks"
+}`, resourceName, consecutiveUp, consecutiveDown)
+}
+
+func testAccCheckCloudflareLoadBalancerMonitorConfigICMPWithExpectedCodes() string {
+	return `
+resource "cloudflare_load_balancer_monitor" "test" {
+  type           = "icmp_ping"
+  expected_codes = "2xx"
+}`
+}
+
+func testAccCheckCloudflareLoadBalancerMonitorConfigTCPWithoutPort() string {
+	return `
+resource "cloudflare_load_balancer_monitor" "test" {
+  type   = "tcp"
+  method = "connection_established"
+}`
+}
+
 func testAccCheckCloudflareLoadBalancerMonitorConfigMissingRequired() string {
 	return `
 resource "cloudflare_load_balancer_monitor" "test" {
@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
-
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -15,6 +20,20 @@ import (
 	"github.com/pkg/errors"
 )
 
+// loadBalancerPool extends cloudflare.LoadBalancerPool with origins that
+// support fields not yet available in cloudflare-go.
+type loadBalancerPool struct {
+	cloudflare.LoadBalancerPool
+	Origins []loadBalancerOrigin `json:"origins"`
+}
+
+// loadBalancerOrigin extends cloudflare.LoadBalancerOrigin with the virtual
+// network used to reach origins on private IP addresses.
+type loadBalancerOrigin struct {
+	cloudflare.LoadBalancerOrigin
+	VirtualNetworkID string `json:"virtual_network_id,omitempty"`
+}
+
 func resourceCloudflareLoadBalancerPool() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareLoadBalancerPoolSchema(),
@@ -35,45 +54,7 @@ func resourceCloudflareLoadBalancerPool() *schema.Resource {
 func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerPool := cloudflare.LoadBalancerPool{
-		Name:           d.Get("name").(string),
-		Origins:        expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
-		Enabled:        d.Get("enabled").(bool),
-		MinimumOrigins: d.Get("minimum_origins").(int),
-	}
-
-	if lat, ok := d.GetOk("latitude"); ok {
-		f := float32(lat.(float64))
-		loadBalancerPool.Latitude = &f
-	}
-	if long, ok := d.GetOk("longitude"); ok {
-		f := float32(long.(float64))
-		loadBalancerPool.Longitude = &f
-	}
-
-	if checkRegions, ok := d.GetOk("check_regions"); ok {
-		loadBalancerPool.CheckRegions = expandInterfaceToStringList(checkRegions.(*schema.Set).List())
-	}
-
-	if description, ok := d.GetOk("description"); ok {
-		loadBalancerPool.Description = description.(string)
-	}
-
-	if monitor, ok := d.GetOk("monitor"); ok {
-		loadBalancerPool.Monitor = monitor.(string)
-	}
-
-	if shed, ok := d.GetOk("load_shedding"); ok {
-		loadBalancerPool.LoadShedding = expandLoadBalancerLoadShedding(shed.(*schema.Set))
-	}
-
-	if steering, ok := d.GetOk("origin_steering"); ok {
-		loadBalancerPool.OriginSteering = expandLoadBalancerOriginSteering(steering.(*schema.Set))
-	}
-
-	if notificationEmail, ok := d.GetOk("notification_email"); ok {
-		loadBalancerPool.NotificationEmail = notificationEmail.(string)
-	}
+	loadBalancerPool := buildLoadBalancerPool(d)
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
 
@@ -82,13 +63,13 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 		accountID = client.AccountID
 	}
 
-	r, err := client.CreateLoadBalancerPool(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateLoadBalancerPoolParams{LoadBalancerPool: loadBalancerPool})
+	r, err := writeLoadBalancerPool(ctx, client, accountID, http.MethodPost, loadBalancerPool)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating load balancer pool"))
 	}
 
 	if r.ID == "" {
-		return diag.FromErr(fmt.Errorf("cailed to find id in create response; resource was empty"))
+		return diag.FromErr(fmt.Errorf("failed to find id in create response; resource was empty"))
 	}
 
 	d.SetId(r.ID)
@@ -101,12 +82,31 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	loadBalancerPool := cloudflare.LoadBalancerPool{
-		ID:             d.Id(),
-		Name:           d.Get("name").(string),
-		Origins:        expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
-		Enabled:        d.Get("enabled").(bool),
-		MinimumOrigins: d.Get("minimum_origins").(int),
+	loadBalancerPool := buildLoadBalancerPool(d)
+	loadBalancerPool.ID = d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		accountID = client.AccountID
+	}
+	_, err := writeLoadBalancerPool(ctx, client, accountID, http.MethodPut, loadBalancerPool)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error updating load balancer pool"))
+	}
+
+	return resourceCloudflareLoadBalancerPoolRead(ctx, d, meta)
+}
+
+func buildLoadBalancerPool(d *schema.ResourceData) loadBalancerPool {
+	loadBalancerPool := loadBalancerPool{
+		LoadBalancerPool: cloudflare.LoadBalancerPool{
+			Name:           d.Get("name").(string),
+			Enabled:        d.Get("enabled").(bool),
+			MinimumOrigins: d.Get("minimum_origins").(int),
+		},
+		Origins: expandLoadBalancerOrigins(d.Get("origins").(*schema.Set)),
 	}
 
 	if lat, ok := d.GetOk("latitude"); ok {
@@ -142,18 +142,7 @@ func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.Res
 		loadBalancerPool.NotificationEmail = notificationEmail.(string)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Load Balancer Pool from struct: %+v", loadBalancerPool))
-
-	accountID := d.Get("account_id").(string)
-	if accountID == "" {
-		accountID = client.AccountID
-	}
-	_, err := client.UpdateLoadBalancerPool(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.UpdateLoadBalancerPoolParams{LoadBalancer: loadBalancerPool})
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, "error updating load balancer pool"))
-	}
-
-	return resourceCloudflareLoadBalancerPoolRead(ctx, d, meta)
+	return loadBalancerPool
 }
 
 func expandLoadBalancerPoolHeader(cfgSet interface{}) map[string][]string {
@@ -207,14 +196,20 @@ func expandLoadBalancerOriginSteering(s *schema.Set) *cloudflare.LoadBalancerOri
 	return nil
 }
 
-func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.LoadBalancerOrigin) {
+func expandLoadBalancerOrigins(originSet *schema.Set) (origins []loadBalancerOrigin) {
 	for _, iface := range originSet.List() {
 		o := iface.(map[string]interface{})
-		origin := cloudflare.LoadBalancerOrigin{
-			Name:    o["name"].(string),
-			Address: o["address"].(string),
-			Enabled: o["enabled"].(bool),
-			Weight:  o["weight"].(float64),
+		origin := loadBalancerOrigin{
+			LoadBalancerOrigin: cloudflare.LoadBalancerOrigin{
+				Name:    o["name"].(string),
+				Address: o["address"].(string),
+				Enabled: o["enabled"].(bool),
+				Weight:  o["weight"].(float64),
+			},
+		}
+
+		if virtualNetworkID, ok := o["virtual_network_id"]; ok {
+			origin.VirtualNetworkID = virtualNetworkID.(string)
 		}
 
 		if header, ok := o["header"]; ok {
@@ -234,7 +229,7 @@ func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.Resou
 		accountID = client.AccountID
 	}
 
-	loadBalancerPool, err := client.GetLoadBalancerPool(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	loadBalancerPool, err := getLoadBalancerPool(ctx, client, accountID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -306,20 +301,98 @@ func flattenLoadBalancerOriginSteering(os *cloudflare.LoadBalancerOriginSteering
 	}})
 }
 
-func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.LoadBalancerOrigin) *schema.Set {
+func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []loadBalancerOrigin) *schema.Set {
 	flattened := make([]interface{}, 0)
 	for _, o := range origins {
 		cfg := map[string]interface{}{
-			"name":    o.Name,
-			"address": o.Address,
-			"enabled": o.Enabled,
-			"weight":  o.Weight,
-			"header":  flattenLoadBalancerPoolHeader(o.Header),
+			"name":               o.Name,
+			"address":            o.Address,
+			"enabled":            o.Enabled,
+			"weight":             normalizeLoadBalancerOriginWeight(o.Weight),
+			"header":             flattenLoadBalancerPoolHeader(o.Header),
+			"virtual_network_id": o.VirtualNetworkID,
 		}
 
 		flattened = append(flattened, cfg)
 	}
-	return schema.NewSet(schema.HashResource(originsElem), flattened)
+	return schema.NewSet(hashLoadBalancerPoolOrigin, flattened)
+}
+
+// normalizeLoadBalancerOriginWeight drops the trailing precision the API
+// adds to origin weights.
+func normalizeLoadBalancerOriginWeight(weight float64) float64 {
+	return math.Round(weight*10000) / 10000
+}
+
+// hashLoadBalancerPoolOrigin hashes every field of an origin, including the
+// values of its headers, so that any change to an origin is detected.
+func hashLoadBalancerPoolOrigin(v interface{}) int {
+	m := v.(map[string]interface{})
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", m["name"]))
+	buf.WriteString(fmt.Sprintf("%s-", m["address"]))
+	buf.WriteString(fmt.Sprintf("%t-", m["enabled"]))
+	buf.WriteString(fmt.Sprintf("%s-", strconv.FormatFloat(normalizeLoadBalancerOriginWeight(m["weight"].(float64)), 'f', -1, 64)))
+	if virtualNetworkID, ok := m["virtual_network_id"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", virtualNetworkID))
+	}
+
+	if header, ok := m["header"].(*schema.Set); ok {
+		headers := make([]string, 0, header.Len())
+		for _, h := range header.List() {
+			hm := h.(map[string]interface{})
+			values := expandInterfaceToStringList(hm["values"].(*schema.Set).List())
+			sort.Strings(values)
+			headers = append(headers, fmt.Sprintf("%s=%s", hm["header"], strings.Join(values, ",")))
+		}
+		sort.Strings(headers)
+		buf.WriteString(strings.Join(headers, ";"))
+	}
+
+	return schema.HashString(buf.String())
+}
+
+func loadBalancerPoolURI(accountID string) string {
+	if accountID == "" {
+		return "/user/load_balancers/pools"
+	}
+
+	return fmt.Sprintf("/accounts/%s/load_balancers/pools", accountID)
+}
+
+func getLoadBalancerPool(ctx context.Context, client *cloudflare.API, accountID, poolID string) (loadBalancerPool, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", loadBalancerPoolURI(accountID), poolID), nil, nil)
+	if err != nil {
+		return loadBalancerPool{}, err
+	}
+
+	var pool loadBalancerPool
+	if err := json.Unmarshal(res, &pool); err != nil {
+		return loadBalancerPool{}, fmt.Errorf("error unmarshalling load balancer pool: %w", err)
+	}
+
+	return pool, nil
+}
+
+// writeLoadBalancerPool creates (POST) or updates (PUT) a load balancer pool.
+func writeLoadBalancerPool(ctx context.Context, client *cloudflare.API, accountID, method string, pool loadBalancerPool) (loadBalancerPool, error) {
+	uri := loadBalancerPoolURI(accountID)
+	if method != http.MethodPost {
+		uri = fmt.Sprintf("%s/%s", uri, pool.ID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, pool, nil)
+	if err != nil {
+		return loadBalancerPool{}, err
+	}
+
+	var result loadBalancerPool
+	if err := json.Unmarshal(res, &result); err != nil {
+		return loadBalancerPool{}, fmt.Errorf("error unmarshalling load balancer pool: %w", err)
+	}
+
+	return result, nil
 }
 
 func resourceCloudflareLoadBalancerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
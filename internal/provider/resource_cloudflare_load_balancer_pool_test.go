@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
@@ -12,6 +15,7 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -314,3 +318,114 @@ resource "cloudflare_load_balancer_pool" "%[1]s" {
 }`, id, headerValue)
 	// TODO add field to config after creating monitor resource
 }
+
+func TestCloudflareLoadBalancerPoolCreateWithVirtualNetwork(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const poolID = "17b5962d775c646f3f9725cbc7a53df4"
+	const virtualNetworkID = "a5624d4e-044a-4ff0-b3e1-e2465353d4b4"
+
+	var sent map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/"+accountID+"/load_balancers/pools":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, fmt.Sprintf(`{"id": "%s"}`, poolID))
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/"+accountID+"/load_balancers/pools/"+poolID:
+			testAPIResult(w, fmt.Sprintf(`{
+  "id": "%s",
+  "created_on": "2014-01-01T05:20:00.12345Z",
+  "modified_on": "2014-02-01T05:20:00.12345Z",
+  "name": "private-pool",
+  "enabled": true,
+  "minimum_origins": 1,
+  "origin_steering": {"policy": "least_outstanding_requests"},
+  "origins": [{
+    "name": "private-origin",
+    "address": "10.0.0.1",
+    "enabled": true,
+    "weight": 0.30000001192092896,
+    "virtual_network_id": "%s",
+    "header": {"Host": ["internal.example.com"]}
+  }]
+}`, poolID, virtualNetworkID))
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLoadBalancerPoolSchema(), map[string]interface{}{
+		"account_id": accountID,
+		"name":       "private-pool",
+		"origins": []interface{}{map[string]interface{}{
+			"name":               "private-origin",
+			"address":            "10.0.0.1",
+			"weight":             0.3,
+			"virtual_network_id": virtualNetworkID,
+			"header": []interface{}{map[string]interface{}{
+				"header": "Host",
+				"values": []interface{}{"internal.example.com"},
+			}},
+		}},
+		"origin_steering": []interface{}{map[string]interface{}{
+			"policy": "least_outstanding_requests",
+		}},
+	})
+
+	if diags := resourceCloudflareLoadBalancerPoolCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	origins, ok := sent["origins"].([]interface{})
+	if !ok || len(origins) != 1 {
+		t.Fatalf("expected one origin to be sent, got %v", sent["origins"])
+	}
+	if got := origins[0].(map[string]interface{})["virtual_network_id"]; got != virtualNetworkID {
+		t.Errorf("expected virtual_network_id %q to be sent, got %v", virtualNetworkID, got)
+	}
+
+	stateOrigins := d.Get("origins").(*schema.Set).List()
+	if len(stateOrigins) != 1 {
+		t.Fatalf("expected one origin in state, got %d", len(stateOrigins))
+	}
+	origin := stateOrigins[0].(map[string]interface{})
+	if origin["weight"] != 0.3 {
+		t.Errorf("expected the origin weight to be normalised to 0.3, got %v", origin["weight"])
+	}
+	if origin["virtual_network_id"] != virtualNetworkID {
+		t.Errorf("expected virtual_network_id %q in state, got %v", virtualNetworkID, origin["virtual_network_id"])
+	}
+	if got := d.Get("origin_steering").(*schema.Set).List()[0].(map[string]interface{})["policy"]; got != "least_outstanding_requests" {
+		t.Errorf("expected origin_steering policy least_outstanding_requests, got %v", got)
+	}
+}
+
+func TestHashLoadBalancerPoolOrigin(t *testing.T) {
+	origin := func(weight float64, hostValue, virtualNetworkID string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":               "origin",
+			"address":            "192.0.2.1",
+			"enabled":            true,
+			"weight":             weight,
+			"virtual_network_id": virtualNetworkID,
+			"header": flattenLoadBalancerPoolHeader(map[string][]string{
+				"Host": {hostValue},
+			}),
+		}
+	}
+
+	base := hashLoadBalancerPoolOrigin(origin(1, "a.example.com", ""))
+
+	if got := hashLoadBalancerPoolOrigin(origin(1.0000000001, "a.example.com", "")); got != base {
+		t.Error("expected weights differing only in trailing precision to hash equally")
+	}
+	if got := hashLoadBalancerPoolOrigin(origin(1, "b.example.com", "")); got == base {
+		t.Error("expected a change of the Host header value to change the hash")
+	}
+	if got := hashLoadBalancerPoolOrigin(origin(1, "a.example.com", "a5624d4e-044a-4ff0-b3e1-e2465353d4b4")); got == base {
+		t.Error("expected a change of virtual_network_id to change the hash")
+	}
+}
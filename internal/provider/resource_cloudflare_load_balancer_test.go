@@ -15,6 +15,7 @@ import (
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -756,3 +757,68 @@ resource "cloudflare_load_balancer" "%[3]s" {
   }
 }`, zoneID, zone, id)
 }
+
+func TestFlattenLoadBalancerSteeringNil(t *testing.T) {
+	if got := flattenAdaptiveRouting(nil).Len(); got != 0 {
+		t.Errorf("expected no adaptive_routing, got %d", got)
+	}
+	if got := flattenLocationStrategy(nil).Len(); got != 0 {
+		t.Errorf("expected no location_strategy, got %d", got)
+	}
+	if got := flattenRandomSteering(nil).Len(); got != 0 {
+		t.Errorf("expected no random_steering, got %d", got)
+	}
+}
+
+func TestExpandLoadBalancerRulesOverridesSteering(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLoadBalancerSchema(), map[string]interface{}{
+		"zone_id":          "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":             "example.com",
+		"fallback_pool_id": "17b5962d775c646f3f9725cbc7a53df4",
+		"default_pool_ids": []interface{}{"17b5962d775c646f3f9725cbc7a53df4"},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"name":      "steering",
+				"condition": "dns.qry.type == 28",
+				"overrides": []interface{}{map[string]interface{}{
+					"adaptive_routing":  []interface{}{map[string]interface{}{"failover_across_pools": true}},
+					"location_strategy": []interface{}{map[string]interface{}{"mode": "resolver_ip"}},
+					"random_steering": []interface{}{map[string]interface{}{
+						"pool_weights": map[string]interface{}{"17b5962d775c646f3f9725cbc7a53df4": 0.5},
+					}},
+				}},
+			},
+			map[string]interface{}{
+				"name":      "empty",
+				"condition": "dns.qry.type == 1",
+				"overrides": []interface{}{map[string]interface{}{
+					"location_strategy": []interface{}{map[string]interface{}{}},
+					"random_steering":   []interface{}{map[string]interface{}{}},
+				}},
+			},
+		},
+	})
+
+	rules, err := expandRules(d.Get("rules"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overrides := rules[0].Overrides
+	if overrides.AdaptiveRouting == nil || overrides.AdaptiveRouting.FailoverAcrossPools == nil || !*overrides.AdaptiveRouting.FailoverAcrossPools {
+		t.Errorf("expected adaptive_routing.failover_across_pools to be true, got %+v", overrides.AdaptiveRouting)
+	}
+	if overrides.LocationStrategy == nil || overrides.LocationStrategy.Mode != "resolver_ip" || overrides.LocationStrategy.PreferECS != "" {
+		t.Errorf("expected only location_strategy.mode to be set, got %+v", overrides.LocationStrategy)
+	}
+	if overrides.RandomSteering == nil || overrides.RandomSteering.PoolWeights["17b5962d775c646f3f9725cbc7a53df4"] != 0.5 || overrides.RandomSteering.DefaultWeight != 0 {
+		t.Errorf("expected only random_steering.pool_weights to be set, got %+v", overrides.RandomSteering)
+	}
+
+	if rules[1].Overrides.LocationStrategy != nil {
+		t.Errorf("expected an empty location_strategy override not to be sent, got %+v", rules[1].Overrides.LocationStrategy)
+	}
+	if rules[1].Overrides.RandomSteering != nil {
+		t.Errorf("expected an empty random_steering override not to be sent, got %+v", rules[1].Overrides.RandomSteering)
+	}
+}
@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -16,6 +21,78 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// logpushJob is a Logpush job including the output options and upload
+// limits which cloudflare.LogpushJob doesn't expose. The filter is kept as
+// the JSON string sent to and returned by the API, an empty string removes
+// the filter of the job.
+type logpushJob struct {
+	ID                       int                   `json:"id,omitempty"`
+	Dataset                  string                `json:"dataset"`
+	Enabled                  bool                  `json:"enabled"`
+	Kind                     string                `json:"kind,omitempty"`
+	Name                     string                `json:"name"`
+	LogpullOptions           string                `json:"logpull_options"`
+	OutputOptions            *logpushOutputOptions `json:"output_options,omitempty"`
+	DestinationConf          string                `json:"destination_conf"`
+	OwnershipChallenge       string                `json:"ownership_challenge,omitempty"`
+	Frequency                string                `json:"frequency,omitempty"`
+	Filter                   *string               `json:"filter,omitempty"`
+	MaxUploadBytes           int                   `json:"max_upload_bytes"`
+	MaxUploadRecords         int                   `json:"max_upload_records"`
+	MaxUploadIntervalSeconds int                   `json:"max_upload_interval_seconds"`
+}
+
+// logpushOutputOptions is the structured replacement for logpull_options.
+type logpushOutputOptions struct {
+	BatchPrefix     string   `json:"batch_prefix,omitempty"`
+	BatchSuffix     string   `json:"batch_suffix,omitempty"`
+	CVE202144228    *bool    `json:"CVE-2021-44228,omitempty"`
+	FieldDelimiter  string   `json:"field_delimiter,omitempty"`
+	FieldNames      []string `json:"field_names,omitempty"`
+	OutputType      string   `json:"output_type,omitempty"`
+	RecordDelimiter string   `json:"record_delimiter,omitempty"`
+	RecordPrefix    string   `json:"record_prefix,omitempty"`
+	RecordSuffix    string   `json:"record_suffix,omitempty"`
+	RecordTemplate  string   `json:"record_template,omitempty"`
+	SampleRate      float64  `json:"sample_rate,omitempty"`
+	TimestampFormat string   `json:"timestamp_format,omitempty"`
+}
+
+// logpushJobAccountDatasets are the datasets which are only available to
+// account scoped Logpush jobs.
+var logpushJobAccountDatasets = []string{
+	"access_requests",
+	"audit_logs",
+	"casb_findings",
+	"device_posture_results",
+	"dns_firewall_logs",
+	"gateway_dns",
+	"gateway_http",
+	"gateway_network",
+	"magic_ids_detections",
+	"network_analytics_logs",
+	"workers_trace_events",
+	"zero_trust_network_sessions",
+}
+
+// logpushJobZoneDatasets are the datasets which are only available to zone
+// scoped Logpush jobs.
+var logpushJobZoneDatasets = []string{
+	"dns_logs",
+	"firewall_events",
+	"http_requests",
+	"nel_reports",
+	"page_shield_events",
+	"spectrum_events",
+}
+
+// logpushJobNoOwnershipChallengeRegex matches the destinations which don't
+// require an ownership challenge.
+var logpushJobNoOwnershipChallengeRegex = regexp.MustCompile(`^((datadog|splunk|https|r2)://|s3://.+endpoint=)`)
+
+// logpushJobDestinationRegex matches the supported destination_conf schemes.
+var logpushJobDestinationRegex = regexp.MustCompile(`^(s3|gs|azure|sumo|splunk|datadog|https|r2)://.+`)
+
 func resourceCloudflareLogpushJob() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareLogpushJobSchema(),
@@ -26,6 +103,7 @@ func resourceCloudflareLogpushJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareLogpushJobImport,
 		},
+		CustomizeDiff: resourceCloudflareLogpushJobValidateDataset,
 		Description: heredoc.Doc(`
 			Provides a resource which manages Cloudflare Logpush jobs. For
 			Logpush jobs pushing to Amazon S3, Google Cloud Storage, Microsoft
@@ -41,57 +119,232 @@ func resourceCloudflareLogpushJob() *schema.Resource {
 	}
 }
 
-func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessIdentifier, error) {
+func getJobFromResource(d *schema.ResourceData, client *cloudflare.API) (logpushJob, *AccessIdentifier, error) {
 	id := 0
 
-	identifier, err := initIdentifier(d)
+	identifier, err := logpushJobIdentifier(d, client)
 	if err != nil {
-		return cloudflare.LogpushJob{}, identifier, err
+		return logpushJob{}, identifier, err
 	}
 
 	if d.Id() != "" {
 		var err error
 		if id, err = strconv.Atoi(d.Id()); err != nil {
-			return cloudflare.LogpushJob{}, identifier, fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err)
+			return logpushJob{}, identifier, fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err)
 		}
 	}
 
 	destConf := d.Get("destination_conf").(string)
 	ownershipChallenge := d.Get("ownership_challenge").(string)
-	var re = regexp.MustCompile(`^((datadog|splunk|https|r2)://|s3://.+endpoint=)`)
-
-	if ownershipChallenge == "" && !re.MatchString(destConf) {
-		return cloudflare.LogpushJob{}, identifier, fmt.Errorf("ownership_challenge must be set for the provided destination_conf")
+	if ownershipChallenge == "" && !logpushJobNoOwnershipChallengeRegex.MatchString(destConf) {
+		return logpushJob{}, identifier, fmt.Errorf("ownership_challenge must be set for the provided destination_conf")
 	}
 
-	job := cloudflare.LogpushJob{
-		ID:                 id,
-		Enabled:            d.Get("enabled").(bool),
-		Kind:               d.Get("kind").(string),
-		Name:               d.Get("name").(string),
-		Dataset:            d.Get("dataset").(string),
-		LogpullOptions:     d.Get("logpull_options").(string),
-		DestinationConf:    destConf,
-		OwnershipChallenge: ownershipChallenge,
-		Frequency:          d.Get("frequency").(string),
+	job := logpushJob{
+		ID:                       id,
+		Enabled:                  d.Get("enabled").(bool),
+		Kind:                     d.Get("kind").(string),
+		Name:                     d.Get("name").(string),
+		Dataset:                  d.Get("dataset").(string),
+		LogpullOptions:           d.Get("logpull_options").(string),
+		OutputOptions:            buildLogpushOutputOptions(d),
+		DestinationConf:          destConf,
+		OwnershipChallenge:       ownershipChallenge,
+		Frequency:                d.Get("frequency").(string),
+		MaxUploadBytes:           d.Get("max_upload_bytes").(int),
+		MaxUploadRecords:         d.Get("max_upload_records").(int),
+		MaxUploadIntervalSeconds: d.Get("max_upload_interval_seconds").(int),
 	}
 
-	filter := d.Get("filter")
+	filter := d.Get("filter").(string)
 	if filter != "" {
 		var jobFilter cloudflare.LogpushJobFilters
-		if err := json.Unmarshal([]byte(filter.(string)), &jobFilter); err != nil {
-			return cloudflare.LogpushJob{}, identifier, err
+		if err := json.Unmarshal([]byte(filter), &jobFilter); err != nil {
+			return logpushJob{}, identifier, err
 		}
 		err := jobFilter.Where.Validate()
 		if err != nil {
 			return job, identifier, err
 		}
-		job.Filter = &jobFilter
+
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, []byte(filter)); err != nil {
+			return logpushJob{}, identifier, err
+		}
+		job.Filter = cloudflare.StringPtr(compacted.String())
+	} else if old, _ := d.GetChange("filter"); old.(string) != "" {
+		job.Filter = cloudflare.StringPtr("")
 	}
 
 	return job, identifier, nil
 }
 
+// logpushJobDatasets returns every dataset supported by Logpush jobs.
+func logpushJobDatasets() []string {
+	datasets := append([]string{}, logpushJobAccountDatasets...)
+	datasets = append(datasets, logpushJobZoneDatasets...)
+	sort.Strings(datasets)
+
+	return datasets
+}
+
+// logpushJobIdentifier returns the identifier the job is managed under.
+// Account only datasets are routed to the account endpoints using the
+// provider level account ID when the resource is configured with a zone.
+func logpushJobIdentifier(d *schema.ResourceData, client *cloudflare.API) (*AccessIdentifier, error) {
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return identifier, err
+	}
+
+	if identifier.Type == ZoneType && isLogpushJobAccountDataset(d.Get("dataset").(string)) && client.AccountID != "" {
+		return &AccessIdentifier{Type: AccountType, Value: client.AccountID}, nil
+	}
+
+	return identifier, nil
+}
+
+func isLogpushJobAccountDataset(dataset string) bool {
+	return contains(logpushJobAccountDatasets, dataset)
+}
+
+func isLogpushJobZoneDataset(dataset string) bool {
+	return contains(logpushJobZoneDatasets, dataset)
+}
+
+// resourceCloudflareLogpushJobValidateDataset rejects datasets which aren't
+// available to the configured account or zone at plan time.
+func resourceCloudflareLogpushJobValidateDataset(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("dataset") || !d.NewValueKnown("account_id") || !d.NewValueKnown("zone_id") {
+		return nil
+	}
+
+	dataset := d.Get("dataset").(string)
+
+	if isLogpushJobZoneDataset(dataset) && d.Get("account_id").(string) != "" {
+		return fmt.Errorf("dataset %q is only available to zone scoped Logpush jobs; use zone_id instead of account_id", dataset)
+	}
+
+	if isLogpushJobAccountDataset(dataset) && d.Get("zone_id").(string) != "" {
+		if client, ok := meta.(*cloudflare.API); ok && client.AccountID != "" {
+			return nil
+		}
+		return fmt.Errorf("dataset %q is only available to account scoped Logpush jobs; use account_id instead of zone_id", dataset)
+	}
+
+	return nil
+}
+
+func validateLogpushJobDestinationConf(v interface{}, k string) (warnings []string, errors []error) {
+	destination := v.(string)
+	if !logpushJobDestinationRegex.MatchString(destination) {
+		errors = append(errors, fmt.Errorf("%q must be a URI using one of the s3, gs, azure, sumo, splunk, datadog, https or r2 schemes, got %q", k, destination))
+		return
+	}
+
+	if strings.HasPrefix(destination, "r2://") {
+		u, err := url.Parse(destination)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q must be a valid URI: %w", k, err))
+			return
+		}
+		if u.Query().Get("account-id") == "" {
+			errors = append(errors, fmt.Errorf("%q must include the account-id parameter for R2 destinations, such as \"r2://bucket/{DATE}?account-id=...\"", k))
+		}
+	}
+
+	return
+}
+
+func buildLogpushOutputOptions(d *schema.ResourceData) *logpushOutputOptions {
+	if _, ok := d.GetOk("output_options"); !ok {
+		return nil
+	}
+
+	cve202144228 := d.Get("output_options.0.cve20214428").(bool)
+	options := &logpushOutputOptions{
+		BatchPrefix:     d.Get("output_options.0.batch_prefix").(string),
+		BatchSuffix:     d.Get("output_options.0.batch_suffix").(string),
+		CVE202144228:    &cve202144228,
+		FieldDelimiter:  d.Get("output_options.0.field_delimiter").(string),
+		OutputType:      d.Get("output_options.0.output_type").(string),
+		RecordDelimiter: d.Get("output_options.0.record_delimiter").(string),
+		RecordPrefix:    d.Get("output_options.0.record_prefix").(string),
+		RecordSuffix:    d.Get("output_options.0.record_suffix").(string),
+		RecordTemplate:  d.Get("output_options.0.record_template").(string),
+		SampleRate:      d.Get("output_options.0.sample_rate").(float64),
+		TimestampFormat: d.Get("output_options.0.timestamp_format").(string),
+	}
+
+	for _, name := range d.Get("output_options.0.field_names").([]interface{}) {
+		options.FieldNames = append(options.FieldNames, name.(string))
+	}
+
+	return options
+}
+
+func flattenLogpushOutputOptions(options *logpushOutputOptions) []interface{} {
+	if options == nil {
+		return nil
+	}
+
+	cve202144228 := false
+	if options.CVE202144228 != nil {
+		cve202144228 = *options.CVE202144228
+	}
+
+	return []interface{}{map[string]interface{}{
+		"batch_prefix":     options.BatchPrefix,
+		"batch_suffix":     options.BatchSuffix,
+		"cve20214428":      cve202144228,
+		"field_delimiter":  options.FieldDelimiter,
+		"field_names":      options.FieldNames,
+		"output_type":      options.OutputType,
+		"record_delimiter": options.RecordDelimiter,
+		"record_prefix":    options.RecordPrefix,
+		"record_suffix":    options.RecordSuffix,
+		"record_template":  options.RecordTemplate,
+		"sample_rate":      options.SampleRate,
+		"timestamp_format": options.TimestampFormat,
+	}}
+}
+
+// flattenLogpushJobFilter returns the filter to store in state, keeping the
+// configured representation when it is equivalent to the API value.
+func flattenLogpushJobFilter(current, remote string) string {
+	if logpushJobFiltersEqual(current, remote) {
+		return current
+	}
+
+	return remote
+}
+
+// logpushJobFiltersEqual reports whether two JSON filters are semantically
+// equal, ignoring whitespace and key order.
+func logpushJobFiltersEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if a == "" || b == "" {
+		return false
+	}
+
+	var aFilter, bFilter interface{}
+	if err := json.Unmarshal([]byte(a), &aFilter); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bFilter); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aFilter, bFilter)
+}
+
+func suppressEquivalentLogpushJobFilter(k, old, new string, d *schema.ResourceData) bool {
+	return logpushJobFiltersEqual(old, new)
+}
+
 func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	jobID, err := strconv.Atoi(d.Id())
@@ -99,16 +352,12 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err))
 	}
 
-	var job cloudflare.LogpushJob
-	identifier, err := initIdentifier(d)
+	identifier, err := logpushJobIdentifier(d, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if identifier.Type == AccountType {
-		job, err = client.GetAccountLogpushJob(ctx, identifier.Value, jobID)
-	} else {
-		job, err = client.GetZoneLogpushJob(ctx, identifier.Value, jobID)
-	}
+
+	job, err := getLogpushJob(ctx, client, identifier, jobID)
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -124,25 +373,18 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
-	var filter string
-
-	if job.Filter != nil {
-		b, err := json.Marshal(job.Filter)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		filter = string(b)
-	}
-
 	d.Set("name", job.Name)
 	d.Set("kind", job.Kind)
 	d.Set("enabled", job.Enabled)
 	d.Set("logpull_options", job.LogpullOptions)
+	d.Set("output_options", flattenLogpushOutputOptions(job.OutputOptions))
 	d.Set("destination_conf", job.DestinationConf)
 	d.Set("ownership_challenge", d.Get("ownership_challenge"))
 	d.Set("frequency", job.Frequency)
-	d.Set("filter", filter)
+	d.Set("filter", flattenLogpushJobFilter(d.Get("filter").(string), cloudflare.String(job.Filter)))
+	d.Set("max_upload_bytes", job.MaxUploadBytes)
+	d.Set("max_upload_records", job.MaxUploadRecords)
+	d.Set("max_upload_interval_seconds", job.MaxUploadIntervalSeconds)
 
 	return nil
 }
@@ -150,19 +392,14 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	job, identifier, err := getJobFromResource(d)
+	job, identifier, err := getJobFromResource(d, client)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing logpush job from resource: %w", err))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Logpush job for %s from struct: %+v", identifier, job))
 
-	var j *cloudflare.LogpushJob
-	if identifier.Type == AccountType {
-		j, err = client.CreateAccountLogpushJob(ctx, identifier.Value, job)
-	} else {
-		j, err = client.CreateZoneLogpushJob(ctx, identifier.Value, job)
-	}
+	j, err := writeLogpushJob(ctx, client, identifier, http.MethodPost, job)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating logpush job for %s: %w", identifier, err))
 	}
@@ -180,20 +417,14 @@ func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceD
 func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	job, identifier, err := getJobFromResource(d)
+	job, identifier, err := getJobFromResource(d, client)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing logpush job from resource: %w", err))
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Logpush job for %s from struct: %+v", identifier, job))
 
-	if identifier.Type == AccountType {
-		err = client.UpdateAccountLogpushJob(ctx, identifier.Value, job.ID, job)
-	} else {
-		err = client.UpdateZoneLogpushJob(ctx, identifier.Value, job.ID, job)
-	}
-
-	if err != nil {
+	if _, err := writeLogpushJob(ctx, client, identifier, http.MethodPut, job); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating logpush job id %q for %s: %w", job.ID, identifier, err))
 	}
 
@@ -203,7 +434,7 @@ func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceD
 func resourceCloudflareLogpushJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	job, identifier, err := getJobFromResource(d)
+	job, identifier, err := getJobFromResource(d, client)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing logpush job from resource: %w", err))
 	}
@@ -260,3 +491,41 @@ func resourceCloudflareLogpushJobImport(ctx context.Context, d *schema.ResourceD
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func logpushJobURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/logpush/jobs", identifier.Type, identifier.Value)
+}
+
+func getLogpushJob(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, jobID int) (logpushJob, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%d", logpushJobURI(identifier), jobID), nil, nil)
+	if err != nil {
+		return logpushJob{}, err
+	}
+
+	var job logpushJob
+	if err := json.Unmarshal(res, &job); err != nil {
+		return logpushJob{}, fmt.Errorf("error unmarshalling Logpush job: %w", err)
+	}
+
+	return job, nil
+}
+
+// writeLogpushJob creates (POST) or updates (PUT) a Logpush job.
+func writeLogpushJob(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, method string, job logpushJob) (logpushJob, error) {
+	uri := logpushJobURI(identifier)
+	if method == http.MethodPut {
+		uri = fmt.Sprintf("%s/%d", uri, job.ID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, job, nil)
+	if err != nil {
+		return logpushJob{}, err
+	}
+
+	var result logpushJob
+	if err := json.Unmarshal(res, &result); err != nil {
+		return logpushJob{}, fmt.Errorf("error unmarshalling Logpush job: %w", err)
+	}
+
+	return result, nil
+}
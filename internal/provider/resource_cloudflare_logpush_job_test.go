@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareLogpushJob_ZoneDatasetWithAccount(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareLogpushJobDataset(rnd, "account_id", accountID, "dns_logs"),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(`dataset "dns_logs" is only available to zone scoped Logpush jobs`)),
+			},
+		},
+	})
+}
+
+func testAccCloudflareLogpushJobDataset(rnd, identifierType, identifier, dataset string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_logpush_job" "%[1]s" {
+  %[2]s       = "%[3]s"
+  name             = "%[1]s"
+  dataset          = "%[4]s"
+  destination_conf = "r2://%[1]s/{DATE}?account-id=%[3]s"
+}
+`, rnd, identifierType, identifier, dataset)
+}
+
+func TestLogpushJobIdentifierRoutesAccountDatasets(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	client, err := cloudflare.New("deadbeef", "test@example.com", cloudflare.UsingAccount(accountID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		dataset  string
+		expected AccessIdentifier
+	}{
+		"zone dataset":    {dataset: "http_requests", expected: AccessIdentifier{Type: ZoneType, Value: zoneID}},
+		"account dataset": {dataset: "gateway_http", expected: AccessIdentifier{Type: AccountType, Value: accountID}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudflareLogpushJobSchema(), map[string]interface{}{
+				"zone_id": zoneID,
+				"dataset": tc.dataset,
+			})
+
+			identifier, err := logpushJobIdentifier(d, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *identifier != tc.expected {
+				t.Errorf("expected identifier %s, got %s", tc.expected, identifier)
+			}
+		})
+	}
+}
+
+func TestValidateLogpushJobDestinationConf(t *testing.T) {
+	testCases := map[string]struct {
+		destination string
+		valid       bool
+	}{
+		"s3":                    {destination: "s3://my-bucket-path?region=us-west-2", valid: true},
+		"https":                 {destination: "https://logs.example.com/ingest?header_Authorization=Basic%20abc", valid: true},
+		"r2":                    {destination: "r2://cloudflare-logs/http_requests/date={DATE}?account-id=f037e56e89293a057740de681ac9abbe&access-key-id=abc&secret-access-key=def", valid: true},
+		"r2 without account ID": {destination: "r2://cloudflare-logs/{DATE}?access-key-id=abc", valid: false},
+		"unsupported scheme":    {destination: "ftp://logs.example.com", valid: false},
+		"missing scheme":        {destination: "my-bucket-path", valid: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, errs := validateLogpushJobDestinationConf(tc.destination, "destination_conf")
+			if tc.valid && len(errs) > 0 {
+				t.Errorf("expected %q to be valid, got %v", tc.destination, errs)
+			}
+			if !tc.valid && len(errs) == 0 {
+				t.Errorf("expected %q to be invalid", tc.destination)
+			}
+		})
+	}
+}
+
+func TestLogpushJobFiltersEqual(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"identical": {
+			a:        `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`,
+			b:        `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`,
+			expected: true,
+		},
+		"whitespace and key order": {
+			a:        "{\n  \"where\": {\n    \"value\": \"example.com\",\n    \"key\": \"ClientRequestHost\",\n    \"operator\": \"eq\"\n  }\n}",
+			b:        `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`,
+			expected: true,
+		},
+		"different value": {
+			a:        `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`,
+			b:        `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.net"}}`,
+			expected: false,
+		},
+		"one empty": {
+			a:        `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`,
+			b:        "",
+			expected: false,
+		},
+		"invalid JSON": {
+			a:        `{"where":`,
+			b:        `{"where":`,
+			expected: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := logpushJobFiltersEqual(tc.a, tc.b); got != tc.expected {
+				t.Errorf("logpushJobFiltersEqual(%q, %q) = %t, expected %t", tc.a, tc.b, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCloudflareLogpushJobCreateWithOutputOptions(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const configuredFilter = "{\n  \"where\": {\n    \"key\": \"ClientRequestHost\",\n    \"operator\": \"eq\",\n    \"value\": \"example.com\"\n  }\n}"
+
+	var sent map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/"+zoneID+"/logpush/jobs":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, `{"id": 1234}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/"+zoneID+"/logpush/jobs/1234":
+			testAPIResult(w, `{
+  "id": 1234,
+  "dataset": "http_requests",
+  "enabled": true,
+  "name": "example",
+  "logpull_options": null,
+  "destination_conf": "r2://logs/{DATE}?account-id=f037e56e89293a057740de681ac9abbe",
+  "frequency": "high",
+  "filter": "{\"where\":{\"key\":\"ClientRequestHost\",\"operator\":\"eq\",\"value\":\"example.com\"}}",
+  "max_upload_bytes": 5000000,
+  "max_upload_records": 1000,
+  "max_upload_interval_seconds": 30,
+  "output_options": {
+    "field_names": ["ClientIP", "RayID"],
+    "timestamp_format": "rfc3339",
+    "output_type": "ndjson",
+    "CVE-2021-44228": true,
+    "sample_rate": 0.5,
+    "record_prefix": "{",
+    "record_suffix": "}\n",
+    "field_delimiter": ","
+  }
+}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLogpushJobSchema(), map[string]interface{}{
+		"zone_id":                     zoneID,
+		"enabled":                     true,
+		"name":                        "example",
+		"dataset":                     "http_requests",
+		"destination_conf":            "r2://logs/{DATE}?account-id=f037e56e89293a057740de681ac9abbe",
+		"filter":                      configuredFilter,
+		"max_upload_bytes":            5000000,
+		"max_upload_records":          1000,
+		"max_upload_interval_seconds": 30,
+		"output_options": []interface{}{map[string]interface{}{
+			"field_names":      []interface{}{"ClientIP", "RayID"},
+			"timestamp_format": "rfc3339",
+			"cve20214428":      true,
+			"sample_rate":      0.5,
+		}},
+	})
+
+	if diags := resourceCloudflareLogpushJobCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if sent["filter"] != `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}` {
+		t.Errorf("expected the filter to be sent compacted, got %v", sent["filter"])
+	}
+	if sent["max_upload_bytes"] != float64(5000000) {
+		t.Errorf("expected max_upload_bytes to be sent, got %v", sent["max_upload_bytes"])
+	}
+	outputOptions, ok := sent["output_options"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected output_options to be sent, got %v", sent["output_options"])
+	}
+	if outputOptions["CVE-2021-44228"] != true {
+		t.Errorf("expected CVE-2021-44228 to be sent, got %v", outputOptions["CVE-2021-44228"])
+	}
+
+	if got := d.Get("filter").(string); got != configuredFilter {
+		t.Errorf("expected the configured filter to be kept in state, got %q", got)
+	}
+	if got := d.Get("output_options.0.timestamp_format").(string); got != "rfc3339" {
+		t.Errorf("expected output_options.0.timestamp_format to be %q, got %q", "rfc3339", got)
+	}
+	if got := d.Get("output_options.0.sample_rate").(float64); got != 0.5 {
+		t.Errorf("expected output_options.0.sample_rate to be 0.5, got %v", got)
+	}
+	if got := d.Get("output_options.0.field_names.#").(int); got != 2 {
+		t.Errorf("expected 2 output_options.0.field_names, got %d", got)
+	}
+	if got := d.Get("max_upload_interval_seconds").(int); got != 30 {
+		t.Errorf("expected max_upload_interval_seconds to be 30, got %d", got)
+	}
+}
+
+func TestCloudflareLogpushJobUpdateRemovesFilter(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const job = `{"id": 1234, "dataset": "http_requests", "enabled": true, "name": "example", "destination_conf": "r2://logs/{DATE}?account-id=f037e56e89293a057740de681ac9abbe"}`
+
+	var sent map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/logpush/jobs/1234" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Fatal(err)
+			}
+		}
+		testAPIResult(w, job)
+	})
+
+	config := map[string]interface{}{
+		"zone_id":          zoneID,
+		"enabled":          true,
+		"name":             "example",
+		"dataset":          "http_requests",
+		"destination_conf": "r2://logs/{DATE}?account-id=f037e56e89293a057740de681ac9abbe",
+	}
+
+	r := resourceCloudflareLogpushJob()
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	d.Set("filter", `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`)
+	d.SetId("1234")
+
+	state := d.State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if filter, ok := sent["filter"]; !ok || filter != "" {
+		t.Errorf("expected the filter to be removed with an empty string, got %#v", sent["filter"])
+	}
+}
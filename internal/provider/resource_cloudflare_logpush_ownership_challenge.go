@@ -2,15 +2,25 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// errR2ObjectNotFound is returned when an R2 object doesn't exist (yet).
+var errR2ObjectNotFound = errors.New("R2 object not found")
+
 func resourceCloudflareLogpushOwnershipChallenge() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareLogpushOwnershipChallengeSchema(),
@@ -18,11 +28,21 @@ func resourceCloudflareLogpushOwnershipChallenge() *schema.Resource {
 		UpdateContext: resourceCloudflareLogpushOwnershipChallengeCreate,
 		ReadContext:   resourceCloudflareLogpushOwnershipChallengeNoop,
 		DeleteContext: resourceCloudflareLogpushOwnershipChallengeNoop,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides a resource which manages Cloudflare Logpush ownership
 			challenges to use in a Logpush Job. On it's own, doesn't do much
 			however this resource should be used in conjunction to create
 			Logpush jobs.
+
+			For R2 destinations the challenge is read back from the bucket and
+			exposed as ` + "`ownership_challenge_contents`" + ` so the Logpush job
+			can be created in a single apply. For all other destinations the
+			contents of ` + "`ownership_challenge_filename`" + ` must be fetched
+			from the destination separately.
 		`),
 	}
 }
@@ -55,8 +75,94 @@ func resourceCloudflareLogpushOwnershipChallengeCreate(ctx context.Context, d *s
 	// here from the filename which will be unique.
 	d.SetId(stringChecksum(challenge.Filename))
 	d.Set("ownership_challenge_filename", challenge.Filename)
+	d.Set("ownership_challenge_contents", "")
 
 	log.Printf("[INFO] Created Cloudflare Logpush Ownership Challenge for %s: %s", identifier, d.Id())
 
+	accountID, bucket, ok := parseR2DestinationConf(destinationConf)
+	if !ok {
+		return nil
+	}
+
+	var contents []byte
+	retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		contents, err = getR2Object(ctx, client, accountID, bucket, challenge.Filename)
+		if errors.Is(err, errR2ObjectNotFound) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(fmt.Errorf("error reading ownership challenge %q from R2 bucket %q: %w", challenge.Filename, bucket, retryErr))
+	}
+
+	d.Set("ownership_challenge_contents", strings.TrimSpace(string(contents)))
+
 	return nil
 }
+
+// parseR2DestinationConf returns the account and bucket of an R2 destination
+// in the "r2://bucket/path?account-id=..." form.
+func parseR2DestinationConf(destinationConf string) (string, string, bool) {
+	if !strings.HasPrefix(destinationConf, "r2://") {
+		return "", "", false
+	}
+
+	u, err := url.Parse(destinationConf)
+	if err != nil || u.Host == "" {
+		return "", "", false
+	}
+
+	accountID := u.Query().Get("account-id")
+	if accountID == "" {
+		return "", "", false
+	}
+
+	return accountID, u.Host, true
+}
+
+// getR2Object fetches the contents of an object from an R2 bucket using the
+// account API. Object bodies aren't wrapped in the usual API response
+// envelope so the request is made outside of client.Raw.
+func getR2Object(ctx context.Context, client *cloudflare.API, accountID, bucket, key string) ([]byte, error) {
+	uri := fmt.Sprintf("%s/accounts/%s/r2/buckets/%s/objects/%s", client.BaseURL, accountID, url.PathEscape(bucket), url.PathEscape(strings.TrimPrefix(key, "/")))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", client.UserAgent)
+	switch {
+	case client.APIToken != "":
+		req.Header.Set("Authorization", "Bearer "+client.APIToken)
+	case client.APIKey != "":
+		req.Header.Set("X-Auth-Key", client.APIKey)
+		req.Header.Set("X-Auth-Email", client.APIEmail)
+	case client.APIUserServiceKey != "":
+		req.Header.Set("X-Auth-User-Service-Key", client.APIUserServiceKey)
+	}
+
+	resp, err := providerHTTPClient(client).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errR2ObjectNotFound
+	case resp.StatusCode >= http.StatusBadRequest:
+		return nil, fmt.Errorf("HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return body, nil
+}
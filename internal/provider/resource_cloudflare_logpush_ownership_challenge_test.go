@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareLogpushOwnershipChallenge(t *testing.T) {
@@ -38,3 +43,64 @@ func testCloudflareLogpushOwnershipChallengeConfig(resourceID, zoneID, destinati
 		}
 		`, resourceID, zoneID, destinationConf)
 }
+
+func TestCloudflareLogpushOwnershipChallengeContents(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	testCases := map[string]struct {
+		destinationConf  string
+		expectedContents string
+	}{
+		"r2":               {destinationConf: "r2://cloudflare-logs/http_requests?account-id=" + accountID, expectedContents: "challenge-token"},
+		"s3 is unreadable": {destinationConf: "s3://my-bucket-path?region=us-west-2", expectedContents: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// The object is read with the HTTP client of the provider, so its
+			// retries apply.
+			transport := newRetryTransport(http.DefaultTransport, 1, 1, 30)
+			transport.wait = func(ctx context.Context, d time.Duration) error { return nil }
+			httpClient := &http.Client{Transport: transport}
+
+			objectRequests := 0
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/zones/"+zoneID+"/logpush/ownership":
+					testAPIResult(w, `{"filename": "http_requests/ownership-challenge-1234.txt", "valid": true, "message": ""}`)
+				case r.Method == http.MethodGet && r.URL.EscapedPath() == "/accounts/"+accountID+"/r2/buckets/cloudflare-logs/objects/http_requests%2Fownership-challenge-1234.txt":
+					if r.Header.Get("X-Auth-Key") != "deadbeef" || r.Header.Get("X-Auth-Email") != "test@example.com" {
+						t.Errorf("expected the request to be authenticated, got key %q and email %q", r.Header.Get("X-Auth-Key"), r.Header.Get("X-Auth-Email"))
+					}
+					if objectRequests++; objectRequests == 1 {
+						w.WriteHeader(http.StatusServiceUnavailable)
+						return
+					}
+					fmt.Fprint(w, "challenge-token\n")
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.EscapedPath())
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}, cloudflare.UsingRetryPolicy(0, 1, 30), cloudflare.HTTPClient(httpClient))
+			providerHTTPClients.Store(client, httpClient)
+			t.Cleanup(func() { providerHTTPClients.Delete(client) })
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareLogpushOwnershipChallengeSchema(), map[string]interface{}{
+				"zone_id":          zoneID,
+				"destination_conf": tc.destinationConf,
+			})
+
+			if diags := resourceCloudflareLogpushOwnershipChallengeCreate(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := d.Get("ownership_challenge_filename").(string); got != "http_requests/ownership-challenge-1234.txt" {
+				t.Errorf("expected ownership_challenge_filename to be set, got %q", got)
+			}
+			if got := d.Get("ownership_challenge_contents").(string); got != tc.expectedContents {
+				t.Errorf("expected ownership_challenge_contents %q, got %q", tc.expectedContents, got)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -20,6 +21,7 @@ func resourceCloudflareMagicFirewallRuleset() *schema.Resource {
 		ReadContext:   resourceCloudflareMagicFirewallRulesetRead,
 		UpdateContext: resourceCloudflareMagicFirewallRulesetUpdate,
 		DeleteContext: resourceCloudflareMagicFirewallRulesetDelete,
+		CustomizeDiff: resourceCloudflareMagicFirewallRulesetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareMagicFirewallRulesetImport,
 		},
@@ -84,21 +86,65 @@ func resourceCloudflareMagicFirewallRulesetRead(ctx context.Context, d *schema.R
 	d.Set("description", ruleset.Description)
 	d.Set("rules", buildStateFromMagicFirewallRulesetRules(ruleset.Rules))
 
+	ruleIDs := make([]string, 0, len(ruleset.Rules))
+	for _, rule := range ruleset.Rules {
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+	d.Set("rule_ids", ruleIDs)
+
 	return nil
 }
 
+// resourceCloudflareMagicFirewallRulesetUpdate changes the rules which were
+// added, removed or modified individually so that the other rules keep their
+// IDs. The whole ruleset is only replaced when rules were reordered, or when
+// the IDs of the current rules aren't known.
 func resourceCloudflareMagicFirewallRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	rules, err := buildMagicFirewallRulesetRulesFromResource(d.Get("rules"))
+	oldRulesConfig, newRulesConfig := d.GetChange("rules")
+	oldRules, err := buildMagicFirewallRulesetRulesFromResource(oldRulesConfig)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error building ruleset from state")))
+	}
+	rules, err := buildMagicFirewallRulesetRulesFromResource(newRulesConfig)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error building ruleset from resource")))
 	}
 
-	_, err = client.UpdateMagicFirewallRuleset(ctx, accountID, d.Id(), d.Get("description").(string), rules)
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating Magic Firewall ruleset with ID %q", d.Id())))
+	oldRuleIDs, _ := d.GetChange("rule_ids")
+	changes, ok := planMagicFirewallRulesetRuleChanges(oldRules, expandInterfaceToStringList(oldRuleIDs), rules)
+
+	if !ok || d.HasChange("description") {
+		_, err = client.UpdateMagicFirewallRuleset(ctx, accountID, d.Id(), d.Get("description").(string), changes.rules)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating Magic Firewall ruleset with ID %q", d.Id())))
+		}
+
+		return resourceCloudflareMagicFirewallRulesetRead(ctx, d, meta)
+	}
+
+	for _, ruleID := range changes.deleted {
+		tflog.Debug(ctx, fmt.Sprintf("Deleting rule %q of Magic Firewall ruleset %q", ruleID, d.Id()))
+		if err := deleteMagicFirewallRulesetRule(ctx, client, accountID, d.Id(), ruleID); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting rule %q of Magic Firewall ruleset with ID %q", ruleID, d.Id())))
+		}
+	}
+
+	for _, index := range changes.added {
+		tflog.Debug(ctx, fmt.Sprintf("Adding rule at position %d of Magic Firewall ruleset %q", index+1, d.Id()))
+		if err := addMagicFirewallRulesetRule(ctx, client, accountID, d.Id(), changes.rules[index], index+1); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error adding rule to Magic Firewall ruleset with ID %q", d.Id())))
+		}
+	}
+
+	for _, index := range changes.updated {
+		rule := changes.rules[index]
+		tflog.Debug(ctx, fmt.Sprintf("Updating rule %q of Magic Firewall ruleset %q", rule.ID, d.Id()))
+		if err := updateMagicFirewallRulesetRule(ctx, client, accountID, d.Id(), rule); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating rule %q of Magic Firewall ruleset with ID %q", rule.ID, d.Id())))
+		}
 	}
 
 	return resourceCloudflareMagicFirewallRulesetRead(ctx, d, meta)
@@ -116,6 +162,16 @@ func resourceCloudflareMagicFirewallRulesetDelete(ctx context.Context, d *schema
 	return nil
 }
 
+// A change to the rules means the rules are given new IDs, at least for the
+// ones which were added.
+func resourceCloudflareMagicFirewallRulesetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("rules") {
+		return d.SetNewComputed("rule_ids")
+	}
+
+	return nil
+}
+
 func ruleElemValidators() map[string]schema.SchemaValidateFunc {
 	v := make(map[string]schema.SchemaValidateFunc)
 
@@ -202,3 +258,141 @@ func buildMagicFirewallRulesetRulesFromResource(r interface{}) ([]cloudflare.Mag
 
 	return rulesetRules, nil
 }
+
+// magicFirewallRulesetRuleChanges are the individual rule changes needed to
+// turn the current rules of a ruleset into the configured ones.
+type magicFirewallRulesetRuleChanges struct {
+	// rules are the configured rules, with the IDs of the current rules they
+	// replace.
+	rules []cloudflare.MagicFirewallRulesetRule
+	// deleted are the IDs of the current rules to delete.
+	deleted []string
+	// added and updated are the indexes of the rules to add and update.
+	added   []int
+	updated []int
+}
+
+// planMagicFirewallRulesetRuleChanges matches the configured rules to the
+// current ones, keeping the longest sequence of unchanged rules in place and
+// pairing the remaining rules between them up as updates. It returns false
+// when the rules can't be changed individually, either because rules were
+// moved or the IDs of the current rules aren't known.
+func planMagicFirewallRulesetRuleChanges(current []cloudflare.MagicFirewallRulesetRule, currentIDs []string, rules []cloudflare.MagicFirewallRulesetRule) (magicFirewallRulesetRuleChanges, bool) {
+	changes := magicFirewallRulesetRuleChanges{rules: make([]cloudflare.MagicFirewallRulesetRule, len(rules))}
+	copy(changes.rules, rules)
+
+	if len(currentIDs) != len(current) {
+		return changes, false
+	}
+	for _, id := range currentIDs {
+		if id == "" {
+			return changes, false
+		}
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of
+	// current[i:] and rules[j:].
+	lengths := make([][]int, len(current)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(rules)+1)
+	}
+	for i := len(current) - 1; i >= 0; i-- {
+		for j := len(rules) - 1; j >= 0; j-- {
+			switch {
+			case magicFirewallRulesetRulesEqual(current[i], rules[j]):
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	var removed, changed []cloudflare.MagicFirewallRulesetRule
+	var removedRules, addedRules []int
+	pair := func() {
+		for len(removedRules) > 0 && len(addedRules) > 0 {
+			i, j := removedRules[0], addedRules[0]
+			changes.rules[j].ID = currentIDs[i]
+			changes.updated = append(changes.updated, j)
+			removed = append(removed, current[i])
+			changed = append(changed, rules[j])
+			removedRules, addedRules = removedRules[1:], addedRules[1:]
+		}
+		for _, i := range removedRules {
+			changes.deleted = append(changes.deleted, currentIDs[i])
+			removed = append(removed, current[i])
+		}
+		for _, j := range addedRules {
+			changes.added = append(changes.added, j)
+			changed = append(changed, rules[j])
+		}
+		removedRules, addedRules = nil, nil
+	}
+
+	i, j := 0, 0
+	for i < len(current) || j < len(rules) {
+		switch {
+		case i < len(current) && j < len(rules) && magicFirewallRulesetRulesEqual(current[i], rules[j]):
+			pair()
+			changes.rules[j].ID = currentIDs[i]
+			i++
+			j++
+		case j == len(rules) || (i < len(current) && lengths[i+1][j] >= lengths[i][j+1]):
+			removedRules = append(removedRules, i)
+			i++
+		default:
+			addedRules = append(addedRules, j)
+			j++
+		}
+	}
+	pair()
+
+	// A rule which is removed in one place and added in another was moved.
+	for _, rule := range changed {
+		for _, removedRule := range removed {
+			if magicFirewallRulesetRulesEqual(rule, removedRule) {
+				return changes, false
+			}
+		}
+	}
+
+	return changes, true
+}
+
+func magicFirewallRulesetRulesEqual(a, b cloudflare.MagicFirewallRulesetRule) bool {
+	return a.Action == b.Action &&
+		a.Expression == b.Expression &&
+		a.Description == b.Description &&
+		a.Enabled == b.Enabled
+}
+
+// magicFirewallRulesetRuleRequest is a rule added to a ruleset at a position.
+type magicFirewallRulesetRuleRequest struct {
+	cloudflare.MagicFirewallRulesetRule
+	Position *magicFirewallRulesetRulePosition `json:"position,omitempty"`
+}
+
+type magicFirewallRulesetRulePosition struct {
+	Index int `json:"index"`
+}
+
+func addMagicFirewallRulesetRule(ctx context.Context, client *cloudflare.API, accountID, rulesetID string, rule cloudflare.MagicFirewallRulesetRule, position int) error {
+	request := magicFirewallRulesetRuleRequest{
+		MagicFirewallRulesetRule: rule,
+		Position:                 &magicFirewallRulesetRulePosition{Index: position},
+	}
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/rulesets/%s/rules", accountID, rulesetID), request, nil)
+	return err
+}
+
+func updateMagicFirewallRulesetRule(ctx context.Context, client *cloudflare.API, accountID, rulesetID string, rule cloudflare.MagicFirewallRulesetRule) error {
+	_, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/accounts/%s/rulesets/%s/rules/%s", accountID, rulesetID, rule.ID), rule, nil)
+	return err
+}
+
+func deleteMagicFirewallRulesetRule(ctx context.Context, client *cloudflare.API, accountID, rulesetID, ruleID string) error {
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/rulesets/%s/rules/%s", accountID, rulesetID, ruleID), nil, nil)
+	return err
+}
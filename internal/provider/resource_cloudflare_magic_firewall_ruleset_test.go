@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -271,3 +276,179 @@ func testAccCheckCloudflareMagicFirewallRulesetUpdateWithHigherPriority(ID, name
     ]
   }`, ID, name, description, accountID)
 }
+
+func TestPlanMagicFirewallRulesetRuleChanges(t *testing.T) {
+	rule := func(expression string) cloudflare.MagicFirewallRulesetRule {
+		return cloudflare.MagicFirewallRulesetRule{Action: "block", Expression: expression, Enabled: true}
+	}
+	current := []cloudflare.MagicFirewallRulesetRule{rule("a"), rule("b"), rule("c")}
+	currentIDs := []string{"1", "2", "3"}
+
+	testCases := map[string]struct {
+		rules      []cloudflare.MagicFirewallRulesetRule
+		currentIDs []string
+		ok         bool
+		ruleIDs    []string
+		deleted    []string
+		added      []int
+		updated    []int
+	}{
+		"unchanged": {
+			rules:   []cloudflare.MagicFirewallRulesetRule{rule("a"), rule("b"), rule("c")},
+			ok:      true,
+			ruleIDs: []string{"1", "2", "3"},
+		},
+		"modified": {
+			rules:   []cloudflare.MagicFirewallRulesetRule{rule("a"), rule("b2"), rule("c")},
+			ok:      true,
+			ruleIDs: []string{"1", "2", "3"},
+			updated: []int{1},
+		},
+		"appended": {
+			rules:   []cloudflare.MagicFirewallRulesetRule{rule("a"), rule("b"), rule("c"), rule("d")},
+			ok:      true,
+			ruleIDs: []string{"1", "2", "3", ""},
+			added:   []int{3},
+		},
+		"inserted first": {
+			rules:   []cloudflare.MagicFirewallRulesetRule{rule("d"), rule("a"), rule("b"), rule("c")},
+			ok:      true,
+			ruleIDs: []string{"", "1", "2", "3"},
+			added:   []int{0},
+		},
+		"removed": {
+			rules:   []cloudflare.MagicFirewallRulesetRule{rule("a"), rule("c")},
+			ok:      true,
+			ruleIDs: []string{"1", "3"},
+			deleted: []string{"2"},
+		},
+		"modified and appended": {
+			rules:   []cloudflare.MagicFirewallRulesetRule{rule("a2"), rule("b"), rule("c"), rule("d")},
+			ok:      true,
+			ruleIDs: []string{"1", "2", "3", ""},
+			added:   []int{3},
+			updated: []int{0},
+		},
+		"reordered": {
+			rules: []cloudflare.MagicFirewallRulesetRule{rule("b"), rule("a"), rule("c")},
+			ok:    false,
+		},
+		"unknown IDs": {
+			rules:      []cloudflare.MagicFirewallRulesetRule{rule("a"), rule("b"), rule("c")},
+			currentIDs: []string{},
+			ok:         false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ids := currentIDs
+			if tc.currentIDs != nil {
+				ids = tc.currentIDs
+			}
+
+			changes, ok := planMagicFirewallRulesetRuleChanges(current, ids, tc.rules)
+			if ok != tc.ok {
+				t.Fatalf("expected ok to be %t, got %t", tc.ok, ok)
+			}
+			if !ok {
+				return
+			}
+
+			var ruleIDs []string
+			for _, rule := range changes.rules {
+				ruleIDs = append(ruleIDs, rule.ID)
+			}
+			if !reflect.DeepEqual(ruleIDs, tc.ruleIDs) {
+				t.Errorf("expected rule IDs %v, got %v", tc.ruleIDs, ruleIDs)
+			}
+			if !reflect.DeepEqual(changes.deleted, tc.deleted) {
+				t.Errorf("expected deleted rules %v, got %v", tc.deleted, changes.deleted)
+			}
+			if !reflect.DeepEqual(changes.added, tc.added) {
+				t.Errorf("expected added rules %v, got %v", tc.added, changes.added)
+			}
+			if !reflect.DeepEqual(changes.updated, tc.updated) {
+				t.Errorf("expected updated rules %v, got %v", tc.updated, changes.updated)
+			}
+		})
+	}
+}
+
+func TestCloudflareMagicFirewallRulesetUpdatesRulesIndividually(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const rulesetID = "3cc6ad7a6f5e4e81a2f8b5e6a9b3c7d1"
+	const rulesetPath = "/accounts/" + accountID + "/rulesets/" + rulesetID
+
+	rules := []cloudflare.MagicFirewallRulesetRule{
+		{ID: "1", Action: "block", Expression: "tcp.dstport in { 32768..65535 }", Enabled: true},
+		{ID: "2", Action: "block", Expression: "udp.dstport in { 32768..65535 }", Description: "udp", Enabled: true},
+	}
+	var requests []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		var request magicFirewallRulesetRuleRequest
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&request)
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == rulesetPath:
+		case r.Method == http.MethodPost && r.URL.Path == rulesetPath+"/rules":
+			requests = append(requests, fmt.Sprintf("POST %d", request.Position.Index))
+			request.ID = fmt.Sprintf("%d", len(rules)+1)
+			index := request.Position.Index - 1
+			rules = append(rules[:index], append([]cloudflare.MagicFirewallRulesetRule{request.MagicFirewallRulesetRule}, rules[index:]...)...)
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, rulesetPath+"/rules/"):
+			ruleID := strings.TrimPrefix(r.URL.Path, rulesetPath+"/rules/")
+			requests = append(requests, "PATCH "+ruleID)
+			for i := range rules {
+				if rules[i].ID == ruleID {
+					rules[i] = request.MagicFirewallRulesetRule
+				}
+			}
+		default:
+			requests = append(requests, r.Method+" "+r.URL.Path)
+		}
+
+		testAPIResultJSON(w, cloudflare.MagicFirewallRuleset{ID: rulesetID, Name: "ruleset", Rules: rules})
+	})
+
+	r := resourceCloudflareMagicFirewallRuleset()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(accountID + "/" + rulesetID)
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := imported[0].State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": accountID,
+		"name":       "ruleset",
+		"rules": []interface{}{
+			map[string]interface{}{"action": "block", "expression": "tcp.dstport in { 32768..65535 }", "enabled": "true"},
+			map[string]interface{}{"action": "allow", "expression": "udp.dstport in { 32768..65535 }", "description": "udp", "enabled": "true"},
+			map[string]interface{}{"action": "allow", "expression": "ip.proto == \"icmp\"", "enabled": "true"},
+		},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if expected := []string{"POST 3", "PATCH 2"}; !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+	if got := newState.Attributes["rule_ids.#"]; got != "3" {
+		t.Fatalf("expected 3 rule IDs, got %s", got)
+	}
+	for i, expected := range []string{"1", "2", "3"} {
+		if got := newState.Attributes[fmt.Sprintf("rule_ids.%d", i)]; got != expected {
+			t.Errorf("expected rule %d to have ID %q, got %q", i, expected, got)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mnmConfiguration is the Magic Network Monitoring configuration of an
+// account.
+type mnmConfiguration struct {
+	Name            string   `json:"name"`
+	DefaultSampling float64  `json:"default_sampling"`
+	RouterIPs       []string `json:"router_ips"`
+}
+
+func resourceCloudflareMNMConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMNMConfigurationSchema(),
+		CreateContext: resourceCloudflareMNMConfigurationCreate,
+		ReadContext:   resourceCloudflareMNMConfigurationRead,
+		UpdateContext: resourceCloudflareMNMConfigurationUpdate,
+		DeleteContext: resourceCloudflareMNMConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMNMConfigurationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages the Magic Network Monitoring
+			configuration of an account, there is a single configuration per
+			account. Destroying the resource deletes the configuration, or
+			clears it when the account still has Magic Network Monitoring rules.
+		`),
+	}
+}
+
+func mnmConfigurationURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/mnm/config", accountID)
+}
+
+func getMNMConfiguration(ctx context.Context, client *cloudflare.API, accountID string) (mnmConfiguration, error) {
+	res, err := client.Raw(ctx, http.MethodGet, mnmConfigurationURI(accountID), nil, nil)
+	if err != nil {
+		return mnmConfiguration{}, err
+	}
+
+	var config mnmConfiguration
+	if err := json.Unmarshal(res, &config); err != nil {
+		return mnmConfiguration{}, fmt.Errorf("error unmarshalling magic network monitoring configuration: %w", err)
+	}
+
+	return config, nil
+}
+
+func buildMNMConfiguration(d *schema.ResourceData) mnmConfiguration {
+	return mnmConfiguration{
+		Name:            d.Get("name").(string),
+		DefaultSampling: float64(d.Get("default_sampling").(int)),
+		RouterIPs:       expandInterfaceToStringList(d.Get("router_ips").(*schema.Set).List()),
+	}
+}
+
+// resourceCloudflareMNMConfigurationCreate replaces the configuration when
+// the account already has one, as there can only be one per account.
+func resourceCloudflareMNMConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	method := http.MethodPost
+	if _, err := getMNMConfiguration(ctx, client, accountID); err == nil {
+		tflog.Info(ctx, fmt.Sprintf("Replacing existing magic network monitoring configuration of account %q", accountID))
+		method = http.MethodPut
+	} else {
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("error reading magic network monitoring configuration of account %q: %w", accountID, err))
+		}
+	}
+
+	if _, err := client.Raw(ctx, method, mnmConfigurationURI(accountID), buildMNMConfiguration(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating magic network monitoring configuration of account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareMNMConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudflareMNMConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	config, err := getMNMConfiguration(ctx, client, accountID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing magic network monitoring configuration of account %q from state because it's not found in API", accountID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading magic network monitoring configuration of account %q: %w", accountID, err))
+	}
+
+	d.Set("name", config.Name)
+	d.Set("default_sampling", int(config.DefaultSampling))
+	d.Set("router_ips", config.RouterIPs)
+
+	return nil
+}
+
+func resourceCloudflareMNMConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := client.Raw(ctx, http.MethodPut, mnmConfigurationURI(accountID), buildMNMConfiguration(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating magic network monitoring configuration of account %q: %w", accountID, err))
+	}
+
+	return resourceCloudflareMNMConfigurationRead(ctx, d, meta)
+}
+
+// resourceCloudflareMNMConfigurationDelete clears the configuration instead
+// of deleting it when the account still has rules, which the API requires to
+// be deleted first.
+func resourceCloudflareMNMConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	rules, err := listMNMRules(ctx, client, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing magic network monitoring rules of account %q: %w", accountID, err))
+	}
+
+	if len(rules) > 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Clearing magic network monitoring configuration of account %q instead of deleting it because the account has %d rules", accountID, len(rules)))
+
+		config := mnmConfiguration{Name: d.Get("name").(string), DefaultSampling: 1, RouterIPs: []string{}}
+		if _, err := client.Raw(ctx, http.MethodPut, mnmConfigurationURI(accountID), config, nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error clearing magic network monitoring configuration of account %q: %w", accountID, err))
+		}
+
+		return nil
+	}
+
+	if _, err := client.Raw(ctx, http.MethodDelete, mnmConfigurationURI(accountID), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting magic network monitoring configuration of account %q: %w", accountID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMNMConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := d.Id()
+
+	d.SetId(accountID)
+	d.Set("account_id", accountID)
+
+	if diags := resourceCloudflareMNMConfigurationRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("failed to read magic network monitoring configuration of account %q", accountID)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("magic network monitoring configuration of account %q not found", accountID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
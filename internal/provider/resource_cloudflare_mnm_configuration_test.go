@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareMNMConfiguration_Basic(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mnm_configuration.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMNMConfiguration(rnd, accountID, 1, "203.0.113.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "default_sampling", "1"),
+					resource.TestCheckResourceAttr(name, "router_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "router_ips.*", "203.0.113.1"),
+				),
+			},
+			{
+				Config: testAccCloudflareMNMConfiguration(rnd, accountID, 5, "203.0.113.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "default_sampling", "5"),
+					resource.TestCheckTypeSetElemAttr(name, "router_ips.*", "203.0.113.2"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     accountID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareMNMConfiguration(resourceName, accountID string, defaultSampling int, routerIP string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_mnm_configuration" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  default_sampling = %[3]d
+  router_ips       = ["%[4]s"]
+}`, resourceName, accountID, defaultSampling, routerIP)
+}
+
+func TestCloudflareMNMConfigurationDeleteClearsConfigurationWithRules(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	var requests []string
+	var cleared mnmConfiguration
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == mnmRuleURI(accountID, ""):
+			testAPIResult(w, `[{"id": "2890e6fa406311ed9b5a23f70f6fb8cf", "name": "rule", "prefixes": ["192.0.2.0/24"], "packet_threshold": 10000, "duration": "1m"}]`)
+		case r.Method == http.MethodPut && r.URL.Path == mnmConfigurationURI(accountID):
+			if err := json.NewDecoder(r.Body).Decode(&cleared); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, `{}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareMNMConfigurationSchema(), map[string]interface{}{
+		"account_id":       accountID,
+		"name":             "config",
+		"default_sampling": 5,
+		"router_ips":       []interface{}{"203.0.113.1"},
+	})
+	d.SetId(accountID)
+
+	if diags := resourceCloudflareMNMConfigurationDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{"GET " + mnmRuleURI(accountID, ""), "PUT " + mnmConfigurationURI(accountID)}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+	if cleared.DefaultSampling != 1 || len(cleared.RouterIPs) != 0 {
+		t.Errorf("expected the configuration to be cleared, got %+v", cleared)
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mnmRule is a Magic Network Monitoring rule which triggers a DDoS alert
+// when the traffic of its prefixes exceeds a threshold.
+type mnmRule struct {
+	ID                     string   `json:"id,omitempty"`
+	Name                   string   `json:"name"`
+	Prefixes               []string `json:"prefixes"`
+	AutomaticAdvertisement *bool    `json:"automatic_advertisement"`
+	BandwidthThreshold     *float64 `json:"bandwidth_threshold,omitempty"`
+	PacketThreshold        *float64 `json:"packet_threshold,omitempty"`
+	Duration               string   `json:"duration,omitempty"`
+}
+
+func resourceCloudflareMNMRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMNMRuleSchema(),
+		CreateContext: resourceCloudflareMNMRuleCreate,
+		ReadContext:   resourceCloudflareMNMRuleRead,
+		UpdateContext: resourceCloudflareMNMRuleUpdate,
+		DeleteContext: resourceCloudflareMNMRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMNMRuleImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages Magic Network Monitoring rules,
+			which trigger DDoS alerts when the traffic of their prefixes
+			exceeds a bandwidth or packet threshold.
+		`),
+	}
+}
+
+func mnmRuleURI(accountID, ruleID string) string {
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules", accountID)
+	if ruleID != "" {
+		uri += "/" + ruleID
+	}
+
+	return uri
+}
+
+func listMNMRules(ctx context.Context, client *cloudflare.API, accountID string) ([]mnmRule, error) {
+	res, err := client.Raw(ctx, http.MethodGet, mnmRuleURI(accountID, ""), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []mnmRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return nil, fmt.Errorf("error unmarshalling magic network monitoring rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+func buildMNMRule(d *schema.ResourceData) mnmRule {
+	automaticAdvertisement := d.Get("automatic_advertisement").(bool)
+	rule := mnmRule{
+		Name:                   d.Get("name").(string),
+		Prefixes:               expandInterfaceToStringList(d.Get("prefixes").(*schema.Set).List()),
+		AutomaticAdvertisement: &automaticAdvertisement,
+		Duration:               d.Get("duration").(string),
+	}
+
+	if threshold, ok := d.GetOk("bandwidth_threshold"); ok {
+		bandwidthThreshold := float64(threshold.(int))
+		rule.BandwidthThreshold = &bandwidthThreshold
+	}
+	if threshold, ok := d.GetOk("packet_threshold"); ok {
+		packetThreshold := float64(threshold.(int))
+		rule.PacketThreshold = &packetThreshold
+	}
+
+	return rule
+}
+
+// buildMNMRulePatch returns only the fields of a rule which changed, with
+// removed thresholds set to null.
+func buildMNMRulePatch(d *schema.ResourceData) map[string]interface{} {
+	rule := buildMNMRule(d)
+	patch := make(map[string]interface{})
+
+	if d.HasChange("name") {
+		patch["name"] = rule.Name
+	}
+	if d.HasChange("prefixes") {
+		patch["prefixes"] = rule.Prefixes
+	}
+	if d.HasChange("automatic_advertisement") {
+		patch["automatic_advertisement"] = rule.AutomaticAdvertisement
+	}
+	if d.HasChange("bandwidth_threshold") {
+		patch["bandwidth_threshold"] = rule.BandwidthThreshold
+	}
+	if d.HasChange("packet_threshold") {
+		patch["packet_threshold"] = rule.PacketThreshold
+	}
+	if d.HasChange("duration") {
+		patch["duration"] = rule.Duration
+	}
+
+	return patch
+}
+
+func resourceCloudflareMNMRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodPost, mnmRuleURI(accountID, ""), buildMNMRule(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating magic network monitoring rule %q: %w", d.Get("name").(string), err))
+	}
+
+	var rule mnmRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling magic network monitoring rule: %w", err))
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceCloudflareMNMRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareMNMRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, mnmRuleURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing magic network monitoring rule %q from state because it's not found in API", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading magic network monitoring rule %q: %w", d.Id(), err))
+	}
+
+	var rule mnmRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling magic network monitoring rule: %w", err))
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("prefixes", rule.Prefixes)
+	d.Set("automatic_advertisement", rule.AutomaticAdvertisement != nil && *rule.AutomaticAdvertisement)
+	d.Set("duration", rule.Duration)
+
+	bandwidthThreshold, packetThreshold := 0, 0
+	if rule.BandwidthThreshold != nil {
+		bandwidthThreshold = int(*rule.BandwidthThreshold)
+	}
+	if rule.PacketThreshold != nil {
+		packetThreshold = int(*rule.PacketThreshold)
+	}
+	d.Set("bandwidth_threshold", bandwidthThreshold)
+	d.Set("packet_threshold", packetThreshold)
+
+	return nil
+}
+
+// resourceCloudflareMNMRuleUpdate only sends the fields which changed.
+func resourceCloudflareMNMRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	patch := buildMNMRulePatch(d)
+	if len(patch) == 0 {
+		return resourceCloudflareMNMRuleRead(ctx, d, meta)
+	}
+
+	if _, err := client.Raw(ctx, http.MethodPatch, mnmRuleURI(accountID, d.Id()), patch, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating magic network monitoring rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMNMRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareMNMRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if _, err := client.Raw(ctx, http.MethodDelete, mnmRuleURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting magic network monitoring rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMNMRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/ruleID\"", d.Id())
+	}
+	accountID, ruleID := attributes[0], attributes[1]
+
+	d.SetId(ruleID)
+	d.Set("account_id", accountID)
+
+	if diags := resourceCloudflareMNMRuleRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("failed to read magic network monitoring rule %q", ruleID)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("magic network monitoring rule %q not found in account %q", ruleID, accountID)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
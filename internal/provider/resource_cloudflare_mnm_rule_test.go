@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareMNMRule_Basic(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mnm_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMNMRule(rnd, accountID, "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "prefixes.*", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(name, "automatic_advertisement", "false"),
+					resource.TestCheckResourceAttr(name, "packet_threshold", "10000"),
+					resource.TestCheckResourceAttr(name, "duration", "1m"),
+				),
+			},
+			{
+				Config: testAccCloudflareMNMRule(rnd, accountID, "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "duration", "5m"),
+					resource.TestCheckResourceAttr(name, "packet_threshold", "10000"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareMNMRule(resourceName, accountID, duration string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_mnm_configuration" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  router_ips = ["203.0.113.1"]
+}
+
+resource "cloudflare_mnm_rule" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  prefixes         = ["192.0.2.0/24"]
+  packet_threshold = 10000
+  duration         = "%[3]s"
+
+  depends_on = [cloudflare_mnm_configuration.%[1]s]
+}`, resourceName, accountID, duration)
+}
+
+func TestCloudflareMNMRuleUpdatePatchesChangedFields(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const ruleID = "2890e6fa406311ed9b5a23f70f6fb8cf"
+
+	rule := map[string]interface{}{
+		"id":                      ruleID,
+		"name":                    "rule",
+		"prefixes":                []string{"192.0.2.0/24"},
+		"automatic_advertisement": false,
+		"bandwidth_threshold":     1000000000,
+		"packet_threshold":        10000,
+		"duration":                "1m",
+	}
+	var patches []map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == mnmRuleURI(accountID, ruleID):
+			var patch map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				t.Fatal(err)
+			}
+			patches = append(patches, patch)
+			for k, v := range patch {
+				rule[k] = v
+			}
+		case r.Method == http.MethodGet && r.URL.Path == mnmRuleURI(accountID, ruleID):
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResultJSON(w, rule)
+	})
+
+	r := resourceCloudflareMNMRule()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(accountID + "/" + ruleID)
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := imported[0].State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":       accountID,
+		"name":             "rule",
+		"prefixes":         []interface{}{"192.0.2.0/24"},
+		"packet_threshold": 10000,
+		"duration":         "5m",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newState, diags := r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{{"duration": "5m", "bandwidth_threshold": nil}}
+	if !reflect.DeepEqual(patches, expected) {
+		t.Errorf("expected patches %v, got %v", expected, patches)
+	}
+	if got := newState.Attributes["duration"]; got != "5m" {
+		t.Errorf("expected the duration to be updated, got %q", got)
+	}
+	if got := newState.Attributes["bandwidth_threshold"]; got != "0" {
+		t.Errorf("expected the bandwidth threshold to be removed, got %q", got)
+	}
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMTLSCertificateSchema(),
+		CreateContext: resourceCloudflareMTLSCertificateCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateRead,
+		DeleteContext: resourceCloudflareMTLSCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare mTLS certificate resource. These certificates
+			may be used with mTLS enabled Cloudflare services.
+		`),
+	}
+}
+
+func resourceCloudflareMTLSCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	params := cloudflare.CreateMTLSCertificateParams{
+		Name:         d.Get("name").(string),
+		Certificates: d.Get("certificates").(string),
+		PrivateKey:   d.Get("private_key").(string),
+		CA:           d.Get("ca").(bool),
+	}
+
+	certificate, err := client.CreateMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating mTLS certificate: %w", err))
+	}
+
+	d.SetId(certificate.ID)
+
+	return resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	certificate, err := client.GetMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing mTLS certificate %s from state because it's not present in API", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", certificate.Name)
+	d.Set("ca", certificate.CA)
+	d.Set("issuer", certificate.Issuer)
+	d.Set("signature", certificate.Signature)
+	d.Set("serial_number", certificate.SerialNumber)
+	d.Set("uploaded_on", certificate.UploadedOn.Format(time.RFC3339Nano))
+	d.Set("expires_on", certificate.ExpiresOn.Format(time.RFC3339Nano))
+
+	// Keep the configured certificates so that formatting differences in the
+	// PEM returned by the API don't cause a replacement.
+	if d.Get("certificates").(string) == "" {
+		d.Set("certificates", certificate.Certificates)
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	_, err := client.DeleteMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/certificateID\"", d.Id())
+	}
+	accountID, certificateID := attributes[0], attributes[1]
+
+	d.SetId(certificateID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type mtlsHostnameAssociations struct {
+	Hostnames         []string `json:"hostnames"`
+	MTLSCertificateID string   `json:"mtls_certificate_id,omitempty"`
+}
+
+func resourceCloudflareMTLSCertificateHostnames() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMTLSCertificateHostnamesSchema(),
+		CreateContext: resourceCloudflareMTLSCertificateHostnamesCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateHostnamesRead,
+		UpdateContext: resourceCloudflareMTLSCertificateHostnamesUpdate,
+		DeleteContext: resourceCloudflareMTLSCertificateHostnamesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateHostnamesImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which associates hostnames of a zone with an
+			mTLS certificate. The API replaces all of the hostnames of a
+			certificate at once, so hostnames which aren't managed by the
+			resource are kept when it's updated or destroyed.
+		`),
+	}
+}
+
+func mtlsHostnameAssociationsURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/certificate_authorities/hostname_associations", zoneID)
+}
+
+func getMTLSHostnameAssociations(ctx context.Context, client *cloudflare.API, zoneID, certificateID string) ([]string, error) {
+	uri := mtlsHostnameAssociationsURI(zoneID) + "?mtls_certificate_id=" + url.QueryEscape(certificateID)
+	res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var associations mtlsHostnameAssociations
+	if err := json.Unmarshal(res, &associations); err != nil {
+		return nil, fmt.Errorf("error unmarshalling hostname associations: %w", err)
+	}
+
+	return associations.Hostnames, nil
+}
+
+// updateMTLSHostnameAssociations replaces the hostnames of the certificate
+// with those currently associated, without the previously managed hostnames
+// and with the desired ones.
+func updateMTLSHostnameAssociations(ctx context.Context, client *cloudflare.API, zoneID, certificateID string, previous, desired []string) error {
+	current, err := getMTLSHostnameAssociations(ctx, client, zoneID, certificateID)
+	if err != nil {
+		return err
+	}
+
+	hostnames := mergeMTLSHostnames(current, previous, desired)
+	tflog.Debug(ctx, fmt.Sprintf("Associating mTLS certificate %q with hostnames %s", certificateID, strings.Join(hostnames, ", ")))
+
+	_, err = client.Raw(ctx, http.MethodPut, mtlsHostnameAssociationsURI(zoneID), mtlsHostnameAssociations{
+		Hostnames:         hostnames,
+		MTLSCertificateID: certificateID,
+	}, nil)
+	return err
+}
+
+// mergeMTLSHostnames returns the current hostnames without previous and with
+// desired, sorted.
+func mergeMTLSHostnames(current, previous, desired []string) []string {
+	hostnames := map[string]bool{}
+	for _, hostname := range current {
+		hostnames[hostname] = true
+	}
+	for _, hostname := range previous {
+		delete(hostnames, hostname)
+	}
+	for _, hostname := range desired {
+		hostnames[hostname] = true
+	}
+
+	merged := make([]string, 0, len(hostnames))
+	for hostname := range hostnames {
+		merged = append(merged, hostname)
+	}
+	sort.Strings(merged)
+
+	return merged
+}
+
+func resourceCloudflareMTLSCertificateHostnamesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	certificateID := d.Get("mtls_certificate_id").(string)
+
+	hostnames := expandInterfaceToStringList(d.Get("hostnames").(*schema.Set).List())
+	if err := updateMTLSHostnameAssociations(ctx, client, zoneID, certificateID, nil, hostnames); err != nil {
+		return diag.FromErr(fmt.Errorf("error associating hostnames with mTLS certificate %q: %w", certificateID, err))
+	}
+
+	d.SetId(certificateID)
+
+	return resourceCloudflareMTLSCertificateHostnamesRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateHostnamesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	current, err := getMTLSHostnameAssociations(ctx, client, zoneID, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading hostname associations of mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	// Only the managed hostnames are tracked, so that removing one outside
+	// of Terraform shows up as drift while other associations are ignored.
+	managed := d.Get("hostnames").(*schema.Set)
+	hostnames := schema.NewSet(schema.HashString, nil)
+	for _, hostname := range current {
+		if managed.Len() == 0 || managed.Contains(hostname) {
+			hostnames.Add(hostname)
+		}
+	}
+
+	if hostnames.Len() == 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Removing hostname associations of mTLS certificate %q from state because none are present in API", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mtls_certificate_id", d.Id())
+	d.Set("hostnames", hostnames)
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateHostnamesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	previous, desired := d.GetChange("hostnames")
+	err := updateMTLSHostnameAssociations(ctx, client, zoneID, d.Id(),
+		expandInterfaceToStringList(previous.(*schema.Set).List()),
+		expandInterfaceToStringList(desired.(*schema.Set).List()),
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error associating hostnames with mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMTLSCertificateHostnamesRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateHostnamesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	hostnames := expandInterfaceToStringList(d.Get("hostnames").(*schema.Set).List())
+	if err := updateMTLSHostnameAssociations(ctx, client, zoneID, d.Id(), hostnames, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error removing hostname associations of mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateHostnamesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/certificateID\"", d.Id())
+	}
+	zoneID, certificateID := attributes[0], attributes[1]
+
+	d.SetId(certificateID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareMTLSCertificateHostnamesRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareMTLSCertificateHostnames_Basic(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mtls_certificate_hostnames.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMTLSCertificateHostnamesConfig(rnd, accountID, zoneID, fmt.Sprintf("%s.%s", rnd, domain)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "hostnames.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "hostnames.*", fmt.Sprintf("%s.%s", rnd, domain)),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareMTLSCertificateHostnamesConfig(resourceName, accountID, zoneID, hostname string) string {
+	return testAccCloudflareMTLSCertificateConfig(resourceName, accountID) + fmt.Sprintf(`
+
+resource "cloudflare_mtls_certificate_hostnames" "%[1]s" {
+  zone_id             = "%[2]s"
+  mtls_certificate_id = cloudflare_mtls_certificate.%[1]s.id
+  hostnames           = ["%[3]s"]
+}`, resourceName, zoneID, hostname)
+}
+
+func TestMergeMTLSHostnames(t *testing.T) {
+	testCases := map[string]struct {
+		current, previous, desired []string
+		expected                   []string
+	}{
+		"create":             {current: []string{"other.example.com"}, desired: []string{"app.example.com"}, expected: []string{"app.example.com", "other.example.com"}},
+		"update":             {current: []string{"app.example.com", "other.example.com"}, previous: []string{"app.example.com"}, desired: []string{"api.example.com"}, expected: []string{"api.example.com", "other.example.com"}},
+		"delete":             {current: []string{"app.example.com", "other.example.com"}, previous: []string{"app.example.com"}, expected: []string{"other.example.com"}},
+		"already associated": {current: []string{"app.example.com"}, desired: []string{"app.example.com"}, expected: []string{"app.example.com"}},
+		"delete last":        {current: []string{"app.example.com"}, previous: []string{"app.example.com"}, expected: []string{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := mergeMTLSHostnames(tc.current, tc.previous, tc.desired); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudflareMTLSCertificateHostnamesKeepsOtherAssociations(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const certificateID = "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60"
+	const otherCertificateID = "80b1c5b4-2a4e-4d1e-8a9c-7f1b3c2e6d90"
+
+	associations := map[string][]string{
+		certificateID:      {"unmanaged.example.com"},
+		otherCertificateID: {"other.example.com"},
+	}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/certificate_authorities/hostname_associations" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		id := r.URL.Query().Get("mtls_certificate_id")
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var body mtlsHostnameAssociations
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			id = body.MTLSCertificateID
+			// The API replaces every hostname of the certificate.
+			associations[id] = body.Hostnames
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		testAPIResultJSON(w, mtlsHostnameAssociations{Hostnames: associations[id]})
+	})
+
+	ctx := context.Background()
+	r := resourceCloudflareMTLSCertificateHostnames()
+	config := map[string]interface{}{
+		"zone_id":             zoneID,
+		"mtls_certificate_id": certificateID,
+		"hostnames":           []interface{}{"app.example.com", "www.example.com"},
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := resourceCloudflareMTLSCertificateHostnamesCreate(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	assertMTLSHostnames(t, associations[certificateID], "app.example.com", "unmanaged.example.com", "www.example.com")
+	assertMTLSHostnames(t, expandInterfaceToStringList(d.Get("hostnames").(*schema.Set).List()), "app.example.com", "www.example.com")
+
+	config["hostnames"] = []interface{}{"api.example.com", "www.example.com"}
+	diff, err := r.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err = schema.InternalMap(r.Schema).Data(d.State(), diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := resourceCloudflareMTLSCertificateHostnamesUpdate(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	assertMTLSHostnames(t, associations[certificateID], "api.example.com", "unmanaged.example.com", "www.example.com")
+
+	if diags := resourceCloudflareMTLSCertificateHostnamesDelete(ctx, d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	assertMTLSHostnames(t, associations[certificateID], "unmanaged.example.com")
+	assertMTLSHostnames(t, associations[otherCertificateID], "other.example.com")
+}
+
+func assertMTLSHostnames(t *testing.T, got []string, expected ...string) {
+	t.Helper()
+
+	sorted := append([]string{}, got...)
+	sort.Strings(sorted)
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected hostnames %v, got %v", expected, sorted)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -66,7 +67,7 @@ func resourceCloudflareNotificationPolicyRead(ctx context.Context, d *schema.Res
 	d.Set("modified", policy.Result.Modified.Format(time.RFC3339))
 
 	if policy.Result.Filters != nil && len(policy.Result.Filters) > 0 {
-		if err := d.Set("filters", flattenNotificationPolicyFilter(policy.Result.Filters)); err != nil {
+		if err := d.Set("filters", flattenNotificationPolicyFilter(ctx, policy.Result.Filters)); err != nil {
 			return diag.FromErr(fmt.Errorf("failed to set filters: %w", err))
 		}
 	}
@@ -185,9 +186,19 @@ func expandNotificationPolicyFilter(list []interface{}) map[string][]string {
 	return filters
 }
 
-func flattenNotificationPolicyFilter(filters map[string][]string) []interface{} {
+// flattenNotificationPolicyFilter converts the API filters to state. Filter
+// keys the schema doesn't know about yet are skipped rather than failing the
+// read.
+func flattenNotificationPolicyFilter(ctx context.Context, filters map[string][]string) []interface{} {
+	knownFilters := notificationPolicyFilterSchema().Elem.(*schema.Resource).Schema
+
 	filtersMap := make(map[string]interface{})
 	for k, v := range filters {
+		if _, ok := knownFilters[k]; !ok {
+			tflog.Warn(ctx, fmt.Sprintf("ignoring unsupported notification policy filter %q", k))
+			continue
+		}
+
 		set := schema.NewSet(schema.HashString, []interface{}{})
 		for _, value := range v {
 			set.Add(value)
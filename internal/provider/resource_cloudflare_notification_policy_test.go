@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"sort"
@@ -178,7 +179,7 @@ func TestFlattenExpandFilters(t *testing.T) {
 		"services": {"waf", "firewallrules"},
 		"zones":    {"abc123"},
 	}
-	flattenedFilters := flattenNotificationPolicyFilter(filters)
+	flattenedFilters := flattenNotificationPolicyFilter(context.Background(), filters)
 	expandedFilters := expandNotificationPolicyFilter(flattenedFilters)
 	for k := range filters {
 		sort.Strings(filters[k])
@@ -186,3 +187,14 @@ func TestFlattenExpandFilters(t *testing.T) {
 		assert.EqualValuesf(t, filters[k], expandedFilters[k], "values should equal without order")
 	}
 }
+
+func TestFlattenFiltersIgnoresUnknownKeys(t *testing.T) {
+	filters := map[string][]string{
+		"incident_impact":       {"INCIDENT_IMPACT_MAJOR"},
+		"not_yet_supported_key": {"value"},
+	}
+	flattenedFilters := flattenNotificationPolicyFilter(context.Background(), filters)
+	expandedFilters := expandNotificationPolicyFilter(flattenedFilters)
+
+	assert.Equal(t, map[string][]string{"incident_impact": {"INCIDENT_IMPACT_MAJOR"}}, expandedFilters)
+}
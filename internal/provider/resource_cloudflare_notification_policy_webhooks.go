@@ -64,12 +64,8 @@ func resourceCloudflareNotificationPolicyWebhookRead(ctx context.Context, d *sch
 	d.Set("created_at", notificationWebhooks.Result.CreatedAt.Format(time.RFC3339))
 	d.Set("type", notificationWebhooks.Result.Type)
 
-	if notificationWebhooks.Result.LastSuccess != nil {
-		d.Set("last_success", notificationWebhooks.Result.LastSuccess.Format(time.RFC3339))
-	}
-	if notificationWebhooks.Result.LastFailure != nil {
-		d.Set("last_failure", notificationWebhooks.Result.LastFailure.Format(time.RFC3339))
-	}
+	d.Set("last_success", formatNotificationWebhookTime(notificationWebhooks.Result.LastSuccess))
+	d.Set("last_failure", formatNotificationWebhookTime(notificationWebhooks.Result.LastFailure))
 
 	return nil
 }
@@ -119,6 +115,19 @@ func resourceCloudflareNotificationPolicyWebhookImport(ctx context.Context, d *s
 	return []*schema.ResourceData{d}, nil
 }
 
+// formatNotificationWebhookTime formats an optional delivery timestamp,
+// returning an empty string for webhooks which haven't been used yet.
+func formatNotificationWebhookTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// buildNotificationPolicyWebhooks builds the request body for both creating
+// and updating a webhook. The API replaces the secret on every update, so it
+// is always sent to rotate it in place rather than dropping it.
 func buildNotificationPolicyWebhooks(d *schema.ResourceData) cloudflare.NotificationUpsertWebhooks {
 	webhooks := cloudflare.NotificationUpsertWebhooks{}
 
@@ -130,9 +139,7 @@ func buildNotificationPolicyWebhooks(d *schema.ResourceData) cloudflare.Notifica
 		webhooks.URL = url.(string)
 	}
 
-	if secret, ok := d.GetOk("secret"); ok {
-		webhooks.Secret = secret.(string)
-	}
+	webhooks.Secret = d.Get("secret").(string)
 
 	return webhooks
 }
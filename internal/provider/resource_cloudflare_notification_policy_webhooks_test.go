@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareNotificationPolicyWebhooks(t *testing.T) {
@@ -65,3 +71,59 @@ func testCheckCloudflareNotificationPolicyWebhooksUpdated(resName, webhooksName,
 	url         = "https://example.com"
   }`, resName, webhooksName, accountID)
 }
+
+func TestCloudflareNotificationPolicyWebhooksUpdateRotatesSecret(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const webhookID = "b115d5ec-15c6-41ee-8b76-92c449b5227b"
+
+	var sent cloudflare.NotificationUpsertWebhooks
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/"+accountID+"/alerting/v3/destinations/webhooks/"+webhookID {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Fatal(err)
+			}
+			testAPIResult(w, `{"id": "`+webhookID+`"}`)
+		case http.MethodGet:
+			testAPIResult(w, `{
+  "id": "`+webhookID+`",
+  "name": "example",
+  "type": "generic",
+  "url": "https://example.com",
+  "created_at": "2022-11-01T10:00:00Z",
+  "last_success": "2022-11-02T10:00:00Z",
+  "last_failure": null
+}`)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareNotificationPolicyWebhookSchema(), map[string]interface{}{
+		"account_id":   accountID,
+		"name":         "example",
+		"url":          "https://example.com",
+		"secret":       "rotated-secret",
+		"last_failure": "2022-10-01T10:00:00Z",
+	})
+	d.SetId(webhookID)
+
+	if diags := resourceCloudflareNotificationPolicyWebhookUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if sent.Secret != "rotated-secret" {
+		t.Errorf("expected the secret to be sent, got %q", sent.Secret)
+	}
+	if got := d.Get("last_success").(string); got != "2022-11-02T10:00:00Z" {
+		t.Errorf("expected last_success to be set, got %q", got)
+	}
+	if got := d.Get("last_failure").(string); got != "" {
+		t.Errorf("expected last_failure to be cleared, got %q", got)
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// observatorySchedule is the schedule of a recurring Observatory page test.
+type observatorySchedule struct {
+	URL       string `json:"url"`
+	Region    string `json:"region"`
+	Frequency string `json:"frequency"`
+}
+
+// observatoryTest is a single Observatory page test run.
+type observatoryTest struct {
+	ID   string    `json:"id"`
+	Date time.Time `json:"date"`
+}
+
+type observatoryScheduleCreateResponse struct {
+	Schedule observatorySchedule `json:"schedule"`
+	Test     observatoryTest     `json:"test"`
+}
+
+var observatoryFrequencyIntervals = map[string]time.Duration{
+	"DAILY":  24 * time.Hour,
+	"WEEKLY": 7 * 24 * time.Hour,
+}
+
+func resourceCloudflareObservatoryScheduledTest() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareObservatoryScheduledTestSchema(),
+		CreateContext: resourceCloudflareObservatoryScheduledTestCreate,
+		ReadContext:   resourceCloudflareObservatoryScheduledTestRead,
+		DeleteContext: resourceCloudflareObservatoryScheduledTestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareObservatoryScheduledTestImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Observatory Scheduled Test resource, which
+			runs a speed test of a page on a recurring schedule. Destroying
+			the resource removes the schedule but keeps the results of the
+			tests that have already run.
+		`),
+	}
+}
+
+// normalizeObservatoryURL returns the form of a page URL used by the API,
+// with the hostname lowercased and any trailing slash removed.
+func normalizeObservatoryURL(pageURL string) string {
+	host, path, found := strings.Cut(strings.TrimSpace(pageURL), "/")
+	normalized := strings.ToLower(host)
+	if found {
+		normalized += "/" + path
+	}
+
+	return strings.TrimRight(normalized, "/")
+}
+
+func observatoryScheduleURI(zoneID, pageURL, region string) string {
+	return fmt.Sprintf("/zones/%s/speed_api/schedule/%s?region=%s", zoneID, url.PathEscape(normalizeObservatoryURL(pageURL)), url.QueryEscape(region))
+}
+
+func resourceCloudflareObservatoryScheduledTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+	frequency := strings.ToUpper(d.Get("frequency").(string))
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Observatory schedule for %q in %q", pageURL, region))
+
+	uri := fmt.Sprintf("%s&frequency=%s", observatoryScheduleURI(zoneID, pageURL, region), url.QueryEscape(frequency))
+	res, err := client.Raw(ctx, http.MethodPost, uri, nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating observatory schedule for %q: %w", pageURL, err))
+	}
+
+	var created observatoryScheduleCreateResponse
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling observatory schedule: %w", err))
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", region, normalizeObservatoryURL(pageURL))))
+	d.Set("test_id", created.Test.ID)
+
+	return resourceCloudflareObservatoryScheduledTestRead(ctx, d, meta)
+}
+
+func resourceCloudflareObservatoryScheduledTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, observatoryScheduleURI(zoneID, pageURL, region), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing observatory schedule for %q from state because it's not found in API", pageURL))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error getting observatory schedule for %q: %w", pageURL, err))
+	}
+
+	var schedule observatorySchedule
+	if err := json.Unmarshal(res, &schedule); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling observatory schedule: %w", err))
+	}
+
+	// Keep the configured URL when it only differs from the API's by the
+	// normalization applied to it.
+	if normalizeObservatoryURL(pageURL) != normalizeObservatoryURL(schedule.URL) {
+		d.Set("url", schedule.URL)
+	}
+	d.Set("region", schedule.Region)
+	d.Set("frequency", schedule.Frequency)
+
+	latest, err := getLatestObservatoryTest(ctx, client, zoneID, pageURL, region)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting observatory tests for %q: %w", pageURL, err))
+	}
+
+	nextRun := ""
+	if latest != nil {
+		nextRun = latest.Date.Add(observatoryFrequencyIntervals[schedule.Frequency]).Format(time.RFC3339)
+	}
+	d.Set("next_run", nextRun)
+
+	return nil
+}
+
+// resourceCloudflareObservatoryScheduledTestDelete only removes the schedule,
+// the results of previous tests of the page are kept.
+func resourceCloudflareObservatoryScheduledTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+
+	if _, err := client.Raw(ctx, http.MethodDelete, observatoryScheduleURI(zoneID, pageURL, region), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting observatory schedule for %q: %w", pageURL, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareObservatoryScheduledTestImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/region/url\"", d.Id())
+	}
+	zoneID, region, pageURL := attributes[0], attributes[1], attributes[2]
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", region, normalizeObservatoryURL(pageURL))))
+	d.Set("zone_id", zoneID)
+	d.Set("region", region)
+	d.Set("url", pageURL)
+
+	resourceCloudflareObservatoryScheduledTestRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getLatestObservatoryTest returns the most recent test of a page in a
+// region, or nil when the page hasn't been tested yet.
+func getLatestObservatoryTest(ctx context.Context, client *cloudflare.API, zoneID, pageURL, region string) (*observatoryTest, error) {
+	uri := fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests?region=%s&page=1&per_page=1", zoneID, url.PathEscape(normalizeObservatoryURL(pageURL)), url.QueryEscape(region))
+	res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var tests []observatoryTest
+	if err := json.Unmarshal(res, &tests); err != nil {
+		return nil, fmt.Errorf("error unmarshalling observatory tests: %w", err)
+	}
+
+	if len(tests) == 0 {
+		return nil, nil
+	}
+
+	return &tests[0], nil
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareObservatoryScheduledTest_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_observatory_scheduled_test.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareObservatoryScheduledTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareObservatoryScheduledTest(rnd, zoneID, domain+"/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "url", domain+"/"),
+					resource.TestCheckResourceAttr(name, "region", "us-central1"),
+					resource.TestCheckResourceAttr(name, "frequency", "DAILY"),
+					resource.TestCheckResourceAttrSet(name, "test_id"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/us-central1/%s/", zoneID, domain),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_id"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareObservatoryScheduledTest(resourceName, zoneID, url string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_observatory_scheduled_test" "%[1]s" {
+  zone_id   = "%[2]s"
+  url       = "%[3]s"
+  region    = "us-central1"
+  frequency = "DAILY"
+}`, resourceName, zoneID, url)
+}
+
+func testAccCheckCloudflareObservatoryScheduledTestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_observatory_scheduled_test" {
+			continue
+		}
+
+		uri := observatoryScheduleURI(rs.Primary.Attributes["zone_id"], rs.Primary.Attributes["url"], rs.Primary.Attributes["region"])
+		_, err := client.Raw(context.Background(), http.MethodGet, uri, nil, nil)
+		if err == nil {
+			return fmt.Errorf("observatory schedule for %q still exists", rs.Primary.Attributes["url"])
+		}
+
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return fmt.Errorf("error reading observatory schedule: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func TestNormalizeObservatoryURL(t *testing.T) {
+	testCases := map[string]struct {
+		url      string
+		expected string
+	}{
+		"already normalized": {url: "example.com/blog", expected: "example.com/blog"},
+		"uppercase host":     {url: "WWW.Example.com/blog", expected: "www.example.com/blog"},
+		"trailing slash":     {url: "example.com/", expected: "example.com"},
+		"path case kept":     {url: "Example.com/Blog/", expected: "example.com/Blog"},
+		"host only":          {url: "example.com", expected: "example.com"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := normalizeObservatoryURL(tc.url); got != tc.expected {
+				t.Errorf("normalizeObservatoryURL(%q) = %q, expected %q", tc.url, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestCloudflareObservatoryScheduledTestLifecycle(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const schedulePath = "/zones/" + zoneID + "/speed_api/schedule/example.com%2Fblog"
+	const testsPath = "/zones/" + zoneID + "/speed_api/pages/example.com%2Fblog/tests"
+
+	var requests []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.EscapedPath())
+
+		if r.URL.Query().Get("region") != "europe-west1" {
+			t.Errorf("expected region europe-west1, got %q", r.URL.Query().Get("region"))
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == schedulePath:
+			if r.URL.Query().Get("frequency") != "WEEKLY" {
+				t.Errorf("expected frequency WEEKLY, got %q", r.URL.Query().Get("frequency"))
+			}
+			testAPIResult(w, `{
+  "schedule": {"url": "example.com/blog", "region": "europe-west1", "frequency": "WEEKLY"},
+  "test": {"id": "1c2d8a2d-a1e2-4c1b-9c1e-2f1a2b3c4d5e", "date": "2023-01-02T03:04:05Z"}
+}`)
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == schedulePath:
+			testAPIResult(w, `{"url": "example.com/blog", "region": "europe-west1", "frequency": "WEEKLY"}`)
+		case r.Method == http.MethodGet && r.URL.EscapedPath() == testsPath:
+			testAPIResult(w, `[{"id": "1c2d8a2d-a1e2-4c1b-9c1e-2f1a2b3c4d5e", "date": "2023-01-02T03:04:05Z"}]`)
+		case r.Method == http.MethodDelete && r.URL.EscapedPath() == schedulePath:
+			testAPIResult(w, `{"count": 1}`)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.EscapedPath())
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareObservatoryScheduledTestSchema(), map[string]interface{}{
+		"zone_id":   zoneID,
+		"url":       "Example.com/blog/",
+		"region":    "europe-west1",
+		"frequency": "weekly",
+	})
+
+	if diags := resourceCloudflareObservatoryScheduledTestCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("url").(string); got != "Example.com/blog/" {
+		t.Errorf("expected the configured url to be kept in state, got %q", got)
+	}
+	if got := d.Get("frequency").(string); got != "WEEKLY" {
+		t.Errorf("expected the frequency to be normalized in state, got %q", got)
+	}
+	if got := d.Get("test_id").(string); got != "1c2d8a2d-a1e2-4c1b-9c1e-2f1a2b3c4d5e" {
+		t.Errorf("expected test_id to be set from the created test, got %q", got)
+	}
+	if got := d.Get("next_run").(string); got != "2023-01-09T03:04:05Z" {
+		t.Errorf("expected next_run to be a week after the latest test, got %q", got)
+	}
+
+	requests = nil
+	if diags := resourceCloudflareObservatoryScheduledTestDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(requests) != 1 || requests[0] != http.MethodDelete+" "+schedulePath {
+		t.Errorf("expected delete to only remove the schedule, got requests %v", requests)
+	}
+}
+
+func TestObservatoryScheduledTestFrequencyIsCaseInsensitive(t *testing.T) {
+	r := resourceCloudflareObservatoryScheduledTest()
+	d := r.Data(nil)
+	d.SetId("0da42c8d2132a9ddaf714f9e7c920711/example.com/blog/europe-west1")
+	d.Set("zone_id", "0da42c8d2132a9ddaf714f9e7c920711")
+	d.Set("url", "example.com/blog")
+	d.Set("region", "europe-west1")
+	d.Set("frequency", "DAILY")
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":   "0da42c8d2132a9ddaf714f9e7c920711",
+		"url":       "example.com/blog",
+		"region":    "europe-west1",
+		"frequency": "daily",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Errorf("expected a lower case frequency not to replace the schedule, got %v", diff)
+	}
+
+	if diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":   "0da42c8d2132a9ddaf714f9e7c920711",
+		"url":       "example.com/blog",
+		"region":    "europe-west1",
+		"frequency": "Weekly",
+	})); diags.HasError() {
+		t.Errorf("expected a mixed case frequency to be valid, got %v", diags)
+	}
+}
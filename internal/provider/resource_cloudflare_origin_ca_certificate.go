@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"math"
+	"net/http"
 	"strings"
 	"time"
 
@@ -34,18 +36,23 @@ func resourceCloudflareOriginCACertificate() *schema.Resource {
 }
 
 func mustRenew(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-	// Check when the cert will expire
-	expiresonRaw := d.Get("expires_on")
-	if (expiresonRaw == nil) || (expiresonRaw == "") {
-		return false
+	// Check when the cert will expire, preferring the certificate itself over
+	// the date returned by the API.
+	expireson, err := originCACertificateExpiry(d.Get("certificate").(string))
+	if err != nil {
+		expiresonRaw := d.Get("expires_on")
+		if (expiresonRaw == nil) || (expiresonRaw == "") {
+			return false
+		}
+		expireson, err = time.Parse(time.RFC3339, expiresonRaw.(string))
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to determine when the certificate expires: %s", err))
+			return false
+		}
 	}
-	expireson, _ := time.Parse(time.RFC3339, expiresonRaw.(string))
 
-	// Calculate when we should renew
-	earlyExpiration := expireson.AddDate(0, 0, -1*d.Get("min_days_for_renewal").(int))
-
-	if time.Now().After(earlyExpiration) {
-		tflog.Info(ctx, fmt.Sprintf("We will renew the certificate as we passed the expected date (%s)", earlyExpiration))
+	if originCACertificateInRenewalWindow(expireson, d.Get("min_days_for_renewal").(int), time.Now()) {
+		tflog.Info(ctx, fmt.Sprintf("We will renew the certificate as it expires on %s", expireson))
 		err := d.SetNewComputed("expires_on")
 		if err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("error setting to renew the certificate: %s", err))
@@ -57,6 +64,34 @@ func mustRenew(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bo
 	return false
 }
 
+// originCACertificateInRenewalWindow returns whether a certificate expiring
+// at expiresOn is within minDaysForRenewal days of its expiry at now.
+func originCACertificateInRenewalWindow(expiresOn time.Time, minDaysForRenewal int, now time.Time) bool {
+	earlyExpiration := expiresOn.AddDate(0, 0, -1*minDaysForRenewal)
+
+	return now.After(earlyExpiration)
+}
+
+// originCACertificateExpiry returns the NotAfter date of a PEM encoded
+// certificate.
+func originCACertificateExpiry(certificate string) (time.Time, error) {
+	x509Cert, err := parseOriginCACertificate(certificate)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return x509Cert.NotAfter.UTC(), nil
+}
+
+func parseOriginCACertificate(certificate string) (*x509.Certificate, error) {
+	certBlock, _ := pem.Decode([]byte(certificate))
+	if certBlock == nil {
+		return nil, fmt.Errorf("invalid PEM data")
+	}
+
+	return x509.ParseCertificate(certBlock.Bytes)
+}
+
 func resourceCloudflareOriginCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -94,7 +129,7 @@ func resourceCloudflareOriginCACertificateCreate(ctx context.Context, d *schema.
 func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	certID := d.Id()
-	cert, err := client.GetOriginCACertificate(ctx, certID)
+	cert, err := getOriginCACertificate(ctx, client, certID)
 
 	tflog.Debug(ctx, fmt.Sprintf("OriginCACertificate: %#v", cert))
 
@@ -107,7 +142,7 @@ func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.Re
 		return diag.FromErr(fmt.Errorf("error finding OriginCACertificate %q: %w", certID, err))
 	}
 
-	if cert.RevokedAt != (time.Time{}) {
+	if cert.RevokedAt != "" {
 		tflog.Info(ctx, fmt.Sprintf("OriginCACertificate %s has been revoked", certID))
 		d.SetId("")
 		return nil
@@ -118,23 +153,60 @@ func resourceCloudflareOriginCACertificateRead(ctx context.Context, d *schema.Re
 		hostnames.Add(h)
 	}
 
+	x509Cert, err := parseOriginCACertificate(cert.Certificate)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing OriginCACertificate %q: %w", certID, err))
+	}
+
 	d.Set("certificate", cert.Certificate)
-	d.Set("expires_on", cert.ExpiresOn.Format(time.RFC3339))
+	d.Set("expires_on", x509Cert.NotAfter.UTC().Format(time.RFC3339))
 	d.Set("hostnames", hostnames)
 	d.Set("request_type", cert.RequestType)
+	d.Set("requested_validity", normalizeOriginCARequestedValidity(cert.RequestValidity, d.Get("requested_validity").(int), x509Cert))
 
-	certBlock, _ := pem.Decode([]byte(cert.Certificate))
-	if certBlock == nil {
-		return diag.FromErr(fmt.Errorf("error decoding OriginCACertificate %q: %w", certID, err))
-	}
+	return nil
+}
+
+// originCACertificate is the subset of an Origin CA certificate read by the
+// provider. The requested validity is decoded as a float as the API doesn't
+// always return it as an integer, and it may be omitted.
+type originCACertificate struct {
+	ID              string   `json:"id"`
+	Certificate     string   `json:"certificate"`
+	Hostnames       []string `json:"hostnames"`
+	RequestType     string   `json:"request_type"`
+	RequestValidity *float64 `json:"requested_validity"`
+	RevokedAt       string   `json:"revoked_at"`
+}
 
-	x509Cert, err := x509.ParseCertificate(certBlock.Bytes)
+func getOriginCACertificate(ctx context.Context, client *cloudflare.API, certID string) (originCACertificate, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/certificates/%s", certID), nil, nil)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing OriginCACertificate %q: %w", certID, err))
+		return originCACertificate{}, err
 	}
-	d.Set("requested_validity", calculateRequestedValidityFromCertificate(x509Cert))
 
-	return nil
+	var cert originCACertificate
+	if err := json.Unmarshal(res, &cert); err != nil {
+		return originCACertificate{}, fmt.Errorf("error unmarshalling OriginCACertificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+// normalizeOriginCARequestedValidity returns the requested validity of a
+// certificate as one of the values accepted by the schema. When the API
+// omits it, the configured value is kept and only derived from the
+// certificate lifetime if there is none, e.g. on import.
+func normalizeOriginCARequestedValidity(requestValidity *float64, configured int, cert *x509.Certificate) int {
+	if requestValidity != nil && *requestValidity > 0 {
+		return closestOriginCARequestedValidity(math.Round(*requestValidity))
+	}
+
+	if configured > 0 {
+		return configured
+	}
+
+	return calculateRequestedValidityFromCertificate(cert)
 }
 
 func resourceCloudflareOriginCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -171,9 +243,14 @@ func calculateRequestedValidityFromCertificate(cert *x509.Certificate) int {
 	diff := cert.NotAfter.UTC().Sub(cert.NotBefore.UTC())
 	days := math.Round(diff.Hours() / 24)
 
+	return closestOriginCARequestedValidity(days)
+}
+
+// closestOriginCARequestedValidity returns the requested validity (in days)
+// closest to days, to avoid possible leap second issues.
+func closestOriginCARequestedValidity(days float64) int {
 	validateDays := []float64{7, 30, 90, 365, 730, 1095, 5475}
 
-	// Find the closest matching requested validity (in days) to avoid possible leap second issue.
 	i := 0
 	d := math.Abs(days - validateDays[i])
 	distanceIdx := i
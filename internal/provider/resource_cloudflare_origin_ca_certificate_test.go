@@ -6,8 +6,11 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
@@ -204,3 +207,156 @@ resource "cloudflare_origin_ca_certificate" "%[1]s" {
 }
 `, name, zoneName, csr)
 }
+
+// generateTestCertificate returns a self-signed PEM encoded certificate valid
+// between notBefore and notAfter.
+func generateTestCertificate(t *testing.T, notBefore, notAfter time.Time) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestOriginCACertificateRenewalWindow(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		notBefore         time.Time
+		notAfter          time.Time
+		minDaysForRenewal int
+		renew             bool
+	}{
+		"7 day certificate outside the window": {
+			notBefore:         now.AddDate(0, 0, -2),
+			notAfter:          now.AddDate(0, 0, 5),
+			minDaysForRenewal: 3,
+			renew:             false,
+		},
+		"7 day certificate inside the window": {
+			notBefore:         now.AddDate(0, 0, -5),
+			notAfter:          now.AddDate(0, 0, 2),
+			minDaysForRenewal: 3,
+			renew:             true,
+		},
+		"90 day certificate without a renewal window": {
+			notBefore:         now.AddDate(0, 0, -89),
+			notAfter:          now.AddDate(0, 0, 1),
+			minDaysForRenewal: 0,
+			renew:             false,
+		},
+		"expired certificate without a renewal window": {
+			notBefore:         now.AddDate(0, 0, -91),
+			notAfter:          now.AddDate(0, 0, -1),
+			minDaysForRenewal: 0,
+			renew:             true,
+		},
+		"365 day certificate outside the window": {
+			notBefore:         now.AddDate(0, 0, -300),
+			notAfter:          now.AddDate(0, 0, 65),
+			minDaysForRenewal: 30,
+			renew:             false,
+		},
+		"15 year certificate inside a large window": {
+			notBefore:         now.AddDate(-14, 0, 0),
+			notAfter:          now.AddDate(1, 0, 0),
+			minDaysForRenewal: 400,
+			renew:             true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			expiresOn, err := originCACertificateExpiry(generateTestCertificate(t, tc.notBefore, tc.notAfter))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !expiresOn.Equal(tc.notAfter) {
+				t.Errorf("expected the expiry to be %s, got %s", tc.notAfter, expiresOn)
+			}
+
+			if got := originCACertificateInRenewalWindow(expiresOn, tc.minDaysForRenewal, now); got != tc.renew {
+				t.Errorf("expected renewal to be %t, got %t", tc.renew, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeOriginCARequestedValidity(t *testing.T) {
+	notBefore := time.Date(2022, 1, 18, 10, 48, 0, 0, time.UTC)
+	cert := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.AddDate(0, 0, 90)}
+
+	testCases := map[string]struct {
+		requestValidity *float64
+		configured      int
+		expected        int
+	}{
+		"integer from the API":         {requestValidity: cloudflare.Float64Ptr(5475), configured: 5475, expected: 5475},
+		"float from the API":           {requestValidity: cloudflare.Float64Ptr(364.99), configured: 365, expected: 365},
+		"omitted by the API":           {requestValidity: nil, configured: 5475, expected: 5475},
+		"omitted by the API on import": {requestValidity: nil, configured: 0, expected: 90},
+		"zero from the API":            {requestValidity: cloudflare.Float64Ptr(0), configured: 30, expected: 30},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := normalizeOriginCARequestedValidity(tc.requestValidity, tc.configured, cert); got != tc.expected {
+				t.Errorf("expected requested validity %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudflareOriginCACertificateReadFloatRequestedValidity(t *testing.T) {
+	const certID = "328578533902268680212849205732770752308931942346"
+	notBefore := time.Now().UTC().Truncate(time.Second)
+	certificate := generateTestCertificate(t, notBefore, notBefore.AddDate(15, 0, 0))
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/certificates/"+certID {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResultJSON(w, map[string]interface{}{
+			"id":                 certID,
+			"certificate":        certificate,
+			"hostnames":          []string{"example.com"},
+			"expires_on":         "2014-01-01 05:20:00 +0000 UTC",
+			"request_type":       "origin-rsa",
+			"requested_validity": json.Number("5475.0"),
+		})
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareOriginCACertificateSchema(), map[string]interface{}{
+		"hostnames":          []interface{}{"example.com"},
+		"request_type":       "origin-rsa",
+		"requested_validity": 5475,
+	})
+	d.SetId(certID)
+
+	if diags := resourceCloudflareOriginCACertificateRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("requested_validity").(int); got != 5475 {
+		t.Errorf("expected requested_validity to be 5475, got %d", got)
+	}
+	if got, expected := d.Get("expires_on").(string), notBefore.AddDate(15, 0, 0).Format(time.RFC3339); got != expected {
+		t.Errorf("expected expires_on to come from the certificate (%s), got %s", expected, got)
+	}
+}
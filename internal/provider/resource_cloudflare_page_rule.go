@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -285,59 +285,11 @@ func transformFromCloudflarePageRuleAction(pageRuleAction *cloudflare.PageRuleAc
 		break
 
 	case pageRuleAction.ID == "cache_key_fields":
-		output := make(map[string]interface{})
-
-		for sectionID, sectionValue := range pageRuleAction.Value.(map[string]interface{}) {
-			switch sectionID {
-			case "cookie", "header", "host", "user":
-				output[sectionID] = []interface{}{sectionValue}
-
-			case "query_string":
-				fieldOutput := map[string]interface{}{}
-
-				for fieldID, fieldValue := range sectionValue.(map[string]interface{}) {
-					fieldOutput[fieldID] = fieldValue
-				}
-
-				if reflect.TypeOf(fieldOutput["exclude"]).Kind() == reflect.String && fieldOutput["exclude"] == "*" {
-					fieldOutput["ignore"] = true
-					fieldOutput["exclude"] = []interface{}{}
-				}
-
-				if reflect.TypeOf(fieldOutput["include"]).Kind() == reflect.String && fieldOutput["include"] == "*" {
-					fieldOutput["ignore"] = false
-					fieldOutput["include"] = []interface{}{}
-				}
-
-				output[sectionID] = []interface{}{fieldOutput}
-			}
-		}
-
-		value = []interface{}{output}
+		value = flattenPageRuleCacheKeyFields(pageRuleAction.Value.(map[string]interface{}))
 		break
 
 	case pageRuleAction.ID == "cache_ttl_by_status":
-		output := make([]map[string]interface{}, 0)
-
-		for key, value := range pageRuleAction.Value.(map[string]interface{}) {
-			entry := map[string]interface{}{"codes": key}
-
-			switch value := value.(type) {
-			case float64:
-				entry["ttl"] = int32(value)
-			case string:
-				switch value {
-				case "no-cache":
-					entry["ttl"] = 0
-				case "no-store":
-					entry["ttl"] = -1
-				}
-			}
-
-			output = append(output, entry)
-		}
-
-		value = output
+		value = flattenPageRuleCacheTTLByStatus(pageRuleAction.Value.(map[string]interface{}))
 		break
 
 	default:
@@ -422,7 +374,7 @@ func transformToCloudflarePageRuleAction(ctx context.Context, id string, value i
 				switch sectionID {
 				case "cookie", "header":
 					for fieldID, fieldValue := range sectionValue.([]interface{})[0].(map[string]interface{}) {
-						sectionOutput[fieldID] = fieldValue.(*schema.Set).List()
+						sectionOutput[fieldID] = sortedStringList(fieldValue.(*schema.Set).List())
 					}
 				case "query_string":
 					if sectionValue.([]interface{})[0] != nil {
@@ -479,11 +431,11 @@ func transformToCloudflarePageRuleAction(ctx context.Context, id string, value i
 		tflog.Debug(ctx, fmt.Sprintf("cache_ttl_by_status action to be applied: %#v", cacheTTLActionSchema))
 
 		if cacheTTLActionSchema.Len() != 0 {
-			output := make(map[string]int)
+			output := make(map[string]interface{})
 
 			for _, code := range cacheTTLActionSchema.List() {
 				code := code.(map[string]interface{})
-				output[code["codes"].(string)] = code["ttl"].(int)
+				output[normalizePageRuleStatusCodes(code["codes"].(string))] = expandPageRuleCacheTTL(code["ttl"].(int))
 			}
 
 			pageRuleAction.Value = output
@@ -497,6 +449,158 @@ func transformToCloudflarePageRuleAction(ctx context.Context, id string, value i
 	return
 }
 
+// pageRuleCacheTTLs maps the TTLs of `cache_ttl_by_status` which the API
+// represents as strings.
+var pageRuleCacheTTLs = map[string]int{
+	"no-cache": 0,
+	"no-store": -1,
+}
+
+func expandPageRuleCacheTTL(ttl int) interface{} {
+	for value, t := range pageRuleCacheTTLs {
+		if t == ttl {
+			return value
+		}
+	}
+
+	return ttl
+}
+
+// flattenPageRuleCacheTTLByStatus keeps the status codes exactly as the API
+// returns them, e.g. `200-299`.
+func flattenPageRuleCacheTTLByStatus(value map[string]interface{}) []interface{} {
+	output := make([]interface{}, 0, len(value))
+
+	for codes, ttl := range value {
+		entry := map[string]interface{}{"codes": codes}
+
+		switch ttl := ttl.(type) {
+		case float64:
+			entry["ttl"] = int(ttl)
+		case string:
+			if t, ok := pageRuleCacheTTLs[ttl]; ok {
+				entry["ttl"] = t
+			} else if t, err := strconv.Atoi(ttl); err == nil {
+				entry["ttl"] = t
+			}
+		}
+
+		output = append(output, entry)
+	}
+
+	return output
+}
+
+// normalizePageRuleStatusCodes removes the whitespace the API drops from
+// status codes and ranges, e.g. `200 - 299`.
+func normalizePageRuleStatusCodes(codes string) string {
+	return strings.Join(strings.Fields(codes), "")
+}
+
+// hashPageRuleCacheTTLByStatus hashes a `cache_ttl_by_status` entry on its
+// normalised status codes so configured and returned values are equal.
+func hashPageRuleCacheTTLByStatus(v interface{}) int {
+	m := v.(map[string]interface{})
+
+	return schema.HashString(fmt.Sprintf("%s-%d", normalizePageRuleStatusCodes(m["codes"].(string)), m["ttl"].(int)))
+}
+
+func suppressEquivalentPageRuleStatusCodes(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePageRuleStatusCodes(old) == normalizePageRuleStatusCodes(new)
+}
+
+// hashPageRuleCacheKeyHeader hashes header names case insensitively as the
+// API returns them in lower case.
+func hashPageRuleCacheKeyHeader(v interface{}) int {
+	return schema.HashString(strings.ToLower(v.(string)))
+}
+
+func suppressEquivalentPageRuleHeaderNames(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// flattenPageRuleCacheKeyFields returns every section and field of the cache
+// key, sorting lists and filling in those the API omits so that the result
+// is the same regardless of what the API returned last.
+func flattenPageRuleCacheKeyFields(value map[string]interface{}) []interface{} {
+	section := func(id string) map[string]interface{} {
+		if m, ok := value[id].(map[string]interface{}); ok {
+			return m
+		}
+		return map[string]interface{}{}
+	}
+	list := func(v interface{}) []interface{} {
+		if l, ok := v.([]interface{}); ok {
+			return sortedStringList(l)
+		}
+		return []interface{}{}
+	}
+	boolean := func(v interface{}) bool {
+		b, _ := v.(bool)
+		return b
+	}
+
+	cookie := section("cookie")
+	header := section("header")
+	host := section("host")
+	queryString := section("query_string")
+	user := section("user")
+
+	headerNames := func(v interface{}) []interface{} {
+		names := list(v)
+		for i, name := range names {
+			names[i] = strings.ToLower(name.(string))
+		}
+		return sortedStringList(names)
+	}
+
+	// `exclude = "*"` ignores the query string completely and `include = "*"`
+	// uses all of it, which is also the default.
+	queryStringOutput := map[string]interface{}{
+		"ignore":  false,
+		"include": list(queryString["include"]),
+		"exclude": list(queryString["exclude"]),
+	}
+	if queryString["exclude"] == "*" {
+		queryStringOutput["ignore"] = true
+	}
+
+	output := map[string]interface{}{
+		"cookie": []interface{}{map[string]interface{}{
+			"check_presence": list(cookie["check_presence"]),
+			"include":        list(cookie["include"]),
+		}},
+		"header": []interface{}{map[string]interface{}{
+			"check_presence": headerNames(header["check_presence"]),
+			"exclude":        headerNames(header["exclude"]),
+			"include":        headerNames(header["include"]),
+		}},
+		"host": []interface{}{map[string]interface{}{
+			"resolved": boolean(host["resolved"]),
+		}},
+		"query_string": []interface{}{queryStringOutput},
+		"user": []interface{}{map[string]interface{}{
+			"device_type": boolean(user["device_type"]),
+			"geo":         boolean(user["geo"]),
+			"lang":        boolean(user["lang"]),
+		}},
+	}
+
+	return []interface{}{output}
+}
+
+func sortedStringList(values []interface{}) []interface{} {
+	sorted := expandInterfaceToStringList(values)
+	sort.Strings(sorted)
+
+	output := make([]interface{}, 0, len(sorted))
+	for _, v := range sorted {
+		output = append(output, v)
+	}
+
+	return output
+}
+
 func resourceCloudflarePageRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// split the id so we can lookup
 	idAttr := strings.SplitN(d.Id(), "/", 2)
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
 	"regexp"
@@ -403,6 +404,127 @@ func TestCacheKeyFieldsNilValue(t *testing.T) {
 	}
 }
 
+func TestCloudflarePageRuleCacheActionsNoDiff(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const pageRuleID = "9a7806061c88ada191ed06f989cc3dac"
+
+	// Captured from the API, which lower cases header names, returns lists
+	// in no particular order and the TTLs of uncached statuses as strings.
+	payloads := map[string]string{
+		"query string includes": `{
+  "cookie": {"include": ["session", "lang"], "check_presence": []},
+  "header": {"include": ["x-forwarded-host", "accept-language"], "exclude": [], "check_presence": []},
+  "host": {"resolved": true},
+  "query_string": {"include": ["utm_source", "page"]},
+  "user": {"device_type": true, "geo": false, "lang": false}
+}`,
+		"query string ignored": `{
+  "cookie": {"check_presence": ["lang", "session"]},
+  "header": {"include": ["accept-language", "x-forwarded-host"]},
+  "host": {},
+  "query_string": {"exclude": "*"},
+  "user": {"device_type": true}
+}`,
+	}
+	configs := map[string]map[string]interface{}{
+		"query string includes": {
+			"cookie":       []interface{}{map[string]interface{}{"include": []interface{}{"lang", "session"}}},
+			"header":       []interface{}{map[string]interface{}{"include": []interface{}{"X-Forwarded-Host", "Accept-Language"}}},
+			"host":         []interface{}{map[string]interface{}{"resolved": true}},
+			"query_string": []interface{}{map[string]interface{}{"include": []interface{}{"page", "utm_source"}}},
+			"user":         []interface{}{map[string]interface{}{"device_type": true, "geo": false, "lang": false}},
+		},
+		"query string ignored": {
+			"cookie":       []interface{}{map[string]interface{}{"check_presence": []interface{}{"session", "lang"}}},
+			"header":       []interface{}{map[string]interface{}{"include": []interface{}{"X-Forwarded-Host", "Accept-Language"}}},
+			"host":         []interface{}{map[string]interface{}{}},
+			"query_string": []interface{}{map[string]interface{}{"ignore": true}},
+			"user":         []interface{}{map[string]interface{}{"device_type": true}},
+		},
+	}
+
+	for name, payload := range payloads {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/zones/"+zoneID+"/pagerules/"+pageRuleID {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/*"}}],
+  "actions": [
+    {"id": "cache_key_fields", "value": %s},
+    {"id": "cache_ttl_by_status", "value": {"200-299": 86400, "404": "no-cache", "500-599": "no-store"}}
+  ],
+  "priority": 1,
+  "status": "active"
+}`, pageRuleID, payload))
+			})
+
+			config := map[string]interface{}{
+				"zone_id": zoneID,
+				"target":  "example.com/*",
+				"actions": []interface{}{map[string]interface{}{
+					"cache_key_fields": []interface{}{configs[name]},
+					"cache_ttl_by_status": []interface{}{
+						map[string]interface{}{"codes": "500-599", "ttl": -1},
+						map[string]interface{}{"codes": "200 - 299", "ttl": 86400},
+						map[string]interface{}{"codes": "404", "ttl": 0},
+					},
+				}},
+			}
+
+			r := resourceCloudflarePageRule()
+			d := schema.TestResourceDataRaw(t, r.Schema, config)
+			d.SetId(pageRuleID)
+
+			if diags := resourceCloudflarePageRuleRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff != nil && len(diff.Attributes) > 0 {
+				for k, v := range diff.Attributes {
+					t.Errorf("unexpected diff for %s: %q => %q", k, v.Old, v.New)
+				}
+			}
+		})
+	}
+}
+
+func TestTransformToCloudflarePageRuleActionCacheTTLByStatus(t *testing.T) {
+	r := resourceCloudflarePageRule()
+	codes := r.Schema["actions"].Elem.(*schema.Resource).Schema["cache_ttl_by_status"]
+
+	pageRuleAction, err := transformToCloudflarePageRuleAction(
+		context.Background(),
+		"cache_ttl_by_status",
+		schema.NewSet(codes.Set, []interface{}{
+			map[string]interface{}{"codes": "200 - 299", "ttl": 86400},
+			map[string]interface{}{"codes": "404", "ttl": 0},
+			map[string]interface{}{"codes": "500-599", "ttl": -1},
+		}),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("Unexpected error transforming page rule action: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"200-299": 86400,
+		"404":     "no-cache",
+		"500-599": "no-store",
+	}
+	if !reflect.DeepEqual(pageRuleAction.Value, expected) {
+		t.Fatalf("Unexpected transformToCloudflarePageRuleAction result, expected %#v, got %#v", expected, pageRuleAction.Value)
+	}
+}
+
 func TestAccCloudflarePageRule_CreatesBrowserCacheTTLIntegerValues(t *testing.T) {
 	var pageRule cloudflare.PageRule
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
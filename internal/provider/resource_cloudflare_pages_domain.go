@@ -2,16 +2,27 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// pagesDomainPendingStatuses are the statuses of a domain which is still
+// being validated and waiting for its certificate.
+var pagesDomainPendingStatuses = []string{"initializing", "pending"}
+
+// pagesDomainFailedStatuses are the statuses of a domain which won't become
+// active without intervention.
+var pagesDomainFailedStatuses = []string{"blocked", "error"}
+
 func resourceCloudflarePagesDomain() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflarePagesDomainSchema(),
@@ -21,8 +32,13 @@ func resourceCloudflarePagesDomain() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflarePagesDomainImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Description: heredoc.Doc(`
-			Provides a resource for managing Cloudflare Pages domains.
+			Provides a resource for managing Cloudflare Pages domains. Creating
+			the resource waits for the domain to be validated and for its
+			certificate to be issued.
 		`),
 	}
 }
@@ -41,10 +57,53 @@ func resourceCloudflarePagesDomainCreate(ctx context.Context, d *schema.Resource
 
 	r, err := client.PagesAddDomain(ctx, params)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating domain for project %q: %w", accountID, err))
+		return diag.FromErr(fmt.Errorf("error creating domain for project %q: %w", projectName, err))
 	}
 	d.SetId(r.ID)
-	return resourceCloudflarePagesDomainRead(ctx, d, meta)
+
+	diags := waitForPagesDomainCertificate(ctx, client, params, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceCloudflarePagesDomainRead(ctx, d, meta)...)
+}
+
+// waitForPagesDomainCertificate polls the domain until it's no longer being
+// validated. Running out of time isn't an error as validation depends on
+// DNS records which may be created later.
+func waitForPagesDomainCertificate(ctx context.Context, client *cloudflare.API, params cloudflare.PagesDomainParameters, timeout time.Duration) diag.Diagnostics {
+	var domain cloudflare.PagesDomain
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		domain, err = client.GetPagesDomain(ctx, params)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error reading domain %q of project %q: %w", params.DomainName, params.ProjectName, err))
+		}
+
+		if contains(pagesDomainPendingStatuses, domain.Status) {
+			return resource.RetryableError(fmt.Errorf("domain %q is %s", params.DomainName, domain.Status))
+		}
+
+		return nil
+	})
+
+	if err != nil && contains(pagesDomainPendingStatuses, domain.Status) {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Domain %q is not active yet", params.DomainName),
+			Detail:   fmt.Sprintf("The domain is still %s with validation %s. It becomes active once it's pointed at the %s Pages project with a CNAME record.", domain.Status, domain.ValidationData.Status, params.ProjectName),
+		}}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if contains(pagesDomainFailedStatuses, domain.Status) {
+		return diag.Errorf("domain %q of project %q failed to activate with status %s", params.DomainName, params.ProjectName, domain.Status)
+	}
+
+	return nil
 }
 
 func resourceCloudflarePagesDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -60,7 +119,13 @@ func resourceCloudflarePagesDomainRead(ctx context.Context, d *schema.ResourceDa
 	}
 	r, err := client.GetPagesDomain(ctx, params)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating domain for project %q: %w", accountID, err))
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Domain %s of Pages project %s no longer exists", domain, projectName))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading domain for project %q: %w", projectName, err))
 	}
 	d.Set("status", r.Status)
 	return nil
@@ -79,7 +144,7 @@ func resourceCloudflarePagesDomainDelete(ctx context.Context, d *schema.Resource
 	}
 	err := client.PagesDeleteDomain(ctx, params)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating domain for project %q: %w", accountID, err))
+		return diag.FromErr(fmt.Errorf("error deleting domain for project %q: %w", projectName, err))
 	}
 	return nil
 }
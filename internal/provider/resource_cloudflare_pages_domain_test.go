@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -48,3 +53,53 @@ func TestAccTestPagesDomain(t *testing.T) {
 		},
 	})
 }
+
+func TestWaitForPagesDomainCertificate(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	testCases := map[string]struct {
+		statuses   []string
+		diagnostic bool
+		severity   diag.Severity
+	}{
+		"becomes active": {statuses: []string{"initializing", "pending", "active"}},
+		"still pending":  {statuses: []string{"pending"}, diagnostic: true, severity: diag.Warning},
+		"fails":          {statuses: []string{"pending", "error"}, diagnostic: true, severity: diag.Error},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requests int
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/pages/projects/example/domains/www.example.com" {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				status := tc.statuses[len(tc.statuses)-1]
+				if requests < len(tc.statuses) {
+					status = tc.statuses[requests]
+				}
+				requests++
+				testAPIResult(w, fmt.Sprintf(`{"id": "domain-id", "name": "www.example.com", "status": %q, "validation_data": {"status": %q}}`, status, status))
+			})
+
+			diags := waitForPagesDomainCertificate(context.Background(), client, cloudflare.PagesDomainParameters{
+				AccountID:   accountID,
+				ProjectName: "example",
+				DomainName:  "www.example.com",
+			}, 5*time.Second)
+
+			if !tc.diagnostic {
+				if len(diags) != 0 {
+					t.Fatalf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Severity != tc.severity {
+				t.Fatalf("expected a single diagnostic with severity %v, got %v", tc.severity, diags)
+			}
+		})
+	}
+}
@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -30,8 +33,40 @@ func resourceCloudflarePagesProject() *schema.Resource {
 	}
 }
 
-func buildDeploymentConfig(environment interface{}) cloudflare.PagesProjectDeploymentConfigEnvironment {
-	config := cloudflare.PagesProjectDeploymentConfigEnvironment{}
+// pagesProject extends cloudflare.PagesProject with the build config and
+// deployment config fields not supported by the library.
+type pagesProject struct {
+	cloudflare.PagesProject
+	BuildConfig       pagesProjectBuildConfig       `json:"build_config"`
+	DeploymentConfigs pagesProjectDeploymentConfigs `json:"deployment_configs"`
+}
+
+type pagesProjectBuildConfig struct {
+	cloudflare.PagesProjectBuildConfig
+	BuildCaching *bool `json:"build_caching,omitempty"`
+}
+
+type pagesProjectDeploymentConfigs struct {
+	Preview    pagesProjectDeploymentConfigEnvironment `json:"preview"`
+	Production pagesProjectDeploymentConfigEnvironment `json:"production"`
+}
+
+type pagesProjectDeploymentConfigEnvironment struct {
+	cloudflare.PagesProjectDeploymentConfigEnvironment
+	QueueProducers          map[string]*pagesQueueProducerBinding          `json:"queue_producers,omitempty"`
+	AnalyticsEngineDatasets map[string]*pagesAnalyticsEngineDatasetBinding `json:"analytics_engine_datasets,omitempty"`
+}
+
+type pagesQueueProducerBinding struct {
+	Name string `json:"name"`
+}
+
+type pagesAnalyticsEngineDatasetBinding struct {
+	Dataset string `json:"dataset"`
+}
+
+func buildDeploymentConfig(environment interface{}) pagesProjectDeploymentConfigEnvironment {
+	config := pagesProjectDeploymentConfigEnvironment{}
 	parsed := environment.(map[string]interface{})
 	deploymentVariables := cloudflare.EnvironmentVariableMap{}
 	for key, value := range parsed {
@@ -46,6 +81,15 @@ func buildDeploymentConfig(environment interface{}) cloudflare.PagesProjectDeplo
 				deploymentVariables[i] = &envVar
 			}
 
+			break
+		case "secrets":
+			variables := value.(map[string]interface{})
+			for i, variable := range variables {
+				deploymentVariables[i] = &cloudflare.EnvironmentVariable{
+					Value: variable.(string),
+					Type:  cloudflare.SecretText,
+				}
+			}
 			break
 		case "kv_namespaces":
 			namespace := cloudflare.NamespaceBindingMap{}
@@ -79,6 +123,22 @@ func buildDeploymentConfig(environment interface{}) cloudflare.PagesProjectDeplo
 			}
 			config.R2Bindings = bindingMap
 			break
+		case "queue_producers":
+			bindingMap := map[string]*pagesQueueProducerBinding{}
+			variables := value.(map[string]interface{})
+			for i, variable := range variables {
+				bindingMap[i] = &pagesQueueProducerBinding{Name: variable.(string)}
+			}
+			config.QueueProducers = bindingMap
+			break
+		case "analytics_engine_datasets":
+			bindingMap := map[string]*pagesAnalyticsEngineDatasetBinding{}
+			variables := value.(map[string]interface{})
+			for i, variable := range variables {
+				bindingMap[i] = &pagesAnalyticsEngineDatasetBinding{Dataset: variable.(string)}
+			}
+			config.AnalyticsEngineDatasets = bindingMap
+			break
 		case "compatibility_date":
 			config.CompatibilityDate = value.(string)
 			break
@@ -113,7 +173,9 @@ func buildDeploymentConfig(environment interface{}) cloudflare.PagesProjectDeplo
 	return config
 }
 
-func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnvironment) (returnValue []map[string]interface{}) {
+// parseDeploymentConfig flattens a deployment config. The API redacts the
+// values of secrets, so those are taken from the given secrets of the state.
+func parseDeploymentConfig(deployment pagesProjectDeploymentConfigEnvironment, secrets map[string]interface{}) (returnValue []map[string]interface{}) {
 	config := make(map[string]interface{})
 
 	config["compatibility_date"] = deployment.CompatibilityDate
@@ -124,12 +186,18 @@ func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnv
 	config["usage_model"] = deployment.UsageModel
 
 	deploymentVars := map[string]string{}
+	deploymentSecrets := map[string]string{}
 	for key, value := range deployment.EnvVars {
-		if value.Type == cloudflare.PlainText {
+		switch value.Type {
+		case cloudflare.PlainText:
 			deploymentVars[key] = value.Value
+		case cloudflare.SecretText:
+			secret, _ := secrets[key].(string)
+			deploymentSecrets[key] = secret
 		}
 	}
 	config["environment_variables"] = deploymentVars
+	config["secrets"] = deploymentSecrets
 
 	deploymentVars = map[string]string{}
 	for key, value := range deployment.KvNamespaces {
@@ -155,6 +223,18 @@ func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnv
 	}
 	config["d1_databases"] = deploymentVars
 
+	deploymentVars = map[string]string{}
+	for key, value := range deployment.QueueProducers {
+		deploymentVars[key] = value.Name
+	}
+	config["queue_producers"] = deploymentVars
+
+	deploymentVars = map[string]string{}
+	for key, value := range deployment.AnalyticsEngineDatasets {
+		deploymentVars[key] = value.Dataset
+	}
+	config["analytics_engine_datasets"] = deploymentVars
+
 	serviceBindings := &schema.Set{F: schema.HashResource(serviceBindingResource)}
 	for key, value := range deployment.ServiceBindings {
 		serviceBindings.Add(map[string]interface{}{
@@ -169,13 +249,13 @@ func parseDeploymentConfig(deployment cloudflare.PagesProjectDeploymentConfigEnv
 	return
 }
 
-func buildPagesProject(d *schema.ResourceData) cloudflare.PagesProject {
-	project := cloudflare.PagesProject{}
+func buildPagesProject(d *schema.ResourceData) pagesProject {
+	project := pagesProject{}
 	project.Name = d.Get("name").(string)
 	project.ProductionBranch = d.Get("production_branch").(string)
 
 	if _, ok := d.GetOk("build_config"); ok {
-		buildConfig := cloudflare.PagesProjectBuildConfig{}
+		buildConfig := pagesProjectBuildConfig{}
 		if buildCommand, ok := d.GetOk("build_config.0.build_command"); ok {
 			buildConfig.BuildCommand = buildCommand.(string)
 		}
@@ -191,6 +271,13 @@ func buildPagesProject(d *schema.ResourceData) cloudflare.PagesProject {
 		if webAnalyticsToken, ok := d.GetOk("build_config.0.web_analytics_token"); ok {
 			buildConfig.WebAnalyticsToken = webAnalyticsToken.(string)
 		}
+		// build_caching is only sent when it's configured, so the setting of
+		// the project is left alone otherwise.
+		if rawBuildConfig := tunnelConfigRawBlock(d.GetRawConfig(), "build_config", 0); !rawBuildConfig.IsNull() {
+			if buildCaching := rawBuildConfig.GetAttr("build_caching"); !buildCaching.IsNull() && buildCaching.IsKnown() {
+				buildConfig.BuildCaching = cloudflare.BoolPtr(buildCaching.True())
+			}
+		}
 		project.BuildConfig = buildConfig
 	}
 
@@ -254,11 +341,22 @@ func resourceCloudflarePagesProjectRead(ctx context.Context, d *schema.ResourceD
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	project, err := client.PagesProject(ctx, accountID, d.Id())
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, d.Id()), nil, nil)
 	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Pages project %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(fmt.Errorf("error reading cloudflare pages project %q: %w", d.Id(), err))
 	}
 
+	var project pagesProject
+	if err := json.Unmarshal(res, &project); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling cloudflare pages project %q: %w", d.Id(), err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Cloudflare Pages Project Response: %#v", project))
 	d.Set("subdomain", project.SubDomain)
 	d.Set("production_branch", project.ProductionBranch)
@@ -267,30 +365,13 @@ func resourceCloudflarePagesProjectRead(ctx context.Context, d *schema.ResourceD
 	d.Set("created_on", project.CreatedOn.Format(time.RFC3339))
 
 	if project.Source != nil {
-		var source []map[string]interface{}
-		source = append(source, map[string]interface{}{
-			"type": project.Source.Type,
-			"config": []map[string]interface{}{
-				{
-					"owner":                         project.Source.Config.Owner,
-					"repo_name":                     project.Source.Config.RepoName,
-					"production_branch":             project.Source.Config.ProductionBranch,
-					"pr_comments_enabled":           project.Source.Config.PRCommentsEnabled,
-					"deployments_enabled":           project.Source.Config.DeploymentsEnabled,
-					"production_deployment_enabled": project.Source.Config.ProductionDeploymentsEnabled,
-					"preview_branch_includes":       project.Source.Config.PreviewBranchIncludes,
-					"preview_branch_excludes":       project.Source.Config.PreviewBranchExcludes,
-					"preview_deployment_setting":    project.Source.Config.PreviewDeploymentSetting,
-				},
-			},
-		},
-		)
-		d.Set("source", source)
+		d.Set("source", flattenPagesProjectSource(project.Source))
 	}
-	emptyProjectBuildConfig := cloudflare.PagesProjectBuildConfig{}
-	if project.BuildConfig != emptyProjectBuildConfig {
+
+	if project.BuildConfig != (pagesProjectBuildConfig{}) {
 		var buildConfig []map[string]interface{}
 		buildConfig = append(buildConfig, map[string]interface{}{
+			"build_caching":       cloudflare.Bool(project.BuildConfig.BuildCaching),
 			"build_command":       project.BuildConfig.BuildCommand,
 			"destination_dir":     project.BuildConfig.DestinationDir,
 			"root_dir":            project.BuildConfig.RootDir,
@@ -303,25 +384,54 @@ func resourceCloudflarePagesProjectRead(ctx context.Context, d *schema.ResourceD
 
 	var deploymentConfigs []map[string]interface{}
 	deploymentConfig := make(map[string]interface{})
-	deploymentConfig["preview"] = parseDeploymentConfig(project.DeploymentConfigs.Preview)
-	deploymentConfig["production"] = parseDeploymentConfig(project.DeploymentConfigs.Production)
+	previewSecrets, _ := d.Get("deployment_configs.0.preview.0.secrets").(map[string]interface{})
+	productionSecrets, _ := d.Get("deployment_configs.0.production.0.secrets").(map[string]interface{})
+	deploymentConfig["preview"] = parseDeploymentConfig(project.DeploymentConfigs.Preview, previewSecrets)
+	deploymentConfig["production"] = parseDeploymentConfig(project.DeploymentConfigs.Production, productionSecrets)
 	deploymentConfigs = append(deploymentConfigs, deploymentConfig)
 	d.Set("deployment_configs", deploymentConfigs)
 
 	return nil
 }
 
+// flattenPagesProjectSource returns the source of a project as stored in
+// state, including projects which were connected to a repository outside of
+// Terraform.
+func flattenPagesProjectSource(source *cloudflare.PagesProjectSource) []map[string]interface{} {
+	flattened := map[string]interface{}{
+		"type": source.Type,
+	}
+
+	if source.Config != nil {
+		flattened["config"] = []map[string]interface{}{
+			{
+				"owner":                         source.Config.Owner,
+				"repo_name":                     source.Config.RepoName,
+				"production_branch":             source.Config.ProductionBranch,
+				"pr_comments_enabled":           source.Config.PRCommentsEnabled,
+				"deployments_enabled":           source.Config.DeploymentsEnabled,
+				"production_deployment_enabled": source.Config.ProductionDeploymentsEnabled,
+				"preview_branch_includes":       source.Config.PreviewBranchIncludes,
+				"preview_branch_excludes":       source.Config.PreviewBranchExcludes,
+				"preview_deployment_setting":    string(source.Config.PreviewDeploymentSetting),
+			},
+		}
+	}
+
+	return []map[string]interface{}{flattened}
+}
+
 func resourceCloudflarePagesProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	pageProject := buildPagesProject(d)
 
-	project, err := client.CreatePagesProject(ctx, accountID, pageProject)
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/pages/projects", accountID), pageProject, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating cloudflare pages project %q: %w", pageProject.Name, err))
 	}
 
-	d.SetId(project.Name)
+	d.SetId(pageProject.Name)
 	return resourceCloudflarePagesProjectRead(ctx, d, meta)
 }
 
@@ -331,7 +441,7 @@ func resourceCloudflarePagesProjectUpdate(ctx context.Context, d *schema.Resourc
 
 	pageProject := buildPagesProject(d)
 
-	_, err := client.UpdatePagesProject(ctx, accountID, d.Id(), pageProject)
+	_, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, d.Id()), pageProject, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating cloudflare pages project %q: %w", d.Id(), err))
 	}
@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -79,6 +87,9 @@ func testPagesProjectDeploymentConfig(resourceID, accountID, projectName string)
 				environment_variables = {
 					ENVIRONMENT = "preview"
 				}
+				secrets = {
+					TURNSTILE_SECRET = "1x0000000000000000000000000000000AA"
+				}
 				kv_namespaces = {
 					KV_BINDING = "5eb63bbbe01eeed093cb22bb8f5acdc3"
 				}
@@ -91,6 +102,12 @@ func testPagesProjectDeploymentConfig(resourceID, accountID, projectName string)
 				d1_databases = {
 					D1_BINDING = "445e2955-951a-4358-a35b-a4d0c813f63"
 				}
+				queue_producers = {
+					QUEUE_BINDING = "some-queue"
+				}
+				analytics_engine_datasets = {
+					AE_BINDING = "some-dataset"
+				}
 				service_binding {
 					name = "MY_SERVICE_BINDING"
 					service = "my-service"
@@ -261,6 +278,16 @@ func TestAccCloudflarePagesProject_DeploymentConfig(t *testing.T) {
 
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.r2_buckets.%", "1"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.r2_buckets.R2_BINDING", "some-bucket"),
+
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.secrets.%", "1"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.secrets.TURNSTILE_SECRET", "1x0000000000000000000000000000000AA"),
+
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.queue_producers.%", "1"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.queue_producers.QUEUE_BINDING", "some-queue"),
+
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.analytics_engine_datasets.%", "1"),
+					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.analytics_engine_datasets.AE_BINDING", "some-dataset"),
+
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.fail_open", "true"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.always_use_latest_compatibility_date", "true"),
 					resource.TestCheckResourceAttr(name, "deployment_configs.0.preview.0.usage_model", "unbound"),
@@ -323,3 +350,205 @@ func TestAccCloudflarePagesProject_DirectUpload(t *testing.T) {
 		},
 	})
 }
+
+func TestCloudflarePagesProjectSecretsAndBindings(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const projectPath = "/accounts/" + accountID + "/pages/projects"
+
+	var created map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == projectPath:
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Fatal(err)
+			}
+		case r.Method == http.MethodGet && r.URL.Path == projectPath+"/example":
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Secrets are returned without their values.
+		testAPIResult(w, `{
+  "name": "example",
+  "subdomain": "example.pages.dev",
+  "production_branch": "main",
+  "created_on": "2023-01-01T00:00:00Z",
+  "deployment_configs": {
+    "preview": {},
+    "production": {
+      "env_vars": {
+        "ENVIRONMENT": {"type": "plain_text", "value": "production"},
+        "API_KEY": {"type": "secret_text", "value": ""}
+      },
+      "queue_producers": {"QUEUE_BINDING": {"name": "some-queue"}},
+      "analytics_engine_datasets": {"AE_BINDING": {"dataset": "some-dataset"}}
+    }
+  }
+}`)
+	})
+
+	production := map[string]interface{}{
+		"environment_variables":     map[string]interface{}{"ENVIRONMENT": "production"},
+		"secrets":                   map[string]interface{}{"API_KEY": "s3cr3t"},
+		"queue_producers":           map[string]interface{}{"QUEUE_BINDING": "some-queue"},
+		"analytics_engine_datasets": map[string]interface{}{"AE_BINDING": "some-dataset"},
+	}
+	d := schema.TestResourceDataRaw(t, resourceCloudflarePagesProjectSchema(), map[string]interface{}{
+		"account_id":        accountID,
+		"name":              "example",
+		"production_branch": "main",
+		"deployment_configs": []interface{}{map[string]interface{}{
+			"preview":    []interface{}{map[string]interface{}{}},
+			"production": []interface{}{production},
+		}},
+	})
+
+	if diags := resourceCloudflarePagesProjectCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	configs := created["deployment_configs"].(map[string]interface{})["production"].(map[string]interface{})
+	envVars := configs["env_vars"].(map[string]interface{})
+	if secret := envVars["API_KEY"].(map[string]interface{}); secret["type"] != "secret_text" || secret["value"] != "s3cr3t" {
+		t.Errorf("expected the secret to be sent as secret_text, got %v", secret)
+	}
+	if _, ok := configs["queue_producers"].(map[string]interface{})["QUEUE_BINDING"]; !ok {
+		t.Errorf("expected the queue producer binding to be sent, got %v", configs["queue_producers"])
+	}
+	if _, ok := configs["analytics_engine_datasets"].(map[string]interface{})["AE_BINDING"]; !ok {
+		t.Errorf("expected the analytics engine binding to be sent, got %v", configs["analytics_engine_datasets"])
+	}
+
+	expected := map[string]string{
+		"deployment_configs.0.production.0.secrets.API_KEY":                      "s3cr3t",
+		"deployment_configs.0.production.0.environment_variables.ENVIRONMENT":    "production",
+		"deployment_configs.0.production.0.queue_producers.QUEUE_BINDING":        "some-queue",
+		"deployment_configs.0.production.0.analytics_engine_datasets.AE_BINDING": "some-dataset",
+	}
+	for key, value := range expected {
+		if got := d.Get(key).(string); got != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, got)
+		}
+	}
+	if _, ok := d.GetOk("deployment_configs.0.production.0.environment_variables.API_KEY"); ok {
+		t.Error("expected the secret not to be stored as an environment variable")
+	}
+}
+
+func TestCloudflarePagesProjectReadSource(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/pages/projects/example" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, `{
+  "name": "example",
+  "production_branch": "main",
+  "created_on": "2023-01-01T00:00:00Z",
+  "build_config": {"build_caching": true, "build_command": "npm run build", "destination_dir": "build"},
+  "source": {
+    "type": "github",
+    "config": {
+      "owner": "cloudflare",
+      "repo_name": "example",
+      "production_branch": "main",
+      "pr_comments_enabled": false,
+      "deployments_enabled": true,
+      "production_deployments_enabled": true,
+      "preview_deployment_setting": "custom",
+      "preview_branch_includes": ["dev"],
+      "preview_branch_excludes": ["main"]
+    }
+  },
+  "deployment_configs": {"preview": {}, "production": {}}
+}`)
+	})
+
+	// The project was connected to the repository outside of Terraform.
+	d := schema.TestResourceDataRaw(t, resourceCloudflarePagesProjectSchema(), map[string]interface{}{
+		"account_id":        accountID,
+		"name":              "example",
+		"production_branch": "main",
+	})
+	d.SetId("example")
+
+	if diags := resourceCloudflarePagesProjectRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]interface{}{
+		"source.0.type":                                   "github",
+		"source.0.config.0.owner":                         "cloudflare",
+		"source.0.config.0.repo_name":                     "example",
+		"source.0.config.0.production_branch":             "main",
+		"source.0.config.0.pr_comments_enabled":           false,
+		"source.0.config.0.deployments_enabled":           true,
+		"source.0.config.0.production_deployment_enabled": true,
+		"source.0.config.0.preview_deployment_setting":    "custom",
+		"source.0.config.0.preview_branch_includes.0":     "dev",
+		"source.0.config.0.preview_branch_excludes.0":     "main",
+		"build_config.0.build_caching":                    true,
+		"build_config.0.build_command":                    "npm run build",
+	}
+	for key, value := range expected {
+		if got := d.Get(key); got != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, got)
+		}
+	}
+}
+
+func TestBuildPagesProjectSendsConfiguredBuildCaching(t *testing.T) {
+	testCases := map[string]struct {
+		buildConfig map[string]interface{}
+		expected    *bool
+	}{
+		"unset": {
+			buildConfig: map[string]interface{}{"build_command": "npm run build"},
+			expected:    nil,
+		},
+		"disabled": {
+			buildConfig: map[string]interface{}{"build_command": "npm run build", "build_caching": false},
+			expected:    cloudflare.BoolPtr(false),
+		},
+		"enabled": {
+			buildConfig: map[string]interface{}{"build_command": "npm run build", "build_caching": true},
+			expected:    cloudflare.BoolPtr(true),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"account_id":        "f037e56e89293a057740de681ac9abbe",
+				"name":              "example",
+				"production_branch": "main",
+				"build_config":      []interface{}{tc.buildConfig},
+			}
+
+			r := resourceCloudflarePagesProject()
+			d := schema.TestResourceDataRaw(t, r.Schema, raw)
+			d.SetId("example")
+
+			state := d.State()
+			config, err := json.Marshal(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			state.RawConfig, err = ctyjson.Unmarshal(config, r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			project := buildPagesProject(r.Data(state))
+			if !reflect.DeepEqual(project.BuildConfig.BuildCaching, tc.expected) {
+				t.Errorf("expected build_caching to be sent as %v, got %v", tc.expected, project.BuildConfig.BuildCaching)
+			}
+		})
+	}
+}
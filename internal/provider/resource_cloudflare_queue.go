@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareQueue() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareQueueSchema(),
+		CreateContext: resourceCloudflareQueueCreate,
+		ReadContext:   resourceCloudflareQueueRead,
+		DeleteContext: resourceCloudflareQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareQueueImport,
+		},
+		Description: heredoc.Doc(`
+			Provides the ability to manage Cloudflare Workers Queue features.
+		`),
+	}
+}
+
+func resourceCloudflareQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	req := cloudflare.CreateQueueParams{
+		Name: d.Get("name").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Workers Queue from struct: %+v", req))
+
+	queue, err := client.CreateQueue(ctx, cloudflare.AccountIdentifier(accountID), req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating workers queue %q: %w", req.Name, err))
+	}
+
+	d.SetId(queue.ID)
+
+	return resourceCloudflareQueueRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	queues, _, err := client.ListQueues(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListQueuesParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing workers queues: %w", err))
+	}
+
+	for _, queue := range queues {
+		if queue.ID == d.Id() {
+			d.Set("name", queue.Name)
+			d.Set("queue_id", queue.ID)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Workers Queue %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareQueueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueName := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Workers Queue: %s", queueName))
+
+	err := client.DeleteQueue(ctx, cloudflare.AccountIdentifier(accountID), queueName)
+	if err != nil {
+		consumers, _, listErr := client.ListQueueConsumers(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListQueueConsumersParams{QueueName: queueName})
+		if listErr == nil && len(consumers) > 0 {
+			scripts := make([]string, 0, len(consumers))
+			for _, consumer := range consumers {
+				scripts = append(scripts, consumer.ScriptName)
+			}
+
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("workers queue %q still has consumers attached", queueName),
+				Detail:   fmt.Sprintf("The queue can only be deleted once all consumers have been removed. Remaining consumers: %s.", strings.Join(scripts, ", ")),
+			}}
+		}
+
+		return diag.FromErr(fmt.Errorf("error deleting workers queue %q: %w", queueName, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareQueueImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/queueID\"", d.Id())
+	}
+
+	accountID, queueID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Workers Queue: id %s for account %s", queueID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(queueID)
+
+	resourceCloudflareQueueRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
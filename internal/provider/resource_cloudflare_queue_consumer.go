@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// queueConsumer is the representation of a Workers Queue consumer in the
+// Cloudflare API. cloudflare-go does not expose the `max_concurrency`
+// setting so the consumer endpoints are called directly.
+type queueConsumer struct {
+	ScriptName      string                `json:"script_name,omitempty"`
+	Service         string                `json:"service,omitempty"`
+	Environment     string                `json:"environment,omitempty"`
+	DeadLetterQueue string                `json:"dead_letter_queue"`
+	Settings        queueConsumerSettings `json:"settings"`
+}
+
+type queueConsumerSettings struct {
+	BatchSize      int  `json:"batch_size,omitempty"`
+	MaxRetries     *int `json:"max_retries,omitempty"`
+	MaxWaitTimeMs  *int `json:"max_wait_time_ms,omitempty"`
+	MaxConcurrency *int `json:"max_concurrency"`
+}
+
+func resourceCloudflareQueueConsumer() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareQueueConsumerSchema(),
+		CreateContext: resourceCloudflareQueueConsumerCreate,
+		ReadContext:   resourceCloudflareQueueConsumerRead,
+		UpdateContext: resourceCloudflareQueueConsumerUpdate,
+		DeleteContext: resourceCloudflareQueueConsumerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareQueueConsumerImport,
+		},
+		Description: heredoc.Doc(`
+			Provides the ability to attach a Worker script as a consumer of a
+			Cloudflare Workers Queue.
+		`),
+	}
+}
+
+func resourceCloudflareQueueConsumerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueName := d.Get("queue_name").(string)
+
+	consumer := buildQueueConsumer(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Workers Queue Consumer from struct: %+v", consumer))
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/workers/queues/%s/consumers", accountID, queueName), consumer, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating workers queue consumer %q for queue %q: %w", consumer.ScriptName, queueName, err))
+	}
+
+	d.SetId(consumer.ScriptName)
+
+	return resourceCloudflareQueueConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueConsumerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueName := d.Get("queue_name").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/queues/%s/consumers", accountID, queueName), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Workers Queue %s no longer exists", queueName))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading workers queue consumers for queue %q: %w", queueName, err))
+	}
+
+	var consumers []queueConsumer
+	if err := json.Unmarshal(res, &consumers); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling workers queue consumers: %w", err))
+	}
+
+	for _, consumer := range consumers {
+		scriptName := consumer.ScriptName
+		if scriptName == "" {
+			scriptName = consumer.Service
+		}
+
+		if scriptName != d.Id() {
+			continue
+		}
+
+		d.Set("script_name", scriptName)
+		d.Set("environment", consumer.Environment)
+		d.Set("dead_letter_queue", consumer.DeadLetterQueue)
+		d.Set("max_batch_size", consumer.Settings.BatchSize)
+		if consumer.Settings.MaxRetries != nil {
+			d.Set("max_retries", *consumer.Settings.MaxRetries)
+		}
+		if consumer.Settings.MaxWaitTimeMs != nil {
+			d.Set("max_batch_timeout", *consumer.Settings.MaxWaitTimeMs/1000)
+		}
+		if consumer.Settings.MaxConcurrency != nil {
+			d.Set("max_concurrency", *consumer.Settings.MaxConcurrency)
+		} else {
+			d.Set("max_concurrency", nil)
+		}
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Workers Queue Consumer %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareQueueConsumerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueName := d.Get("queue_name").(string)
+
+	consumer := buildQueueConsumer(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Workers Queue Consumer from struct: %+v", consumer))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/workers/queues/%s/consumers/%s", accountID, queueName, d.Id()), consumer, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating workers queue consumer %q for queue %q: %w", d.Id(), queueName, err))
+	}
+
+	return resourceCloudflareQueueConsumerRead(ctx, d, meta)
+}
+
+func resourceCloudflareQueueConsumerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	queueName := d.Get("queue_name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Workers Queue Consumer %s from queue %s", d.Id(), queueName))
+
+	err := client.DeleteQueueConsumer(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.DeleteQueueConsumerParams{
+		QueueName:    queueName,
+		ConsumerName: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting workers queue consumer %q for queue %q: %w", d.Id(), queueName, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareQueueConsumerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/queueName/scriptName\"", d.Id())
+	}
+
+	accountID, queueName, scriptName := attributes[0], attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Workers Queue Consumer: %s for queue %s in account %s", scriptName, queueName, accountID))
+
+	d.Set("account_id", accountID)
+	d.Set("queue_name", queueName)
+	d.SetId(scriptName)
+
+	resourceCloudflareQueueConsumerRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildQueueConsumer(d *schema.ResourceData) queueConsumer {
+	consumer := queueConsumer{
+		ScriptName:      d.Get("script_name").(string),
+		Environment:     d.Get("environment").(string),
+		DeadLetterQueue: d.Get("dead_letter_queue").(string),
+	}
+
+	if batchSize, ok := d.GetOk("max_batch_size"); ok {
+		consumer.Settings.BatchSize = batchSize.(int)
+	}
+
+	if retries, ok := d.GetOkExists("max_retries"); ok {
+		r := retries.(int)
+		consumer.Settings.MaxRetries = &r
+	}
+
+	if timeout, ok := d.GetOkExists("max_batch_timeout"); ok {
+		t := timeout.(int) * 1000
+		consumer.Settings.MaxWaitTimeMs = &t
+	}
+
+	if concurrency, ok := d.GetOk("max_concurrency"); ok {
+		c := concurrency.(int)
+		consumer.Settings.MaxConcurrency = &c
+	}
+
+	return consumer
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareQueueConsumer_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_queue_consumer." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareQueueConsumerConfig(rnd, accountID, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rnd),
+					resource.TestCheckResourceAttr(resourceName, "queue_name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "script_name", rnd),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_queue", rnd+"-dlq"),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "max_retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "2"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareQueueConsumerConfig(rnd, accountID, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rnd),
+					resource.TestCheckResourceAttr(resourceName, "max_batch_size", "20"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/%s/", accountID, rnd),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareQueueConsumerConfig(rnd, accountID string, batchSize int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_queue" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_queue" "%[1]s_dlq" {
+  account_id = "%[2]s"
+  name       = "%[1]s-dlq"
+}
+
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  content    = "%[4]s"
+  module     = true
+}
+
+resource "cloudflare_queue_consumer" "%[1]s" {
+  account_id        = "%[2]s"
+  queue_name        = cloudflare_queue.%[1]s.name
+  script_name       = cloudflare_worker_script.%[1]s.name
+  dead_letter_queue = cloudflare_queue.%[1]s_dlq.name
+  max_batch_size    = %[3]d
+  max_batch_timeout = 5
+  max_retries       = 3
+  max_concurrency   = 2
+}
+`, rnd, accountID, batchSize, moduleContent)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// r2BucketNotEmptyErrorCode is returned by the API when attempting to delete
+// a bucket that still contains objects.
+const r2BucketNotEmptyErrorCode = 10008
+
+// r2Bucket is the representation of an R2 bucket in the Cloudflare API
+// including the location hint which isn't supported by cloudflare-go.
+type r2Bucket struct {
+	Name         string `json:"name"`
+	Location     string `json:"location,omitempty"`
+	LocationHint string `json:"locationHint,omitempty"`
+	CreationDate string `json:"creation_date,omitempty"`
+}
+
+func resourceCloudflareR2Bucket() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareR2BucketSchema(),
+		CreateContext: resourceCloudflareR2BucketCreate,
+		ReadContext:   resourceCloudflareR2BucketRead,
+		DeleteContext: resourceCloudflareR2BucketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareR2BucketImport,
+		},
+		Description: heredoc.Doc(`
+			The [R2 Bucket](https://developers.cloudflare.com/r2/) resource allows you to manage Cloudflare R2 buckets.
+		`),
+	}
+}
+
+func resourceCloudflareR2BucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	bucket := r2Bucket{
+		Name:         d.Get("name").(string),
+		LocationHint: strings.ToUpper(d.Get("location").(string)),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare R2 Bucket from struct: %+v", bucket))
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/r2/buckets", accountID), bucket, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating R2 bucket %q: %w", bucket.Name, err))
+	}
+
+	d.SetId(bucket.Name)
+
+	return resourceCloudflareR2BucketRead(ctx, d, meta)
+}
+
+func resourceCloudflareR2BucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/r2/buckets/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("R2 bucket %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading R2 bucket %q: %w", d.Id(), err))
+	}
+
+	var bucket r2Bucket
+	if err := json.Unmarshal(res, &bucket); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling R2 bucket: %w", err))
+	}
+
+	d.Set("name", bucket.Name)
+	d.Set("location", bucket.Location)
+
+	return nil
+}
+
+func resourceCloudflareR2BucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare R2 Bucket: %s", d.Id()))
+
+	err := client.DeleteR2Bucket(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		var requestError *cloudflare.RequestError
+		if errors.As(err, &requestError) && requestError.InternalErrorCodeIs(r2BucketNotEmptyErrorCode) {
+			return diag.Errorf("R2 bucket %q is not empty. All objects must be removed from the bucket before it can be deleted", d.Id())
+		}
+		return diag.FromErr(fmt.Errorf("error deleting R2 bucket %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareR2BucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 || attributes[0] != "account" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/bucketName\"", d.Id())
+	}
+
+	accountID, bucketName := attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare R2 Bucket: %s for account %s", bucketName, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(bucketName)
+
+	resourceCloudflareR2BucketRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -25,7 +26,7 @@ func resourceCloudflareRecord() *schema.Resource {
 			StateContext: resourceCloudflareRecordImport,
 		},
 		Description:   heredoc.Doc(`Provides a Cloudflare record resource.`),
-		SchemaVersion: 2,
+		SchemaVersion: 3,
 		Schema:        resourceCloudflareRecordSchema(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
@@ -37,6 +38,11 @@ func resourceCloudflareRecord() *schema.Resource {
 				Upgrade: resourceCloudflareRecordStateUpgradeV2,
 				Version: 1,
 			},
+			{
+				Type:    resourceCloudflareRecordV2().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceCloudflareRecordStateUpgradeV3,
+				Version: 2,
+			},
 		},
 	}
 }
@@ -63,18 +69,10 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 	data, dataOk := d.GetOk("data")
 	tflog.Debug(ctx, fmt.Sprintf("Data found in config: %#v", data))
 
-	newDataMap := make(map[string]interface{})
-
 	if dataOk {
-		dataMap := data.([]interface{})[0]
-		for id, value := range dataMap.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(newRecord.Type, id, value)
-			if err != nil {
-				return diag.FromErr(err)
-			} else if newData == nil {
-				continue
-			}
-			newDataMap[id] = newData
+		newDataMap, err := expandRecordData(newRecord.Type, data.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
 		newRecord.Data = newDataMap
@@ -198,7 +196,7 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get("zone_id").(string)
 
-	record, err := client.GetDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), d.Id())
+	record, err := getDNSRecord(ctx, client, zoneID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -217,6 +215,11 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 		dataMap := record.Data.(map[string]interface{})
 		if dataMap != nil {
 			for id, value := range dataMap {
+				if id == "flags" && record.Type == "NAPTR" {
+					readDataMap["naptr_flags"] = value
+					continue
+				}
+
 				newData, err := transformToCloudflareDNSData(record.Type, id, value)
 				if err != nil {
 					return diag.FromErr(err)
@@ -226,6 +229,10 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 				readDataMap[id] = newData
 			}
 
+			if record.Type == "SRV" {
+				normalizeSRVRecordDataName(readDataMap)
+			}
+
 			record.Data = []interface{}{readDataMap}
 		}
 	}
@@ -270,18 +277,10 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 	data, dataOk := d.GetOk("data")
 	tflog.Debug(ctx, fmt.Sprintf("Data found in config: %#v", data))
 
-	newDataMap := make(map[string]interface{})
-
 	if dataOk {
-		dataMap := data.([]interface{})[0]
-		for id, value := range dataMap.(map[string]interface{}) {
-			newData, err := transformToCloudflareDNSData(updateRecord.Type, id, value)
-			if err != nil {
-				return diag.FromErr(err)
-			} else if newData == nil {
-				continue
-			}
-			newDataMap[id] = newData
+		newDataMap, err := expandRecordData(updateRecord.Type, data.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
 		updateRecord.Data = newDataMap
@@ -315,6 +314,16 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// The API client omits empty values from the request so removing the
+	// comment or all of the tags needs to be explicitly sent to the API.
+	clearedFields := make(map[string]interface{})
+	if d.HasChange("comment") && updateRecord.Comment == "" {
+		clearedFields["comment"] = ""
+	}
+	if d.HasChange("tags") && len(updateRecord.Tags) == 0 {
+		clearedFields["tags"] = []string{}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Cloudflare Record update configuration: %#v", updateRecord))
 
 	retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
@@ -328,6 +337,14 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 			return resource.NonRetryableError(fmt.Errorf("failed to create DNS record: %w", err))
 		}
 
+		if len(clearedFields) > 0 {
+			_, err = client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/dns_records/%s", zoneID, d.Id()), clearedFields, nil)
+			if err != nil {
+				return resource.NonRetryableError(fmt.Errorf("failed to clear DNS record comment and tags: %w", err))
+			}
+		}
+
+		forgetDNSRecord(client, zoneID, d.Id())
 		resourceCloudflareRecordRead(ctx, d, meta)
 		return nil
 	})
@@ -350,6 +367,8 @@ func resourceCloudflareRecordDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error deleting Cloudflare Record: %w", err))
 	}
 
+	forgetDNSRecord(client, zoneID, d.Id())
+
 	return nil
 }
 
@@ -432,20 +451,21 @@ func transformToCloudflareDNSData(recordType string, id string, value interface{
 	case id == "flags":
 		switch {
 		case strings.ToUpper(recordType) == "SRV",
-			strings.ToUpper(recordType) == "DNSKEY":
-			newValue, err = value.(string), nil
-		case strings.ToUpper(recordType) == "NAPTR":
-			newValue, err = value.(string), nil
-		case strings.ToUpper(recordType) == "CAA":
-			// this is required because "flags" is shared however, it comes from
-			// the API as a float64 but the Terraform internal type is string 😢.
+			strings.ToUpper(recordType) == "DNSKEY",
+			strings.ToUpper(recordType) == "CAA":
+			// flags come from the API as a float64 but the Terraform internal
+			// type is int.
 			switch value.(type) {
 			case float64:
-				newValue, err = fmt.Sprintf("%.0f", value.(float64)), nil
-			case string:
-				newValue, err = value.(string), nil
+				newValue, err = int(value.(float64)), nil
+			case int:
+				newValue, err = value.(int), nil
 			}
 		}
+	case id == "naptr_flags":
+		// sent and read as the `flags` of NAPTR records, see expandRecordData
+		// and resourceCloudflareRecordRead.
+		newValue, err = nil, nil
 	case contains(dnsTypeIntFields, id):
 		newValue, err = value, nil
 	case contains(dnsTypeFloatFields, id):
@@ -457,6 +477,45 @@ func transformToCloudflareDNSData(recordType string, id string, value interface{
 	return
 }
 
+// expandRecordData builds the data of a record sent to the API from the
+// `data` block of the configuration.
+func expandRecordData(recordType string, data map[string]interface{}) (map[string]interface{}, error) {
+	newDataMap := make(map[string]interface{})
+
+	for id, value := range data {
+		newData, err := transformToCloudflareDNSData(recordType, id, value)
+		if err != nil {
+			return nil, err
+		} else if newData == nil {
+			continue
+		}
+		newDataMap[id] = newData
+	}
+
+	// The flags of NAPTR records are text, unlike the numeric flags of other
+	// record types.
+	if strings.ToUpper(recordType) == "NAPTR" {
+		if flags, ok := data["naptr_flags"].(string); ok {
+			newDataMap["flags"] = flags
+		}
+	}
+
+	return newDataMap, nil
+}
+
+// normalizeSRVRecordDataName removes the service and protocol the API
+// prepends to the name of SRV records.
+func normalizeSRVRecordDataName(data map[string]interface{}) {
+	name, _ := data["name"].(string)
+	service, _ := data["service"].(string)
+	proto, _ := data["proto"].(string)
+	if name == "" || service == "" || proto == "" {
+		return
+	}
+
+	data["name"] = strings.TrimPrefix(name, service+"."+proto+".")
+}
+
 func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
 	recordType := d.Get("type").(string)
 	if recordType != "MX" && recordType != "URI" {
@@ -30,3 +30,58 @@ func TestCloudflareRecordStateUpgradeV0(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
 	}
 }
+
+func TestCloudflareRecordStateUpgradeV3(t *testing.T) {
+	testCases := map[string]struct {
+		rawState map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"CAA flags become numeric": {
+			rawState: map[string]interface{}{
+				"type": "CAA",
+				"data": []interface{}{map[string]interface{}{"flags": "128", "tag": "issue", "value": "letsencrypt.org"}},
+			},
+			expected: map[string]interface{}{
+				"type": "CAA",
+				"data": []interface{}{map[string]interface{}{"flags": 128, "tag": "issue", "value": "letsencrypt.org"}},
+			},
+		},
+		"NAPTR flags are moved": {
+			rawState: map[string]interface{}{
+				"type": "NAPTR",
+				"data": []interface{}{map[string]interface{}{"flags": "U", "order": float64(100)}},
+			},
+			expected: map[string]interface{}{
+				"type": "NAPTR",
+				"data": []interface{}{map[string]interface{}{"naptr_flags": "U", "order": float64(100)}},
+			},
+		},
+		"empty flags are dropped": {
+			rawState: map[string]interface{}{
+				"type": "SRV",
+				"data": []interface{}{map[string]interface{}{"flags": "", "port": float64(443)}},
+			},
+			expected: map[string]interface{}{
+				"type": "SRV",
+				"data": []interface{}{map[string]interface{}{"port": float64(443)}},
+			},
+		},
+		"no data": {
+			rawState: map[string]interface{}{"type": "A", "value": "192.0.2.1"},
+			expected: map[string]interface{}{"type": "A", "value": "192.0.2.1"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := resourceCloudflareRecordStateUpgradeV3(context.TODO(), tc.rawState, nil)
+			if err != nil {
+				t.Fatalf("error migrating state: %s", err)
+			}
+
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"testing"
 	"time"
@@ -85,8 +86,8 @@ func TestAccCloudflareRecord_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "metadata.%", "4"),
 					resource.TestCheckResourceAttr(resourceName, "metadata.auto_added", "false"),
 					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.0", "tag1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.1", "tag2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "tag1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "tag2"),
 					resource.TestCheckResourceAttr(resourceName, "comment", "this is a comment"),
 				),
 			},
@@ -311,6 +312,18 @@ func TestAccCloudflareRecord_Updated(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareRecordExists(resourceName, &record),
 					testAccCheckCloudflareRecordAttributesUpdated(&record),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "updated_tag1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags.*", "updated_tag2"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "this is am updated comment"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigNoCommentOrTags(zoneID, recordName, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
 				),
 			},
 		},
@@ -539,6 +552,30 @@ func TestAccCloudflareRecord_HTTPS(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_SVCB(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigSVCB(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "SVCB"),
+					resource.TestCheckResourceAttr(name, "data.0.priority", "1"),
+					resource.TestCheckResourceAttr(name, "data.0.target", "svc.example.com."),
+					resource.TestCheckResourceAttr(name, "data.0.value", `alpn="h3,h2" port="8443"`),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_MXNull(t *testing.T) {
 	t.Parallel()
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
@@ -769,7 +806,7 @@ resource "cloudflare_record" "%[1]s" {
   zone_id = "%[2]s"
   name = "%[3]s"
   data {
-    flags = "0"
+    flags = 0
     tag   = "issue"
     value = "letsencrypt.org"
   }
@@ -802,6 +839,17 @@ resource "cloudflare_record" "%[3]s" {
 }`, zoneID, name, rnd)
 }
 
+func testAccCheckCloudflareRecordConfigNoCommentOrTags(zoneID, name, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[3]s" {
+	zone_id = "%[1]s"
+	name = "%[2]s"
+	value = "192.168.0.11"
+	type = "A"
+	ttl = 3600
+}`, zoneID, name, rnd)
+}
+
 func testAccCheckCloudflareRecordConfigChangeType(zoneID, name, zoneName, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[4]s" {
@@ -876,6 +924,21 @@ resource "cloudflare_record" "%[2]s" {
 }`, zoneID, rnd)
 }
 
+func testAccCheckCloudflareRecordConfigSVCB(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "_8443._foo.%[2]s"
+	type = "SVCB"
+	data {
+		priority = 1
+		target   = "svc.example.com."
+		value    = "alpn=\"h3,h2\" port=\"8443\""
+	}
+	ttl = 300
+}`, zoneID, rnd)
+}
+
 func testAccCheckCloudflareRecordNullMX(zoneID, rnd string) string {
 	return fmt.Sprintf(`
 	resource "cloudflare_record" "%[1]s" {
@@ -887,3 +950,83 @@ func testAccCheckCloudflareRecordNullMX(zoneID, rnd string) string {
 	  }
 	`, rnd, zoneID)
 }
+
+func TestExpandRecordData(t *testing.T) {
+	testCases := map[string]struct {
+		recordType string
+		data       map[string]interface{}
+		expected   map[string]interface{}
+	}{
+		"CAA flags are sent as a number": {
+			recordType: "CAA",
+			data:       map[string]interface{}{"flags": 128, "tag": "issue", "value": "letsencrypt.org"},
+			expected:   map[string]interface{}{"flags": 128, "tag": "issue", "value": "letsencrypt.org"},
+		},
+		"DNSKEY flags are sent as a number": {
+			recordType: "DNSKEY",
+			data:       map[string]interface{}{"flags": 257, "protocol": 3, "algorithm": 13, "public_key": "mdsswUyr3DPW132mOi8V9xESWE8jTo0d"},
+			expected:   map[string]interface{}{"flags": 257, "protocol": 3, "algorithm": 13, "public_key": "mdsswUyr3DPW132mOi8V9xESWE8jTo0d"},
+		},
+		"NAPTR flags are sent as text": {
+			recordType: "NAPTR",
+			data:       map[string]interface{}{"flags": 0, "naptr_flags": "U", "order": 100, "preference": 10},
+			expected:   map[string]interface{}{"flags": "U", "order": 100, "preference": 10},
+		},
+		"SVCB parameters": {
+			recordType: "SVCB",
+			data:       map[string]interface{}{"flags": 0, "naptr_flags": "", "priority": 1, "target": ".", "value": `alpn="h2"`},
+			expected:   map[string]interface{}{"priority": 1, "target": ".", "value": `alpn="h2"`},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := expandRecordData(tc.recordType, tc.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestTransformToCloudflareDNSDataNumericFlags(t *testing.T) {
+	for _, recordType := range []string{"CAA", "DNSKEY", "SRV"} {
+		got, err := transformToCloudflareDNSData(recordType, "flags", float64(257))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != 257 {
+			t.Errorf("expected %s flags read from the API to be %d, got %#v", recordType, 257, got)
+		}
+	}
+}
+
+func TestNormalizeSRVRecordDataName(t *testing.T) {
+	testCases := map[string]struct {
+		name     string
+		expected string
+	}{
+		"service and proto prepended": {name: "_xmpp-client._tcp.example.com", expected: "example.com"},
+		"already normalized":          {name: "example.com", expected: "example.com"},
+		"other prefix":                {name: "_sip._udp.example.com", expected: "_sip._udp.example.com"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			data := map[string]interface{}{
+				"name":    tc.name,
+				"service": "_xmpp-client",
+				"proto":   "_tcp",
+			}
+			normalizeSRVRecordDataName(data)
+
+			if data["name"] != tc.expected {
+				t.Errorf("expected name %q, got %q", tc.expected, data["name"])
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalHostname is the representation of a hostname pinned to a region
+// by the Data Localization Suite.
+type regionalHostname struct {
+	Hostname  string `json:"hostname,omitempty"`
+	RegionKey string `json:"region_key"`
+	CreatedOn string `json:"created_on,omitempty"`
+}
+
+func resourceCloudflareRegionalHostname() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareRegionalHostnameSchema(),
+		CreateContext: resourceCloudflareRegionalHostnameCreate,
+		ReadContext:   resourceCloudflareRegionalHostnameRead,
+		UpdateContext: resourceCloudflareRegionalHostnameUpdate,
+		DeleteContext: resourceCloudflareRegionalHostnameDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRegionalHostnameImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Data Localization Suite Regional Hostname which
+			restricts the regions in which TLS is terminated and requests
+			are processed for a hostname.
+		`),
+	}
+}
+
+func resourceCloudflareRegionalHostnameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	hostname := regionalHostname{
+		Hostname:  d.Get("hostname").(string),
+		RegionKey: d.Get("region_key").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Regional Hostname %s in region %s", hostname.Hostname, hostname.RegionKey))
+
+	_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/addressing/regional_hostnames", zoneID), hostname, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Regional Hostname %q: %w", hostname.Hostname, err))
+	}
+
+	d.SetId(hostname.Hostname)
+
+	return resourceCloudflareRegionalHostnameRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegionalHostnameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Regional Hostname %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Regional Hostname %q: %w", d.Id(), err))
+	}
+
+	var hostname regionalHostname
+	if err := json.Unmarshal(res, &hostname); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Regional Hostname: %w", err))
+	}
+
+	d.Set("hostname", hostname.Hostname)
+	d.Set("region_key", hostname.RegionKey)
+	d.Set("created_on", hostname.CreatedOn)
+
+	return nil
+}
+
+func resourceCloudflareRegionalHostnameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	hostname := regionalHostname{
+		RegionKey: d.Get("region_key").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Regional Hostname %s to region %s", d.Id(), hostname.RegionKey))
+
+	_, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", zoneID, d.Id()), hostname, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Regional Hostname %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareRegionalHostnameRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegionalHostnameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Regional Hostname %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/addressing/regional_hostnames/%s", zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Regional Hostname %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRegionalHostnameImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/hostname\"", d.Id())
+	}
+
+	zoneID, hostname := attributes[0], attributes[1]
+
+	d.Set("zone_id", zoneID)
+	d.SetId(hostname)
+
+	resourceCloudflareRegionalHostnameRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRegionalHostname_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_regional_hostname." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	hostname := fmt.Sprintf("%s.%s", rnd, os.Getenv("CLOUDFLARE_DOMAIN"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRegionalHostnameConfig(rnd, zoneID, hostname, "eu"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "region_key", "eu"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_on"),
+				),
+			},
+			{
+				Config: testAccCloudflareRegionalHostnameConfig(rnd, zoneID, hostname, "us"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "region_key", "us"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareRegionalHostnameConfig(rnd, zoneID, hostname, regionKey string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_regional_hostname" "%[1]s" {
+  zone_id    = "%[2]s"
+  hostname   = "%[3]s"
+  region_key = "%[4]s"
+}
+`, rnd, zoneID, hostname, regionKey)
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 	"regexp"
 	"sort"
@@ -11,7 +13,9 @@ import (
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -22,6 +26,62 @@ const (
 	duplicateRulesetError        = "failed to create ruleset %q as a similar configuration with rules already exists and overwriting will have unintended consequences. If you are migrating from the Dashboard, you will need to first remove the existing rules otherwise you can remove the existing phase yourself using the API (%s)."
 )
 
+// ruleset extends cloudflare.Ruleset with rules supporting the action
+// parameters that aren't available in cloudflare-go yet.
+type ruleset struct {
+	cloudflare.Ruleset
+	Rules []rulesetRule `json:"rules"`
+}
+
+type rulesetRule struct {
+	cloudflare.RulesetRule
+	ActionParameters *rulesetRuleActionParameters `json:"action_parameters,omitempty"`
+	Categories       []string                     `json:"categories,omitempty"`
+	RateLimit        *rulesetRuleRateLimit        `json:"ratelimit,omitempty"`
+}
+
+// rulesetRuleRateLimit adds the complexity based rate limiting of the
+// http_ratelimit phase, and always sends the mitigation timeout as 0 is
+// required by rules which only log or challenge.
+type rulesetRuleRateLimit struct {
+	Characteristics         []string `json:"characteristics,omitempty"`
+	RequestsPerPeriod       int      `json:"requests_per_period,omitempty"`
+	ScorePerPeriod          int      `json:"score_per_period,omitempty"`
+	ScoreResponseHeaderName string   `json:"score_response_header_name,omitempty"`
+	Period                  int      `json:"period,omitempty"`
+	MitigationTimeout       int      `json:"mitigation_timeout"`
+	CountingExpression      string   `json:"counting_expression,omitempty"`
+	RequestsToOrigin        bool     `json:"requests_to_origin,omitempty"`
+}
+
+// rulesetRateLimitColoCharacteristic is the characteristic the API requires
+// every rate limiting rule to count requests by.
+const rulesetRateLimitColoCharacteristic = "cf.colo.id"
+
+type rulesetRuleActionParameters struct {
+	cloudflare.RulesetRuleActionParameters
+	Overrides          *rulesetRuleActionParametersOverrides    `json:"overrides,omitempty"`
+	AssetName          string                                   `json:"asset_name,omitempty"`
+	OriginCacheControl *bool                                    `json:"origin_cache_control,omitempty"`
+	ReadTimeout        *uint                                    `json:"read_timeout,omitempty"`
+	CacheReserve       *rulesetRuleActionParametersCacheReserve `json:"cache_reserve,omitempty"`
+}
+
+type rulesetRuleActionParametersOverrides struct {
+	cloudflare.RulesetRuleActionParametersOverrides
+	Categories []rulesetRuleActionParametersCategory `json:"categories,omitempty"`
+}
+
+type rulesetRuleActionParametersCategory struct {
+	cloudflare.RulesetRuleActionParametersCategories
+	SensitivityLevel string `json:"sensitivity_level,omitempty"`
+}
+
+type rulesetRuleActionParametersCacheReserve struct {
+	Eligible        *bool `json:"eligible,omitempty"`
+	MinimumFileSize *uint `json:"minimum_file_size,omitempty"`
+}
+
 func resourceCloudflareRuleset() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareRulesetSchema(),
@@ -32,6 +92,10 @@ func resourceCloudflareRuleset() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareRulesetImport,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			resourceCloudflareRulesetValidateRateLimit,
+			resourceCloudflareRulesetValidateErrorResponse,
+		),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -52,21 +116,59 @@ func resourceCloudflareRuleset() *schema.Resource {
 	}
 }
 
+// resourceCloudflareRulesetValidateRateLimit rejects rate limiting rules
+// which don't count requests by colo, which the API requires, at plan time.
+func resourceCloudflareRulesetValidateRateLimit(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, rule := range d.Get("rules").([]interface{}) {
+		rule, ok := rule.(map[string]interface{})
+		if !ok || len(rule["ratelimit"].([]interface{})) == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("rules.%d.ratelimit.0.characteristics", i)
+		if !d.NewValueKnown(key) {
+			continue
+		}
+
+		characteristics, _ := d.Get(key).(*schema.Set)
+		if characteristics == nil || !characteristics.Contains(rulesetRateLimitColoCharacteristic) {
+			return fmt.Errorf("the ratelimit characteristics of rule %d must include %q", i, rulesetRateLimitColoCharacteristic)
+		}
+	}
+
+	return nil
+}
+
+// resourceCloudflareRulesetValidateErrorResponse rejects rules serving both
+// an asset and content as their error response. ConflictsWith can't refer to
+// an attribute in the same element of the unbounded rule list, so the
+// conflict is checked here.
+func resourceCloudflareRulesetValidateErrorResponse(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i := range d.Get("rules").([]interface{}) {
+		prefix := fmt.Sprintf("rules.%d.action_parameters.0", i)
+		if d.Get(prefix+".asset_name").(string) != "" && d.Get(prefix+".content").(string) != "" {
+			return fmt.Errorf("the action_parameters of rule %d can't set both asset_name and content", i)
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 	rulesetPhase := d.Get("phase").(string)
 
-	var ruleset cloudflare.Ruleset
+	var phaseRuleset cloudflare.Ruleset
 	var sempahoreErr error
 	if accountID != "" {
-		ruleset, sempahoreErr = client.GetAccountRulesetPhase(ctx, accountID, rulesetPhase)
+		phaseRuleset, sempahoreErr = client.GetAccountRulesetPhase(ctx, accountID, rulesetPhase)
 	} else {
-		ruleset, sempahoreErr = client.GetZoneRulesetPhase(ctx, zoneID, rulesetPhase)
+		phaseRuleset, sempahoreErr = client.GetZoneRulesetPhase(ctx, zoneID, rulesetPhase)
 	}
 
-	if len(ruleset.Rules) > 0 {
+	if len(phaseRuleset.Rules) > 0 {
 		deleteRulesetURL := accountLevelRulesetDeleteURL
 		if accountID == "" {
 			deleteRulesetURL = zoneLevelRulesetDeleteURL
@@ -77,11 +179,13 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 	rulesetName := d.Get("name").(string)
 	rulesetDescription := d.Get("description").(string)
 	rulesetKind := d.Get("kind").(string)
-	rs := cloudflare.Ruleset{
-		Name:        rulesetName,
-		Description: rulesetDescription,
-		Kind:        rulesetKind,
-		Phase:       rulesetPhase,
+	rs := ruleset{
+		Ruleset: cloudflare.Ruleset{
+			Name:        rulesetName,
+			Description: rulesetDescription,
+			Kind:        rulesetKind,
+			Phase:       rulesetPhase,
+		},
 	}
 
 	rules, err := buildRulesetRulesFromResource(d)
@@ -93,13 +197,13 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		rs.Rules = rules
 	}
 
-	if sempahoreErr == nil && len(ruleset.Rules) == 0 && ruleset.Description == "" {
+	if sempahoreErr == nil && len(phaseRuleset.Rules) == 0 && phaseRuleset.Description == "" {
 		log.Print("[DEBUG] default ruleset created by the UI with empty rules found, recreating from scratch")
 		var deleteRulesetErr error
 		if accountID != "" {
-			deleteRulesetErr = client.DeleteAccountRuleset(ctx, accountID, ruleset.ID)
+			deleteRulesetErr = client.DeleteAccountRuleset(ctx, accountID, phaseRuleset.ID)
 		} else {
-			deleteRulesetErr = client.DeleteZoneRuleset(ctx, zoneID, ruleset.ID)
+			deleteRulesetErr = client.DeleteZoneRuleset(ctx, zoneID, phaseRuleset.ID)
 		}
 
 		if deleteRulesetErr != nil {
@@ -107,37 +211,26 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	var rulesetCreateErr error
-	if accountID != "" {
-		ruleset, rulesetCreateErr = client.CreateAccountRuleset(ctx, accountID, rs)
-	} else {
-		ruleset, rulesetCreateErr = client.CreateZoneRuleset(ctx, zoneID, rs)
-	}
-
-	if rulesetCreateErr != nil {
-		return diag.FromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, rulesetCreateErr))
+	created, err := writeRuleset(ctx, client, http.MethodPost, rulesetURI(accountID, zoneID), rs)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, err))
 	}
 
-	rulesetEntryPoint := cloudflare.Ruleset{
-		Description: rulesetDescription,
-		Rules:       rules,
+	rulesetEntryPoint := ruleset{
+		Ruleset: cloudflare.Ruleset{Description: rulesetDescription},
+		Rules:   rules,
 	}
 
 	// For "custom" rulesets, we don't send a follow up PUT it to the entrypoint
 	// endpoint.
 	if rulesetKind != string(cloudflare.RulesetKindCustom) {
-		if accountID != "" {
-			_, err = client.UpdateAccountRulesetPhase(ctx, accountID, rulesetPhase, rulesetEntryPoint)
-		} else {
-			_, err = client.UpdateZoneRulesetPhase(ctx, zoneID, rulesetPhase, rulesetEntryPoint)
-		}
-
-		if err != nil {
+		entryPointURI := fmt.Sprintf("%s/phases/%s/entrypoint", rulesetURI(accountID, zoneID), rulesetPhase)
+		if _, err := writeRuleset(ctx, client, http.MethodPut, entryPointURI, rulesetEntryPoint); err != nil {
 			return diag.FromErr(fmt.Errorf("error updating ruleset phase entrypoint %s: %w", rulesetName, err))
 		}
 	}
 
-	d.SetId(ruleset.ID)
+	d.SetId(created.ID)
 
 	return resourceCloudflareRulesetRead(ctx, d, meta)
 }
@@ -146,16 +239,29 @@ func resourceCloudflareRulesetImport(ctx context.Context, d *schema.ResourceData
 	attributes := strings.SplitN(d.Id(), "/", 3)
 
 	if len(attributes) != 3 {
-		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "resourceType/resourceTypeID/rulesetID`, d.Id())
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "resourceType/resourceTypeID/rulesetID" or "resourceType/resourceTypeID/phase/phase"`, d.Id())
 	}
 
 	resourceType, resourceTypeID, rulesetID := attributes[0], attributes[1], attributes[2]
 
+	var accountID, zoneID string
 	if resourceType == "account" {
+		accountID = resourceTypeID
 		d.Set("account_id", resourceTypeID)
 	} else {
+		zoneID = resourceTypeID
 		d.Set("zone_id", resourceTypeID)
 	}
+
+	// The entrypoint ruleset of a phase can be imported by the name of the
+	// phase, such as the custom rules Cloudflare migrates Firewall Rules to.
+	if phase := strings.TrimPrefix(rulesetID, "phase/"); phase != rulesetID {
+		entrypoint, err := getRulesetPhaseEntrypoint(ctx, meta.(*cloudflare.API), accountID, zoneID, phase)
+		if err != nil {
+			return nil, fmt.Errorf("error reading entrypoint ruleset of phase %q: %w", phase, err)
+		}
+		rulesetID = entrypoint.ID
+	}
 	d.SetId(rulesetID)
 
 	resourceCloudflareRulesetRead(ctx, d, meta)
@@ -168,15 +274,7 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 	accountID := d.Get("account_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
-	var ruleset cloudflare.Ruleset
-	var err error
-
-	if accountID != "" {
-		ruleset, err = client.GetAccountRuleset(ctx, accountID, d.Id())
-	} else {
-		ruleset, err = client.GetZoneRuleset(ctx, zoneID, d.Id())
-	}
-
+	rs, err := getRuleset(ctx, client, accountID, zoneID, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "could not find ruleset") {
 			log.Printf("[INFO] Ruleset %s no longer exists", d.Id())
@@ -186,12 +284,14 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error reading ruleset ID %q: %w", d.Id(), err))
 	}
 
-	d.Set("name", ruleset.Name)
-	d.Set("description", ruleset.Description)
-	d.Set("kind", ruleset.Kind)
-	d.Set("phase", ruleset.Phase)
+	d.Set("name", rs.Name)
+	d.Set("description", rs.Description)
+	d.Set("kind", rs.Kind)
+	d.Set("phase", rs.Phase)
 
-	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
+	sortRulesetOverridesByState(d.Get("rules").([]interface{}), rs.Rules)
+
+	if err := d.Set("rules", buildStateFromRulesetRules(rs.Rules)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -208,14 +308,15 @@ func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(fmt.Errorf("error building ruleset from resource: %w", err))
 	}
 
-	description := d.Get("description").(string)
-	if accountID != "" {
-		_, err = client.UpdateAccountRuleset(ctx, accountID, d.Id(), description, rules)
-	} else {
-		_, err = client.UpdateZoneRuleset(ctx, zoneID, d.Id(), description, rules)
+	stateRules, _ := d.GetChange("rules")
+	matchRulesetRulesToState(stateRules.([]interface{}), rules)
+
+	rs := ruleset{
+		Ruleset: cloudflare.Ruleset{Description: d.Get("description").(string)},
+		Rules:   rules,
 	}
 
-	if err != nil {
+	if _, err := writeRuleset(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s", rulesetURI(accountID, zoneID), d.Id()), rs); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating ruleset with ID %q: %w", d.Id(), err))
 	}
 
@@ -243,11 +344,13 @@ func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData
 
 // buildStateFromRulesetRules receives the current ruleset rules and returns an
 // interface for the state file.
-func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
+func buildStateFromRulesetRules(rules []rulesetRule) interface{} {
 	var rulesData []map[string]interface{}
 	for _, r := range rules {
 		rule := map[string]interface{}{
 			"id":         r.ID,
+			"version":    r.Version,
+			"ref":        r.Ref,
 			"expression": r.Expression,
 			"action":     r.Action,
 			"enabled":    r.Enabled,
@@ -279,6 +382,7 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				fromListFields         []map[string]interface{}
 				fromValueFields        []map[string]interface{}
 				autoMinifyFields       []map[string]interface{}
+				cacheReserveFields     []map[string]interface{}
 				polishSetting          string
 				sslSetting             string
 				securityLevel          string
@@ -298,9 +402,10 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 
 				for _, overrideRule := range r.ActionParameters.Overrides.Categories {
 					categoryBasedOverrides = append(categoryBasedOverrides, map[string]interface{}{
-						"category": overrideRule.Category,
-						"action":   overrideRule.Action,
-						"status":   apiEnabledToStatusFieldConversion(overrideRule.Enabled),
+						"category":          overrideRule.Category,
+						"action":            overrideRule.Action,
+						"status":            apiEnabledToStatusFieldConversion(overrideRule.Enabled),
+						"sensitivity_level": overrideRule.SensitivityLevel,
 					})
 				}
 
@@ -524,6 +629,16 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				cacheKeyFields = append(cacheKeyFields, cacheKey)
 			}
 
+			if !reflect.ValueOf(r.ActionParameters.CacheReserve).IsNil() {
+				cacheReserve := map[string]interface{}{
+					"eligible": r.ActionParameters.CacheReserve.Eligible,
+				}
+				if r.ActionParameters.CacheReserve.MinimumFileSize != nil {
+					cacheReserve["minimum_file_size"] = int(*r.ActionParameters.CacheReserve.MinimumFileSize)
+				}
+				cacheReserveFields = append(cacheReserveFields, cacheReserve)
+			}
+
 			if !reflect.ValueOf(r.ActionParameters.FromList).IsNil() {
 				fromListFields = append(fromListFields, map[string]interface{}{
 					"name": r.ActionParameters.FromList.Name,
@@ -589,11 +704,15 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				"respect_strong_etags":       r.ActionParameters.RespectStrongETags,
 				"cache_key":                  cacheKeyFields,
 				"origin_error_page_passthru": r.ActionParameters.OriginErrorPagePassthru,
+				"origin_cache_control":       r.ActionParameters.OriginCacheControl,
+				"read_timeout":               r.ActionParameters.ReadTimeout,
+				"cache_reserve":              cacheReserveFields,
 				"from_list":                  fromListFields,
 				"from_value":                 fromValueFields,
 				"content":                    r.ActionParameters.Content,
 				"content_type":               r.ActionParameters.ContentType,
 				"status_code":                r.ActionParameters.StatusCode,
+				"asset_name":                 r.ActionParameters.AssetName,
 				"automatic_https_rewrites":   r.ActionParameters.AutomaticHTTPSRewrites,
 				"autominify":                 autoMinifyFields,
 				"bic":                        r.ActionParameters.BrowserIntegrityCheck,
@@ -619,12 +738,14 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 			var rateLimit []map[string]interface{}
 
 			rateLimit = append(rateLimit, map[string]interface{}{
-				"characteristics":     r.RateLimit.Characteristics,
-				"period":              r.RateLimit.Period,
-				"requests_per_period": r.RateLimit.RequestsPerPeriod,
-				"mitigation_timeout":  r.RateLimit.MitigationTimeout,
-				"counting_expression": r.RateLimit.CountingExpression,
-				"requests_to_origin":  r.RateLimit.RequestsToOrigin,
+				"characteristics":            r.RateLimit.Characteristics,
+				"period":                     r.RateLimit.Period,
+				"requests_per_period":        r.RateLimit.RequestsPerPeriod,
+				"score_per_period":           r.RateLimit.ScorePerPeriod,
+				"score_response_header_name": r.RateLimit.ScoreResponseHeaderName,
+				"mitigation_timeout":         r.RateLimit.MitigationTimeout,
+				"counting_expression":        r.RateLimit.CountingExpression,
+				"requests_to_origin":         r.RateLimit.RequestsToOrigin,
 			})
 
 			rule["ratelimit"] = rateLimit
@@ -658,8 +779,8 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 }
 
 // receives the resource config and builds a ruleset rule array.
-func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.RulesetRule, error) {
-	var rulesetRules []cloudflare.RulesetRule
+func buildRulesetRulesFromResource(d *schema.ResourceData) ([]rulesetRule, error) {
+	var rulesetRules []rulesetRule
 
 	rules, ok := d.Get("rules").([]interface{})
 	if !ok {
@@ -667,7 +788,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 	}
 
 	for rulesCounter, v := range rules {
-		var rule cloudflare.RulesetRule
+		var rule rulesetRule
 
 		resourceRule, ok := v.(map[string]interface{})
 		if !ok {
@@ -675,7 +796,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 		}
 
 		if len(resourceRule["action_parameters"].([]interface{})) > 0 {
-			rule.ActionParameters = &cloudflare.RulesetRuleActionParameters{}
+			rule.ActionParameters = &rulesetRuleActionParameters{}
 			for _, parameter := range resourceRule["action_parameters"].([]interface{}) {
 				for pKey, pValue := range parameter.(map[string]interface{}) {
 					switch pKey {
@@ -730,8 +851,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 					case "increment":
 						rule.ActionParameters.Increment = pValue.(int)
 					case "overrides":
-						var overrideConfiguration cloudflare.RulesetRuleActionParametersOverrides
-						var categories []cloudflare.RulesetRuleActionParametersCategories
+						var overrideConfiguration rulesetRuleActionParametersOverrides
+						var categories []rulesetRuleActionParametersCategory
 						var rules []cloudflare.RulesetRuleActionParametersRules
 
 						for overrideCounter, overrideParamValue := range pValue.([]interface{}) {
@@ -753,9 +874,12 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							if val, ok := overrideParamValue.(map[string]interface{})["categories"]; ok {
 								for categoryCounter, category := range val.([]interface{}) {
 									cData := category.(map[string]interface{})
-									categoryOverride := cloudflare.RulesetRuleActionParametersCategories{
-										Category: cData["category"].(string),
-										Action:   cData["action"].(string),
+									categoryOverride := rulesetRuleActionParametersCategory{
+										RulesetRuleActionParametersCategories: cloudflare.RulesetRuleActionParametersCategories{
+											Category: cData["category"].(string),
+											Action:   cData["action"].(string),
+										},
+										SensitivityLevel: cData["sensitivity_level"].(string),
 									}
 
 									if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.overrides.%d.categories.%d.status", rulesCounter, overrideCounter, categoryCounter)); ok {
@@ -795,7 +919,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							overrideConfiguration.Rules = rules
 						}
 
-						if !reflect.DeepEqual(overrideConfiguration, cloudflare.RulesetRuleActionParametersOverrides{}) {
+						if !reflect.DeepEqual(overrideConfiguration, rulesetRuleActionParametersOverrides{}) {
 							rule.ActionParameters.Overrides = &overrideConfiguration
 						}
 
@@ -860,6 +984,9 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 					case "status_code":
 						rule.ActionParameters.StatusCode = uint16(pValue.(int))
 
+					case "asset_name":
+						rule.ActionParameters.AssetName = pValue.(string)
+
 					case "host_header":
 						rule.ActionParameters.HostHeader = pValue.(string)
 
@@ -871,8 +998,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							}
 						}
 					case "automatic_https_rewrites":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.automatic_https_rewrites", rulesCounter)); ok {
-							rule.ActionParameters.AutomaticHTTPSRewrites = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.automatic_https_rewrites", rulesCounter)); ok {
+							rule.ActionParameters.AutomaticHTTPSRewrites = cloudflare.BoolPtr(value)
 						}
 					case "autominify":
 						for i := range pValue.([]interface{}) {
@@ -884,32 +1011,32 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 						}
 
 					case "bic":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.bic", rulesCounter)); ok {
-							rule.ActionParameters.BrowserIntegrityCheck = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.bic", rulesCounter)); ok {
+							rule.ActionParameters.BrowserIntegrityCheck = cloudflare.BoolPtr(value)
 						}
 					case "disable_apps":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.disable_apps", rulesCounter)); ok {
-							rule.ActionParameters.DisableApps = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.disable_apps", rulesCounter)); ok {
+							rule.ActionParameters.DisableApps = cloudflare.BoolPtr(value)
 						}
 					case "disable_zaraz":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.disable_zaraz", rulesCounter)); ok {
-							rule.ActionParameters.DisableZaraz = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.disable_zaraz", rulesCounter)); ok {
+							rule.ActionParameters.DisableZaraz = cloudflare.BoolPtr(value)
 						}
 					case "disable_railgun":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.disable_zaraz", rulesCounter)); ok {
-							rule.ActionParameters.DisableRailgun = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.disable_railgun", rulesCounter)); ok {
+							rule.ActionParameters.DisableRailgun = cloudflare.BoolPtr(value)
 						}
 					case "email_obfuscation":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.email_obfuscation", rulesCounter)); ok {
-							rule.ActionParameters.EmailObfuscation = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.email_obfuscation", rulesCounter)); ok {
+							rule.ActionParameters.EmailObfuscation = cloudflare.BoolPtr(value)
 						}
 					case "mirage":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.mirage", rulesCounter)); ok {
-							rule.ActionParameters.Mirage = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.mirage", rulesCounter)); ok {
+							rule.ActionParameters.Mirage = cloudflare.BoolPtr(value)
 						}
 					case "opportunistic_encryption":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.opportunistic_encryption", rulesCounter)); ok {
-							rule.ActionParameters.OpportunisticEncryption = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.opportunistic_encryption", rulesCounter)); ok {
+							rule.ActionParameters.OpportunisticEncryption = cloudflare.BoolPtr(value)
 						}
 					case "polish":
 						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.polish", rulesCounter)); ok {
@@ -917,8 +1044,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							rule.ActionParameters.Polish = p
 						}
 					case "rocket_loader":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.rocket_loader", rulesCounter)); ok {
-							rule.ActionParameters.RocketLoader = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.rocket_loader", rulesCounter)); ok {
+							rule.ActionParameters.RocketLoader = cloudflare.BoolPtr(value)
 						}
 					case "security_level":
 						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.security_level", rulesCounter)); ok {
@@ -926,8 +1053,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							rule.ActionParameters.SecurityLevel = sl
 						}
 					case "server_side_excludes":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.server_side_excludes", rulesCounter)); ok {
-							rule.ActionParameters.ServerSideExcludes = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.server_side_excludes", rulesCounter)); ok {
+							rule.ActionParameters.ServerSideExcludes = cloudflare.BoolPtr(value)
 						}
 					case "ssl":
 						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.ssl", rulesCounter)); ok {
@@ -935,12 +1062,12 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							rule.ActionParameters.SSL = ssl
 						}
 					case "sxg":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.sxg", rulesCounter)); ok {
-							rule.ActionParameters.SXG = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.sxg", rulesCounter)); ok {
+							rule.ActionParameters.SXG = cloudflare.BoolPtr(value)
 						}
 					case "hotlink_protection":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.hotlink_protection", rulesCounter)); ok {
-							rule.ActionParameters.HotLinkProtection = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.hotlink_protection", rulesCounter)); ok {
+							rule.ActionParameters.HotLinkProtection = cloudflare.BoolPtr(value)
 						}
 					case "sni":
 						for i := range pValue.([]interface{}) {
@@ -949,8 +1076,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							}
 						}
 					case "cache":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache", rulesCounter)); ok {
-							rule.ActionParameters.Cache = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache", rulesCounter)); ok {
+							rule.ActionParameters.Cache = cloudflare.BoolPtr(value)
 						}
 
 					case "edge_ttl":
@@ -1029,16 +1156,16 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							for pKey := range pValue.([]interface{})[i].(map[string]interface{}) {
 								switch pKey {
 								case "disable_stale_while_updating":
-									if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.serve_stale.0.disable_stale_while_updating", rulesCounter)); ok {
-										rule.ActionParameters.ServeStale.DisableStaleWhileUpdating = cloudflare.BoolPtr(value.(bool))
+									if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.serve_stale.0.disable_stale_while_updating", rulesCounter)); ok {
+										rule.ActionParameters.ServeStale.DisableStaleWhileUpdating = cloudflare.BoolPtr(value)
 									}
 								}
 							}
 						}
 
 					case "respect_strong_etags":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.respect_strong_etags", rulesCounter)); ok {
-							rule.ActionParameters.RespectStrongETags = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.respect_strong_etags", rulesCounter)); ok {
+							rule.ActionParameters.RespectStrongETags = cloudflare.BoolPtr(value)
 						}
 
 					case "cache_key":
@@ -1050,16 +1177,16 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							for pKey, pValue := range pValue.([]interface{})[i].(map[string]interface{}) {
 								switch pKey {
 								case "cache_by_device_type":
-									if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.cache_by_device_type", rulesCounter)); ok {
-										rule.ActionParameters.CacheKey.CacheByDeviceType = cloudflare.BoolPtr(value.(bool))
+									if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.cache_by_device_type", rulesCounter)); ok {
+										rule.ActionParameters.CacheKey.CacheByDeviceType = cloudflare.BoolPtr(value)
 									}
 								case "ignore_query_strings_order":
-									if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.ignore_query_strings_order", rulesCounter)); ok {
-										rule.ActionParameters.CacheKey.IgnoreQueryStringsOrder = cloudflare.BoolPtr(value.(bool))
+									if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.ignore_query_strings_order", rulesCounter)); ok {
+										rule.ActionParameters.CacheKey.IgnoreQueryStringsOrder = cloudflare.BoolPtr(value)
 									}
 								case "cache_deception_armor":
-									if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.cache_deception_armor", rulesCounter)); ok {
-										rule.ActionParameters.CacheKey.CacheDeceptionArmor = cloudflare.BoolPtr(value.(bool))
+									if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.cache_deception_armor", rulesCounter)); ok {
+										rule.ActionParameters.CacheKey.CacheDeceptionArmor = cloudflare.BoolPtr(value)
 									}
 								case "custom_key":
 									for i := range pValue.([]interface{}) {
@@ -1123,8 +1250,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 																rule.ActionParameters.CacheKey.CustomKey.Header.CheckPresence = append(rule.ActionParameters.CacheKey.CustomKey.Header.CheckPresence, pValue.([]interface{})[i].(string))
 															}
 														case "exclude_origin":
-															if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.header.0.exclude_origin", rulesCounter)); ok {
-																rule.ActionParameters.CacheKey.CustomKey.Header.ExcludeOrigin = cloudflare.BoolPtr(value.(bool))
+															if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.header.0.exclude_origin", rulesCounter)); ok {
+																rule.ActionParameters.CacheKey.CustomKey.Header.ExcludeOrigin = cloudflare.BoolPtr(value)
 															}
 														}
 													}
@@ -1157,16 +1284,16 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 													for pKey := range pValue.([]interface{})[i].(map[string]interface{}) {
 														switch pKey {
 														case "device_type":
-															if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.user.0.device_type", rulesCounter)); ok {
-																rule.ActionParameters.CacheKey.CustomKey.User.DeviceType = cloudflare.BoolPtr(value.(bool))
+															if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.user.0.device_type", rulesCounter)); ok {
+																rule.ActionParameters.CacheKey.CustomKey.User.DeviceType = cloudflare.BoolPtr(value)
 															}
 														case "geo":
-															if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.user.0.geo", rulesCounter)); ok {
-																rule.ActionParameters.CacheKey.CustomKey.User.Geo = cloudflare.BoolPtr(value.(bool))
+															if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.user.0.geo", rulesCounter)); ok {
+																rule.ActionParameters.CacheKey.CustomKey.User.Geo = cloudflare.BoolPtr(value)
 															}
 														case "lang":
-															if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.user.0.lang", rulesCounter)); ok {
-																rule.ActionParameters.CacheKey.CustomKey.User.Lang = cloudflare.BoolPtr(value.(bool))
+															if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.user.0.lang", rulesCounter)); ok {
+																rule.ActionParameters.CacheKey.CustomKey.User.Lang = cloudflare.BoolPtr(value)
 															}
 														}
 													}
@@ -1180,8 +1307,8 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 													for pKey := range pValue.([]interface{})[i].(map[string]interface{}) {
 														switch pKey {
 														case "resolved":
-															if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.host.0.resolved", rulesCounter)); ok {
-																rule.ActionParameters.CacheKey.CustomKey.Host.Resolved = cloudflare.BoolPtr(value.(bool))
+															if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.host.0.resolved", rulesCounter)); ok {
+																rule.ActionParameters.CacheKey.CustomKey.Host.Resolved = cloudflare.BoolPtr(value)
 															}
 														}
 													}
@@ -1194,8 +1321,32 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 						}
 
 					case "origin_error_page_passthru":
-						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.origin_error_page_passthru", rulesCounter)); ok {
-							rule.ActionParameters.OriginErrorPagePassthru = cloudflare.BoolPtr(value.(bool))
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.origin_error_page_passthru", rulesCounter)); ok {
+							rule.ActionParameters.OriginErrorPagePassthru = cloudflare.BoolPtr(value)
+						}
+
+					case "origin_cache_control":
+						if value, ok := getRulesetConfigBool(d, fmt.Sprintf("rules.%d.action_parameters.0.origin_cache_control", rulesCounter)); ok {
+							rule.ActionParameters.OriginCacheControl = cloudflare.BoolPtr(value)
+						}
+
+					case "read_timeout":
+						if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.read_timeout", rulesCounter)); ok {
+							rule.ActionParameters.ReadTimeout = cloudflare.UintPtr(uint(value.(int)))
+						}
+
+					case "cache_reserve":
+						for i := range pValue.([]interface{}) {
+							if pValue.([]interface{})[i] == nil {
+								continue
+							}
+							cacheReserve := pValue.([]interface{})[i].(map[string]interface{})
+							rule.ActionParameters.CacheReserve = &rulesetRuleActionParametersCacheReserve{
+								Eligible: cloudflare.BoolPtr(cacheReserve["eligible"].(bool)),
+							}
+							if value, ok := d.GetOk(fmt.Sprintf("rules.%d.action_parameters.0.cache_reserve.0.minimum_file_size", rulesCounter)); ok {
+								rule.ActionParameters.CacheReserve.MinimumFileSize = cloudflare.UintPtr(uint(value.(int)))
+							}
 						}
 
 					case "request_fields":
@@ -1262,7 +1413,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 		}
 
 		if len(resourceRule["ratelimit"].([]interface{})) > 0 {
-			rule.RateLimit = &cloudflare.RulesetRuleRateLimit{}
+			rule.RateLimit = &rulesetRuleRateLimit{}
 			for _, parameter := range resourceRule["ratelimit"].([]interface{}) {
 				for pKey, pValue := range parameter.(map[string]interface{}) {
 					switch pKey {
@@ -1276,6 +1427,10 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 						rule.RateLimit.Period = pValue.(int)
 					case "requests_per_period":
 						rule.RateLimit.RequestsPerPeriod = pValue.(int)
+					case "score_per_period":
+						rule.RateLimit.ScorePerPeriod = pValue.(int)
+					case "score_response_header_name":
+						rule.RateLimit.ScoreResponseHeaderName = pValue.(string)
 					case "mitigation_timeout":
 						rule.RateLimit.MitigationTimeout = pValue.(int)
 					case "counting_expression":
@@ -1332,6 +1487,12 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 			rule.Description = resourceRule["description"].(string)
 		}
 
+		// The planned ref of an existing rule is the one of the rule that was at
+		// the same position so only a ref set in the configuration is used here.
+		if ref, ok := getRulesetConfigString(d, fmt.Sprintf("rules.%d.ref", rulesCounter)); ok {
+			rule.Ref = ref
+		}
+
 		rulesetRules = append(rulesetRules, rule)
 	}
 
@@ -1365,3 +1526,222 @@ func apiEnabledToStatusFieldConversion(s *bool) string {
 		return ""
 	}
 }
+
+// sortRulesetOverridesByState orders the rule and category overrides of each
+// rule to match state, by rule ID and category name, as the API doesn't keep
+// their order stable. Overrides that aren't in state are kept in their API
+// order after the known ones.
+func sortRulesetOverridesByState(stateRules []interface{}, rules []rulesetRule) {
+	for i, rule := range rules {
+		if rule.ActionParameters == nil || rule.ActionParameters.Overrides == nil {
+			continue
+		}
+
+		stateRule := findRulesetStateRule(stateRules, rule.ID, i)
+		if stateRule == nil {
+			continue
+		}
+
+		actionParameters, ok := stateRule["action_parameters"].([]interface{})
+		if !ok || len(actionParameters) == 0 || actionParameters[0] == nil {
+			continue
+		}
+		overrides, ok := actionParameters[0].(map[string]interface{})["overrides"].([]interface{})
+		if !ok || len(overrides) == 0 || overrides[0] == nil {
+			continue
+		}
+		stateOverrides := overrides[0].(map[string]interface{})
+
+		ruleOrder := rulesetOverridesOrder(stateOverrides["rules"], "id")
+		sort.SliceStable(rule.ActionParameters.Overrides.Rules, func(a, b int) bool {
+			return rulesetOverridePosition(ruleOrder, rule.ActionParameters.Overrides.Rules[a].ID) < rulesetOverridePosition(ruleOrder, rule.ActionParameters.Overrides.Rules[b].ID)
+		})
+
+		categoryOrder := rulesetOverridesOrder(stateOverrides["categories"], "category")
+		sort.SliceStable(rule.ActionParameters.Overrides.Categories, func(a, b int) bool {
+			return rulesetOverridePosition(categoryOrder, rule.ActionParameters.Overrides.Categories[a].Category) < rulesetOverridePosition(categoryOrder, rule.ActionParameters.Overrides.Categories[b].Category)
+		})
+	}
+}
+
+// findRulesetStateRule returns the state of the rule with the given ID,
+// falling back to the rule at the same position for rules that don't have an
+// ID in state yet.
+func findRulesetStateRule(stateRules []interface{}, id string, index int) map[string]interface{} {
+	for _, v := range stateRules {
+		if stateRule, ok := v.(map[string]interface{}); ok && id != "" && stateRule["id"] == id {
+			return stateRule
+		}
+	}
+
+	if index < len(stateRules) {
+		if stateRule, ok := stateRules[index].(map[string]interface{}); ok && stateRule["id"] == "" {
+			return stateRule
+		}
+	}
+
+	return nil
+}
+
+// rulesetOverridesOrder maps the value of key for each override in state to
+// its position.
+func rulesetOverridesOrder(overrides interface{}, key string) map[string]int {
+	order := make(map[string]int)
+	list, _ := overrides.([]interface{})
+	for i, v := range list {
+		if override, ok := v.(map[string]interface{}); ok {
+			if name, ok := override[key].(string); ok {
+				if _, exists := order[name]; !exists {
+					order[name] = i
+				}
+			}
+		}
+	}
+
+	return order
+}
+
+func rulesetOverridePosition(order map[string]int, name string) int {
+	if position, ok := order[name]; ok {
+		return position
+	}
+
+	return len(order)
+}
+
+// matchRulesetRulesToState carries the ID and ref of the rules in state over
+// to the matching configured rules so that the API updates them in place
+// instead of replacing them, which would lose their identity and reset any
+// counters attached to them. Rules are matched by configured ref, then by
+// content and finally by position, since positions shift whenever a rule is
+// added, removed or reordered.
+func matchRulesetRulesToState(stateRules []interface{}, rules []rulesetRule) {
+	matchers := []func(stateRule map[string]interface{}, stateIndex, index int) bool{
+		func(stateRule map[string]interface{}, _, index int) bool {
+			return rules[index].Ref != "" && stateRule["ref"] == rules[index].Ref
+		},
+		func(stateRule map[string]interface{}, _, index int) bool {
+			return stateRule["action"] == rules[index].Action &&
+				stateRule["expression"] == rules[index].Expression &&
+				stateRule["description"] == rules[index].Description
+		},
+		func(stateRule map[string]interface{}, _, index int) bool {
+			return stateRule["action"] == rules[index].Action && stateRule["expression"] == rules[index].Expression
+		},
+		func(_ map[string]interface{}, stateIndex, index int) bool {
+			return stateIndex == index && rules[index].Ref == ""
+		},
+	}
+
+	used := make([]bool, len(stateRules))
+	matched := make([]bool, len(rules))
+	for _, matches := range matchers {
+		for i := range rules {
+			if matched[i] {
+				continue
+			}
+
+			for j, v := range stateRules {
+				stateRule, ok := v.(map[string]interface{})
+				if !ok || used[j] {
+					continue
+				}
+
+				id, _ := stateRule["id"].(string)
+				if id == "" || !matches(stateRule, j, i) {
+					continue
+				}
+
+				used[j], matched[i] = true, true
+				rules[i].ID = id
+				if rules[i].Ref == "" {
+					rules[i].Ref, _ = stateRule["ref"].(string)
+				}
+				break
+			}
+		}
+	}
+}
+
+// getRulesetConfigString returns the string at key and whether it has been
+// set in the configuration.
+func getRulesetConfigString(d *schema.ResourceData, key string) (string, bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		if value, ok := d.GetOk(key); ok {
+			return value.(string), true
+		}
+		return "", false
+	}
+
+	value := getRawValue(key, rawConfig)
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) || value.AsString() == "" {
+		return "", false
+	}
+
+	return value.AsString(), true
+}
+
+// getRulesetConfigBool returns the boolean at key and whether it has been set
+// in the configuration. Unlike d.GetOk, an explicit `false` is reported as set
+// so that it's sent to the API instead of falling back to the default.
+func getRulesetConfigBool(d *schema.ResourceData, key string) (bool, bool) {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		if value, ok := d.GetOk(key); ok {
+			return value.(bool), true
+		}
+		return false, false
+	}
+
+	value := getRawValue(key, rawConfig)
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.Bool) {
+		return false, false
+	}
+
+	return value.True(), true
+}
+
+// rulesetURI returns the rulesets endpoint of either the account or the zone.
+func rulesetURI(accountID, zoneID string) string {
+	if accountID != "" {
+		return fmt.Sprintf("/accounts/%s/rulesets", accountID)
+	}
+
+	return fmt.Sprintf("/zones/%s/rulesets", zoneID)
+}
+
+func getRuleset(ctx context.Context, client *cloudflare.API, accountID, zoneID, rulesetID string) (ruleset, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s/%s", rulesetURI(accountID, zoneID), rulesetID), nil, nil)
+	if err != nil {
+		return ruleset{}, err
+	}
+
+	var rs ruleset
+	if err := json.Unmarshal(res, &rs); err != nil {
+		return ruleset{}, fmt.Errorf("error unmarshalling ruleset: %w", err)
+	}
+
+	return rs, nil
+}
+
+// getRulesetPhaseEntrypoint returns the entrypoint ruleset of a phase.
+func getRulesetPhaseEntrypoint(ctx context.Context, client *cloudflare.API, accountID, zoneID, phase string) (ruleset, error) {
+	return getRuleset(ctx, client, accountID, zoneID, fmt.Sprintf("phases/%s/entrypoint", phase))
+}
+
+// writeRuleset creates (POST) or updates (PUT) the ruleset, or phase
+// entrypoint ruleset, at uri.
+func writeRuleset(ctx context.Context, client *cloudflare.API, method, uri string, rs ruleset) (ruleset, error) {
+	res, err := client.Raw(ctx, method, uri, rs, nil)
+	if err != nil {
+		return ruleset{}, err
+	}
+
+	var written ruleset
+	if err := json.Unmarshal(res, &written); err != nil {
+		return ruleset{}, fmt.Errorf("error unmarshalling ruleset: %w", err)
+	}
+
+	return written, nil
+}
@@ -2,13 +2,21 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
 
@@ -734,6 +742,39 @@ func TestAccCloudflareRuleset_RateLimit(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_RateLimitCountingExpression(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetRateLimitCountingExpression(rnd, "example HTTP rate limit", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "managed_challenge"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.characteristics.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.counting_expression", "(http.request.method eq \"POST\")"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.mitigation_timeout", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_CustomErrors(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -1699,6 +1740,93 @@ func TestAccCloudflareRuleset_CacheSettings(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_CacheSettingsOriginOptions(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetCacheSettingsOriginOptions(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_cache_settings"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "set_cache_settings"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.read_timeout", "900"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.origin_cache_control", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.respect_strong_etags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_reserve.0.eligible", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_reserve.0.minimum_file_size", "100000"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.serve_stale.0.disable_stale_while_updating", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.user.0.lang", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_PreserveRuleIDsOnInsert(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+	ruleIDs := make(map[string]string)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetOrderedRules(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					testAccCheckCloudflareRulesetRuleID(resourceName, 0, "gb", ruleIDs),
+					testAccCheckCloudflareRulesetRuleID(resourceName, 1, "fr", ruleIDs),
+				),
+			},
+			{
+				Config: testAccCloudflareRulesetOrderedRules(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.description", "de"),
+					testAccCheckCloudflareRulesetRuleID(resourceName, 1, "gb", ruleIDs),
+					testAccCheckCloudflareRulesetRuleID(resourceName, 2, "fr", ruleIDs),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCloudflareRulesetRuleID records the ID of the rule at index
+// under name on first use and checks it's unchanged afterwards.
+func testAccCheckCloudflareRulesetRuleID(resourceName string, index int, name string, ruleIDs map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		id := rs.Primary.Attributes[fmt.Sprintf("rules.%d.id", index)]
+		if id == "" {
+			return fmt.Errorf("rule %q has no ID", name)
+		}
+
+		if previous, ok := ruleIDs[name]; ok && previous != id {
+			return fmt.Errorf("expected rule %q to keep ID %q, got %q", name, previous, id)
+		}
+		ruleIDs[name] = id
+
+		return nil
+	}
+}
+
 func TestAccCloudflareRuleset_Config(t *testing.T) {
 	t.Parallel()
 	rnd := generateRandomResourceName()
@@ -1744,6 +1872,59 @@ func TestAccCloudflareRuleset_Config(t *testing.T) {
 		},
 	})
 }
+func TestAccCloudflareRuleset_ConfigDisabled(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetConfigDisabled(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_config_settings"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "set_config"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.rocket_loader", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.email_obfuscation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.disable_zaraz", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.disable_railgun", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.bic", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_CustomErrorsAssetName(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetCustomErrorsAssetName(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_custom_errors"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "serve_error"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.asset_name", "maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.content_type", "text/html"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.status_code", "503"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_Redirect(t *testing.T) {
 	t.Parallel()
 	rnd := generateRandomResourceName()
@@ -2518,6 +2699,34 @@ func testAccCheckCloudflareRulesetRateLimit(rnd, name, zoneID, zoneName string)
   }`, rnd, name, zoneID, zoneName)
 }
 
+func testAccCheckCloudflareRulesetRateLimitCountingExpression(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_ratelimit"
+
+    rules {
+      action = "managed_challenge"
+      ratelimit {
+        characteristics = [
+          "cf.colo.id",
+          "http.request.headers[\"x-api-key\"]"
+        ]
+        period              = 60
+        requests_per_period = 100
+        mitigation_timeout  = 0
+        counting_expression = "(http.request.method eq \"POST\")"
+      }
+      expression  = "(http.request.uri.path matches \"^/api/\")"
+      description = "example http rate limit"
+      enabled     = true
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
 func testAccCheckCloudflareRulesetActionParametersOverridesActionEnabled(rnd, name, zoneID, zoneName string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -3110,6 +3319,80 @@ func testAccCloudflareRulesetCacheSettingsEdgeTTLRespectOrigin(rnd, zoneID strin
 	}`, rnd, zoneID)
 }
 
+func testAccCloudflareRulesetCacheSettingsOriginOptions(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_ruleset" "%[1]s" {
+		zone_id     = "%[2]s"
+		name        = "%[1]s"
+		description = "%[1]s ruleset description"
+		kind        = "zone"
+		phase       = "http_request_cache_settings"
+
+		rules {
+			action = "set_cache_settings"
+			action_parameters {
+				cache                = true
+				read_timeout         = 900
+				origin_cache_control = false
+				respect_strong_etags = false
+				cache_reserve {
+					eligible          = true
+					minimum_file_size = 100000
+				}
+				serve_stale {
+					disable_stale_while_updating = false
+				}
+				cache_key {
+					custom_key {
+						query_string {
+							include = ["a", "b"]
+						}
+						user {
+							lang = true
+						}
+					}
+				}
+			}
+			expression  = "true"
+			description = "%[1]s set cache settings rule"
+			enabled     = true
+		}
+	}`, rnd, zoneID)
+}
+
+func testAccCloudflareRulesetOrderedRules(rnd, zoneID string, insert bool) string {
+	inserted := ""
+	if insert {
+		inserted = `
+    rules {
+      action      = "block"
+      expression  = "(ip.geoip.country eq \"DE\")"
+      description = "de"
+    }`
+	}
+
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_firewall_custom"
+%[3]s
+    rules {
+      action      = "challenge"
+      expression  = "(ip.geoip.country eq \"GB\")"
+      description = "gb"
+    }
+
+    rules {
+      action      = "challenge"
+      expression  = "(ip.geoip.country eq \"FR\")"
+      description = "fr"
+    }
+  }`, rnd, zoneID, inserted)
+}
+
 func testAccCloudflareRulesetConfigAllEnabled(rnd, accountID, zoneID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -3150,6 +3433,54 @@ func testAccCloudflareRulesetConfigAllEnabled(rnd, accountID, zoneID string) str
   }`, rnd, accountID, zoneID)
 }
 
+func testAccCloudflareRulesetConfigDisabled(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_config_settings"
+
+    rules {
+      action = "set_config"
+      action_parameters {
+        rocket_loader     = false
+        email_obfuscation = false
+        disable_zaraz     = false
+        disable_railgun   = true
+        bic               = false
+      }
+      expression  = "true"
+      description = "%[1]s set config rule"
+      enabled     = true
+    }
+  }`, rnd, zoneID)
+}
+
+func testAccCloudflareRulesetCustomErrorsAssetName(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_custom_errors"
+
+    rules {
+      action = "serve_error"
+      action_parameters {
+        asset_name   = "maintenance"
+        content_type = "text/html"
+        status_code  = 503
+      }
+      expression  = "(http.request.uri.path matches \"^/api/\")"
+      description = "%[1]s custom error asset"
+      enabled     = true
+    }
+  }`, rnd, zoneID)
+}
+
 func testAccCloudflareRulesetRedirectFromList(rnd, accountID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_list" "list-%[1]s" {
@@ -3232,3 +3563,667 @@ func testAccCheckCloudflareRulesetActionParametersOverrideSensitivityForAllRules
     }
   }`, rnd, name, zoneID, zoneName)
 }
+
+// testRulesetResourceData builds the resource data for raw, including the raw
+// configuration Terraform sends so that unset values can be told apart from
+// explicit zero values.
+func testRulesetResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	r := resourceCloudflareRuleset()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("2c0fc9fa937b11eaa1b71c4d701ab86e")
+
+	config, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := d.State()
+	state.RawConfig, err = ctyjson.Unmarshal(config, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r.Data(state)
+}
+
+func TestBuildRulesetRulesFromResourceCacheSettings(t *testing.T) {
+	d := testRulesetResourceData(t, map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":    "cache settings",
+		"kind":    "zone",
+		"phase":   "http_request_cache_settings",
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "set_cache_settings",
+			"expression": "true",
+			"action_parameters": []interface{}{map[string]interface{}{
+				"cache":                false,
+				"read_timeout":         900,
+				"origin_cache_control": true,
+				"cache_reserve": []interface{}{map[string]interface{}{
+					"eligible": false,
+				}},
+				"serve_stale": []interface{}{map[string]interface{}{
+					"disable_stale_while_updating": false,
+				}},
+				"cache_key": []interface{}{map[string]interface{}{
+					"ignore_query_strings_order": false,
+					"custom_key": []interface{}{map[string]interface{}{
+						"query_string": []interface{}{map[string]interface{}{
+							"exclude": []interface{}{"*"},
+						}},
+						"user": []interface{}{map[string]interface{}{
+							"geo": false,
+						}},
+					}},
+				}},
+			}},
+		}},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sent []map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	actionParameters := sent[0]["action_parameters"].(map[string]interface{})
+	if actionParameters["cache"] != false {
+		t.Errorf("expected cache to be sent as false, got %v", actionParameters["cache"])
+	}
+	if actionParameters["read_timeout"] != float64(900) {
+		t.Errorf("expected read_timeout to be 900, got %v", actionParameters["read_timeout"])
+	}
+	if actionParameters["origin_cache_control"] != true {
+		t.Errorf("expected origin_cache_control to be true, got %v", actionParameters["origin_cache_control"])
+	}
+	if _, ok := actionParameters["respect_strong_etags"]; ok {
+		t.Errorf("expected unset respect_strong_etags to be omitted, got %v", actionParameters["respect_strong_etags"])
+	}
+	if _, ok := actionParameters["origin_error_page_passthru"]; ok {
+		t.Errorf("expected unset origin_error_page_passthru to be omitted, got %v", actionParameters["origin_error_page_passthru"])
+	}
+
+	cacheReserve := actionParameters["cache_reserve"].(map[string]interface{})
+	if cacheReserve["eligible"] != false {
+		t.Errorf("expected cache_reserve.eligible to be false, got %v", cacheReserve["eligible"])
+	}
+	if _, ok := cacheReserve["minimum_file_size"]; ok {
+		t.Errorf("expected unset cache_reserve.minimum_file_size to be omitted, got %v", cacheReserve["minimum_file_size"])
+	}
+
+	serveStale := actionParameters["serve_stale"].(map[string]interface{})
+	if serveStale["disable_stale_while_updating"] != false {
+		t.Errorf("expected serve_stale.disable_stale_while_updating to be false, got %v", serveStale["disable_stale_while_updating"])
+	}
+
+	cacheKey := actionParameters["cache_key"].(map[string]interface{})
+	if cacheKey["ignore_query_strings_order"] != false {
+		t.Errorf("expected cache_key.ignore_query_strings_order to be false, got %v", cacheKey["ignore_query_strings_order"])
+	}
+	if _, ok := cacheKey["cache_deception_armor"]; ok {
+		t.Errorf("expected unset cache_key.cache_deception_armor to be omitted, got %v", cacheKey["cache_deception_armor"])
+	}
+
+	customKey := cacheKey["custom_key"].(map[string]interface{})
+	if exclude := customKey["query_string"].(map[string]interface{})["exclude"]; exclude != "*" {
+		t.Errorf("expected cache_key.custom_key.query_string.exclude to be \"*\", got %v", exclude)
+	}
+
+	user := customKey["user"].(map[string]interface{})
+	if user["geo"] != false {
+		t.Errorf("expected cache_key.custom_key.user.geo to be false, got %v", user["geo"])
+	}
+	if _, ok := user["lang"]; ok {
+		t.Errorf("expected unset cache_key.custom_key.user.lang to be omitted, got %v", user["lang"])
+	}
+}
+
+func TestBuildStateFromRulesetRulesCacheSettings(t *testing.T) {
+	var rs ruleset
+	if err := json.Unmarshal([]byte(`{
+  "id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+  "name": "cache settings",
+  "kind": "zone",
+  "phase": "http_request_cache_settings",
+  "rules": [
+    {
+      "id": "3a03d665bac047339bb530ecb439a90d",
+      "version": "1",
+      "action": "set_cache_settings",
+      "expression": "true",
+      "enabled": true,
+      "action_parameters": {
+        "cache": true,
+        "read_timeout": 900,
+        "origin_cache_control": false,
+        "origin_error_page_passthru": true,
+        "cache_reserve": {
+          "eligible": true,
+          "minimum_file_size": 100000
+        },
+        "serve_stale": {
+          "disable_stale_while_updating": true
+        },
+        "cache_key": {
+          "cache_deception_armor": true,
+          "custom_key": {
+            "query_string": {
+              "include": ["a", "b"]
+            },
+            "header": {
+              "include": ["x-header"],
+              "exclude_origin": true
+            },
+            "cookie": {
+              "check_presence": ["session"]
+            },
+            "user": {
+              "device_type": true,
+              "lang": true
+            },
+            "host": {
+              "resolved": true
+            }
+          }
+        }
+      }
+    }
+  ]
+}`), &rs); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{})
+	if err := d.Set("rules", buildStateFromRulesetRules(rs.Rules)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"rules.0.action_parameters.0.cache":                                              true,
+		"rules.0.action_parameters.0.read_timeout":                                       900,
+		"rules.0.action_parameters.0.origin_cache_control":                               false,
+		"rules.0.action_parameters.0.origin_error_page_passthru":                         true,
+		"rules.0.action_parameters.0.respect_strong_etags":                               false,
+		"rules.0.action_parameters.0.cache_reserve.0.eligible":                           true,
+		"rules.0.action_parameters.0.cache_reserve.0.minimum_file_size":                  100000,
+		"rules.0.action_parameters.0.serve_stale.0.disable_stale_while_updating":         true,
+		"rules.0.action_parameters.0.cache_key.0.cache_deception_armor":                  true,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.#":  2,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.1":  "b",
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.exclude.#":  0,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.header.0.include.0":        "x-header",
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.header.0.exclude_origin":   true,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.cookie.0.check_presence.0": "session",
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.user.0.device_type":        true,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.user.0.geo":                false,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.user.0.lang":               true,
+		"rules.0.action_parameters.0.cache_key.0.custom_key.0.host.0.resolved":           true,
+	}
+
+	for key, value := range expected {
+		if got := d.Get(key); got != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, got)
+		}
+	}
+}
+
+func TestBuildRulesetRulesFromResourceOverrides(t *testing.T) {
+	d := testRulesetResourceData(t, map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":    "managed WAF overrides",
+		"kind":    "zone",
+		"phase":   "http_request_firewall_managed",
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "execute",
+			"expression": "true",
+			"action_parameters": []interface{}{map[string]interface{}{
+				"id": "efb7b8c949ac4650a09736fc376e9aee",
+				"overrides": []interface{}{map[string]interface{}{
+					"sensitivity_level": "medium",
+					"categories": []interface{}{map[string]interface{}{
+						"category":          "wordpress",
+						"sensitivity_level": "low",
+					}},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"id":              "6179ae15870a4bb7b2d480d4843b323c",
+							"action":          "log",
+							"score_threshold": 60,
+						},
+						map[string]interface{}{
+							"id":     "e3a567afc347477d9702d9047e97d760",
+							"status": "disabled",
+						},
+					},
+				}},
+			}},
+		}},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sent []map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	overrides := sent[0]["action_parameters"].(map[string]interface{})["overrides"].(map[string]interface{})
+	if _, ok := overrides["enabled"]; ok {
+		t.Errorf("expected unset overrides.enabled to be omitted, got %v", overrides["enabled"])
+	}
+	if overrides["sensitivity_level"] != "medium" {
+		t.Errorf("expected overrides.sensitivity_level to be %q, got %v", "medium", overrides["sensitivity_level"])
+	}
+
+	category := overrides["categories"].([]interface{})[0].(map[string]interface{})
+	if category["sensitivity_level"] != "low" {
+		t.Errorf("expected categories.0.sensitivity_level to be %q, got %v", "low", category["sensitivity_level"])
+	}
+	if _, ok := category["enabled"]; ok {
+		t.Errorf("expected unset categories.0.enabled to be omitted, got %v", category["enabled"])
+	}
+
+	ruleOverrides := overrides["rules"].([]interface{})
+	first := ruleOverrides[0].(map[string]interface{})
+	if first["score_threshold"] != float64(60) {
+		t.Errorf("expected rules.0.score_threshold to be 60, got %v", first["score_threshold"])
+	}
+	if _, ok := first["enabled"]; ok {
+		t.Errorf("expected unset rules.0.enabled to be omitted, got %v", first["enabled"])
+	}
+	if second := ruleOverrides[1].(map[string]interface{}); second["enabled"] != false {
+		t.Errorf("expected rules.1.enabled to be false, got %v", second["enabled"])
+	}
+}
+
+func TestSortRulesetOverridesByState(t *testing.T) {
+	stateRules := []interface{}{map[string]interface{}{
+		"id": "3a03d665bac047339bb530ecb439a90d",
+		"action_parameters": []interface{}{map[string]interface{}{
+			"overrides": []interface{}{map[string]interface{}{
+				"categories": []interface{}{
+					map[string]interface{}{"category": "joomla"},
+					map[string]interface{}{"category": "wordpress"},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{"id": "b"},
+					map[string]interface{}{"id": "a"},
+				},
+			}},
+		}},
+	}}
+
+	overrides := &rulesetRuleActionParametersOverrides{
+		Categories: []rulesetRuleActionParametersCategory{
+			{RulesetRuleActionParametersCategories: cloudflare.RulesetRuleActionParametersCategories{Category: "wordpress"}},
+			{RulesetRuleActionParametersCategories: cloudflare.RulesetRuleActionParametersCategories{Category: "drupal"}},
+			{RulesetRuleActionParametersCategories: cloudflare.RulesetRuleActionParametersCategories{Category: "joomla"}},
+		},
+	}
+	overrides.Rules = []cloudflare.RulesetRuleActionParametersRules{{ID: "c"}, {ID: "a"}, {ID: "b"}}
+
+	rules := []rulesetRule{{
+		RulesetRule:      cloudflare.RulesetRule{ID: "3a03d665bac047339bb530ecb439a90d"},
+		ActionParameters: &rulesetRuleActionParameters{Overrides: overrides},
+	}}
+
+	sortRulesetOverridesByState(stateRules, rules)
+
+	var categories []string
+	for _, category := range overrides.Categories {
+		categories = append(categories, category.Category)
+	}
+	if expected := []string{"joomla", "wordpress", "drupal"}; !reflect.DeepEqual(categories, expected) {
+		t.Errorf("expected categories %v, got %v", expected, categories)
+	}
+
+	var ids []string
+	for _, rule := range overrides.Rules {
+		ids = append(ids, rule.ID)
+	}
+	if expected := []string{"b", "a", "c"}; !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected rules %v, got %v", expected, ids)
+	}
+}
+
+func TestBuildRulesetRulesFromResourceConfigSettings(t *testing.T) {
+	d := testRulesetResourceData(t, map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":    "config settings",
+		"kind":    "zone",
+		"phase":   "http_config_settings",
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "set_config",
+			"expression": "true",
+			"action_parameters": []interface{}{map[string]interface{}{
+				"rocket_loader":   false,
+				"disable_zaraz":   false,
+				"disable_railgun": true,
+			}},
+		}},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actionParameters := rules[0].ActionParameters
+	if actionParameters.RocketLoader == nil || *actionParameters.RocketLoader {
+		t.Errorf("expected rocket_loader to be sent as false, got %v", actionParameters.RocketLoader)
+	}
+	if actionParameters.DisableZaraz == nil || *actionParameters.DisableZaraz {
+		t.Errorf("expected disable_zaraz to be sent as false, got %v", actionParameters.DisableZaraz)
+	}
+	if actionParameters.DisableRailgun == nil || !*actionParameters.DisableRailgun {
+		t.Errorf("expected disable_railgun to be sent as true, got %v", actionParameters.DisableRailgun)
+	}
+	if actionParameters.EmailObfuscation != nil {
+		t.Errorf("expected unset email_obfuscation to be omitted, got %v", *actionParameters.EmailObfuscation)
+	}
+}
+
+func TestBuildRulesetRulesFromResourceCustomErrors(t *testing.T) {
+	d := testRulesetResourceData(t, map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":    "custom errors",
+		"kind":    "zone",
+		"phase":   "http_custom_errors",
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "serve_error",
+			"expression": "true",
+			"action_parameters": []interface{}{map[string]interface{}{
+				"asset_name":   "maintenance",
+				"content_type": "text/html",
+				"status_code":  503,
+			}},
+		}},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(rules[0].ActionParameters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sent map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	if sent["asset_name"] != "maintenance" {
+		t.Errorf("expected asset_name to be %q, got %v", "maintenance", sent["asset_name"])
+	}
+	if sent["status_code"] != float64(503) {
+		t.Errorf("expected status_code to be 503, got %v", sent["status_code"])
+	}
+	if _, ok := sent["content"]; ok {
+		t.Errorf("expected unset content to be omitted, got %v", sent["content"])
+	}
+
+	state := buildStateFromRulesetRules([]rulesetRule{rules[0]}).([]map[string]interface{})
+	if got := state[0]["action_parameters"].([]map[string]interface{})[0]["asset_name"]; got != "maintenance" {
+		t.Errorf("expected asset_name to be flattened, got %v", got)
+	}
+}
+
+func TestMatchRulesetRulesToState(t *testing.T) {
+	stateRules := []interface{}{
+		map[string]interface{}{"id": "1", "ref": "1", "action": "block", "expression": "gb", "description": "gb"},
+		map[string]interface{}{"id": "2", "ref": "dashboard", "action": "log", "expression": "dashboard", "description": "added in the dashboard"},
+		map[string]interface{}{"id": "3", "ref": "3", "action": "challenge", "expression": "fr", "description": "fr"},
+		map[string]interface{}{"id": "4", "ref": "4", "action": "block", "expression": "us", "description": "us"},
+		map[string]interface{}{"id": "5", "ref": "pinned", "action": "block", "expression": "old", "description": "pinned"},
+	}
+
+	rules := []rulesetRule{
+		{RulesetRule: cloudflare.RulesetRule{Action: "block", Expression: "de", Description: "new"}},
+		{RulesetRule: cloudflare.RulesetRule{Action: "block", Expression: "gb", Description: "gb"}},
+		{RulesetRule: cloudflare.RulesetRule{Action: "challenge", Expression: "fr", Description: "renamed"}},
+		{RulesetRule: cloudflare.RulesetRule{Action: "block", Expression: "us and edited", Description: "us"}},
+		{RulesetRule: cloudflare.RulesetRule{Action: "block", Expression: "new", Description: "pinned", Ref: "pinned"}},
+	}
+
+	matchRulesetRulesToState(stateRules, rules)
+
+	expected := []struct{ id, ref string }{
+		{"", ""},
+		{"1", "1"},
+		{"3", "3"},
+		{"4", "4"},
+		{"5", "pinned"},
+	}
+	for i, e := range expected {
+		if rules[i].ID != e.id || rules[i].Ref != e.ref {
+			t.Errorf("expected rule %d to have ID %q and ref %q, got %q and %q", i, e.id, e.ref, rules[i].ID, rules[i].Ref)
+		}
+	}
+}
+
+func TestCloudflareRulesetImportMigratedFirewallRules(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const rulesetID = "4814384a9e5d4991b9815dcfc25d2f1f"
+
+	entrypoint := `{
+  "id": "` + rulesetID + `",
+  "name": "default",
+  "kind": "zone",
+  "phase": "http_request_firewall_custom",
+  "rules": [
+    {"id": "6c3a2b6f5d1f4b7c9e0a1b2c3d4e5f60", "version": "1", "ref": "372e67954025e0ba6aaa6d586b9e0b60", "action": "block", "expression": "(ip.src eq 192.0.2.1)", "description": "block bad actor", "enabled": true},
+    {"id": "7d4b3c7a6e2a5c8d0f1b2c3d4e5f6a71", "version": "1", "ref": "43be31339d7c4b11a2a0f82a8fe1d0f2", "action": "managed_challenge", "expression": "(http.request.uri.path contains \"/login\")", "description": "challenge logins", "enabled": false}
+  ]
+}`
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/zones/" + zoneID + "/rulesets/phases/http_request_firewall_custom/entrypoint", "/zones/" + zoneID + "/rulesets/" + rulesetID:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+
+		testAPIResult(w, entrypoint)
+	})
+
+	ctx := context.Background()
+	r := resourceCloudflareRuleset()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId("zone/" + zoneID + "/phase/http_request_firewall_custom")
+
+	imported, err := r.Importer.StateContext(ctx, d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported[0].Id() != rulesetID {
+		t.Fatalf("expected the entrypoint ruleset %q to be imported, got %q", rulesetID, imported[0].Id())
+	}
+
+	// The configuration written from the Firewall Rules and their filters.
+	diff, err := r.Diff(ctx, imported[0].State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id": zoneID,
+		"name":    "default",
+		"kind":    "zone",
+		"phase":   "http_request_firewall_custom",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"ref":         "372e67954025e0ba6aaa6d586b9e0b60",
+				"action":      "block",
+				"expression":  "(ip.src eq 192.0.2.1)",
+				"description": "block bad actor",
+				"enabled":     true,
+			},
+			map[string]interface{}{
+				"ref":         "43be31339d7c4b11a2a0f82a8fe1d0f2",
+				"action":      "managed_challenge",
+				"expression":  `(http.request.uri.path contains "/login")`,
+				"description": "challenge logins",
+				"enabled":     false,
+			},
+		},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes after importing the migrated rules, got %#v", diff.Attributes)
+	}
+}
+
+func TestBuildRulesetRulesFromResourceRateLimit(t *testing.T) {
+	d := testRulesetResourceData(t, map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+		"name":    "rate limit",
+		"kind":    "zone",
+		"phase":   "http_ratelimit",
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "log",
+			"expression": `(http.request.uri.path matches "^/graphql")`,
+			"ratelimit": []interface{}{map[string]interface{}{
+				"characteristics":            []interface{}{"cf.colo.id", "cf.bot_management.ja3_hash", `http.request.headers["x-api-key"]`},
+				"period":                     60,
+				"score_per_period":           400,
+				"score_response_header_name": "x-score",
+				"mitigation_timeout":         0,
+				"counting_expression":        `(http.request.method eq "POST")`,
+			}},
+		}},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sent []map[string]interface{}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	rateLimit := sent[0]["ratelimit"].(map[string]interface{})
+	if rateLimit["score_per_period"] != float64(400) {
+		t.Errorf("expected score_per_period to be 400, got %v", rateLimit["score_per_period"])
+	}
+	if rateLimit["score_response_header_name"] != "x-score" {
+		t.Errorf("expected score_response_header_name to be x-score, got %v", rateLimit["score_response_header_name"])
+	}
+	if timeout, ok := rateLimit["mitigation_timeout"]; !ok || timeout != float64(0) {
+		t.Errorf("expected mitigation_timeout to be sent as 0, got %v", rateLimit["mitigation_timeout"])
+	}
+	if _, ok := rateLimit["requests_per_period"]; ok {
+		t.Errorf("expected unset requests_per_period to be omitted, got %v", rateLimit["requests_per_period"])
+	}
+	if rateLimit["counting_expression"] != `(http.request.method eq "POST")` {
+		t.Errorf("expected the counting expression to be sent, got %v", rateLimit["counting_expression"])
+	}
+	if characteristics := rateLimit["characteristics"].([]interface{}); len(characteristics) != 3 {
+		t.Errorf("expected 3 characteristics, got %v", characteristics)
+	}
+
+	state := buildStateFromRulesetRules(rules).([]map[string]interface{})
+	stateRateLimit := state[0]["ratelimit"].([]map[string]interface{})[0]
+	if stateRateLimit["score_per_period"] != 400 || stateRateLimit["score_response_header_name"] != "x-score" {
+		t.Errorf("expected the score parameters to be read back, got %v", stateRateLimit)
+	}
+}
+
+func TestCloudflareRulesetValidateRateLimitCharacteristics(t *testing.T) {
+	testCases := map[string]struct {
+		characteristics []interface{}
+		err             bool
+	}{
+		"with colo":    {characteristics: []interface{}{"cf.colo.id", "ip.src"}},
+		"without colo": {characteristics: []interface{}{"ip.src"}, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := resourceCloudflareRuleset().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+				"name":    "rate limit",
+				"kind":    "zone",
+				"phase":   "http_ratelimit",
+				"rules": []interface{}{map[string]interface{}{
+					"action":     "block",
+					"expression": "true",
+					"ratelimit": []interface{}{map[string]interface{}{
+						"characteristics":     tc.characteristics,
+						"period":              60,
+						"requests_per_period": 100,
+						"mitigation_timeout":  600,
+					}},
+				}},
+			}), nil)
+
+			if tc.err != (err != nil) {
+				t.Errorf("expected error: %t, got %v", tc.err, err)
+			}
+			if err != nil && !strings.Contains(err.Error(), "cf.colo.id") {
+				t.Errorf("expected the error to name cf.colo.id, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCloudflareRulesetValidateErrorResponse(t *testing.T) {
+	testCases := map[string]struct {
+		actionParameters map[string]interface{}
+		err              bool
+	}{
+		"asset":             {actionParameters: map[string]interface{}{"asset_name": "error-page"}},
+		"content":           {actionParameters: map[string]interface{}{"content": "oops", "content_type": "text/plain"}},
+		"asset and content": {actionParameters: map[string]interface{}{"asset_name": "error-page", "content": "oops"}, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := resourceCloudflareRuleset().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+				"name":    "custom errors",
+				"kind":    "zone",
+				"phase":   "http_custom_errors",
+				"rules": []interface{}{map[string]interface{}{
+					"action":            "serve_error",
+					"expression":        "http.response.code eq 500",
+					"action_parameters": []interface{}{tc.actionParameters},
+				}},
+			}), nil)
+
+			if tc.err != (err != nil) {
+				t.Errorf("expected error: %t, got %v", tc.err, err)
+			}
+		})
+	}
+}
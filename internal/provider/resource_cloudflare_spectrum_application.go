@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -16,14 +17,23 @@ import (
 
 func resourceCloudflareSpectrumApplication() *schema.Resource {
 	return &schema.Resource{
+		SchemaVersion: 1,
 		Schema:        resourceCloudflareSpectrumApplicationSchema(),
 		CreateContext: resourceCloudflareSpectrumApplicationCreate,
 		ReadContext:   resourceCloudflareSpectrumApplicationRead,
 		UpdateContext: resourceCloudflareSpectrumApplicationUpdate,
 		DeleteContext: resourceCloudflareSpectrumApplicationDelete,
+		CustomizeDiff: resourceCloudflareSpectrumApplicationCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareSpectrumApplicationImport,
 		},
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceCloudflareSpectrumApplicationV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceCloudflareSpectrumApplicationStateUpgradeV1,
+				Version: 0,
+			},
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Spectrum Application. You can extend the power
 			of Cloudflare's DDoS, TLS, and IP Firewall to your other TCP-based
@@ -89,7 +99,10 @@ func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.Re
 			fmt.Sprintf("Error reading spectrum application resource from API for resource %s in zone %s", applicationID, zoneID)))
 	}
 
-	d.Set("protocol", application.Protocol)
+	// The API normalises the casing of the protocol, keep the configured form.
+	if !strings.EqualFold(d.Get("protocol").(string), application.Protocol) {
+		d.Set("protocol", application.Protocol)
+	}
 
 	if err := d.Set("dns", flattenDNS(application.DNS)); err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Error setting dns on spectrum application %q: %s", d.Id(), err))
@@ -108,12 +121,12 @@ func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.Re
 	}
 
 	if application.OriginPort != nil {
-		if application.OriginPort.Port > 0 {
-			d.Set("origin_port", int(application.OriginPort.Port))
-		} else {
+		if _, ok := d.GetOk("origin_port_range"); ok && application.OriginPort.End > 0 {
 			if err := d.Set("origin_port_range", flattenOriginPortRange(application.OriginPort)); err != nil {
 				tflog.Warn(ctx, fmt.Sprintf("Error setting origin port range on spectrum application %q: %s", d.Id(), err))
 			}
+		} else {
+			d.Set("origin_port", flattenOriginPort(application.OriginPort))
 		}
 	}
 
@@ -121,12 +134,6 @@ func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.Re
 		if err := d.Set("edge_ips", flattenEdgeIPs(application.EdgeIPs)); err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("Error setting Edge IPs on spectrum application %q: %s", d.Id(), err))
 		}
-
-		if application.EdgeIPs.Connectivity != nil {
-			if err := d.Set("edge_ip_connectivity", application.EdgeIPs.Connectivity.String()); err != nil {
-				tflog.Warn(ctx, fmt.Sprintf("Error setting Edge IP connectivity on spectrum application %q: %s", d.Id(), err))
-			}
-		}
 	}
 
 	d.Set("tls", application.TLS)
@@ -205,6 +212,108 @@ func expandOriginPortRange(d interface{}) *cloudflare.SpectrumApplicationOriginP
 	return port
 }
 
+// parseSpectrumPortRange parses a single port such as `22` or a port range
+// such as `1000-2000`. A single port is returned as a range of one port.
+func parseSpectrumPortRange(value string) (uint16, uint16, error) {
+	bounds := strings.SplitN(value, "-", 2)
+
+	start, err := strconv.ParseUint(bounds[0], 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", bounds[0])
+	}
+	if len(bounds) == 1 {
+		return uint16(start), uint16(start), nil
+	}
+
+	end, err := strconv.ParseUint(bounds[1], 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", bounds[1])
+	}
+	if start >= end {
+		return 0, 0, fmt.Errorf("the start of port range %q must be lower than its end", value)
+	}
+
+	return uint16(start), uint16(end), nil
+}
+
+// spectrumProtocolPorts returns the port range of a protocol such as
+// `tcp/1000-2000`.
+func spectrumProtocolPorts(protocol string) (uint16, uint16, error) {
+	parts := strings.SplitN(protocol, "/", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return 0, 0, fmt.Errorf("protocol %q must be in the format `<protocol>/<port>`, e.g. `tcp/22`", protocol)
+	}
+
+	return parseSpectrumPortRange(parts[1])
+}
+
+func validateSpectrumApplicationProtocol(v interface{}, k string) ([]string, []error) {
+	if _, _, err := spectrumProtocolPorts(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("invalid value for %s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+func validateSpectrumApplicationOriginPort(v interface{}, k string) ([]string, []error) {
+	if _, _, err := parseSpectrumPortRange(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("invalid value for %s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+func suppressSpectrumApplicationProtocolCase(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// resourceCloudflareSpectrumApplicationCustomizeDiff checks that an origin
+// port range maps onto a protocol port range of the same length and that the
+// edge IP configuration matches its type.
+func resourceCloudflareSpectrumApplicationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown("protocol") && d.NewValueKnown("origin_port") && d.NewValueKnown("origin_port_range") {
+		protocol := d.Get("protocol").(string)
+		protocolStart, protocolEnd, err := spectrumProtocolPorts(protocol)
+		if err != nil {
+			return err
+		}
+
+		var originStart, originEnd uint16
+		originPort := d.Get("origin_port").(string)
+		if originPort != "" {
+			if originStart, originEnd, err = parseSpectrumPortRange(originPort); err != nil {
+				return err
+			}
+		} else if _, ok := d.GetOk("origin_port_range"); ok {
+			originStart = uint16(d.Get("origin_port_range.0.start").(int))
+			originEnd = uint16(d.Get("origin_port_range.0.end").(int))
+			originPort = fmt.Sprintf("%d-%d", originStart, originEnd)
+		}
+
+		if originStart != originEnd && originEnd-originStart != protocolEnd-protocolStart {
+			return fmt.Errorf("origin port range %q must be the same length as the port range of protocol %q", originPort, protocol)
+		}
+	}
+
+	if d.NewValueKnown("edge_ips") {
+		edgeIPType := d.Get("edge_ips.0.type").(string)
+		ips := d.Get("edge_ips.0.ips").(*schema.Set).Len()
+
+		switch edgeIPType {
+		case string(cloudflare.SpectrumEdgeTypeStatic):
+			if ips == 0 {
+				return fmt.Errorf("edge_ips.0.ips must be set for static edge IPs")
+			}
+		case string(cloudflare.SpectrumEdgeTypeDynamic):
+			if ips > 0 {
+				return fmt.Errorf("edge_ips.0.ips can only be set for static edge IPs")
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenDNS(dns cloudflare.SpectrumApplicationDNS) []map[string]interface{} {
 	flattened := map[string]interface{}{}
 	flattened["type"] = dns.Type
@@ -228,14 +337,60 @@ func flattenOriginPortRange(port *cloudflare.SpectrumApplicationOriginPort) []ma
 	return []map[string]interface{}{flattened}
 }
 
-func flattenEdgeIPs(edgeIPs *cloudflare.SpectrumApplicationEdgeIPs) []string {
-	flattened := make([]string, 0)
+func flattenOriginPort(port *cloudflare.SpectrumApplicationOriginPort) string {
+	if port.End > 0 {
+		return fmt.Sprintf("%d-%d", port.Start, port.End)
+	}
+
+	return strconv.Itoa(int(port.Port))
+}
 
+func flattenEdgeIPs(edgeIPs *cloudflare.SpectrumApplicationEdgeIPs) []map[string]interface{} {
+	ips := make([]interface{}, 0, len(edgeIPs.IPs))
 	for _, ip := range edgeIPs.IPs {
-		flattened = append(flattened, ip.String())
+		ips = append(ips, ip.String())
+	}
+
+	flattened := map[string]interface{}{}
+	flattened["type"] = string(edgeIPs.Type)
+	flattened["ips"] = ips
+	if edgeIPs.Connectivity != nil {
+		flattened["connectivity"] = edgeIPs.Connectivity.String()
 	}
 
-	return flattened
+	return []map[string]interface{}{flattened}
+}
+
+func expandOriginPort(value string) *cloudflare.SpectrumApplicationOriginPort {
+	start, end, _ := parseSpectrumPortRange(value)
+	if start == end {
+		return &cloudflare.SpectrumApplicationOriginPort{Port: start}
+	}
+
+	return &cloudflare.SpectrumApplicationOriginPort{Start: start, End: end}
+}
+
+func expandEdgeIPs(d interface{}) *cloudflare.SpectrumApplicationEdgeIPs {
+	cfg := d.([]interface{})
+	m := cfg[0].(map[string]interface{})
+
+	edgeIPs := &cloudflare.SpectrumApplicationEdgeIPs{
+		Type: cloudflare.SpectrumApplicationEdgeType(m["type"].(string)),
+	}
+
+	// Connectivity only applies to the IPs Cloudflare assigns.
+	if connectivity, ok := m["connectivity"].(string); ok && connectivity != "" && edgeIPs.Type != cloudflare.SpectrumEdgeTypeStatic {
+		c := cloudflare.SpectrumApplicationConnectivity(connectivity)
+		edgeIPs.Connectivity = &c
+	}
+
+	if ips, ok := m["ips"].(*schema.Set); ok {
+		for _, value := range ips.List() {
+			edgeIPs.IPs = append(edgeIPs.IPs, net.ParseIP(value.(string)))
+		}
+	}
+
+	return edgeIPs
 }
 
 func applicationFromResource(d *schema.ResourceData) cloudflare.SpectrumApplication {
@@ -254,7 +409,7 @@ func applicationFromResource(d *schema.ResourceData) cloudflare.SpectrumApplicat
 	}
 
 	if originPort, ok := d.GetOk("origin_port"); ok {
-		application.OriginPort = &cloudflare.SpectrumApplicationOriginPort{Port: uint16(originPort.(int))}
+		application.OriginPort = expandOriginPort(originPort.(string))
 	} else if originPortRange, ok := d.GetOk("origin_port_range"); ok {
 		application.OriginPort = expandOriginPortRange(originPortRange)
 	}
@@ -279,23 +434,13 @@ func applicationFromResource(d *schema.ResourceData) cloudflare.SpectrumApplicat
 		application.ArgoSmartRouting = argoSmartRouting.(bool)
 	}
 
-	connectivity := cloudflare.SpectrumApplicationConnectivity(cloudflare.SpectrumConnectivityAll)
-	application.EdgeIPs = &cloudflare.SpectrumApplicationEdgeIPs{
-		Type:         cloudflare.SpectrumEdgeTypeDynamic,
-		Connectivity: &connectivity,
-	}
-
-	if edgeIPConnectivity, ok := d.GetOk("edge_ip_connectivity"); ok {
-		connectivity = cloudflare.SpectrumApplicationConnectivity(edgeIPConnectivity.(string))
-	}
-
 	if edgeIPs, ok := d.GetOk("edge_ips"); ok {
+		application.EdgeIPs = expandEdgeIPs(edgeIPs)
+	} else {
+		connectivity := cloudflare.SpectrumApplicationConnectivity(cloudflare.SpectrumConnectivityAll)
 		application.EdgeIPs = &cloudflare.SpectrumApplicationEdgeIPs{
-			Type: cloudflare.SpectrumEdgeTypeStatic,
-		}
-		// connectivity = cloudflare.SpectrumConnectivityStatic
-		for _, value := range edgeIPs.(*schema.Set).List() {
-			application.EdgeIPs.IPs = append(application.EdgeIPs.IPs, net.ParseIP(value.(string)))
+			Type:         cloudflare.SpectrumEdgeTypeDynamic,
+			Connectivity: &connectivity,
 		}
 	}
 
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareSpectrumApplicationV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"traffic_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dns": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"origin_direct": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"origin_dns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"origin_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"origin_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"end": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"tls": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_firewall": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"proxy_protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"edge_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"edge_ip_connectivity": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"argo_smart_routing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+// resourceCloudflareSpectrumApplicationStateUpgradeV1 moves the edge IPs and
+// their connectivity into the `edge_ips` block and stores the origin port as
+// a string.
+func resourceCloudflareSpectrumApplicationStateUpgradeV1(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	edgeIPs := map[string]interface{}{
+		"type":         "dynamic",
+		"connectivity": rawState["edge_ip_connectivity"],
+		"ips":          []interface{}{},
+	}
+	if ips, ok := rawState["edge_ips"].([]interface{}); ok && len(ips) > 0 {
+		edgeIPs["type"] = "static"
+		edgeIPs["connectivity"] = nil
+		edgeIPs["ips"] = ips
+	}
+	rawState["edge_ips"] = []interface{}{edgeIPs}
+	delete(rawState, "edge_ip_connectivity")
+
+	switch port := rawState["origin_port"].(type) {
+	case float64:
+		if port > 0 {
+			rawState["origin_port"] = fmt.Sprintf("%d", int(port))
+		} else {
+			delete(rawState, "origin_port")
+		}
+	case int:
+		if port > 0 {
+			rawState["origin_port"] = fmt.Sprintf("%d", port)
+		} else {
+			delete(rawState, "origin_port")
+		}
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCloudflareSpectrumApplicationStateUpgradeV1(t *testing.T) {
+	testCases := map[string]struct {
+		v0 map[string]interface{}
+		v1 map[string]interface{}
+	}{
+		"dynamic": {
+			v0: map[string]interface{}{
+				"protocol":             "tcp/22",
+				"origin_port":          float64(22),
+				"edge_ips":             []interface{}{},
+				"edge_ip_connectivity": "ipv4",
+			},
+			v1: map[string]interface{}{
+				"protocol":    "tcp/22",
+				"origin_port": "22",
+				"edge_ips": []interface{}{map[string]interface{}{
+					"type":         "dynamic",
+					"connectivity": "ipv4",
+					"ips":          []interface{}{},
+				}},
+			},
+		},
+		"static": {
+			v0: map[string]interface{}{
+				"protocol":             "tcp/22-23",
+				"origin_port":          float64(0),
+				"origin_port_range":    []interface{}{map[string]interface{}{"start": float64(2022), "end": float64(2023)}},
+				"edge_ips":             []interface{}{"172.65.64.13"},
+				"edge_ip_connectivity": "all",
+			},
+			v1: map[string]interface{}{
+				"protocol":          "tcp/22-23",
+				"origin_port_range": []interface{}{map[string]interface{}{"start": float64(2022), "end": float64(2023)}},
+				"edge_ips": []interface{}{map[string]interface{}{
+					"type":         "static",
+					"connectivity": nil,
+					"ips":          []interface{}{"172.65.64.13"},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := resourceCloudflareSpectrumApplicationStateUpgradeV1(context.TODO(), tc.v0, nil)
+			if err != nil {
+				t.Fatalf("error migrating state: %s", err)
+			}
+
+			if !reflect.DeepEqual(tc.v1, actual) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tc.v1, actual)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 	"testing"
 
 	"os"
@@ -12,6 +14,7 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -143,6 +146,39 @@ func TestAccCloudflareSpectrumApplication_OriginPortRange(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareSpectrumApplication_OriginPortRangeString(t *testing.T) {
+	var spectrumApp cloudflare.SpectrumApplication
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_spectrum_application." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareSpectrumApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareSpectrumApplicationConfigOriginPortRangeString(zoneID, domain, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
+					testAccCheckCloudflareSpectrumApplicationIDIsValid(name),
+					resource.TestCheckResourceAttr(name, "protocol", "TCP/1000-2000"),
+					resource.TestCheckResourceAttr(name, "origin_port", "3000-4000"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+				// The API returns the protocol in lower case.
+				ImportStateVerifyIgnore: []string{"protocol"},
+			},
+		},
+	})
+}
+
 func TestAccCloudflareSpectrumApplication_Update(t *testing.T) {
 	var spectrumApp cloudflare.SpectrumApplication
 	var initialID string
@@ -219,7 +255,8 @@ func TestAccCloudflareSpectrumApplication_EdgeIPConnectivity(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
 					testAccCheckCloudflareSpectrumApplicationIDIsValid(name),
-					resource.TestCheckResourceAttr(name, "edge_ip_connectivity", "ipv4"),
+					resource.TestCheckResourceAttr(name, "edge_ips.0.type", "dynamic"),
+					resource.TestCheckResourceAttr(name, "edge_ips.0.connectivity", "ipv4"),
 				),
 			},
 		},
@@ -242,8 +279,9 @@ func TestAccCloudflareSpectrumApplication_EdgeIPsWithoutConnectivity(t *testing.
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
 					testAccCheckCloudflareSpectrumApplicationIDIsValid(name),
-					resource.TestCheckResourceAttr(name, "edge_ips.#", "1"),
-					resource.TestCheckResourceAttr(name, "edge_ips.0", "172.65.64.13"),
+					resource.TestCheckResourceAttr(name, "edge_ips.0.type", "static"),
+					resource.TestCheckResourceAttr(name, "edge_ips.0.ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "edge_ips.0.ips.*", "172.65.64.13"),
 				),
 			},
 		},
@@ -266,9 +304,9 @@ func TestAccCloudflareSpectrumApplication_EdgeIPsMultiple(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
 					testAccCheckCloudflareSpectrumApplicationIDIsValid(name),
-					resource.TestCheckResourceAttr(name, "edge_ips.#", "2"),
-					resource.TestCheckTypeSetElemAttr(name, "edge_ips.*", "172.65.64.13"),
-					resource.TestCheckTypeSetElemAttr(name, "edge_ips.*", "172.65.64.49"),
+					resource.TestCheckResourceAttr(name, "edge_ips.0.ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "edge_ips.0.ips.*", "172.65.64.13"),
+					resource.TestCheckTypeSetElemAttr(name, "edge_ips.0.ips.*", "172.65.64.49"),
 				),
 			},
 			{
@@ -276,9 +314,9 @@ func TestAccCloudflareSpectrumApplication_EdgeIPsMultiple(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
 					testAccCheckCloudflareSpectrumApplicationIDIsValid(name),
-					resource.TestCheckResourceAttr(name, "edge_ips.#", "2"),
-					resource.TestCheckTypeSetElemAttr(name, "edge_ips.*", "172.65.64.13"),
-					resource.TestCheckTypeSetElemAttr(name, "edge_ips.*", "172.65.64.49"),
+					resource.TestCheckResourceAttr(name, "edge_ips.0.ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "edge_ips.0.ips.*", "172.65.64.13"),
+					resource.TestCheckTypeSetElemAttr(name, "edge_ips.0.ips.*", "172.65.64.49"),
 				),
 			},
 		},
@@ -418,6 +456,22 @@ resource "cloudflare_spectrum_application" "%[3]s" {
 }`, zoneID, zoneName, ID)
 }
 
+func testAccCheckCloudflareSpectrumApplicationConfigOriginPortRangeString(zoneID, zoneName, ID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_spectrum_application" "%[3]s" {
+  zone_id  = "%[1]s"
+  protocol = "TCP/1000-2000"
+
+  dns {
+    type = "CNAME"
+    name = "%[3]s.%[2]s"
+  }
+
+  origin_direct = ["tcp://128.66.0.1"]
+  origin_port   = "3000-4000"
+}`, zoneID, zoneName, ID)
+}
+
 func testAccCheckCloudflareSpectrumApplicationConfigBasicUpdated(zoneID, zoneName, ID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_spectrum_application" "%[3]s" {
@@ -447,7 +501,11 @@ resource "cloudflare_spectrum_application" "%[3]s" {
 
   origin_direct = ["tcp://128.66.0.3:23"]
   origin_port   = 22
-  edge_ip_connectivity = "ipv4"
+
+  edge_ips {
+    type         = "dynamic"
+    connectivity = "ipv4"
+  }
 }`, zoneID, zoneName, ID)
 }
 
@@ -464,7 +522,11 @@ resource "cloudflare_spectrum_application" "%[3]s" {
 
   origin_direct = ["tcp://128.66.0.4:23"]
   origin_port   = 22
-  edge_ips = ["172.65.64.13"]
+
+  edge_ips {
+    type = "static"
+    ips  = ["172.65.64.13"]
+  }
 }`, zoneID, zoneName, ID)
 }
 
@@ -481,6 +543,136 @@ resource "cloudflare_spectrum_application" "%[3]s" {
 
   origin_direct = ["tcp://128.66.0.4:23"]
   origin_port   = 22
-  edge_ips = [%[4]s]
+
+  edge_ips {
+    type = "static"
+    ips  = [%[4]s]
+  }
 }`, zoneID, zoneName, ID, IPs)
 }
+
+func TestCloudflareSpectrumApplicationCustomizeDiff(t *testing.T) {
+	testCases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"single origin port": {
+			config: map[string]interface{}{"protocol": "tcp/22", "origin_port": "2022"},
+		},
+		"range to a single origin port": {
+			config: map[string]interface{}{"protocol": "tcp/1000-2000", "origin_port": "22"},
+		},
+		"ranges of the same length": {
+			config: map[string]interface{}{"protocol": "tcp/1000-2000", "origin_port": "3000-4000"},
+		},
+		"ranges of different lengths": {
+			config: map[string]interface{}{"protocol": "tcp/1000-2000", "origin_port": "3000-3500"},
+			err:    "must be the same length",
+		},
+		"origin range without a protocol range": {
+			config: map[string]interface{}{"protocol": "tcp/22", "origin_port": "3000-3500"},
+			err:    "must be the same length",
+		},
+		"deprecated origin port range": {
+			config: map[string]interface{}{
+				"protocol":          "tcp/22-23",
+				"origin_port_range": []interface{}{map[string]interface{}{"start": 2022, "end": 2024}},
+			},
+			err: "must be the same length",
+		},
+		"static edge IPs": {
+			config: map[string]interface{}{
+				"protocol":    "tcp/22",
+				"origin_port": "22",
+				"edge_ips":    []interface{}{map[string]interface{}{"type": "static", "ips": []interface{}{"172.65.64.13"}}},
+			},
+		},
+		"static edge IPs without IPs": {
+			config: map[string]interface{}{
+				"protocol":    "tcp/22",
+				"origin_port": "22",
+				"edge_ips":    []interface{}{map[string]interface{}{"type": "static"}},
+			},
+			err: "edge_ips.0.ips must be set",
+		},
+		"dynamic edge IPs with IPs": {
+			config: map[string]interface{}{
+				"protocol":    "tcp/22",
+				"origin_port": "22",
+				"edge_ips":    []interface{}{map[string]interface{}{"type": "dynamic", "connectivity": "all", "ips": []interface{}{"172.65.64.13"}}},
+			},
+			err: "can only be set for static edge IPs",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"zone_id":       "0da42c8d2132a9ddaf714f9e7c920711",
+				"dns":           []interface{}{map[string]interface{}{"type": "CNAME", "name": "ssh.example.com"}},
+				"origin_direct": []interface{}{"tcp://192.0.2.1"},
+			}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := resourceCloudflareSpectrumApplication().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected an error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestCloudflareSpectrumApplicationRead(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const applicationID = "ea95132c15732412d22c1476fa83f27a"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/zones/"+zoneID+"/spectrum/apps/"+applicationID {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "protocol": "tcp/1000-2000",
+  "dns": {"type": "ADDRESS", "name": "ssh.example.com"},
+  "origin_direct": ["tcp://192.0.2.1"],
+  "origin_port": "3000-4000",
+  "edge_ips": {"type": "static", "ips": ["172.65.64.13"]},
+  "traffic_type": "direct",
+  "tls": "off",
+  "proxy_protocol": "off"
+}`, applicationID))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareSpectrumApplicationSchema(), map[string]interface{}{
+		"zone_id":  zoneID,
+		"protocol": "TCP/1000-2000",
+	})
+	d.SetId(applicationID)
+
+	if diags := resourceCloudflareSpectrumApplicationRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("protocol").(string); got != "TCP/1000-2000" {
+		t.Errorf("expected the configured protocol casing to be kept, got %q", got)
+	}
+	if got := d.Get("origin_port").(string); got != "3000-4000" {
+		t.Errorf("expected origin port %q, got %q", "3000-4000", got)
+	}
+	if got := d.Get("edge_ips.0.type").(string); got != "static" {
+		t.Errorf("expected static edge IPs, got %q", got)
+	}
+	if ips := d.Get("edge_ips.0.ips").(*schema.Set); ips.Len() != 1 || !ips.Contains("172.65.64.13") {
+		t.Errorf("expected the edge IPs to be read, got %v", ips.List())
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -33,7 +32,7 @@ func resourceCloudflareSplitTunnelRead(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
-	_, policyID := parseDevicePolicyID(d.Get("policy_id").(string))
+	policyID := devicePolicyIDFromAttribute(accountID, d.Get("policy_id").(string))
 
 	var splitTunnel []cloudflare.SplitTunnel
 	var err error
@@ -47,6 +46,8 @@ func resourceCloudflareSplitTunnelRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("error finding %q Split Tunnels: %w", mode, err))
 	}
 
+	d.SetId(splitTunnelID(accountID, policyID, mode))
+
 	if err := d.Set("tunnels", flattenSplitTunnels(splitTunnel)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting %q tunnels attribute: %w", mode, err))
 	}
@@ -58,7 +59,7 @@ func resourceCloudflareSplitTunnelUpdate(ctx context.Context, d *schema.Resource
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
-	_, policyID := parseDevicePolicyID(d.Get("policy_id").(string))
+	policyID := devicePolicyIDFromAttribute(accountID, d.Get("policy_id").(string))
 
 	tunnelList, err := expandSplitTunnels(d.Get("tunnels").(*schema.Set).List())
 	if err != nil {
@@ -67,16 +68,16 @@ func resourceCloudflareSplitTunnelUpdate(ctx context.Context, d *schema.Resource
 
 	var newSplitTunnels []cloudflare.SplitTunnel
 	if policyID == "" {
-		d.SetId(accountID)
 		newSplitTunnels, err = client.UpdateSplitTunnel(ctx, accountID, mode, tunnelList)
 	} else {
-		d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
 		newSplitTunnels, err = client.UpdateSplitTunnelDeviceSettingsPolicy(ctx, accountID, policyID, mode, tunnelList)
 	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating %q Split Tunnels: %w", mode, err))
 	}
 
+	d.SetId(splitTunnelID(accountID, policyID, mode))
+
 	if err := d.Set("tunnels", flattenSplitTunnels(newSplitTunnels)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting %q tunnels attribute: %w", mode, err))
 	}
@@ -88,7 +89,7 @@ func resourceCloudflareSplitTunnelDelete(ctx context.Context, d *schema.Resource
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	mode := d.Get("mode").(string)
-	_, policyID := parseDevicePolicyID(d.Get("policy_id").(string))
+	policyID := devicePolicyIDFromAttribute(accountID, d.Get("policy_id").(string))
 
 	var err error
 	if policyID == "" {
@@ -105,29 +106,35 @@ func resourceCloudflareSplitTunnelDelete(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareSplitTunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	attributes := strings.SplitN(d.Id(), "/", 3)
-
-	if len(attributes) != 3 {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/policyID/mode\"", d.Id())
+	attributes, err := parseDevicePolicyImportID(d.Id(), 3, "account/accountID/policyID/mode")
+	if err != nil {
+		return nil, err
 	}
 
 	accountID, policyID, mode := attributes[0], attributes[1], attributes[2]
 
 	d.Set("account_id", accountID)
 	d.Set("mode", mode)
-	if policyID == "default" {
-		d.Set("policy_id", accountID)
-		d.SetId(accountID)
-	} else {
+	if policyID != "default" {
 		d.Set("policy_id", fmt.Sprintf("%s/%s", accountID, policyID))
-		d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
 	}
+	d.SetId(splitTunnelID(accountID, devicePolicyIDFromAttribute(accountID, policyID), mode))
 
 	resourceCloudflareSplitTunnelRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// splitTunnelID builds the resource ID for the split tunnel configuration of
+// a mode in either the default (empty policyID) or a custom settings policy.
+func splitTunnelID(accountID, policyID, mode string) string {
+	if policyID == "" {
+		policyID = "default"
+	}
+
+	return fmt.Sprintf("%s/%s/%s", accountID, policyID, mode)
+}
+
 // flattenSplitTunnels accepts the cloudflare.SplitTunnel struct and returns the
 // schema representation for use in Terraform state.
 func flattenSplitTunnels(tunnels []cloudflare.SplitTunnel) *schema.Set {
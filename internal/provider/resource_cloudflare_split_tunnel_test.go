@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareSplitTunnel_Include(t *testing.T) {
@@ -50,6 +51,47 @@ func TestAccCloudflareSplitTunnel_Include(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareSplitTunnel_WithAttachedPolicy(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_split_tunnel.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareSplitTunnelInclude(rnd, accountID, "example domain", "*.example.com", "include"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "mode", "include"),
+					resource.TestCheckResourceAttr(name, "tunnels.0.description", "example domain"),
+					resource.TestCheckResourceAttr(name, "tunnels.0.host", "*.example.com"),
+					resource.TestCheckResourceAttrPair(name, "policy_id", fmt.Sprintf("cloudflare_device_settings_policy.%s", rnd), "id"),
+				),
+			},
+			{
+				ResourceName: name,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[fmt.Sprintf("cloudflare_device_settings_policy.%s", rnd)]
+					_, policyID := parseDevicePolicyID(rs.Primary.ID)
+					return fmt.Sprintf("account/%s/%s/include", accountID, policyID), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudflareSplitTunnel_ConflictingTunnelProperties(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
 	// service does not yet support the API tokens and it results in
@@ -107,6 +149,7 @@ resource "cloudflare_device_settings_policy" "%[1]s" {
 
 resource "cloudflare_split_tunnel" "%[1]s" {
   account_id = "%[2]s"
+  policy_id = cloudflare_device_settings_policy.%[1]s.id
   mode = "%[5]s"
   tunnels {
     description = "%[3]s"
@@ -47,17 +47,24 @@ func resourceCloudflareStaticRouteCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceCloudflareStaticRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	attributes := strings.SplitN(d.Id(), "/", 2)
+	// The "account/" prefix is optional.
+	attributes := strings.SplitN(strings.TrimPrefix(d.Id(), "account/"), "/", 2)
 
 	if len(attributes) != 2 {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/routeID\"", d.Id())
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/routeID\"", d.Id())
 	}
 
 	accountID, routeID := attributes[0], attributes[1]
 	d.SetId(routeID)
 	d.Set("account_id", accountID)
 
-	resourceCloudflareStaticRouteRead(ctx, d, meta)
+	if diags := resourceCloudflareStaticRouteRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("failed to read static route %q: %s", routeID, diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("static route %q not found in account %q", routeID, accountID)
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type streamLiveInput struct {
+	UID            string                   `json:"uid,omitempty"`
+	DefaultCreator string                   `json:"defaultCreator"`
+	Recording      streamLiveInputRecording `json:"recording"`
+	RTMPS          *streamLiveInputRTMPS    `json:"rtmps,omitempty"`
+	SRT            *streamLiveInputSRT      `json:"srt,omitempty"`
+	Created        string                   `json:"created,omitempty"`
+	Modified       string                   `json:"modified,omitempty"`
+}
+
+type streamLiveInputRecording struct {
+	Mode              string `json:"mode"`
+	TimeoutSeconds    int    `json:"timeoutSeconds"`
+	RequireSignedURLs bool   `json:"requireSignedURLs"`
+}
+
+type streamLiveInputRTMPS struct {
+	URL       string `json:"url"`
+	StreamKey string `json:"streamKey"`
+}
+
+type streamLiveInputSRT struct {
+	URL        string `json:"url"`
+	StreamID   string `json:"streamId"`
+	Passphrase string `json:"passphrase"`
+}
+
+func resourceCloudflareStreamLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamLiveInputSchema(),
+		CreateContext: resourceCloudflareStreamLiveInputCreate,
+		ReadContext:   resourceCloudflareStreamLiveInputRead,
+		UpdateContext: resourceCloudflareStreamLiveInputUpdate,
+		DeleteContext: resourceCloudflareStreamLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamLiveInputImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages Cloudflare Stream live inputs,
+			the RTMPS and SRT ingest points of live streams. Destroying a live
+			input keeps the videos already recorded from it.
+		`),
+	}
+}
+
+func streamLiveInputURI(accountID, liveInputID string) string {
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs", accountID)
+	if liveInputID != "" {
+		uri += "/" + liveInputID
+	}
+	return uri
+}
+
+func buildStreamLiveInput(d *schema.ResourceData) streamLiveInput {
+	input := streamLiveInput{
+		DefaultCreator: d.Get("default_creator").(string),
+		Recording: streamLiveInputRecording{
+			Mode: "off",
+		},
+	}
+
+	if _, ok := d.GetOk("recording"); ok {
+		input.Recording = streamLiveInputRecording{
+			Mode:              d.Get("recording.0.mode").(string),
+			TimeoutSeconds:    d.Get("recording.0.timeout").(int),
+			RequireSignedURLs: d.Get("recording.0.require_signed_urls").(bool),
+		}
+	}
+
+	return input
+}
+
+func writeStreamLiveInput(ctx context.Context, client *cloudflare.API, method, uri string, input streamLiveInput) (streamLiveInput, error) {
+	res, err := client.Raw(ctx, method, uri, input, nil)
+	if err != nil {
+		return streamLiveInput{}, err
+	}
+
+	var result streamLiveInput
+	if err := json.Unmarshal(res, &result); err != nil {
+		return streamLiveInput{}, fmt.Errorf("error unmarshalling live input: %w", err)
+	}
+
+	return result, nil
+}
+
+func resourceCloudflareStreamLiveInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	input, err := writeStreamLiveInput(ctx, client, http.MethodPost, streamLiveInputURI(accountID, ""), buildStreamLiveInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream live input: %w", err))
+	}
+
+	d.SetId(input.UID)
+	setStreamLiveInputIngest(d, input)
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+// setStreamLiveInputIngest sets the ingest points of the live input. The API
+// redacts the stream key and passphrase in some responses, so the values
+// already in state are kept when they're missing.
+func setStreamLiveInputIngest(d *schema.ResourceData, input streamLiveInput) {
+	if input.RTMPS != nil {
+		streamKey := input.RTMPS.StreamKey
+		if streamKey == "" {
+			streamKey = d.Get("rtmps.0.stream_key").(string)
+		}
+		d.Set("rtmps", []map[string]interface{}{{
+			"url":        input.RTMPS.URL,
+			"stream_key": streamKey,
+		}})
+	}
+
+	if input.SRT != nil {
+		passphrase := input.SRT.Passphrase
+		if passphrase == "" {
+			passphrase = d.Get("srt.0.passphrase").(string)
+		}
+		d.Set("srt", []map[string]interface{}{{
+			"url":        input.SRT.URL,
+			"stream_id":  input.SRT.StreamID,
+			"passphrase": passphrase,
+		}})
+	}
+}
+
+func resourceCloudflareStreamLiveInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, streamLiveInputURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Stream live input %q from state because it's not found in API", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Stream live input %q: %w", d.Id(), err))
+	}
+
+	var input streamLiveInput
+	if err := json.Unmarshal(res, &input); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling live input: %w", err))
+	}
+
+	d.Set("default_creator", input.DefaultCreator)
+	d.Set("recording", []map[string]interface{}{{
+		"mode":                input.Recording.Mode,
+		"timeout":             input.Recording.TimeoutSeconds,
+		"require_signed_urls": input.Recording.RequireSignedURLs,
+	}})
+	setStreamLiveInputIngest(d, input)
+	d.Set("created", input.Created)
+	d.Set("modified", input.Modified)
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	input, err := writeStreamLiveInput(ctx, client, http.MethodPut, streamLiveInputURI(accountID, d.Id()), buildStreamLiveInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Stream live input %q: %w", d.Id(), err))
+	}
+
+	setStreamLiveInputIngest(d, input)
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+// resourceCloudflareStreamLiveInputDelete only deletes the live input, the
+// videos recorded from it are kept.
+func resourceCloudflareStreamLiveInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Stream live input %q", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, streamLiveInputURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting Stream live input %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/liveInputID\"", d.Id())
+	}
+	accountID, liveInputID := attributes[0], attributes[1]
+
+	d.SetId(liveInputID)
+	d.Set("account_id", accountID)
+
+	resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
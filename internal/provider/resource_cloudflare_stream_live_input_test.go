@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareStreamLiveInput_Basic(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_stream_live_input.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "default_creator", rnd),
+					resource.TestCheckResourceAttr(name, "recording.0.mode", "off"),
+					resource.TestCheckResourceAttrSet(name, "rtmps.0.url"),
+					resource.TestCheckResourceAttrSet(name, "rtmps.0.stream_key"),
+					resource.TestCheckResourceAttrSet(name, "srt.0.url"),
+				),
+			},
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID, "automatic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "recording.0.mode", "automatic"),
+					resource.TestCheckResourceAttr(name, "recording.0.timeout", "60"),
+					resource.TestCheckResourceAttrSet(name, "rtmps.0.stream_key"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+				// The API may redact the stream key and passphrase.
+				ImportStateVerifyIgnore: []string{"rtmps.0.stream_key", "srt.0.passphrase"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamLiveInputConfig(resourceName, accountID, mode string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_stream_live_input" "%[1]s" {
+  account_id      = "%[2]s"
+  default_creator = "%[1]s"
+
+  recording {
+    mode    = "%[3]s"
+    timeout = 60
+  }
+}`, resourceName, accountID, mode)
+}
+
+func TestCloudflareStreamLiveInputKeepsRedactedKeys(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const liveInputID = "66be4bf738797e01e1fca35a7bdecdcd"
+	liveInputPath := "/accounts/" + accountID + "/stream/live_inputs"
+
+	var deleted []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		streamKey, passphrase := "", ""
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == liveInputPath:
+			streamKey, passphrase = "2fb3cb9f17e68a2568d6ebed8d5505eak3ceaf8c9b1f395e1b76b79332497cada", "2fb3cb9f17e68a2568d6ebed8d5505eak3ceaf8c9b1f395e1b76b79332497cada"
+		case r.Method == http.MethodGet && r.URL.Path == liveInputPath+"/"+liveInputID:
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			testAPIResult(w, `null`)
+			return
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, fmt.Sprintf(`{
+  "uid": %q,
+  "defaultCreator": "creator",
+  "recording": {"mode": "automatic", "timeoutSeconds": 60, "requireSignedURLs": false},
+  "rtmps": {"url": "rtmps://live.cloudflare.com:443/live/", "streamKey": %q},
+  "srt": {"url": "srt://live.cloudflare.com:778", "streamId": %q, "passphrase": %q},
+  "created": "2023-01-01T00:00:00Z",
+  "modified": "2023-01-01T00:00:00Z"
+}`, liveInputID, streamKey, liveInputID, passphrase))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareStreamLiveInputSchema(), map[string]interface{}{
+		"account_id":      accountID,
+		"default_creator": "creator",
+		"recording": []interface{}{map[string]interface{}{
+			"mode":    "automatic",
+			"timeout": 60,
+		}},
+	})
+	if diags := resourceCloudflareStreamLiveInputCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != liveInputID {
+		t.Errorf("expected ID %q, got %q", liveInputID, d.Id())
+	}
+	if got := d.Get("rtmps.0.stream_key").(string); got == "" {
+		t.Error("expected the redacted RTMPS stream key to be kept")
+	}
+	if got := d.Get("srt.0.passphrase").(string); got == "" {
+		t.Error("expected the redacted SRT passphrase to be kept")
+	}
+	if got := d.Get("srt.0.stream_id").(string); got != liveInputID {
+		t.Errorf("expected SRT stream ID %q, got %q", liveInputID, got)
+	}
+
+	if diags := resourceCloudflareStreamLiveInputDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(deleted) != 1 || deleted[0] != liveInputPath+"/"+liveInputID {
+		t.Errorf("expected only the live input to be deleted, got %v", deleted)
+	}
+}
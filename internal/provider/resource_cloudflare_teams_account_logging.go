@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareTeamsAccountLogging() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTeamsAccountLoggingSchema(),
+		CreateContext: resourceCloudflareTeamsAccountLoggingUpdate,
+		ReadContext:   resourceCloudflareTeamsAccountLoggingRead,
+		UpdateContext: resourceCloudflareTeamsAccountLoggingUpdate,
+		// The logging settings are part of the account and can't be deleted.
+		DeleteContext: func(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics { return nil },
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTeamsAccountLoggingImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare resource to manage the Gateway logging
+			settings of an account. Rule types which aren't configured keep
+			their current settings.
+		`),
+	}
+}
+
+func resourceCloudflareTeamsAccountLoggingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	logSettings, err := client.TeamsAccountLoggingConfiguration(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Teams Account log settings %q: %w", d.Id(), err))
+	}
+
+	flattened := flattenTeamsLoggingSettings(&logSettings)[0].(map[string]interface{})
+	if err := d.Set("settings_by_rule_type", flattened["settings_by_rule_type"]); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing teams account log settings: %w", err))
+	}
+	d.Set("redact_pii", logSettings.RedactPii)
+
+	return nil
+}
+
+func resourceCloudflareTeamsAccountLoggingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	current, err := client.TeamsAccountLoggingConfiguration(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Teams Account log settings for account %q: %w", accountID, err))
+	}
+
+	logSettings := expandTeamsLoggingSettings(d, "", current)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams Account log settings from struct: %+v", logSettings))
+
+	if err := updateTeamsLoggingSettings(ctx, client, accountID, logSettings); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Teams Account logging settings for account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+	return resourceCloudflareTeamsAccountLoggingRead(ctx, d, meta)
+}
+
+func resourceCloudflareTeamsAccountLoggingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("account_id", d.Id())
+
+	resourceCloudflareTeamsAccountLoggingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
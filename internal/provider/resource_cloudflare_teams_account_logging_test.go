@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareTeamsAccountLogging(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_account_logging.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsAccountLogging(rnd, accountID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "redact_pii", "true"),
+					resource.TestCheckResourceAttr(name, "settings_by_rule_type.0.dns.0.log_all", "false"),
+					resource.TestCheckResourceAttr(name, "settings_by_rule_type.0.dns.0.log_blocks", "true"),
+					resource.TestCheckResourceAttrSet(name, "settings_by_rule_type.0.http.0.log_all"),
+					resource.TestCheckResourceAttrSet(name, "settings_by_rule_type.0.l4.0.log_all"),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsAccountLogging(rnd, accountID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "redact_pii", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     accountID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsAccountLogging(rnd, accountID string, redactPii bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_account_logging" "%[1]s" {
+  account_id = "%[2]s"
+  redact_pii = %[3]t
+  settings_by_rule_type {
+    dns {
+      log_all    = false
+      log_blocks = true
+    }
+  }
+}
+`, rnd, accountID, redactPii)
+}
+
+func TestCloudflareTeamsAccountLoggingKeepsUnconfiguredRuleTypes(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	current := `{"redact_pii": true, "settings_by_rule_type": {"dns": {"log_all": true, "log_blocks": true}, "http": {"log_all": true, "log_blocks": false}, "l4": {"log_all": false, "log_blocks": true}}}`
+	var updates []map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/"+accountID+"/gateway/logging" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			var update map[string]interface{}
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Fatal(err)
+			}
+			updates = append(updates, update)
+			current = string(body)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		testAPIResult(w, current)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsAccountLoggingSchema(), map[string]interface{}{
+		"account_id": accountID,
+		"settings_by_rule_type": []interface{}{map[string]interface{}{
+			"dns": []interface{}{map[string]interface{}{"log_all": false, "log_blocks": true}},
+		}},
+	})
+
+	if diags := resourceCloudflareTeamsAccountLoggingUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("expected a single update, got %d", len(updates))
+	}
+
+	expected := map[string]interface{}{
+		"redact_pii": true,
+		"settings_by_rule_type": map[string]interface{}{
+			"dns":  map[string]interface{}{"log_all": false, "log_blocks": true},
+			"http": map[string]interface{}{"log_all": true, "log_blocks": false},
+			"l4":   map[string]interface{}{"log_all": false, "log_blocks": true},
+		},
+	}
+	if got, want := fmt.Sprint(updates[0]), fmt.Sprint(expected); got != want {
+		t.Errorf("expected update %s, got %s", want, got)
+	}
+
+	if d.Id() != accountID {
+		t.Errorf("expected ID %q, got %q", accountID, d.Id())
+	}
+	if got := d.Get("settings_by_rule_type.0.http.0.log_all").(bool); !got {
+		t.Error("expected the HTTP settings to be read back")
+	}
+
+	// Turning off redaction must be sent to the API.
+	d.Set("redact_pii", false)
+	if diags := resourceCloudflareTeamsAccountLoggingUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if redactPii, ok := updates[1]["redact_pii"]; !ok || redactPii != false {
+		t.Errorf("expected redact_pii to be turned off, got %v", updates[1])
+	}
+}
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
@@ -12,6 +15,46 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// teamsAccountConfig extends the Teams account configuration with the
+// settings that aren't available in cloudflare-go yet.
+type teamsAccountConfig struct {
+	cloudflare.TeamsConfiguration
+	Settings teamsAccountSettingsConfig `json:"settings"`
+}
+
+type teamsAccountSettingsConfig struct {
+	cloudflare.TeamsAccountSettings
+	BrowserIsolation      *teamsBrowserIsolationConfig      `json:"browser_isolation,omitempty"`
+	ProtocolDetection     *teamsProtocolDetectionConfig     `json:"protocol_detection,omitempty"`
+	BodyScanning          *teamsBodyScanningConfig          `json:"body_scanning,omitempty"`
+	ExtendedEmailMatching *teamsExtendedEmailMatchingConfig `json:"extended_email_matching,omitempty"`
+	CustomCertificate     *teamsCustomCertificateConfig     `json:"custom_certificate,omitempty"`
+}
+
+type teamsBrowserIsolationConfig struct {
+	UrlBrowserIsolationEnabled bool `json:"url_browser_isolation_enabled"`
+	NonIdentityEnabled         bool `json:"non_identity_enabled"`
+}
+
+type teamsProtocolDetectionConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+type teamsBodyScanningConfig struct {
+	InspectionMode string `json:"inspection_mode"`
+}
+
+type teamsExtendedEmailMatchingConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+type teamsCustomCertificateConfig struct {
+	Enabled       bool       `json:"enabled"`
+	ID            string     `json:"id,omitempty"`
+	BindingStatus string     `json:"binding_status,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
+
 func resourceCloudflareTeamsAccount() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareTeamsAccountSchema(),
@@ -34,7 +77,7 @@ func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceD
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	configuration, err := client.TeamsAccountConfiguration(ctx, accountID)
+	configuration, err := getTeamsAccountConfiguration(ctx, client, accountID)
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 400") {
 			tflog.Info(ctx, fmt.Sprintf("Teams Account config %s does not exists", d.Id()))
@@ -62,8 +105,10 @@ func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if err := d.Set("activity_log_enabled", configuration.Settings.ActivityLog.Enabled); err != nil {
-		return diag.FromErr(fmt.Errorf("error parsing account activity log enablement: %w", err))
+	if configuration.Settings.ActivityLog != nil {
+		if err := d.Set("activity_log_enabled", configuration.Settings.ActivityLog.Enabled); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account activity log enablement: %w", err))
+		}
 	}
 
 	if configuration.Settings.FIPS != nil {
@@ -76,6 +121,33 @@ func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceD
 		if err := d.Set("url_browser_isolation_enabled", configuration.Settings.BrowserIsolation.UrlBrowserIsolationEnabled); err != nil {
 			return diag.FromErr(fmt.Errorf("error parsing account url browser isolation enablement: %w", err))
 		}
+		if err := d.Set("non_identity_browser_isolation_enabled", configuration.Settings.BrowserIsolation.NonIdentityEnabled); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account non-identity browser isolation enablement: %w", err))
+		}
+	}
+
+	if configuration.Settings.ProtocolDetection != nil {
+		if err := d.Set("protocol_detection_enabled", configuration.Settings.ProtocolDetection.Enabled); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account protocol detection enablement: %w", err))
+		}
+	}
+
+	if configuration.Settings.BodyScanning != nil {
+		if err := d.Set("body_scanning", flattenBodyScanningConfig(configuration.Settings.BodyScanning)); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account body scanning config: %w", err))
+		}
+	}
+
+	if configuration.Settings.ExtendedEmailMatching != nil {
+		if err := d.Set("extended_email_matching", flattenExtendedEmailMatchingConfig(configuration.Settings.ExtendedEmailMatching)); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account extended email matching config: %w", err))
+		}
+	}
+
+	if configuration.Settings.CustomCertificate != nil {
+		if err := d.Set("custom_certificate", flattenCustomCertificateConfig(configuration.Settings.CustomCertificate)); err != nil {
+			return diag.FromErr(fmt.Errorf("error parsing account custom certificate config: %w", err))
+		}
 	}
 
 	logSettings, err := client.TeamsAccountLoggingConfiguration(ctx, accountID)
@@ -107,13 +179,17 @@ func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.Resourc
 	blockPageConfig := inflateBlockPageConfig(d.Get("block_page"))
 	fipsConfig := inflateFIPSConfig(d.Get("fips"))
 	antivirusConfig := inflateAntivirusConfig(d.Get("antivirus"))
-	loggingConfig := inflateLoggingSettings(d.Get("logging"))
 	deviceConfig := inflateDeviceSettings(d.Get("proxy"))
-	updatedTeamsAccount := cloudflare.TeamsConfiguration{
-		Settings: cloudflare.TeamsAccountSettings{
-			Antivirus: antivirusConfig,
-			BlockPage: blockPageConfig,
-			FIPS:      fipsConfig,
+	updatedTeamsAccount := teamsAccountConfig{
+		Settings: teamsAccountSettingsConfig{
+			TeamsAccountSettings: cloudflare.TeamsAccountSettings{
+				Antivirus: antivirusConfig,
+				BlockPage: blockPageConfig,
+				FIPS:      fipsConfig,
+			},
+			BodyScanning:          inflateBodyScanningConfig(d.Get("body_scanning")),
+			ExtendedEmailMatching: inflateExtendedEmailMatchingConfig(d.Get("extended_email_matching")),
+			CustomCertificate:     inflateCustomCertificateConfig(d.Get("custom_certificate")),
 		},
 	}
 
@@ -131,18 +207,35 @@ func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.Resourc
 
 	//nolint:staticcheck
 	browserIsolation, ok := d.GetOkExists("url_browser_isolation_enabled")
+	//nolint:staticcheck
+	nonIdentityBrowserIsolation, nonIdentityOk := d.GetOkExists("non_identity_browser_isolation_enabled")
+	if ok || nonIdentityOk {
+		updatedTeamsAccount.Settings.BrowserIsolation = &teamsBrowserIsolationConfig{
+			UrlBrowserIsolationEnabled: browserIsolation.(bool),
+			NonIdentityEnabled:         nonIdentityBrowserIsolation.(bool),
+		}
+	}
+
+	//nolint:staticcheck
+	protocolDetection, ok := d.GetOkExists("protocol_detection_enabled")
 	if ok {
-		updatedTeamsAccount.Settings.BrowserIsolation = &cloudflare.BrowserIsolation{UrlBrowserIsolationEnabled: browserIsolation.(bool)}
+		updatedTeamsAccount.Settings.ProtocolDetection = &teamsProtocolDetectionConfig{Enabled: protocolDetection.(bool)}
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams Account configuration from struct: %+v", updatedTeamsAccount))
 
-	if _, err := client.TeamsAccountUpdateConfiguration(ctx, accountID, updatedTeamsAccount); err != nil {
+	if err := updateTeamsAccountConfiguration(ctx, client, accountID, updatedTeamsAccount); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Teams Account configuration for account %q: %w", accountID, err))
 	}
 
-	if loggingConfig != nil {
-		if _, err := client.TeamsAccountUpdateLoggingConfiguration(ctx, accountID, *loggingConfig); err != nil {
+	if _, ok := d.GetOk("logging"); ok {
+		currentLogSettings, err := client.TeamsAccountLoggingConfiguration(ctx, accountID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error finding Teams Account log settings for account %q: %w", accountID, err))
+		}
+
+		loggingConfig := expandTeamsLoggingSettings(d, "logging.0.", currentLogSettings)
+		if err := updateTeamsLoggingSettings(ctx, client, accountID, loggingConfig); err != nil {
 			return diag.FromErr(fmt.Errorf("error updating Teams Account logging settings for account %q: %w", accountID, err))
 		}
 	}
@@ -168,7 +261,7 @@ func resourceCloudflareTeamsAccountImport(ctx context.Context, d *schema.Resourc
 
 func flattenBlockPageConfig(blockPage *cloudflare.TeamsBlockPage) []interface{} {
 	return []interface{}{map[string]interface{}{
-		"enabled":          *blockPage.Enabled,
+		"enabled":          cloudflare.Bool(blockPage.Enabled),
 		"footer_text":      blockPage.FooterText,
 		"header_text":      blockPage.HeaderText,
 		"logo_path":        blockPage.LogoPath,
@@ -265,78 +358,159 @@ func inflateFIPSConfig(fipsList interface{}) *cloudflare.TeamsFIPS {
 	}
 }
 
-func inflateLoggingSettings(log interface{}) *cloudflare.TeamsLoggingSettings {
-	logList := log.([]interface{})
+// teamsLoggingSettings is the Gateway logging configuration of an account.
+// cloudflare-go omits `redact_pii` when it's false, which makes it
+// impossible to turn off.
+type teamsLoggingSettings struct {
+	cloudflare.TeamsLoggingSettings
+	RedactPii bool `json:"redact_pii"`
+}
 
-	if len(logList) != 1 {
-		return nil
+// teamsLoggingRuleTypes maps the rule type blocks of the logging settings to
+// the rule types of the API.
+var teamsLoggingRuleTypes = map[string]cloudflare.TeamsRuleType{
+	"dns":  cloudflare.TeamsDnsRuleType,
+	"http": cloudflare.TeamsHttpRuleType,
+	"l4":   cloudflare.TeamsL4RuleType,
+}
+
+// expandTeamsLoggingSettings returns the logging settings under prefix
+// applied on top of the current ones. The API replaces the whole object, so
+// rule types and redaction which aren't configured keep their current value.
+func expandTeamsLoggingSettings(d *schema.ResourceData, prefix string, current cloudflare.TeamsLoggingSettings) cloudflare.TeamsLoggingSettings {
+	settings := cloudflare.TeamsLoggingSettings{
+		LoggingSettingsByRuleType: map[cloudflare.TeamsRuleType]cloudflare.TeamsAccountLoggingConfiguration{},
+		RedactPii:                 current.RedactPii,
+	}
+	for ruleType, config := range current.LoggingSettingsByRuleType {
+		settings.LoggingSettingsByRuleType[ruleType] = config
 	}
 
-	logSettings, ok := logList[0].(map[string]interface{})
-	if !ok {
-		return nil
+	for key, ruleType := range teamsLoggingRuleTypes {
+		path := fmt.Sprintf("%ssettings_by_rule_type.0.%s", prefix, key)
+		if _, ok := d.GetOk(path); !ok {
+			continue
+		}
+		settings.LoggingSettingsByRuleType[ruleType] = cloudflare.TeamsAccountLoggingConfiguration{
+			LogAll:    d.Get(path + ".0.log_all").(bool),
+			LogBlocks: d.Get(path + ".0.log_blocks").(bool),
+		}
 	}
-	logRuleSettingsList, ok := logSettings["settings_by_rule_type"].([]interface{})
-	if !ok {
-		return nil
+
+	//nolint:staticcheck
+	if redactPii, ok := d.GetOkExists(prefix + "redact_pii"); ok {
+		settings.RedactPii = redactPii.(bool)
 	}
 
-	logRuleSettings, ok := logRuleSettingsList[0].(map[string]interface{})
-	if !ok {
+	return settings
+}
+
+func updateTeamsLoggingSettings(ctx context.Context, client *cloudflare.API, accountID string, settings cloudflare.TeamsLoggingSettings) error {
+	uri := fmt.Sprintf("/accounts/%s/gateway/logging", accountID)
+	_, err := client.Raw(ctx, http.MethodPut, uri, teamsLoggingSettings{
+		TeamsLoggingSettings: settings,
+		RedactPii:            settings.RedactPii,
+	}, nil)
+	return err
+}
+
+func inflateDeviceSettings(device interface{}) *cloudflare.TeamsDeviceSettings {
+	deviceList := device.([]interface{})
+
+	if len(deviceList) != 1 {
 		return nil
 	}
 
-	dnsRuleSettingsList, ok := logRuleSettings["dns"].([]interface{})
+	deviceSettings, ok := deviceList[0].(map[string]interface{})
 	if !ok {
 		return nil
 	}
-	dnsRuleSettings := dnsRuleSettingsList[0].(map[string]interface{})
 
-	httpRuleSettingsList, ok := logRuleSettings["http"].([]interface{})
-	if !ok {
-		return nil
+	return &cloudflare.TeamsDeviceSettings{
+		GatewayProxyEnabled:    deviceSettings["tcp"].(bool),
+		GatewayProxyUDPEnabled: deviceSettings["udp"].(bool),
 	}
-	httpRuleSettings := httpRuleSettingsList[0].(map[string]interface{})
+}
 
-	l4RuleSettingsList, ok := logRuleSettings["l4"].([]interface{})
-	if !ok {
+func flattenBodyScanningConfig(bodyScanning *teamsBodyScanningConfig) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"inspection_mode": bodyScanning.InspectionMode,
+	}}
+}
+
+func inflateBodyScanningConfig(bodyScanning interface{}) *teamsBodyScanningConfig {
+	list := bodyScanning.([]interface{})
+	if len(list) != 1 {
 		return nil
 	}
-	l4RuleSettings := l4RuleSettingsList[0].(map[string]interface{})
 
-	return &cloudflare.TeamsLoggingSettings{
-		LoggingSettingsByRuleType: map[cloudflare.TeamsRuleType]cloudflare.TeamsAccountLoggingConfiguration{
-			cloudflare.TeamsDnsRuleType: {
-				LogAll:    dnsRuleSettings["log_all"].(bool),
-				LogBlocks: dnsRuleSettings["log_blocks"].(bool),
-			},
-			cloudflare.TeamsHttpRuleType: {
-				LogAll:    httpRuleSettings["log_all"].(bool),
-				LogBlocks: httpRuleSettings["log_blocks"].(bool),
-			},
-			cloudflare.TeamsL4RuleType: {
-				LogAll:    l4RuleSettings["log_all"].(bool),
-				LogBlocks: l4RuleSettings["log_blocks"].(bool),
-			},
-		},
-		RedactPii: logSettings["redact_pii"].(bool),
+	m := list[0].(map[string]interface{})
+	return &teamsBodyScanningConfig{
+		InspectionMode: m["inspection_mode"].(string),
 	}
 }
 
-func inflateDeviceSettings(device interface{}) *cloudflare.TeamsDeviceSettings {
-	deviceList := device.([]interface{})
+func flattenExtendedEmailMatchingConfig(extendedEmailMatching *teamsExtendedEmailMatchingConfig) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"enabled": extendedEmailMatching.Enabled,
+	}}
+}
 
-	if len(deviceList) != 1 {
+func inflateExtendedEmailMatchingConfig(extendedEmailMatching interface{}) *teamsExtendedEmailMatchingConfig {
+	list := extendedEmailMatching.([]interface{})
+	if len(list) != 1 {
 		return nil
 	}
 
-	deviceSettings, ok := deviceList[0].(map[string]interface{})
-	if !ok {
+	m := list[0].(map[string]interface{})
+	return &teamsExtendedEmailMatchingConfig{
+		Enabled: m["enabled"].(bool),
+	}
+}
+
+func flattenCustomCertificateConfig(customCertificate *teamsCustomCertificateConfig) []interface{} {
+	updatedAt := ""
+	if customCertificate.UpdatedAt != nil {
+		updatedAt = customCertificate.UpdatedAt.Format(time.RFC3339Nano)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"enabled":        customCertificate.Enabled,
+		"id":             customCertificate.ID,
+		"binding_status": customCertificate.BindingStatus,
+		"updated_at":     updatedAt,
+	}}
+}
+
+func inflateCustomCertificateConfig(customCertificate interface{}) *teamsCustomCertificateConfig {
+	list := customCertificate.([]interface{})
+	if len(list) != 1 {
 		return nil
 	}
 
-	return &cloudflare.TeamsDeviceSettings{
-		GatewayProxyEnabled:    deviceSettings["tcp"].(bool),
-		GatewayProxyUDPEnabled: deviceSettings["udp"].(bool),
+	m := list[0].(map[string]interface{})
+	return &teamsCustomCertificateConfig{
+		Enabled: m["enabled"].(bool),
+		ID:      m["id"].(string),
 	}
 }
+
+func getTeamsAccountConfiguration(ctx context.Context, client *cloudflare.API, accountID string) (teamsAccountConfig, error) {
+	var configuration teamsAccountConfig
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/configuration", accountID), nil, nil)
+	if err != nil {
+		return configuration, err
+	}
+
+	if err := json.Unmarshal(res, &configuration); err != nil {
+		return configuration, fmt.Errorf("error unmarshalling Teams Account configuration: %w", err)
+	}
+
+	return configuration, nil
+}
+
+func updateTeamsAccountConfiguration(ctx context.Context, client *cloudflare.API, accountID string, configuration teamsAccountConfig) error {
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/gateway/configuration", accountID), configuration, nil)
+	return err
+}
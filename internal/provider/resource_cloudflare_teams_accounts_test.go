@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareTeamsAccountConfigurationBasic(t *testing.T) {
@@ -47,6 +50,9 @@ func TestAccCloudflareTeamsAccountConfigurationBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "logging.0.settings_by_rule_type.0.l4.0.log_blocks", "true"),
 					resource.TestCheckResourceAttr(name, "proxy.0.tcp", "true"),
 					resource.TestCheckResourceAttr(name, "proxy.0.udp", "false"),
+					resource.TestCheckResourceAttr(name, "protocol_detection_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "body_scanning.0.inspection_mode", "deep"),
+					resource.TestCheckResourceAttr(name, "extended_email_matching.0.enabled", "true"),
 				),
 			},
 		},
@@ -59,6 +65,13 @@ resource "cloudflare_teams_account" "%[1]s" {
   account_id = "%[2]s"
   tls_decrypt_enabled = true
   activity_log_enabled = true
+  protocol_detection_enabled = true
+  body_scanning {
+    inspection_mode = "deep"
+  }
+  extended_email_matching {
+    enabled = true
+  }
   block_page {
     name = "%[1]s"
     enabled = true
@@ -99,3 +112,109 @@ resource "cloudflare_teams_account" "%[1]s" {
 }
 `, rnd, accountID)
 }
+
+func TestCloudflareTeamsAccountReadSettings(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+
+	testCases := map[string]struct {
+		configuration string
+		expected      map[string]string
+		absent        []string
+	}{
+		"all settings": {
+			configuration: `{
+  "settings": {
+    "activity_log": {"enabled": true},
+    "antivirus": {"enabled_download_phase": true, "enabled_upload_phase": false, "fail_closed": true},
+    "block_page": {"enabled": true, "name": "example", "footer_text": "footer"},
+    "body_scanning": {"inspection_mode": "deep"},
+    "browser_isolation": {"url_browser_isolation_enabled": true, "non_identity_enabled": true},
+    "custom_certificate": {"enabled": true, "id": "d1b364c5-1311-466e-a194-f0e943e0799f", "binding_status": "active", "updated_at": "2023-01-05T20:15:30Z"},
+    "extended_email_matching": {"enabled": true},
+    "fips": {"tls": true},
+    "protocol_detection": {"enabled": true},
+    "tls_decrypt": {"enabled": true}
+  },
+  "created_at": "2022-12-01T10:00:00Z",
+  "updated_at": "2023-01-05T20:15:30Z"
+}`,
+			expected: map[string]string{
+				"activity_log_enabled":                   "true",
+				"tls_decrypt_enabled":                    "true",
+				"url_browser_isolation_enabled":          "true",
+				"non_identity_browser_isolation_enabled": "true",
+				"protocol_detection_enabled":             "true",
+				"block_page.0.name":                      "example",
+				"body_scanning.0.inspection_mode":        "deep",
+				"extended_email_matching.0.enabled":      "true",
+				"custom_certificate.0.enabled":           "true",
+				"custom_certificate.0.id":                "d1b364c5-1311-466e-a194-f0e943e0799f",
+				"custom_certificate.0.binding_status":    "active",
+				"custom_certificate.0.updated_at":        "2023-01-05T20:15:30Z",
+			},
+		},
+		"unentitled account": {
+			configuration: `{
+  "settings": {
+    "tls_decrypt": {"enabled": false}
+  },
+  "created_at": "2022-12-01T10:00:00Z",
+  "updated_at": "2022-12-01T10:00:00Z"
+}`,
+			expected: map[string]string{
+				"tls_decrypt_enabled": "false",
+				"proxy.0.tcp":         "true",
+			},
+			absent: []string{
+				"activity_log_enabled",
+				"block_page.#",
+				"non_identity_browser_isolation_enabled",
+				"body_scanning.#",
+				"extended_email_matching.#",
+				"custom_certificate.#",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			responses := map[string]string{
+				"/accounts/" + accountID + "/gateway/configuration": tc.configuration,
+				"/accounts/" + accountID + "/gateway/logging":       `{"redact_pii": false, "settings_by_rule_type": {}}`,
+				"/accounts/" + accountID + "/devices/settings":      `{"gateway_proxy_enabled": true, "gateway_udp_proxy_enabled": false}`,
+			}
+
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				result, ok := responses[r.URL.Path]
+				if !ok {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				testAPIResult(w, result)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsAccountSchema(), map[string]interface{}{
+				"account_id": accountID,
+			})
+			d.SetId(accountID)
+
+			if diags := resourceCloudflareTeamsAccountRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			state := d.State()
+			for key, value := range tc.expected {
+				if got := state.Attributes[key]; got != value {
+					t.Errorf("expected %s to be %q, got %q", key, value, got)
+				}
+			}
+			for _, key := range tc.absent {
+				if got, ok := state.Attributes[key]; ok {
+					t.Errorf("expected %s to be unset, got %q", key, got)
+				}
+			}
+		})
+	}
+}
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -34,29 +37,43 @@ func resourceCloudflareTeamsList() *schema.Resource {
 func resourceCloudflareTeamsListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
-	newTeamsList := cloudflare.CreateTeamsListParams{
-		Name:        d.Get("name").(string),
-		Type:        d.Get("type").(string),
-		Description: d.Get("description").(string),
+	items := expandTeamsListItems(d.Get("items"), d.Get("items_with_description"))
+	newTeamsList := teamsListCreate{
+		CreateTeamsListParams: cloudflare.CreateTeamsListParams{
+			Name:        d.Get("name").(string),
+			Type:        d.Get("type").(string),
+			Description: d.Get("description").(string),
+		},
 	}
 
-	itemValues := d.Get("items").(*schema.Set).List()
-	for _, v := range itemValues {
-		newTeamsList.Items = append(newTeamsList.Items, cloudflare.TeamsListItem{Value: v.(string)})
+	// Lists are created with the first chunk of items and the rest are
+	// appended to them afterwards.
+	if len(items) > teamsListItemsChunkSize {
+		newTeamsList.Items, items = items[:teamsListItemsChunkSize], items[teamsListItemsChunkSize:]
+	} else {
+		newTeamsList.Items, items = items, nil
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams List from struct: %+v", newTeamsList))
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams List %q with %d items", newTeamsList.Name, len(newTeamsList.Items)))
 
 	accountID := d.Get("account_id").(string)
 
-	identifier := cloudflare.AccountIdentifier(accountID)
-	list, err := client.CreateTeamsList(ctx, identifier, newTeamsList)
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/gateway/lists", accountID), newTeamsList, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Teams List for account %q: %w", accountID, err))
 	}
 
+	var list cloudflare.TeamsList
+	if err := json.Unmarshal(res, &list); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Teams List: %w", err))
+	}
+
 	d.SetId(list.ID)
 
+	if err := patchTeamsListItems(ctx, client, accountID, list.ID, nil, items); err != nil {
+		return diag.FromErr(fmt.Errorf("error adding items to Teams List %q: %w", list.ID, err))
+	}
+
 	return resourceCloudflareTeamsListRead(ctx, d, meta)
 }
 
@@ -81,55 +98,71 @@ func resourceCloudflareTeamsListRead(ctx context.Context, d *schema.ResourceData
 	d.Set("type", list.Type)
 	d.Set("description", list.Description)
 
-	listItems, _, err := client.ListTeamsListItems(ctx, identifier, cloudflare.ListTeamsListItemsParams{
-		ListID: d.Id(),
-	})
+	listItems, err := listTeamsListItems(ctx, client, accountID, d.Id())
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Teams List %q: %w", d.Id(), err))
 	}
 
-	d.Set("items", convertListItemsToSchema(listItems))
+	// Items are kept in the attribute they are configured with, imported
+	// lists use `items_with_description` only when their items have
+	// descriptions.
+	_, withDescription := d.GetOk("items_with_description")
+	if _, ok := d.GetOk("items"); !ok && !withDescription {
+		for _, item := range listItems {
+			if item.Description != "" {
+				withDescription = true
+				break
+			}
+		}
+	}
+
+	if withDescription {
+		d.Set("items", nil)
+		d.Set("items_with_description", convertListItemsWithDescriptionToSchema(listItems))
+	} else {
+		d.Set("items", convertListItemsToSchema(listItems))
+		d.Set("items_with_description", nil)
+	}
 
 	return nil
 }
 
 func resourceCloudflareTeamsListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
-
-	updatedTeamsList := cloudflare.UpdateTeamsListParams{
-		ID:          d.Id(),
-		Name:        d.Get("name").(string),
-		Type:        d.Get("type").(string),
-		Description: d.Get("description").(string),
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams List from struct: %+v", updatedTeamsList))
-
 	accountID := d.Get("account_id").(string)
-
 	identifier := cloudflare.AccountIdentifier(accountID)
-	teamsList, err := client.UpdateTeamsList(ctx, identifier, updatedTeamsList)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Teams List for account %q: %w", accountID, err))
-	}
-	if teamsList.ID == "" {
-		return diag.FromErr(fmt.Errorf("failed to find Teams List ID in update response; resource was empty"))
-	}
 
-	if d.HasChange("items") {
-		oldItemsIface, newItemsIface := d.GetChange("items")
-		oldItems := oldItemsIface.(*schema.Set).List()
-		newItems := newItemsIface.(*schema.Set).List()
-		patchTeamsList := cloudflare.PatchTeamsListParams{ID: d.Id()}
-		setListItemDiff(&patchTeamsList, oldItems, newItems)
+	if d.HasChanges("name", "type", "description") {
+		updatedTeamsList := cloudflare.UpdateTeamsListParams{
+			ID:          d.Id(),
+			Name:        d.Get("name").(string),
+			Type:        d.Get("type").(string),
+			Description: d.Get("description").(string),
+		}
 
-		l, err := client.PatchTeamsList(ctx, identifier, patchTeamsList)
+		tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams List from struct: %+v", updatedTeamsList))
 
+		teamsList, err := client.UpdateTeamsList(ctx, identifier, updatedTeamsList)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error updating Teams List for account %q: %w", accountID, err))
 		}
+		if teamsList.ID == "" {
+			return diag.FromErr(fmt.Errorf("failed to find Teams List ID in update response; resource was empty"))
+		}
+	}
+
+	if d.HasChanges("items", "items_with_description") {
+		oldItems, newItems := d.GetChange("items")
+		oldItemsWithDescription, newItemsWithDescription := d.GetChange("items_with_description")
 
-		teamsList.Items = l.Items
+		remove, add := diffTeamsListItems(
+			expandTeamsListItems(oldItems, oldItemsWithDescription),
+			expandTeamsListItems(newItems, newItemsWithDescription),
+		)
+
+		if err := patchTeamsListItems(ctx, client, accountID, d.Id(), remove, add); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Teams List for account %q: %w", accountID, err))
+		}
 	}
 
 	return resourceCloudflareTeamsListRead(ctx, d, meta)
@@ -172,32 +205,185 @@ func resourceCloudflareTeamsListImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
-func setListItemDiff(patchList *cloudflare.PatchTeamsListParams, oldItems, newItems []interface{}) {
-	counts := make(map[string]int)
-	for _, val := range newItems {
-		counts[val.(string)] += 1
+func convertListItemsToSchema(listItems []teamsListItem) []string {
+	itemValues := []string{}
+	// The API returns items in reverse order so we iterate backwards for correct ordering.
+	for i := len(listItems) - 1; i >= 0; i-- {
+		item := listItems[i]
+		itemValues = append(itemValues, item.Value)
+	}
+
+	return itemValues
+}
+
+func convertListItemsWithDescriptionToSchema(listItems []teamsListItem) []map[string]interface{} {
+	items := []map[string]interface{}{}
+	for i := len(listItems) - 1; i >= 0; i-- {
+		items = append(items, map[string]interface{}{
+			"value":       listItems[i].Value,
+			"description": listItems[i].Description,
+		})
+	}
+
+	return items
+}
+
+// teamsListItemsChunkSize is the maximum number of items sent in a single
+// request, larger lists are created and updated in several requests.
+const teamsListItemsChunkSize = 1000
+
+// teamsListItem extends cloudflare.TeamsListItem with the description of the
+// item.
+type teamsListItem struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+type teamsListCreate struct {
+	cloudflare.CreateTeamsListParams
+	Items []teamsListItem `json:"items,omitempty"`
+}
+
+type teamsListPatch struct {
+	Append []teamsListItem `json:"append"`
+	Remove []string        `json:"remove"`
+}
+
+// expandTeamsListItems returns the items of either the `items` or the
+// `items_with_description` attribute.
+func expandTeamsListItems(items, itemsWithDescription interface{}) []teamsListItem {
+	var listItems []teamsListItem
+
+	if set, ok := items.(*schema.Set); ok {
+		for _, v := range set.List() {
+			listItems = append(listItems, teamsListItem{Value: v.(string)})
+		}
+	}
+
+	if set, ok := itemsWithDescription.(*schema.Set); ok {
+		for _, v := range set.List() {
+			item := v.(map[string]interface{})
+			listItems = append(listItems, teamsListItem{
+				Value:       item["value"].(string),
+				Description: item["description"].(string),
+			})
+		}
+	}
+
+	return listItems
+}
+
+// diffTeamsListItems returns the values of the items to remove from a list
+// and the items to append to it. Items whose description changed are
+// removed and appended again, they come first in both slices and in the same
+// order so patchTeamsListItems sends their removal and append in the same
+// request.
+func diffTeamsListItems(oldItems, newItems []teamsListItem) ([]string, []teamsListItem) {
+	oldDescriptions := make(map[string]string, len(oldItems))
+	for _, item := range oldItems {
+		oldDescriptions[item.Value] = item.Description
 	}
-	for _, val := range oldItems {
-		counts[val.(string)] -= 1
+	newDescriptions := make(map[string]string, len(newItems))
+	for _, item := range newItems {
+		newDescriptions[item.Value] = item.Description
 	}
 
-	for key, val := range counts {
-		if val > 0 {
-			patchList.Append = append(patchList.Append, cloudflare.TeamsListItem{Value: key})
+	var remove []string
+	for _, item := range oldItems {
+		if description, ok := newDescriptions[item.Value]; !ok || description != item.Description {
+			remove = append(remove, item.Value)
 		}
-		if val < 0 {
-			patchList.Remove = append(patchList.Remove, key)
+	}
+
+	var add []teamsListItem
+	for _, item := range newItems {
+		if description, ok := oldDescriptions[item.Value]; !ok || description != item.Description {
+			add = append(add, item)
 		}
 	}
+
+	sort.Slice(remove, func(i, j int) bool {
+		_, iChanged := newDescriptions[remove[i]]
+		_, jChanged := newDescriptions[remove[j]]
+		if iChanged != jChanged {
+			return iChanged
+		}
+		return remove[i] < remove[j]
+	})
+	sort.Slice(add, func(i, j int) bool {
+		_, iChanged := oldDescriptions[add[i].Value]
+		_, jChanged := oldDescriptions[add[j].Value]
+		if iChanged != jChanged {
+			return iChanged
+		}
+		return add[i].Value < add[j].Value
+	})
+
+	return remove, add
 }
 
-func convertListItemsToSchema(listItems []cloudflare.TeamsListItem) []string {
-	itemValues := []string{}
-	// The API returns items in reverse order so we iterate backwards for correct ordering.
-	for i := len(listItems) - 1; i >= 0; i-- {
-		item := listItems[i]
-		itemValues = append(itemValues, item.Value)
+// patchTeamsListItems removes and appends items of a list in requests of at
+// most teamsListItemsChunkSize removed and teamsListItemsChunkSize appended
+// items. The API removes items before appending them so an item removed and
+// appended in the same request is updated without leaving the list.
+func patchTeamsListItems(ctx context.Context, client *cloudflare.API, accountID, listID string, remove []string, add []teamsListItem) error {
+	uri := fmt.Sprintf("/accounts/%s/gateway/lists/%s", accountID, listID)
+
+	for len(remove) > 0 || len(add) > 0 {
+		patch := teamsListPatch{Append: add, Remove: remove}
+		if len(patch.Remove) > teamsListItemsChunkSize {
+			patch.Remove = patch.Remove[:teamsListItemsChunkSize]
+		}
+		if len(patch.Append) > teamsListItemsChunkSize {
+			patch.Append = patch.Append[:teamsListItemsChunkSize]
+		}
+		remove, add = remove[len(patch.Remove):], add[len(patch.Append):]
+
+		// Empty fields are sent as empty lists rather than null.
+		if patch.Remove == nil {
+			patch.Remove = []string{}
+		}
+		if patch.Append == nil {
+			patch.Append = []teamsListItem{}
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Removing %d and appending %d items to Teams List %q", len(patch.Remove), len(patch.Append), listID))
+		if _, err := client.Raw(ctx, http.MethodPatch, uri, patch, nil); err != nil {
+			return err
+		}
 	}
 
-	return itemValues
+	return nil
+}
+
+// listTeamsListItems returns all items of a list, including their
+// descriptions. Pages are requested until one is empty as the API may return
+// fewer items than requested per page. A page repeating items means the API
+// ignored the page parameter and returned all of them at once.
+func listTeamsListItems(ctx context.Context, client *cloudflare.API, accountID, listID string) ([]teamsListItem, error) {
+	const perPage = 1000
+
+	var items []teamsListItem
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		uri := fmt.Sprintf("/accounts/%s/gateway/lists/%s/items?page=%d&per_page=%d", accountID, listID, page, perPage)
+		res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageItems []teamsListItem
+		if err := json.Unmarshal(res, &pageItems); err != nil {
+			return nil, fmt.Errorf("error unmarshalling Teams List items: %w", err)
+		}
+
+		if len(pageItems) == 0 || seen[pageItems[0].Value] {
+			return items, nil
+		}
+
+		for _, item := range pageItems {
+			seen[item.Value] = true
+		}
+		items = append(items, pageItems...)
+	}
 }
@@ -2,14 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -105,6 +109,55 @@ func TestAccCloudflareTeamsList_Reordered(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareTeamsList_ItemsWithDescription(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_list.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsListConfigItemsWithDescription(rnd, accountID, "first serial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "items.#", "0"),
+					resource.TestCheckResourceAttr(name, "items_with_description.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "items_with_description.*", map[string]string{
+						"value":       "asdf-1234",
+						"description": "first serial",
+					}),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsListConfigItemsWithDescription(rnd, accountID, "updated serial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "items_with_description.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "items_with_description.*", map[string]string{
+						"value":       "asdf-1234",
+						"description": "updated serial",
+					}),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
 func testAccCloudflareTeamsListConfigBasic(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_teams_list" "%[1]s" {
@@ -146,6 +199,27 @@ resource "cloudflare_teams_list" "%[1]s" {
 `, rnd, accountID)
 }
 
+func testAccCloudflareTeamsListConfigItemsWithDescription(rnd, accountID, description string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_list" "%[1]s" {
+	account_id  = "%[2]s"
+	name        = "%[1]s"
+	description = "My description"
+	type        = "SERIAL"
+
+	items_with_description {
+		value       = "asdf-1234"
+		description = "%[3]s"
+	}
+
+	items_with_description {
+		value       = "asdf-5678"
+		description = "second serial"
+	}
+}
+`, rnd, accountID, description)
+}
+
 func testAccCheckCloudflareTeamsListDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -163,3 +237,169 @@ func testAccCheckCloudflareTeamsListDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestDiffTeamsListItems(t *testing.T) {
+	oldItems := []teamsListItem{{Value: "a"}, {Value: "b", Description: "b"}, {Value: "c"}}
+	newItems := []teamsListItem{{Value: "a"}, {Value: "b", Description: "updated"}, {Value: "d"}}
+
+	remove, add := diffTeamsListItems(oldItems, newItems)
+
+	// The item whose description changed comes first in both slices so it is
+	// removed and appended again in the same request.
+	if expected := []string{"b", "c"}; !reflect.DeepEqual(remove, expected) {
+		t.Errorf("expected %v to be removed, got %v", expected, remove)
+	}
+	if expected := []teamsListItem{{Value: "b", Description: "updated"}, {Value: "d"}}; !reflect.DeepEqual(add, expected) {
+		t.Errorf("expected %v to be appended, got %v", expected, add)
+	}
+
+	oldItems = []teamsListItem{{Value: "a"}, {Value: "z", Description: "z"}}
+	newItems = []teamsListItem{{Value: "b"}, {Value: "z", Description: "updated"}}
+
+	remove, add = diffTeamsListItems(oldItems, newItems)
+
+	if expected := []string{"z", "a"}; !reflect.DeepEqual(remove, expected) {
+		t.Errorf("expected %v to be removed, got %v", expected, remove)
+	}
+	if expected := []teamsListItem{{Value: "z", Description: "updated"}, {Value: "b"}}; !reflect.DeepEqual(add, expected) {
+		t.Errorf("expected %v to be appended, got %v", expected, add)
+	}
+}
+
+func TestCloudflareTeamsListUpdatePatchesItemsInChunks(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const listID = "971fc4e8-388e-4ab9-b377-16430c0fc018"
+	const listPath = "/accounts/" + accountID + "/gateway/lists/" + listID
+
+	items := []teamsListItem{{Value: "asdf-1234"}}
+	var patches []teamsListPatch
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		var result interface{} = map[string]interface{}{"id": listID, "name": "list", "type": "SERIAL"}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == listPath:
+		case r.Method == http.MethodGet && r.URL.Path == listPath+"/items":
+			result = []teamsListItem{}
+			if r.URL.Query().Get("page") == "1" {
+				result = items
+			}
+		case r.Method == http.MethodPatch && r.URL.Path == listPath:
+			var patch teamsListPatch
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				t.Fatal(err)
+			}
+			patches = append(patches, patch)
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResultJSON(w, result)
+	})
+
+	r := resourceCloudflareTeamsList()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(accountID + "/" + listID)
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newItems := []interface{}{}
+	for i := 0; i < 2500; i++ {
+		newItems = append(newItems, map[string]interface{}{"value": fmt.Sprintf("example-%04d", i), "description": "serial"})
+	}
+
+	state := imported[0].State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":             accountID,
+		"name":                   "list",
+		"type":                   "SERIAL",
+		"items_with_description": newItems,
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(patches) != 3 {
+		t.Fatalf("expected 3 patches, got %d", len(patches))
+	}
+	if !reflect.DeepEqual(patches[0].Remove, []string{"asdf-1234"}) {
+		t.Errorf("expected the first patch to remove the old item, got %+v", patches[0].Remove)
+	}
+	for i, expected := range []int{1000, 1000, 500} {
+		if got := len(patches[i].Append); got != expected || (i > 0 && len(patches[i].Remove) != 0) {
+			t.Errorf("expected patch %d to append %d items, got %d", i, expected, got)
+		}
+	}
+	if patches[0].Append[0] != (teamsListItem{Value: "example-0000", Description: "serial"}) {
+		t.Errorf("expected items to be appended with their description, got %+v", patches[0].Append[0])
+	}
+}
+
+func TestListTeamsListItemsPagesUntilEmptyPage(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const listID = "971fc4e8-388e-4ab9-b377-16430c0fc018"
+
+	testCases := map[string]struct {
+		pageSizes     map[string]int
+		repeatPages   bool
+		expectedPages []string
+		expectedItems int
+	}{
+		"page size capped by the API": {
+			pageSizes:     map[string]int{"1": 500, "2": 500, "3": 5},
+			expectedPages: []string{"1", "2", "3", "4"},
+			expectedItems: 1005,
+		},
+		"page parameter ignored by the API": {
+			pageSizes:     map[string]int{"1": 5, "2": 5},
+			repeatPages:   true,
+			expectedPages: []string{"1", "2"},
+			expectedItems: 5,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var pages []string
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/gateway/lists/"+listID+"/items" {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				page := r.URL.Query().Get("page")
+				pages = append(pages, page)
+
+				prefix := page
+				if tc.repeatPages {
+					prefix = "1"
+				}
+				items := []teamsListItem{}
+				for i := 0; i < tc.pageSizes[page]; i++ {
+					items = append(items, teamsListItem{Value: fmt.Sprintf("%s-%d", prefix, i)})
+				}
+
+				testAPIResultJSON(w, items)
+			})
+
+			items, err := listTeamsListItems(context.Background(), client, accountID, listID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(pages, tc.expectedPages) {
+				t.Errorf("expected pages %v to be fetched, got %v", tc.expectedPages, pages)
+			}
+			if len(items) != tc.expectedItems {
+				t.Errorf("expected %d items, got %d", tc.expectedItems, len(items))
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,9 +37,10 @@ func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.Resource
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	location, err := client.TeamsLocation(ctx, accountID, d.Id())
+	location, err := getTeamsLocation(ctx, client, accountID, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "Location ID is invalid") {
+		var notFoundError *cloudflare.NotFoundError
+		if strings.Contains(err.Error(), "Location ID is invalid") || errors.As(err, &notFoundError) {
 			tflog.Info(ctx, fmt.Sprintf("Teams Location %s no longer exists", d.Id()))
 			d.SetId("")
 			return nil
@@ -64,9 +69,24 @@ func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.Resource
 	if err := d.Set("ipv4_destination", location.IPv4Destination); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing Location IPv4 destination"))
 	}
+	if err := d.Set("ipv4_destination_backup", location.IPv4DestinationBackup); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location IPv4 destination backup"))
+	}
+	if err := d.Set("dns_destination_ips_id", location.DNSDestinationIPsID); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location DNS destination IPs ID"))
+	}
+	if err := d.Set("dns_destination_ipv6_block_id", location.DNSDestinationIPv6BlockID); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location DNS destination IPv6 block ID"))
+	}
 	if err := d.Set("client_default", location.ClientDefault); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing Location client default"))
 	}
+	if err := d.Set("ecs_support", location.ECSSupport != nil && *location.ECSSupport); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location ECS support"))
+	}
+	if err := d.Set("endpoints", flattenTeamsLocationEndpoints(location.Endpoints, location.Networks)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location endpoints"))
+	}
 
 	return nil
 }
@@ -74,22 +94,16 @@ func resourceCloudflareTeamsLocationCreate(ctx context.Context, d *schema.Resour
 	client := meta.(*cloudflare.API)
 
 	accountID := d.Get("account_id").(string)
-	networks, err := inflateTeamsLocationNetworks(d.Get("networks"))
+	newTeamLocation, err := buildTeamsLocation(d)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Teams Location for account %q: %w, %v", accountID, err, networks))
-	}
-
-	newTeamLocation := cloudflare.TeamsLocation{
-		Name:          d.Get("name").(string),
-		Networks:      networks,
-		ClientDefault: d.Get("client_default").(bool),
+		return diag.FromErr(fmt.Errorf("error creating Teams Location for account %q: %w", accountID, err))
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams Location from struct: %+v", newTeamLocation))
 
-	location, err := client.CreateTeamsLocation(ctx, accountID, newTeamLocation)
+	location, err := createTeamsLocation(ctx, client, accountID, newTeamLocation)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating Teams Location for account %q: %w, %v", accountID, err, networks))
+		return diag.FromErr(fmt.Errorf("error creating Teams Location for account %q: %w, %v", accountID, err, newTeamLocation.Networks))
 	}
 
 	d.SetId(location.ID)
@@ -98,19 +112,14 @@ func resourceCloudflareTeamsLocationCreate(ctx context.Context, d *schema.Resour
 func resourceCloudflareTeamsLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
-	networks, err := inflateTeamsLocationNetworks(d.Get("networks"))
+	updatedTeamsLocation, err := buildTeamsLocation(d)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating Teams Location for account %q: %w, %v", accountID, err, networks))
-	}
-	updatedTeamsLocation := cloudflare.TeamsLocation{
-		ID:            d.Id(),
-		Name:          d.Get("name").(string),
-		ClientDefault: d.Get("client_default").(bool),
-		Networks:      networks,
+		return diag.FromErr(fmt.Errorf("error updating Teams Location for account %q: %w", accountID, err))
 	}
+	updatedTeamsLocation.ID = d.Id()
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams Location from struct: %+v", updatedTeamsLocation))
 
-	teamsLocation, err := client.UpdateTeamsLocation(ctx, accountID, updatedTeamsLocation)
+	teamsLocation, err := updateTeamsLocation(ctx, client, accountID, updatedTeamsLocation)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Teams Location for account %q: %w", accountID, err))
 	}
@@ -185,3 +194,180 @@ func flattenTeamsLocationNetworks(networks []cloudflare.TeamsLocationNetwork) []
 	}
 	return flattenedNetworks
 }
+
+// teamsLocation extends cloudflare.TeamsLocation with the endpoints, ECS
+// support and DNS destinations of the location.
+type teamsLocation struct {
+	cloudflare.TeamsLocation
+	ECSSupport                *bool                   `json:"ecs_support,omitempty"`
+	Endpoints                 *teamsLocationEndpoints `json:"endpoints,omitempty"`
+	IPv4DestinationBackup     string                  `json:"ipv4_destination_backup,omitempty"`
+	DNSDestinationIPsID       string                  `json:"dns_destination_ips_id,omitempty"`
+	DNSDestinationIPv6BlockID string                  `json:"dns_destination_ipv6_block_id,omitempty"`
+}
+
+type teamsLocationEndpoints struct {
+	IPv4 teamsLocationEndpoint `json:"ipv4"`
+	IPv6 teamsLocationEndpoint `json:"ipv6"`
+	DoT  teamsLocationEndpoint `json:"dot"`
+	DoH  teamsLocationEndpoint `json:"doh"`
+}
+
+// teamsLocationEndpoint is the endpoint of a location for a DNS protocol, the
+// networks of the IPv4 endpoint are the networks of the location.
+type teamsLocationEndpoint struct {
+	Enabled  bool                           `json:"enabled"`
+	Networks []teamsLocationEndpointNetwork `json:"networks,omitempty"`
+}
+
+type teamsLocationEndpointNetwork struct {
+	Network string `json:"network"`
+}
+
+func getTeamsLocation(ctx context.Context, client *cloudflare.API, accountID, locationID string) (teamsLocation, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/locations/%s", accountID, locationID), nil, nil)
+	if err != nil {
+		return teamsLocation{}, err
+	}
+
+	return unmarshalTeamsLocation(res)
+}
+
+func createTeamsLocation(ctx context.Context, client *cloudflare.API, accountID string, location teamsLocation) (teamsLocation, error) {
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/gateway/locations", accountID), location, nil)
+	if err != nil {
+		return teamsLocation{}, err
+	}
+
+	return unmarshalTeamsLocation(res)
+}
+
+func updateTeamsLocation(ctx context.Context, client *cloudflare.API, accountID string, location teamsLocation) (teamsLocation, error) {
+	res, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/gateway/locations/%s", accountID, location.ID), location, nil)
+	if err != nil {
+		return teamsLocation{}, err
+	}
+
+	return unmarshalTeamsLocation(res)
+}
+
+func unmarshalTeamsLocation(res json.RawMessage) (teamsLocation, error) {
+	var location teamsLocation
+	if err := json.Unmarshal(res, &location); err != nil {
+		return teamsLocation{}, fmt.Errorf("error unmarshalling Teams Location: %w", err)
+	}
+
+	return location, nil
+}
+
+func buildTeamsLocation(d *schema.ResourceData) (teamsLocation, error) {
+	networks, err := inflateTeamsLocationNetworks(d.Get("networks"))
+	if err != nil {
+		return teamsLocation{}, err
+	}
+
+	// The networks of the IPv4 endpoint replace the deprecated `networks`
+	// when they are configured.
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsNull() && rawConfig.GetAttr("networks").IsNull() {
+		if ipv4Networks := teamsLocationConfiguredIPv4Networks(rawConfig); ipv4Networks != nil {
+			networks = nil
+			for _, network := range ipv4Networks.List() {
+				networks = append(networks, cloudflare.TeamsLocationNetwork{Network: network.(string)})
+			}
+		}
+	}
+
+	location := teamsLocation{
+		TeamsLocation: cloudflare.TeamsLocation{
+			Name:          d.Get("name").(string),
+			Networks:      networks,
+			ClientDefault: d.Get("client_default").(bool),
+		},
+	}
+
+	// Values which aren't configured are taken from state so that, for
+	// example, endpoints enabled in the dashboard are kept.
+	if ecsSupport, ok := d.GetOkExists("ecs_support"); ok {
+		value := ecsSupport.(bool)
+		location.ECSSupport = &value
+	}
+
+	if _, ok := d.GetOk("endpoints"); ok {
+		location.Endpoints = &teamsLocationEndpoints{
+			IPv4: teamsLocationEndpoint{Enabled: d.Get("endpoints.0.ipv4.0.enabled").(bool)},
+			IPv6: expandTeamsLocationEndpoint(d, "ipv6"),
+			DoT:  expandTeamsLocationEndpoint(d, "dot"),
+			DoH:  expandTeamsLocationEndpoint(d, "doh"),
+		}
+	}
+
+	return location, nil
+}
+
+// teamsLocationConfiguredIPv4Networks returns the configured networks of the
+// IPv4 endpoint, or nil when they aren't configured.
+func teamsLocationConfiguredIPv4Networks(rawConfig cty.Value) *schema.Set {
+	endpoints := rawConfig.GetAttr("endpoints")
+	if endpoints.IsNull() || !endpoints.IsKnown() || endpoints.LengthInt() == 0 {
+		return nil
+	}
+	ipv4 := endpoints.Index(cty.NumberIntVal(0)).GetAttr("ipv4")
+	if ipv4.IsNull() || !ipv4.IsKnown() || ipv4.LengthInt() == 0 {
+		return nil
+	}
+	networks := ipv4.Index(cty.NumberIntVal(0)).GetAttr("networks")
+	if networks.IsNull() || !networks.IsKnown() {
+		return nil
+	}
+
+	set := schema.NewSet(schema.HashString, nil)
+	for _, network := range networks.AsValueSlice() {
+		set.Add(network.AsString())
+	}
+
+	return set
+}
+
+func expandTeamsLocationEndpoint(d *schema.ResourceData, protocol string) teamsLocationEndpoint {
+	prefix := fmt.Sprintf("endpoints.0.%s.0", protocol)
+	endpoint := teamsLocationEndpoint{Enabled: d.Get(prefix + ".enabled").(bool)}
+
+	if networks, ok := d.Get(prefix + ".networks").(*schema.Set); ok {
+		for _, network := range networks.List() {
+			endpoint.Networks = append(endpoint.Networks, teamsLocationEndpointNetwork{Network: network.(string)})
+		}
+	}
+
+	return endpoint
+}
+
+func flattenTeamsLocationEndpoints(endpoints *teamsLocationEndpoints, networks []cloudflare.TeamsLocationNetwork) []interface{} {
+	if endpoints == nil {
+		endpoints = &teamsLocationEndpoints{}
+	}
+
+	ipv4Networks := make([]teamsLocationEndpointNetwork, 0, len(networks))
+	for _, network := range networks {
+		ipv4Networks = append(ipv4Networks, teamsLocationEndpointNetwork{Network: network.Network})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"ipv4": flattenTeamsLocationEndpoint(teamsLocationEndpoint{Enabled: endpoints.IPv4.Enabled, Networks: ipv4Networks}),
+		"ipv6": flattenTeamsLocationEndpoint(endpoints.IPv6),
+		"dot":  flattenTeamsLocationEndpoint(endpoints.DoT),
+		"doh":  flattenTeamsLocationEndpoint(endpoints.DoH),
+	}}
+}
+
+func flattenTeamsLocationEndpoint(endpoint teamsLocationEndpoint) []interface{} {
+	networks := make([]string, 0, len(endpoint.Networks))
+	for _, network := range endpoint.Networks {
+		networks = append(networks, network.Network)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"enabled":  endpoint.Enabled,
+		"networks": networks,
+	}}
+}
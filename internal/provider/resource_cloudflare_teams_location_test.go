@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -41,6 +44,46 @@ func TestAccCloudflareTeamsLocationBasic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareTeamsLocationEndpoints(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_location.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsLocationConfigEndpoints(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ecs_support", "true"),
+					resource.TestCheckResourceAttr(name, "endpoints.0.ipv4.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "endpoints.0.ipv4.0.networks.#", "1"),
+					resource.TestCheckResourceAttr(name, "endpoints.0.dot.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "endpoints.0.dot.0.networks.#", "1"),
+					resource.TestCheckResourceAttr(name, "networks.#", "1"),
+					resource.TestCheckResourceAttrSet(name, "doh_subdomain"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
 func testAccCloudflareTeamsLocationConfigBasic(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_teams_location" "%[1]s" {
@@ -50,6 +93,28 @@ resource "cloudflare_teams_location" "%[1]s" {
 `, rnd, accountID)
 }
 
+func testAccCloudflareTeamsLocationConfigEndpoints(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_location" "%[1]s" {
+  name        = "%[1]s"
+  account_id  = "%[2]s"
+  ecs_support = true
+
+  endpoints {
+    ipv4 {
+      enabled  = true
+      networks = ["198.51.100.0/24"]
+    }
+
+    dot {
+      enabled  = true
+      networks = ["203.0.113.0/24"]
+    }
+  }
+}
+`, rnd, accountID)
+}
+
 func testAccCheckCloudflareTeamsLocationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -66,3 +131,82 @@ func testAccCheckCloudflareTeamsLocationDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestCloudflareTeamsLocationUpdateKeepsEndpoints(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const locationID = "ed35569b41ce4d1facfe683550f54086"
+	const locationPath = "/accounts/" + accountID + "/gateway/locations/" + locationID
+
+	location := map[string]interface{}{
+		"id":            locationID,
+		"name":          "office",
+		"networks":      []interface{}{map[string]interface{}{"id": "n1", "network": "198.51.100.0/24"}},
+		"doh_subdomain": "oli3n9zkz5",
+		"ecs_support":   false,
+		"endpoints": map[string]interface{}{
+			"ipv4": map[string]interface{}{"enabled": true},
+			"ipv6": map[string]interface{}{"enabled": true},
+			"dot":  map[string]interface{}{"enabled": true, "networks": []interface{}{map[string]interface{}{"network": "203.0.113.0/24"}}},
+			"doh":  map[string]interface{}{"enabled": false},
+		},
+	}
+	var updates []map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == locationPath:
+			var update map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+			updates = append(updates, update)
+			location["name"] = update["name"]
+		case r.Method == http.MethodGet && r.URL.Path == locationPath:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResultJSON(w, location)
+	})
+
+	r := resourceCloudflareTeamsLocation()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	d.SetId(accountID + "/" + locationID)
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := imported[0].Get("endpoints.0.ipv4.0.networks").(*schema.Set).List(); len(got) != 1 || got[0] != "198.51.100.0/24" {
+		t.Errorf("expected the networks to be mapped onto the IPv4 endpoint, got %v", got)
+	}
+
+	state := imported[0].State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": accountID,
+		"name":       "updated",
+		"networks":   []interface{}{map[string]interface{}{"network": "198.51.100.0/24"}},
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(updates) != 1 {
+		t.Fatalf("expected a single update, got %d", len(updates))
+	}
+	endpoints, _ := updates[0]["endpoints"].(map[string]interface{})
+	dot, _ := endpoints["dot"].(map[string]interface{})
+	if dot["enabled"] != true || len(dot["networks"].([]interface{})) != 1 {
+		t.Errorf("expected the DoT endpoint to be kept, got %v", endpoints)
+	}
+	if ipv6, _ := endpoints["ipv6"].(map[string]interface{}); ipv6["enabled"] != true {
+		t.Errorf("expected the IPv6 endpoint to be kept, got %v", endpoints)
+	}
+	if networks, _ := updates[0]["networks"].([]interface{}); len(networks) != 1 {
+		t.Errorf("expected the networks to be sent, got %v", updates[0]["networks"])
+	}
+}
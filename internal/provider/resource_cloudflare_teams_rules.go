@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -13,6 +14,53 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// teamsRuleConfig extends the Teams rule with the rule settings that aren't
+// available in cloudflare-go yet. The rule_settings field shadows the one of
+// the embedded rule.
+type teamsRuleConfig struct {
+	cloudflare.TeamsRule
+	RuleSettings teamsRuleSettingsConfig `json:"rule_settings"`
+}
+
+// teamsRuleSettingsConfig holds the additional rule settings. They are only
+// sent when configured as the API rejects settings that don't apply to the
+// rule's action.
+type teamsRuleSettingsConfig struct {
+	cloudflare.TeamsRuleSettings
+	UntrustedCertSettings       *teamsUntrustedCertConfig `json:"untrusted_cert,omitempty"`
+	PayloadLog                  *teamsPayloadLogConfig    `json:"payload_log,omitempty"`
+	NotificationSettings        *teamsNotificationConfig  `json:"notification_settings,omitempty"`
+	ResolveDNSThroughCloudflare *bool                     `json:"resolve_dns_through_cloudflare,omitempty"`
+	DNSResolvers                *teamsDNSResolversConfig  `json:"dns_resolvers,omitempty"`
+	IgnoreCNAMECategoryMatches  *bool                     `json:"ignore_cname_category_matches,omitempty"`
+}
+
+type teamsUntrustedCertConfig struct {
+	Action string `json:"action"`
+}
+
+type teamsPayloadLogConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+type teamsNotificationConfig struct {
+	Enabled    *bool  `json:"enabled,omitempty"`
+	Message    string `json:"msg,omitempty"`
+	SupportURL string `json:"support_url,omitempty"`
+}
+
+type teamsDNSResolversConfig struct {
+	V4Resolvers []teamsDNSResolverAddressConfig `json:"ipv4,omitempty"`
+	V6Resolvers []teamsDNSResolverAddressConfig `json:"ipv6,omitempty"`
+}
+
+type teamsDNSResolverAddressConfig struct {
+	IP                         string `json:"ip"`
+	Port                       *int   `json:"port,omitempty"`
+	VnetID                     string `json:"vnet_id,omitempty"`
+	RouteThroughPrivateNetwork *bool  `json:"route_through_private_network,omitempty"`
+}
+
 func resourceCloudflareTeamsRule() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareTeamsRuleSchema(),
@@ -33,7 +81,7 @@ func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	rule, err := client.TeamsRule(ctx, accountID, d.Id())
+	rule, err := getTeamsRule(ctx, client, accountID, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid rule id") {
 			tflog.Info(ctx, fmt.Sprintf("Teams Rule config %s does not exists", d.Id()))
@@ -91,7 +139,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 
 	ruleName := d.Get("name").(string)
 	apiPrecedence := providerToApiRulePrecedence(int64(d.Get("precedence").(int)), ruleName)
-	newTeamsRule := cloudflare.TeamsRule{
+	newTeamsRule := teamsRuleConfig{TeamsRule: cloudflare.TeamsRule{
 		Name:          ruleName,
 		Description:   d.Get("description").(string),
 		Precedence:    uint64(apiPrecedence),
@@ -102,7 +150,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 		Identity:      d.Get("identity").(string),
 		DevicePosture: d.Get("device_posture").(string),
 		Version:       uint64(d.Get("version").(int)),
-	}
+	}}
 
 	if settings != nil {
 		newTeamsRule.RuleSettings = *settings
@@ -110,7 +158,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams Rule from struct: %+v", newTeamsRule))
 
-	rule, err := client.TeamsCreateRule(ctx, accountID, newTeamsRule)
+	rule, err := writeTeamsRule(ctx, client, accountID, http.MethodPost, newTeamsRule)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Teams rule for account %q: %w", accountID, err))
 	}
@@ -131,7 +179,7 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 
 	ruleName := d.Get("name").(string)
 	apiPrecedence := providerToApiRulePrecedence(int64(d.Get("precedence").(int)), ruleName)
-	teamsRule := cloudflare.TeamsRule{
+	teamsRule := teamsRuleConfig{TeamsRule: cloudflare.TeamsRule{
 		ID:            d.Id(),
 		Name:          ruleName,
 		Description:   d.Get("description").(string),
@@ -143,14 +191,14 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 		Identity:      d.Get("identity").(string),
 		DevicePosture: d.Get("device_posture").(string),
 		Version:       uint64(d.Get("version").(int)),
-	}
+	}}
 
 	if settings != nil {
 		teamsRule.RuleSettings = *settings
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams rule from struct: %+v", teamsRule))
 
-	updatedTeamsRule, err := client.TeamsUpdateRule(ctx, accountID, teamsRule.ID, teamsRule)
+	updatedTeamsRule, err := writeTeamsRule(ctx, client, accountID, http.MethodPut, teamsRule)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Teams rule for account %q: %w", accountID, err))
 	}
@@ -194,7 +242,7 @@ func resourceCloudflareTeamsRuleImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interface{} {
+func flattenTeamsRuleSettings(settings *teamsRuleSettingsConfig) []interface{} {
 	return []interface{}{map[string]interface{}{
 		"block_page_enabled":                 settings.BlockPageEnabled,
 		"block_page_reason":                  settings.BlockReason,
@@ -205,10 +253,16 @@ func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interfac
 		"check_session":                      flattenTeamsCheckSessionSettings(settings.CheckSession),
 		"add_headers":                        flattenTeamsAddHeaders(settings.AddHeaders),
 		"insecure_disable_dnssec_validation": settings.InsecureDisableDNSSECValidation,
+		"untrusted_cert":                     flattenTeamsUntrustedCertSettings(settings.UntrustedCertSettings),
+		"payload_log":                        flattenTeamsPayloadLogSettings(settings.PayloadLog),
+		"notification_settings":              flattenTeamsNotificationSettings(settings.NotificationSettings),
+		"resolve_dns_through_cloudflare":     cloudflare.Bool(settings.ResolveDNSThroughCloudflare),
+		"dns_resolvers":                      flattenTeamsDNSResolverSettings(settings.DNSResolvers),
+		"ignore_cname_category_matches":      cloudflare.Bool(settings.IgnoreCNAMECategoryMatches),
 	}}
 }
 
-func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSettings {
+func inflateTeamsRuleSettings(settings interface{}) *teamsRuleSettingsConfig {
 	settingsList := settings.([]interface{})
 	if len(settingsList) != 1 {
 		return nil
@@ -231,7 +285,7 @@ func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSetting
 	addHeaders := inflateTeamsAddHeaders(settingsMap["add_headers"].(map[string]interface{}))
 	insecureDisableDNSSECValidation := settingsMap["insecure_disable_dnssec_validation"].(bool)
 
-	return &cloudflare.TeamsRuleSettings{
+	ruleSettings := &teamsRuleSettingsConfig{TeamsRuleSettings: cloudflare.TeamsRuleSettings{
 		BlockPageEnabled:                enabled,
 		BlockReason:                     reason,
 		OverrideIPs:                     overrideIPs,
@@ -241,7 +295,23 @@ func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSetting
 		CheckSession:                    checkSessionSettings,
 		AddHeaders:                      addHeaders,
 		InsecureDisableDNSSECValidation: insecureDisableDNSSECValidation,
+	}}
+
+	ruleSettings.UntrustedCertSettings = inflateTeamsUntrustedCertSettings(settingsMap["untrusted_cert"].([]interface{}))
+	ruleSettings.PayloadLog = inflateTeamsPayloadLogSettings(settingsMap["payload_log"].([]interface{}))
+	ruleSettings.NotificationSettings = inflateTeamsNotificationSettings(settingsMap["notification_settings"].([]interface{}))
+	ruleSettings.DNSResolvers = inflateTeamsDNSResolverSettings(settingsMap["dns_resolvers"].([]interface{}))
+
+	// Unset booleans can't be told apart from false ones, so these are only
+	// sent when enabled.
+	if settingsMap["resolve_dns_through_cloudflare"].(bool) {
+		ruleSettings.ResolveDNSThroughCloudflare = cloudflare.BoolPtr(true)
+	}
+	if settingsMap["ignore_cname_category_matches"].(bool) {
+		ruleSettings.IgnoreCNAMECategoryMatches = cloudflare.BoolPtr(true)
 	}
+
+	return ruleSettings
 }
 
 func flattenTeamsRuleBisoAdminControls(settings *cloudflare.TeamsBISOAdminControlSettings) []interface{} {
@@ -363,6 +433,154 @@ func inflateTeamsL4Override(settings interface{}) *cloudflare.TeamsL4OverrideSet
 	}
 }
 
+func flattenTeamsUntrustedCertSettings(settings *teamsUntrustedCertConfig) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"action": settings.Action,
+	}}
+}
+
+func inflateTeamsUntrustedCertSettings(settings []interface{}) *teamsUntrustedCertConfig {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+	return &teamsUntrustedCertConfig{
+		Action: settingsMap["action"].(string),
+	}
+}
+
+func flattenTeamsPayloadLogSettings(settings *teamsPayloadLogConfig) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"enabled": settings.Enabled,
+	}}
+}
+
+func inflateTeamsPayloadLogSettings(settings []interface{}) *teamsPayloadLogConfig {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+	return &teamsPayloadLogConfig{
+		Enabled: settingsMap["enabled"].(bool),
+	}
+}
+
+func flattenTeamsNotificationSettings(settings *teamsNotificationConfig) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"enabled":     cloudflare.Bool(settings.Enabled),
+		"message":     settings.Message,
+		"support_url": settings.SupportURL,
+	}}
+}
+
+func inflateTeamsNotificationSettings(settings []interface{}) *teamsNotificationConfig {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+	return &teamsNotificationConfig{
+		Enabled:    cloudflare.BoolPtr(settingsMap["enabled"].(bool)),
+		Message:    settingsMap["message"].(string),
+		SupportURL: settingsMap["support_url"].(string),
+	}
+}
+
+func flattenTeamsDNSResolverSettings(settings *teamsDNSResolversConfig) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"ipv4": flattenTeamsDNSResolverAddresses(settings.V4Resolvers),
+		"ipv6": flattenTeamsDNSResolverAddresses(settings.V6Resolvers),
+	}}
+}
+
+func flattenTeamsDNSResolverAddresses(addresses []teamsDNSResolverAddressConfig) []interface{} {
+	var ret []interface{}
+	for _, address := range addresses {
+		ret = append(ret, map[string]interface{}{
+			"ip":                            address.IP,
+			"port":                          cloudflare.Int(address.Port),
+			"vnet_id":                       address.VnetID,
+			"route_through_private_network": cloudflare.Bool(address.RouteThroughPrivateNetwork),
+		})
+	}
+	return ret
+}
+
+func inflateTeamsDNSResolverSettings(settings []interface{}) *teamsDNSResolversConfig {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+	return &teamsDNSResolversConfig{
+		V4Resolvers: inflateTeamsDNSResolverAddresses(settingsMap["ipv4"].([]interface{})),
+		V6Resolvers: inflateTeamsDNSResolverAddresses(settingsMap["ipv6"].([]interface{})),
+	}
+}
+
+func inflateTeamsDNSResolverAddresses(addresses []interface{}) []teamsDNSResolverAddressConfig {
+	var ret []teamsDNSResolverAddressConfig
+	for _, address := range addresses {
+		addressMap := address.(map[string]interface{})
+		resolver := teamsDNSResolverAddressConfig{
+			IP:     addressMap["ip"].(string),
+			Port:   cloudflare.IntPtr(addressMap["port"].(int)),
+			VnetID: addressMap["vnet_id"].(string),
+		}
+		if addressMap["route_through_private_network"].(bool) {
+			resolver.RouteThroughPrivateNetwork = cloudflare.BoolPtr(true)
+		}
+		ret = append(ret, resolver)
+	}
+	return ret
+}
+
+func getTeamsRule(ctx context.Context, client *cloudflare.API, accountID, ruleID string) (teamsRuleConfig, error) {
+	var rule teamsRuleConfig
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/rules/%s", accountID, ruleID), nil, nil)
+	if err != nil {
+		return rule, err
+	}
+
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return rule, fmt.Errorf("error unmarshalling Teams rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// writeTeamsRule creates (POST) or updates (PUT) a Teams rule.
+func writeTeamsRule(ctx context.Context, client *cloudflare.API, accountID, method string, rule teamsRuleConfig) (teamsRuleConfig, error) {
+	uri := fmt.Sprintf("/accounts/%s/gateway/rules", accountID)
+	if method == http.MethodPut {
+		uri = fmt.Sprintf("%s/%s", uri, rule.ID)
+	}
+
+	var result teamsRuleConfig
+
+	res, err := client.Raw(ctx, method, uri, rule, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("error unmarshalling Teams rule: %w", err)
+	}
+
+	return result, nil
+}
+
 func providerToApiRulePrecedence(provided int64, ruleName string) int64 {
 	return provided*rulePrecedenceFactor + int64(hashCodeString(ruleName))%rulePrecedenceFactor
 }
@@ -68,6 +68,176 @@ resource "cloudflare_teams_rule" "%[1]s" {
 `, rnd, accountID)
 }
 
+func TestAccCloudflareTeamsRuleHTTPSettings(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsRuleConfigHTTPSettings(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "block"),
+					resource.TestCheckResourceAttr(name, "filters.0", "http"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.untrusted_cert.#", "0"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.payload_log.#", "0"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.0.message", "blocked by policy"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.0.support_url", "https://example.com/support"),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsRuleConfigHTTPAllowSettings(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "allow"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.untrusted_cert.0.action", "block"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.payload_log.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTeamsRuleResolverSettings(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsRuleConfigDNSResolvers(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "resolve"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.resolve_dns_through_cloudflare", "false"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.0.ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.0.port", "5053"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv6.0.ip", "2001:db8::53"),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsRuleConfigResolveThroughCloudflare(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rule_settings.0.resolve_dns_through_cloudflare", "true"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsRuleConfigHTTPSettings(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12303
+  action = "block"
+  filters = ["http"]
+  traffic = "any(http.request.domains[*] == \"example.com\")"
+  rule_settings {
+    notification_settings {
+      enabled = true
+      message = "blocked by policy"
+      support_url = "https://example.com/support"
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareTeamsRuleConfigHTTPAllowSettings(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12303
+  action = "allow"
+  filters = ["http"]
+  traffic = "any(http.request.domains[*] == \"example.com\")"
+  rule_settings {
+    untrusted_cert {
+      action = "block"
+    }
+    payload_log {
+      enabled = true
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareTeamsRuleConfigDNSResolvers(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12304
+  action = "resolve"
+  filters = ["dns_resolver"]
+  traffic = "any(dns.domains[*] == \"example.com\")"
+  rule_settings {
+    dns_resolvers {
+      ipv4 {
+        ip   = "192.0.2.53"
+        port = 5053
+      }
+      ipv6 {
+        ip = "2001:db8::53"
+      }
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareTeamsRuleConfigResolveThroughCloudflare(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12304
+  action = "resolve"
+  filters = ["dns_resolver"]
+  traffic = "any(dns.domains[*] == \"example.com\")"
+  rule_settings {
+    resolve_dns_through_cloudflare = true
+  }
+}
+`, rnd, accountID)
+}
+
 func testAccCheckCloudflareTeamsRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -17,9 +17,15 @@ func resourceCloudflareTieredCache() *schema.Resource {
 		UpdateContext: resourceCloudflareTieredCacheUpdate,
 		CreateContext: resourceCloudflareTieredCacheUpdate,
 		DeleteContext: resourceCloudflareTieredCacheDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTieredCacheImport,
+		},
 		Description: heredoc.Doc(`
 			Provides a resource, that manages Cloudflare Tiered Cache settings.
-			This allows you to adjust topologies for your zone.
+			This allows you to adjust topologies for your zone. The generic
+			tiered caching setting and the Smart Tiered Cache topology are
+			updated in the order required by the selected ` + "`cache_type`" + `
+			and both are turned off on destroy.
 		`),
 	}
 }
@@ -71,8 +77,19 @@ func resourceCloudflareTieredCacheDelete(ctx context.Context, d *schema.Resource
 
 	_, err := client.DeleteTieredCache(ctx, cloudflare.ZoneIdentifier(zoneID))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating deleting tiered cache configuration: %w", err))
+		return diag.FromErr(fmt.Errorf("error deleting tiered cache configuration: %w", err))
 	}
 
 	return nil
 }
+
+func resourceCloudflareTieredCacheImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareTieredCacheRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
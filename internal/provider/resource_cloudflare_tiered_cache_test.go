@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func testTieredCacheConfig(rnd, zoneID, cacheType string) string {
@@ -33,6 +37,11 @@ func TestAccCloudflareTieredCache_Smart(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "cache_type", "smart"),
 				),
 			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -56,3 +65,71 @@ func TestAccCloudflareTieredCache_Generic(t *testing.T) {
 		},
 	})
 }
+
+func TestCloudflareTieredCacheSmartAndDestroy(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const genericPath = "/zones/" + zoneID + "/argo/tiered_caching"
+	const smartPath = "/zones/" + zoneID + "/cache/tiered_cache_smart_topology_enable"
+
+	generic, smart := "off", "off"
+	var requests []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == genericPath:
+			testAPIResult(w, fmt.Sprintf(`{"id": "tiered_caching", "value": %q}`, generic))
+			return
+		case r.Method == http.MethodGet && r.URL.Path == smartPath:
+			testAPIResult(w, fmt.Sprintf(`{"id": "tiered_cache_smart_topology_enable", "value": %q}`, smart))
+			return
+		case r.Method == http.MethodPatch && r.URL.Path == genericPath:
+			if generic == "on" {
+				generic = "off"
+			} else {
+				generic = "on"
+			}
+		case r.Method == http.MethodPatch && r.URL.Path == smartPath:
+			if generic != "on" {
+				t.Error("expected generic tiered caching to be enabled before the smart topology")
+			}
+			smart = "on"
+		case r.Method == http.MethodDelete && r.URL.Path == smartPath:
+			smart = "off"
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		testAPIResult(w, `{"id": "tiered_caching"}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTieredCacheSchema(), map[string]interface{}{
+		"zone_id":    zoneID,
+		"cache_type": "smart",
+	})
+
+	if diags := resourceCloudflareTieredCacheUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get("cache_type").(string); got != "smart" {
+		t.Errorf("expected cache_type %q, got %q", "smart", got)
+	}
+
+	if diags := resourceCloudflareTieredCacheDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []string{
+		"PATCH " + genericPath,
+		"PATCH " + smartPath,
+		"DELETE " + smartPath,
+		"PATCH " + genericPath,
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+	if generic != "off" || smart != "off" {
+		t.Errorf("expected tiered caching to be turned off on destroy, got generic %q and smart %q", generic, smart)
+	}
+}
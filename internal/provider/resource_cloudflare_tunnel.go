@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+const argoTunnelCNAME = "cfargotunnel.com"
+
+// tunnelSecretLength is the number of random bytes generated for a tunnel
+// secret when none is provided.
+const tunnelSecretLength = 32
+
+// tunnel extends cloudflare.Tunnel with the source of the tunnel's
+// configuration and its health.
+type tunnel struct {
+	cloudflare.Tunnel
+	ConfigSrc    string `json:"config_src,omitempty"`
+	RemoteConfig bool   `json:"remote_config,omitempty"`
+	Status       string `json:"status,omitempty"`
+}
+
+func resourceCloudflareTunnel() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTunnelSchema(),
+		CreateContext: resourceCloudflareTunnelCreate,
+		ReadContext:   resourceCloudflareTunnelRead,
+		DeleteContext: resourceCloudflareTunnelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTunnelImport,
+		},
+		Description: heredoc.Doc(`
+			Tunnel exposes applications running on your local web server on any
+			network with an internet connection without manually adding DNS
+			records or configuring a firewall or router.
+		`),
+	}
+}
+
+func resourceCloudflareArgoTunnel() *schema.Resource {
+	r := resourceCloudflareTunnel()
+	r.DeprecationMessage = "This resource is deprecated, use the `cloudflare_tunnel` instead."
+	return r
+}
+
+func resourceCloudflareTunnelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accID := d.Get("account_id").(string)
+
+	secret := d.Get("secret").(string)
+	if secret == "" {
+		var err error
+		secret, err = generateTunnelSecret()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to generate Tunnel secret: %w", err))
+		}
+	}
+
+	params := struct {
+		Name      string `json:"name"`
+		Secret    string `json:"tunnel_secret"`
+		ConfigSrc string `json:"config_src"`
+	}{
+		Name:      d.Get("name").(string),
+		Secret:    secret,
+		ConfigSrc: d.Get("config_src").(string),
+	}
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/cfd_tunnel", accID), params, nil)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("failed to create Tunnel")))
+	}
+
+	var created tunnel
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Tunnel: %w", err))
+	}
+
+	d.SetId(created.ID)
+	d.Set("secret", secret)
+
+	return resourceCloudflareTunnelRead(ctx, d, meta)
+}
+
+func resourceCloudflareTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accID := d.Get("account_id").(string)
+
+	tunnel, err := getTunnel(ctx, client, accID, d.Id())
+	var notFoundError *cloudflare.NotFoundError
+	if errors.As(err, &notFoundError) {
+		tflog.Info(ctx, fmt.Sprintf("Tunnel %s no longer exists", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to fetch Tunnel: %w", err))
+	}
+
+	// deleted tunnels are still returned by the API for a while.
+	if tunnel.DeletedAt != nil {
+		tflog.Info(ctx, fmt.Sprintf("Tunnel %s has been deleted", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", tunnel.Name)
+	d.Set("cname", fmt.Sprintf("%s.%s", tunnel.ID, argoTunnelCNAME))
+	d.Set("config_src", tunnel.configSrc())
+
+	token, err := client.TunnelToken(ctx, cloudflare.AccountIdentifier(accID), tunnel.ID)
+	if err != nil {
+		tflog.Warn(ctx, "unable to set the tunnel_token in state because it's not found in API")
+		d.Set("tunnel_token", "")
+		return nil
+	}
+
+	d.Set("tunnel_token", token)
+
+	return nil
+}
+
+func resourceCloudflareTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accID := d.Get("account_id").(string)
+
+	cleanupErr := client.CleanupArgoTunnelConnections(ctx, accID, d.Id())
+	if cleanupErr != nil {
+		return diag.FromErr(errors.Wrap(cleanupErr, fmt.Sprintf("failed to clean up Tunnel connections")))
+	}
+
+	deleteErr := client.DeleteArgoTunnel(ctx, accID, d.Id())
+	if deleteErr != nil {
+		return diag.FromErr(errors.Wrap(deleteErr, fmt.Sprintf("failed to delete Tunnel")))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareTunnelImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*cloudflare.API)
+	attributes := strings.Split(d.Id(), "/")
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelUUID\"", d.Id())
+	}
+
+	accID, tunnelID := attributes[0], attributes[1]
+
+	tunnel, err := getTunnel(ctx, client, accID, tunnelID)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("failed to fetch Tunnel %s", tunnelID))
+	}
+
+	d.Set("account_id", accID)
+	d.Set("name", tunnel.Name)
+	d.SetId(tunnel.ID)
+
+	resourceCloudflareTunnelRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// configSrc returns where the tunnel's configuration is managed. Older API
+// responses only expose the `remote_config` flag.
+func (t tunnel) configSrc() string {
+	if t.ConfigSrc != "" {
+		return t.ConfigSrc
+	}
+
+	if t.RemoteConfig {
+		return "cloudflare"
+	}
+
+	return "local"
+}
+
+// generateTunnelSecret returns a random base64 encoded tunnel secret.
+func generateTunnelSecret() (string, error) {
+	secret := make([]byte, tunnelSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+func getTunnel(ctx context.Context, client *cloudflare.API, accountID, tunnelID string) (tunnel, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accountID, tunnelID), nil, nil)
+	if err != nil {
+		return tunnel{}, err
+	}
+
+	var t tunnel
+	if err := json.Unmarshal(res, &t); err != nil {
+		return tunnel{}, fmt.Errorf("error unmarshalling Tunnel: %w", err)
+	}
+
+	return t, nil
+}
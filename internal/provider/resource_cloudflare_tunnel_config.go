@@ -2,17 +2,59 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/MakeNowJust/heredoc/v2"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// tunnelConfiguration mirrors cloudflare.TunnelConfiguration with support for
+// per ingress rule origin request settings.
+type tunnelConfiguration struct {
+	Ingress       []tunnelIngressRule           `json:"ingress,omitempty"`
+	WarpRouting   *cloudflare.WarpRoutingConfig `json:"warp-routing,omitempty"`
+	OriginRequest *tunnelOriginRequestConfig    `json:"originRequest,omitempty"`
+}
+
+// tunnelIngressRule extends cloudflare.UnvalidatedIngressRule with the origin
+// request settings overriding the top level ones for the rule.
+type tunnelIngressRule struct {
+	cloudflare.UnvalidatedIngressRule
+	OriginRequest *tunnelOriginRequestConfig `json:"originRequest,omitempty"`
+}
+
+// tunnelOriginRequestConfig extends cloudflare.OriginRequestConfig with
+// Access JWT enforcement.
+type tunnelOriginRequestConfig struct {
+	cloudflare.OriginRequestConfig
+	Access *tunnelAccessConfig `json:"access,omitempty"`
+}
+
+// tunnelAccessConfig configures cloudflared to validate the Access JWT of
+// requests before forwarding them to the origin.
+type tunnelAccessConfig struct {
+	Required bool     `json:"required,omitempty"`
+	TeamName string   `json:"teamName"`
+	AudTag   []string `json:"audTag"`
+}
+
+// tunnelConfigurationResult is the response payload of the tunnel
+// configuration endpoints.
+type tunnelConfigurationResult struct {
+	TunnelID string              `json:"tunnel_id,omitempty"`
+	Config   tunnelConfiguration `json:"config"`
+	Version  int                 `json:"version,omitempty"`
+}
+
 func resourceCloudflareTunnelConfig() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareTunnelConfigSchema(),
@@ -20,204 +62,343 @@ func resourceCloudflareTunnelConfig() *schema.Resource {
 		CreateContext: resourceCloudflareTunnelConfigUpdate,
 		UpdateContext: resourceCloudflareTunnelConfigUpdate,
 		DeleteContext: resourceCloudflareTunnelConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTunnelConfigImport,
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Tunnel configuration resource.
 		`),
 	}
 }
 
-func buildTunnelConfig(d *schema.ResourceData) cloudflare.TunnelConfiguration {
-	warpRouting := cloudflare.WarpRoutingConfig{}
+func buildTunnelConfig(d *schema.ResourceData) tunnelConfiguration {
+	config := tunnelConfiguration{}
+
 	if item, ok := d.GetOk("config.0.warp_routing.0"); ok {
-		warpRouting = cloudflare.WarpRoutingConfig{
+		config.WarpRouting = &cloudflare.WarpRoutingConfig{
 			Enabled: item.(map[string]interface{})["enabled"].(bool),
 		}
 	}
 
-	originConfig := cloudflare.OriginRequestConfig{}
+	rawConfig := tunnelConfigRawBlock(d.GetRawConfig(), "config", 0)
+
 	if item, ok := d.GetOk("config.0.origin_request.0"); ok {
-		originRequest := item.(map[string]interface{})
-		if v, ok := originRequest["connect_timeout"]; ok {
-			timeout, _ := time.ParseDuration(v.(string))
-			originConfig.ConnectTimeout = &timeout
-		}
-		if v, ok := originRequest["tls_timeout"]; ok {
-			timeout, _ := time.ParseDuration(v.(string))
-			originConfig.TLSTimeout = &timeout
-		}
-		if v, ok := originRequest["tcp_keep_alive"]; ok {
-			timeout, _ := time.ParseDuration(v.(string))
-			originConfig.TCPKeepAlive = &timeout
-		}
-		if v, ok := originRequest["no_happy_eyeballs"]; ok {
-			originConfig.NoHappyEyeballs = cloudflare.BoolPtr(v.(bool))
-		}
-		if v, ok := originRequest["keep_alive_connections"]; ok {
-			originConfig.KeepAliveConnections = cloudflare.IntPtr(v.(int))
-		}
-		if v, ok := originRequest["keep_alive_timeout"]; ok {
-			timeout, _ := time.ParseDuration(v.(string))
-			originConfig.KeepAliveTimeout = &timeout
-		}
-		if v, ok := originRequest["http_host_header"]; ok {
-			originConfig.HTTPHostHeader = cloudflare.StringPtr(v.(string))
-		}
-		if v, ok := originRequest["origin_server_name"]; ok {
-			originConfig.OriginServerName = cloudflare.StringPtr(v.(string))
-		}
-		if v, ok := originRequest["ca_pool"]; ok {
-			originConfig.CAPool = cloudflare.StringPtr(v.(string))
-		}
-		if v, ok := originRequest["no_tls_verify"]; ok {
-			originConfig.NoTLSVerify = cloudflare.BoolPtr(v.(bool))
+		config.OriginRequest = buildTunnelOriginRequestConfig(item.(map[string]interface{}), tunnelConfigRawBlock(rawConfig, "origin_request", 0))
+	}
+
+	for i, ingressRule := range d.Get("config.0.ingress_rule").([]interface{}) {
+		ingressRuleConfig := ingressRule.(map[string]interface{})
+		rule := tunnelIngressRule{
+			UnvalidatedIngressRule: cloudflare.UnvalidatedIngressRule{
+				Service:  ingressRuleConfig["service"].(string),
+				Hostname: ingressRuleConfig["hostname"].(string),
+				Path:     ingressRuleConfig["path"].(string),
+			},
 		}
-		if v, ok := originRequest["disable_chunked_encoding"]; ok {
-			originConfig.DisableChunkedEncoding = cloudflare.BoolPtr(v.(bool))
+		if originRequest, ok := ingressRuleConfig["origin_request"].([]interface{}); ok && len(originRequest) > 0 && originRequest[0] != nil {
+			rawOriginRequest := tunnelConfigRawBlock(tunnelConfigRawBlock(rawConfig, "ingress_rule", i), "origin_request", 0)
+			rule.OriginRequest = buildTunnelOriginRequestConfig(originRequest[0].(map[string]interface{}), rawOriginRequest)
 		}
-		if v, ok := originRequest["bastion_mode"]; ok {
-			originConfig.BastionMode = cloudflare.BoolPtr(v.(bool))
+		config.Ingress = append(config.Ingress, rule)
+	}
+
+	return config
+}
+
+// tunnelConfigRawBlock returns the element at index of the block name of a
+// raw configuration, or cty.NilVal when it isn't configured or known.
+func tunnelConfigRawBlock(rawConfig cty.Value, name string, index int) cty.Value {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(name) {
+		return cty.NilVal
+	}
+	block := rawConfig.GetAttr(name)
+	if block.IsNull() || !block.IsKnown() || block.LengthInt() <= index {
+		return cty.NilVal
+	}
+
+	return block.Index(cty.NumberIntVal(int64(index)))
+}
+
+// buildTunnelOriginRequestConfig converts the schema representation of the
+// origin request settings, omitting unset values so cloudflared applies its
+// own defaults (or the top level settings for ingress rules). Booleans
+// configured as false in rawConfig are sent so they override those.
+func buildTunnelOriginRequestConfig(originRequest map[string]interface{}, rawConfig cty.Value) *tunnelOriginRequestConfig {
+	originConfig := &tunnelOriginRequestConfig{}
+
+	duration := func(key string) *time.Duration {
+		v, ok := originRequest[key].(string)
+		if !ok || v == "" {
+			return nil
 		}
-		if v, ok := originRequest["proxy_address"]; ok {
-			originConfig.ProxyAddress = cloudflare.StringPtr(v.(string))
+		timeout, _ := time.ParseDuration(v)
+		return &timeout
+	}
+	str := func(key string) *string {
+		if v, ok := originRequest[key].(string); ok && v != "" {
+			return cloudflare.StringPtr(v)
 		}
-		if v, ok := originRequest["proxy_port"]; ok {
-			originConfig.ProxyPort = cloudflare.UintPtr(uint(v.(int)))
+		return nil
+	}
+	boolean := func(key string) *bool {
+		v, ok := originRequest[key].(bool)
+		if !ok {
+			return nil
 		}
-		if v, ok := originRequest["proxy_type"]; ok {
-			originConfig.ProxyType = cloudflare.StringPtr(v.(string))
+		if !v && (rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().HasAttribute(key) || rawConfig.GetAttr(key).IsNull()) {
+			return nil
 		}
+		return cloudflare.BoolPtr(v)
+	}
+
+	originConfig.ConnectTimeout = duration("connect_timeout")
+	originConfig.TLSTimeout = duration("tls_timeout")
+	originConfig.TCPKeepAlive = duration("tcp_keep_alive")
+	originConfig.KeepAliveTimeout = duration("keep_alive_timeout")
+	originConfig.NoHappyEyeballs = boolean("no_happy_eyeballs")
+	originConfig.NoTLSVerify = boolean("no_tls_verify")
+	originConfig.DisableChunkedEncoding = boolean("disable_chunked_encoding")
+	originConfig.BastionMode = boolean("bastion_mode")
+	originConfig.HTTPHostHeader = str("http_host_header")
+	originConfig.OriginServerName = str("origin_server_name")
+	originConfig.CAPool = str("ca_pool")
+	originConfig.ProxyAddress = str("proxy_address")
+	originConfig.ProxyType = str("proxy_type")
 
-		var ipRules []cloudflare.IngressIPRule
-		if v, ok := originRequest["ip_rules"]; ok {
-			for _, ingressRule := range v.(*schema.Set).List() {
-				ingressRuleConfig := ingressRule.(map[string]interface{})
-				ipRule := cloudflare.IngressIPRule{
-					Prefix: cloudflare.StringPtr(ingressRuleConfig["prefix"].(string)),
-					Allow:  ingressRuleConfig["allow"].(bool),
-				}
-				for _, value := range ingressRuleConfig["ports"].([]interface{}) {
-					ipRule.Ports = append(ipRule.Ports, value.(int))
-				}
-				ipRules = append(ipRules, ipRule)
+	if v, ok := originRequest["keep_alive_connections"].(int); ok && v != 0 {
+		originConfig.KeepAliveConnections = cloudflare.IntPtr(v)
+	}
+	if v, ok := originRequest["proxy_port"].(int); ok && v != 0 {
+		originConfig.ProxyPort = cloudflare.UintPtr(uint(v))
+	}
+
+	if v, ok := originRequest["ip_rules"].(*schema.Set); ok {
+		for _, ingressRule := range v.List() {
+			ingressRuleConfig := ingressRule.(map[string]interface{})
+			ipRule := cloudflare.IngressIPRule{
+				Prefix: cloudflare.StringPtr(ingressRuleConfig["prefix"].(string)),
+				Allow:  ingressRuleConfig["allow"].(bool),
+			}
+			for _, value := range ingressRuleConfig["ports"].([]interface{}) {
+				ipRule.Ports = append(ipRule.Ports, value.(int))
 			}
+			originConfig.IPRules = append(originConfig.IPRules, ipRule)
 		}
-		originConfig.IPRules = ipRules
 	}
 
-	var ingressRules []cloudflare.UnvalidatedIngressRule
-	for _, ingressRule := range d.Get("config.0.ingress_rule").([]interface{}) {
-		ingressRuleConfig := ingressRule.(map[string]interface{})
-		ingressRule := cloudflare.UnvalidatedIngressRule{
-			Service:  ingressRuleConfig["service"].(string),
-			Hostname: ingressRuleConfig["hostname"].(string),
-			Path:     ingressRuleConfig["path"].(string),
+	if v, ok := originRequest["access"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		access := v[0].(map[string]interface{})
+		originConfig.Access = &tunnelAccessConfig{
+			Required: access["required"].(bool),
+			TeamName: access["team_name"].(string),
+			AudTag:   expandInterfaceToStringList(access["aud_tag"].(*schema.Set).List()),
 		}
-		ingressRules = append(ingressRules, ingressRule)
 	}
 
-	return cloudflare.TunnelConfiguration{
-		OriginRequest: originConfig,
-		WarpRouting:   &warpRouting,
-		Ingress:       ingressRules,
-	}
+	return originConfig
 }
 
 func resourceCloudflareTunnelConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
-	result, err := client.GetTunnelConfiguration(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	result, err := getTunnelConfiguration(ctx, client, accountID, d.Id())
 	tflog.Debug(ctx, fmt.Sprintf("GetTunnelConfiguration: %+v", result))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error getting tunnel config %q: %w", d.Id(), err))
 	}
 
-	d.SetId(result.TunnelID)
+	d.Set("tunnel_id", d.Id())
 	config := result.Config
-	var configMap []map[string]interface{}
 
 	var warpConfigMap []map[string]interface{}
-
-	emptyWarpRouting := cloudflare.WarpRoutingConfig{}
-	if !reflect.DeepEqual(config.WarpRouting, &emptyWarpRouting) {
+	if config.WarpRouting != nil && config.WarpRouting.Enabled {
 		warpConfigMap = append(warpConfigMap, map[string]interface{}{
 			"enabled": config.WarpRouting.Enabled,
 		})
 	}
 
-	var originRequestMap []map[string]interface{}
-	emptyOriginRequest := cloudflare.OriginRequestConfig{}
-	if !reflect.DeepEqual(config.OriginRequest, emptyOriginRequest) {
-		originRequestMap = append(originRequestMap, map[string]interface{}{
-			"connect_timeout":          config.OriginRequest.ConnectTimeout.String(),
-			"tls_timeout":              config.OriginRequest.TLSTimeout.String(),
-			"tcp_keep_alive":           config.OriginRequest.TCPKeepAlive.String(),
-			"no_happy_eyeballs":        cloudflare.Bool(config.OriginRequest.NoHappyEyeballs),
-			"keep_alive_connections":   cloudflare.Int(config.OriginRequest.KeepAliveConnections),
-			"keep_alive_timeout":       config.OriginRequest.KeepAliveTimeout.String(),
-			"http_host_header":         cloudflare.String(config.OriginRequest.HTTPHostHeader),
-			"origin_server_name":       cloudflare.String(config.OriginRequest.OriginServerName),
-			"ca_pool":                  cloudflare.String(config.OriginRequest.CAPool),
-			"no_tls_verify":            cloudflare.Bool(config.OriginRequest.NoTLSVerify),
-			"disable_chunked_encoding": cloudflare.Bool(config.OriginRequest.DisableChunkedEncoding),
-			"bastion_mode":             cloudflare.Bool(config.OriginRequest.BastionMode),
-			"proxy_address":            cloudflare.String(config.OriginRequest.ProxyAddress),
-			"proxy_port":               int(cloudflare.Uint(config.OriginRequest.ProxyPort)),
-			"proxy_type":               cloudflare.String(config.OriginRequest.ProxyType),
-		})
-		var ipRules []map[string]interface{}
-		for _, ipRule := range config.OriginRequest.IPRules {
-			ipRules = append(ipRules, map[string]interface{}{
-				"prefix": ipRule.Prefix,
-				"allow":  ipRule.Allow,
-				"ports":  ipRule.Ports,
-			})
-		}
-		originRequestMap[0]["ip_rules"] = ipRules
-	}
-
 	var ingressRules []map[string]interface{}
-	for _, ingressRule := range config.Ingress {
+	for i, ingressRule := range config.Ingress {
 		ingressRules = append(ingressRules, map[string]interface{}{
-			"service":  ingressRule.Service,
-			"hostname": ingressRule.Hostname,
-			"path":     ingressRule.Path,
+			"service":        ingressRule.Service,
+			"hostname":       ingressRule.Hostname,
+			"path":           ingressRule.Path,
+			"origin_request": flattenTunnelOriginRequestConfig(d, fmt.Sprintf("config.0.ingress_rule.%d.origin_request.0", i), ingressRule.OriginRequest),
 		})
 	}
-	configMap = append(configMap, map[string]interface{}{
+
+	configMap := []map[string]interface{}{{
 		"warp_routing":   warpConfigMap,
-		"origin_request": originRequestMap,
+		"origin_request": flattenTunnelOriginRequestConfig(d, "config.0.origin_request.0", config.OriginRequest),
 		"ingress_rule":   ingressRules,
-	})
-	d.Set("config", configMap)
+	}}
+	if err := d.Set("config", configMap); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tunnel config %q: %w", d.Id(), err))
+	}
+
 	return nil
 }
 
+// flattenTunnelOriginRequestConfig converts the origin request settings into
+// their schema representation. Durations are returned by the API as
+// nanoseconds and are written back using the representation found in state
+// at statePath whenever it is equivalent.
+func flattenTunnelOriginRequestConfig(d *schema.ResourceData, statePath string, originRequest *tunnelOriginRequestConfig) []map[string]interface{} {
+	if originRequest == nil || reflect.DeepEqual(*originRequest, tunnelOriginRequestConfig{}) {
+		return nil
+	}
+
+	duration := func(key string, remote *time.Duration) string {
+		current, _ := d.Get(fmt.Sprintf("%s.%s", statePath, key)).(string)
+		return flattenTunnelConfigDuration(current, remote)
+	}
+
+	var ipRules []map[string]interface{}
+	for _, ipRule := range originRequest.IPRules {
+		ipRules = append(ipRules, map[string]interface{}{
+			"prefix": cloudflare.String(ipRule.Prefix),
+			"allow":  ipRule.Allow,
+			"ports":  ipRule.Ports,
+		})
+	}
+
+	var access []map[string]interface{}
+	if originRequest.Access != nil {
+		access = append(access, map[string]interface{}{
+			"required":  originRequest.Access.Required,
+			"team_name": originRequest.Access.TeamName,
+			"aud_tag":   originRequest.Access.AudTag,
+		})
+	}
+
+	return []map[string]interface{}{{
+		"connect_timeout":          duration("connect_timeout", originRequest.ConnectTimeout),
+		"tls_timeout":              duration("tls_timeout", originRequest.TLSTimeout),
+		"tcp_keep_alive":           duration("tcp_keep_alive", originRequest.TCPKeepAlive),
+		"no_happy_eyeballs":        cloudflare.Bool(originRequest.NoHappyEyeballs),
+		"keep_alive_connections":   cloudflare.Int(originRequest.KeepAliveConnections),
+		"keep_alive_timeout":       duration("keep_alive_timeout", originRequest.KeepAliveTimeout),
+		"http_host_header":         cloudflare.String(originRequest.HTTPHostHeader),
+		"origin_server_name":       cloudflare.String(originRequest.OriginServerName),
+		"ca_pool":                  cloudflare.String(originRequest.CAPool),
+		"no_tls_verify":            cloudflare.Bool(originRequest.NoTLSVerify),
+		"disable_chunked_encoding": cloudflare.Bool(originRequest.DisableChunkedEncoding),
+		"bastion_mode":             cloudflare.Bool(originRequest.BastionMode),
+		"proxy_address":            cloudflare.String(originRequest.ProxyAddress),
+		"proxy_port":               int(cloudflare.Uint(originRequest.ProxyPort)),
+		"proxy_type":               cloudflare.String(originRequest.ProxyType),
+		"ip_rules":                 ipRules,
+		"access":                   access,
+	}}
+}
+
+// flattenTunnelConfigDuration returns the current representation of a
+// duration when it matches the remote value and the canonical duration
+// string otherwise.
+func flattenTunnelConfigDuration(current string, remote *time.Duration) string {
+	if remote == nil {
+		return ""
+	}
+
+	if currentDuration, err := time.ParseDuration(current); err == nil && currentDuration == *remote {
+		return current
+	}
+
+	return remote.String()
+}
+
+func suppressEquivalentTunnelConfigDuration(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDuration == newDuration
+}
+
 func resourceCloudflareTunnelConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 	tunnelID := d.Get("tunnel_id").(string)
-	tunnel := cloudflare.TunnelConfigurationParams{
-		TunnelID: tunnelID,
-		Config:   buildTunnelConfig(d),
-	}
-	result, err := client.UpdateTunnelConfiguration(ctx, cloudflare.AccountIdentifier(accountID), tunnel)
-	if err != nil {
+
+	if _, err := updateTunnelConfiguration(ctx, client, accountID, tunnelID, buildTunnelConfig(d)); err != nil {
 		return diag.FromErr(fmt.Errorf("error creating / updating tunnel config %q: %w", tunnelID, err))
 	}
 
-	d.SetId(result.TunnelID)
+	d.SetId(tunnelID)
 	return resourceCloudflareTunnelConfigRead(ctx, d, meta)
 }
 
+// resourceCloudflareTunnelConfigDelete replaces the configuration with a
+// single catch-all rule responding with a 404 as the tunnel itself is managed
+// by the `cloudflare_tunnel` resource.
 func resourceCloudflareTunnelConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
 
-	err := client.DeleteTunnel(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
-	if err != nil {
+	config := tunnelConfiguration{
+		Ingress: []tunnelIngressRule{{
+			UnvalidatedIngressRule: cloudflare.UnvalidatedIngressRule{Service: "http_status:404"},
+		}},
+	}
+	if _, err := updateTunnelConfiguration(ctx, client, accountID, d.Id(), config); err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting tunnel config %q: %w", d.Id(), err))
 	}
 
 	d.SetId("")
 	return nil
 }
+
+func resourceCloudflareTunnelConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 || attributes[0] == "" || attributes[1] == "" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelID\"", d.Id())
+	}
+
+	accountID, tunnelID := attributes[0], attributes[1]
+
+	d.Set("account_id", accountID)
+	d.Set("tunnel_id", tunnelID)
+	d.SetId(tunnelID)
+
+	resourceCloudflareTunnelConfigRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getTunnelConfiguration(ctx context.Context, client *cloudflare.API, accountID, tunnelID string) (tunnelConfigurationResult, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelID), nil, nil)
+	if err != nil {
+		return tunnelConfigurationResult{}, err
+	}
+
+	var result tunnelConfigurationResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return tunnelConfigurationResult{}, fmt.Errorf("error unmarshalling tunnel config: %w", err)
+	}
+
+	return result, nil
+}
+
+func updateTunnelConfiguration(ctx context.Context, client *cloudflare.API, accountID, tunnelID string, config tunnelConfiguration) (tunnelConfigurationResult, error) {
+	body := struct {
+		Config tunnelConfiguration `json:"config"`
+	}{Config: config}
+
+	res, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelID), body, nil)
+	if err != nil {
+		return tunnelConfigurationResult{}, err
+	}
+
+	var result tunnelConfigurationResult
+	if err := json.Unmarshal(res, &result); err != nil {
+		return tunnelConfigurationResult{}, fmt.Errorf("error unmarshalling tunnel config: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,17 +1,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func testTunnelConfig(resourceID, accountID, tunnelSecret string) string {
 	return fmt.Sprintf(`
-		resource "cloudflare_argo_tunnel" "%[1]s" {
+		resource "cloudflare_tunnel" "%[1]s" {
 		  account_id = "%[2]s"
 		  name       = "%[1]s"
 		  secret     = "%[3]s"
@@ -19,7 +24,7 @@ func testTunnelConfig(resourceID, accountID, tunnelSecret string) string {
 
 		resource "cloudflare_tunnel_config" "%[1]s" {
 		  account_id         = "%[2]s"
-		  tunnel_id          = cloudflare_argo_tunnel.%[1]s.id
+		  tunnel_id          = cloudflare_tunnel.%[1]s.id
 
 		  config {
 			warp_routing {
@@ -51,6 +56,15 @@ func testTunnelConfig(resourceID, accountID, tunnelSecret string) string {
 			  hostname = "foo"
 			  path = "/bar"
 			  service = "http://10.0.0.2:8080"
+			  origin_request {
+				connect_timeout = "2m"
+				no_tls_verify = true
+				access {
+				  required = true
+				  team_name = "terraform"
+				  aud_tag = ["AUD_TAG"]
+				}
+			  }
 			}
 			ingress_rule {
 				service = "https://10.0.0.3:8081"
@@ -62,7 +76,7 @@ func testTunnelConfig(resourceID, accountID, tunnelSecret string) string {
 
 func testTunnelConfigShort(resourceID, accountID, tunnelSecret string) string {
 	return fmt.Sprintf(`
-		resource "cloudflare_argo_tunnel" "%[1]s" {
+		resource "cloudflare_tunnel" "%[1]s" {
 		  account_id = "%[2]s"
 		  name       = "%[1]s"
 		  secret     = "%[3]s"
@@ -70,7 +84,7 @@ func testTunnelConfigShort(resourceID, accountID, tunnelSecret string) string {
 
 		resource "cloudflare_tunnel_config" "%[1]s" {
 		  account_id         = "%[2]s"
-		  tunnel_id          = cloudflare_argo_tunnel.%[1]s.id
+		  tunnel_id          = cloudflare_tunnel.%[1]s.id
 
 		  config {
 			ingress_rule {
@@ -124,11 +138,23 @@ func TestAccCloudflareTunnelConfig_Full(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.hostname", "foo"),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.path", "/bar"),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.service", "http://10.0.0.2:8080"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.origin_request.0.connect_timeout", "2m"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.origin_request.0.no_tls_verify", "true"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.origin_request.0.access.0.required", "true"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.origin_request.0.access.0.team_name", "terraform"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.origin_request.0.access.0.aud_tag.#", "1"),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.1.hostname", ""),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.1.path", ""),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.1.service", "https://10.0.0.3:8081"),
+					resource.TestCheckNoResourceAttr(name, "config.0.ingress_rule.1.origin_request.#"),
 				),
 			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:   true,
+			},
 		},
 	})
 }
@@ -156,3 +182,136 @@ func TestAccCloudflareTunnelConfig_Short(t *testing.T) {
 		},
 	})
 }
+
+func TestCloudflareTunnelConfigReadNormalizesDurations(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const tunnelID = "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID+"/configurations" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, `{
+  "tunnel_id": "`+tunnelID+`",
+  "version": 3,
+  "config": {
+    "originRequest": {"connectTimeout": 60000000000, "tlsTimeout": 10000000000, "keepAliveConnections": 100},
+    "ingress": [
+      {
+        "hostname": "foo.example.com",
+        "service": "http://10.0.0.2:8080",
+        "originRequest": {
+          "connectTimeout": 120000000000,
+          "access": {"required": true, "teamName": "terraform", "audTag": ["AUD_TAG"]}
+        }
+      },
+      {"service": "http_status:404"}
+    ]
+  }
+}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTunnelConfigSchema(), map[string]interface{}{
+		"account_id": accountID,
+		"tunnel_id":  tunnelID,
+		"config": []interface{}{map[string]interface{}{
+			"origin_request": []interface{}{map[string]interface{}{
+				"connect_timeout": "1m",
+			}},
+			"ingress_rule": []interface{}{
+				map[string]interface{}{
+					"hostname": "foo.example.com",
+					"service":  "http://10.0.0.2:8080",
+					"origin_request": []interface{}{map[string]interface{}{
+						"connect_timeout": "120s",
+					}},
+				},
+				map[string]interface{}{
+					"service": "http_status:404",
+				},
+			},
+		}},
+	})
+	d.SetId(tunnelID)
+
+	if diags := resourceCloudflareTunnelConfigRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	state := d.State()
+	expected := map[string]string{
+		"config.0.origin_request.0.connect_timeout":                   "1m",
+		"config.0.origin_request.0.tls_timeout":                       "10s",
+		"config.0.origin_request.0.tcp_keep_alive":                    "",
+		"config.0.origin_request.0.keep_alive_connections":            "100",
+		"config.0.ingress_rule.#":                                     "2",
+		"config.0.ingress_rule.0.origin_request.0.connect_timeout":    "120s",
+		"config.0.ingress_rule.0.origin_request.0.access.0.required":  "true",
+		"config.0.ingress_rule.0.origin_request.0.access.0.team_name": "terraform",
+		"config.0.ingress_rule.1.service":                             "http_status:404",
+	}
+	for key, value := range expected {
+		if got := state.Attributes[key]; got != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, got)
+		}
+	}
+	if got, ok := state.Attributes["config.0.ingress_rule.1.origin_request.#"]; ok && got != "0" {
+		t.Errorf("expected config.0.ingress_rule.1.origin_request to be unset, got %q", got)
+	}
+}
+
+func TestCloudflareTunnelConfigSendsBooleansConfiguredAsFalse(t *testing.T) {
+	raw := map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"tunnel_id":  "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415",
+		"config": []interface{}{map[string]interface{}{
+			"origin_request": []interface{}{map[string]interface{}{
+				"no_tls_verify": true,
+			}},
+			"ingress_rule": []interface{}{
+				map[string]interface{}{
+					"hostname": "foo.example.com",
+					"service":  "https://10.0.0.2:8443",
+					"origin_request": []interface{}{map[string]interface{}{
+						"no_tls_verify": false,
+					}},
+				},
+				map[string]interface{}{
+					"service": "http_status:404",
+				},
+			},
+		}},
+	}
+
+	r := resourceCloudflareTunnelConfig()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(raw["tunnel_id"].(string))
+
+	state := d.State()
+	config, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.RawConfig, err = ctyjson.Unmarshal(config, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tunnelConfig := buildTunnelConfig(r.Data(state))
+
+	if v := tunnelConfig.OriginRequest.NoTLSVerify; v == nil || !*v {
+		t.Errorf("expected no_tls_verify to be sent as true, got %v", v)
+	}
+	if v := tunnelConfig.OriginRequest.BastionMode; v != nil {
+		t.Errorf("expected the unset bastion_mode to be omitted, got %v", *v)
+	}
+	if v := tunnelConfig.Ingress[0].OriginRequest.NoTLSVerify; v == nil || *v {
+		t.Errorf("expected the no_tls_verify of the ingress rule to be sent as false, got %v", v)
+	}
+	if v := tunnelConfig.Ingress[0].OriginRequest.NoHappyEyeballs; v != nil {
+		t.Errorf("expected the unset no_happy_eyeballs of the ingress rule to be omitted, got %v", *v)
+	}
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareTunnelCreate_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	accID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_tunnel.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareTunnelBasic(accID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "secret", "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="),
+					resource.TestMatchResourceAttr(name, "cname", regexp.MustCompile(".*\\.cfargotunnel\\.com")),
+					resource.TestCheckResourceAttr(name, "config_src", "local"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTunnelCreate_Managed(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	accID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_tunnel.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareTunnelManaged(accID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "config_src", "cloudflare"),
+					resource.TestMatchResourceAttr(name, "secret", regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`)),
+					resource.TestCheckResourceAttrSet(name, "tunnel_token"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareTunnelManaged(accID, rnd),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareArgoTunnelCreate_Deprecated(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	accID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_argo_tunnel.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareArgoTunnelBasic(accID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestMatchResourceAttr(name, "cname", regexp.MustCompile(".*\\.cfargotunnel\\.com")),
+				),
+			},
+		},
+	})
+}
+
+func TestGenerateTunnelSecret(t *testing.T) {
+	secret, err := generateTunnelSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("expected a base64 encoded secret, got %q: %s", secret, err)
+	}
+	if len(decoded) != tunnelSecretLength {
+		t.Errorf("expected a %d byte secret, got %d bytes", tunnelSecretLength, len(decoded))
+	}
+
+	other, _ := generateTunnelSecret()
+	if other == secret {
+		t.Error("expected generated secrets to differ")
+	}
+}
+
+func TestCloudflareTunnelReadRemovesMissingTunnel(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const tunnelID = "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415"
+
+	testCases := map[string]http.HandlerFunc{
+		"not found": func(w http.ResponseWriter, r *http.Request) {
+			testAPIError(w, http.StatusNotFound, 1003, "Tunnel not found")
+		},
+		"deleted": func(w http.ResponseWriter, r *http.Request) {
+			testAPIResult(w, `{"id": "`+tunnelID+`", "name": "example", "deleted_at": "2023-01-31T15:56:36Z"}`)
+		},
+	}
+
+	for name, handler := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				handler(w, r)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareTunnelSchema(), map[string]interface{}{
+				"account_id": accountID,
+				"name":       "example",
+			})
+			d.SetId(tunnelID)
+
+			if diags := resourceCloudflareTunnelRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != "" {
+				t.Errorf("expected the tunnel to be removed from state, got ID %q", d.Id())
+			}
+		})
+	}
+}
+
+func testAccCheckCloudflareTunnelManaged(accID, name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_tunnel" "%[2]s" {
+		account_id = "%[1]s"
+		name       = "%[2]s"
+		config_src = "cloudflare"
+	}`, accID, name)
+}
+
+func testAccCheckCloudflareArgoTunnelBasic(accID, name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_argo_tunnel" "%[2]s" {
+		account_id = "%[1]s"
+		name       = "%[2]s"
+		secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+	}`, accID, name)
+}
+
+func testAccCheckCloudflareTunnelBasic(accID, name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_tunnel" "%[2]s" {
+		account_id = "%[1]s"
+		name       = "%[2]s"
+		secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+	}`, accID, name)
+}
+
+func testAccCheckCloudflareTunnelDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_tunnel" && rs.Type != "cloudflare_argo_tunnel" {
+			continue
+		}
+
+		accountID := rs.Primary.Attributes["account_id"]
+		tunnelID := rs.Primary.ID
+		client := testAccProvider.Meta().(*cloudflare.API)
+		tunnel, err := client.ArgoTunnel(context.Background(), accountID, tunnelID)
+
+		if err != nil {
+			return err
+		}
+
+		if tunnel.DeletedAt == nil {
+			return fmt.Errorf("tunnel with ID %s still exists", tunnel.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// turnstileWidget is the representation of a Turnstile widget in the
+// Cloudflare API.
+type turnstileWidget struct {
+	SiteKey      string   `json:"sitekey,omitempty"`
+	Secret       string   `json:"secret,omitempty"`
+	Name         string   `json:"name"`
+	Domains      []string `json:"domains"`
+	Mode         string   `json:"mode"`
+	BotFightMode bool     `json:"bot_fight_mode"`
+	Region       string   `json:"region,omitempty"`
+	OffLabel     bool     `json:"offlabel"`
+}
+
+func resourceCloudflareTurnstileWidget() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareTurnstileWidgetSchema(),
+		CreateContext: resourceCloudflareTurnstileWidgetCreate,
+		ReadContext:   resourceCloudflareTurnstileWidgetRead,
+		UpdateContext: resourceCloudflareTurnstileWidgetUpdate,
+		DeleteContext: resourceCloudflareTurnstileWidgetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTurnstileWidgetImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Turnstile Widget resource. Turnstile widgets
+			are used to verify visitors without showing them a CAPTCHA.
+			The ID of the resource is the widget sitekey.
+		`),
+	}
+}
+
+func resourceCloudflareTurnstileWidgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	widget := buildTurnstileWidget(d)
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Turnstile Widget from struct: %+v", widget))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/challenges/widgets", accountID), widget, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Turnstile Widget %q: %w", widget.Name, err))
+	}
+
+	var created turnstileWidget
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Turnstile Widget: %w", err))
+	}
+
+	d.SetId(created.SiteKey)
+	d.Set("secret", created.Secret)
+
+	return resourceCloudflareTurnstileWidgetRead(ctx, d, meta)
+}
+
+func resourceCloudflareTurnstileWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/challenges/widgets/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Turnstile Widget %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Turnstile Widget %q: %w", d.Id(), err))
+	}
+
+	var widget turnstileWidget
+	if err := json.Unmarshal(res, &widget); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Turnstile Widget: %w", err))
+	}
+
+	// The secret is only returned when the widget is created or the secret
+	// is rotated so it is intentionally not set here to avoid clobbering the
+	// value in state.
+	d.Set("name", widget.Name)
+	d.Set("domains", widget.Domains)
+	d.Set("mode", widget.Mode)
+	d.Set("bot_fight_mode", widget.BotFightMode)
+	d.Set("region", widget.Region)
+	d.Set("offlabel", widget.OffLabel)
+
+	return nil
+}
+
+func resourceCloudflareTurnstileWidgetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	widget := buildTurnstileWidget(d)
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Turnstile Widget from struct: %+v", widget))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/challenges/widgets/%s", accountID, d.Id()), widget, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Turnstile Widget %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareTurnstileWidgetRead(ctx, d, meta)
+}
+
+func resourceCloudflareTurnstileWidgetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Turnstile Widget: id %s for account %s", d.Id(), accountID))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/challenges/widgets/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Turnstile Widget %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareTurnstileWidgetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 || attributes[0] != "account" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/sitekey\"", d.Id())
+	}
+
+	accountID, siteKey := attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Turnstile Widget: sitekey %s for account %s", siteKey, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(siteKey)
+
+	resourceCloudflareTurnstileWidgetRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildTurnstileWidget(d *schema.ResourceData) turnstileWidget {
+	widget := turnstileWidget{
+		Name:         d.Get("name").(string),
+		Mode:         d.Get("mode").(string),
+		BotFightMode: d.Get("bot_fight_mode").(bool),
+		Region:       d.Get("region").(string),
+		OffLabel:     d.Get("offlabel").(bool),
+	}
+
+	for _, domain := range d.Get("domains").(*schema.Set).List() {
+		widget.Domains = append(widget.Domains, domain.(string))
+	}
+
+	return widget
+}
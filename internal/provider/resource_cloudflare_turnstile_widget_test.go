@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareTurnstileWidget_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_turnstile_widget.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTurnstileWidgetConfig(rnd, accountID, "managed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "mode", "managed"),
+					resource.TestCheckResourceAttr(name, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "domains.*", "example.com"),
+					resource.TestCheckResourceAttrSet(name, "secret"),
+				),
+			},
+			{
+				Config: testAccCloudflareTurnstileWidgetConfig(rnd, accountID, "invisible"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "mode", "invisible"),
+					resource.TestCheckResourceAttrSet(name, "secret"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("account/%s/", accountID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareTurnstileWidgetConfig(rnd, accountID, mode string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_turnstile_widget" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  domains    = ["example.com"]
+  mode       = "%[3]s"
+}
+`, rnd, accountID, mode)
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -13,6 +15,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// waitingRoom extends cloudflare.WaitingRoom with the routing, cookie and
+// origin command settings not yet exposed by the library.
+type waitingRoom struct {
+	cloudflare.WaitingRoom
+	AdditionalRoutes      []waitingRoomRoute `json:"additional_routes"`
+	QueueingStatusCode    int                `json:"queueing_status_code,omitempty"`
+	CookieSuffix          string             `json:"cookie_suffix"`
+	EnabledOriginCommands []string           `json:"enabled_origin_commands"`
+}
+
+// waitingRoomRoute is an additional host and path covered by a waiting room.
+type waitingRoomRoute struct {
+	Host string `json:"host,omitempty"`
+	Path string `json:"path"`
+}
+
 func resourceCloudflareWaitingRoom() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCloudflareWaitingRoomCreate,
@@ -32,23 +50,63 @@ func resourceCloudflareWaitingRoom() *schema.Resource {
 	}
 }
 
-func buildWaitingRoom(d *schema.ResourceData) cloudflare.WaitingRoom {
-	return cloudflare.WaitingRoom{
-		Name:                    d.Get("name").(string),
-		Description:             d.Get("description").(string),
-		Suspended:               d.Get("suspended").(bool),
-		Host:                    d.Get("host").(string),
-		Path:                    d.Get("path").(string),
-		TotalActiveUsers:        d.Get("total_active_users").(int),
-		NewUsersPerMinute:       d.Get("new_users_per_minute").(int),
-		CustomPageHTML:          d.Get("custom_page_html").(string),
-		QueueingMethod:          d.Get("queueing_method").(string),
-		DefaultTemplateLanguage: d.Get("default_template_language").(string),
-		SessionDuration:         d.Get("session_duration").(int),
-		JsonResponseEnabled:     d.Get("json_response_enabled").(bool),
-		QueueAll:                d.Get("queue_all").(bool),
-		DisableSessionRenewal:   d.Get("disable_session_renewal").(bool),
+func buildWaitingRoom(d *schema.ResourceData) waitingRoom {
+	room := waitingRoom{
+		WaitingRoom: cloudflare.WaitingRoom{
+			Name:                    d.Get("name").(string),
+			Description:             d.Get("description").(string),
+			Suspended:               d.Get("suspended").(bool),
+			Host:                    d.Get("host").(string),
+			Path:                    d.Get("path").(string),
+			TotalActiveUsers:        d.Get("total_active_users").(int),
+			NewUsersPerMinute:       d.Get("new_users_per_minute").(int),
+			CustomPageHTML:          d.Get("custom_page_html").(string),
+			QueueingMethod:          d.Get("queueing_method").(string),
+			DefaultTemplateLanguage: d.Get("default_template_language").(string),
+			SessionDuration:         d.Get("session_duration").(int),
+			JsonResponseEnabled:     d.Get("json_response_enabled").(bool),
+			QueueAll:                d.Get("queue_all").(bool),
+			DisableSessionRenewal:   d.Get("disable_session_renewal").(bool),
+		},
+		AdditionalRoutes:      []waitingRoomRoute{},
+		QueueingStatusCode:    d.Get("queueing_status_code").(int),
+		CookieSuffix:          d.Get("cookie_suffix").(string),
+		EnabledOriginCommands: []string{},
+	}
+
+	for _, r := range d.Get("additional_routes").([]interface{}) {
+		route := r.(map[string]interface{})
+		room.AdditionalRoutes = append(room.AdditionalRoutes, waitingRoomRoute{
+			Host: route["host"].(string),
+			Path: route["path"].(string),
+		})
+	}
+
+	for _, command := range d.Get("enabled_origin_commands").(*schema.Set).List() {
+		room.EnabledOriginCommands = append(room.EnabledOriginCommands, command.(string))
+	}
+
+	return room
+}
+
+func flattenWaitingRoomAdditionalRoutes(routes []waitingRoomRoute) []interface{} {
+	flattened := make([]interface{}, 0, len(routes))
+
+	for _, route := range routes {
+		flattened = append(flattened, map[string]interface{}{
+			"host": route.Host,
+			"path": route.Path,
+		})
 	}
+
+	return flattened
+}
+
+// suppressWaitingRoomAdditionalRouteHost ignores the difference between an
+// omitted additional route host and the waiting room host the API fills in
+// for it.
+func suppressWaitingRoomAdditionalRouteHost(k, old, new string, d *schema.ResourceData) bool {
+	return new == "" && strings.EqualFold(old, d.Get("host").(string))
 }
 
 func resourceCloudflareWaitingRoomCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -57,7 +115,7 @@ func resourceCloudflareWaitingRoomCreate(ctx context.Context, d *schema.Resource
 
 	newWaitingRoom := buildWaitingRoom(d)
 
-	waitingRoom, err := client.CreateWaitingRoom(ctx, zoneID, newWaitingRoom)
+	waitingRoom, err := writeWaitingRoom(ctx, client, zoneID, http.MethodPost, newWaitingRoom)
 
 	if err != nil {
 		name := d.Get("name").(string)
@@ -74,7 +132,7 @@ func resourceCloudflareWaitingRoomRead(ctx context.Context, d *schema.ResourceDa
 	waitingRoomID := d.Id()
 	zoneID := d.Get("zone_id").(string)
 
-	waitingRoom, err := client.WaitingRoom(ctx, zoneID, waitingRoomID)
+	waitingRoom, err := getWaitingRoom(ctx, client, zoneID, waitingRoomID)
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -100,6 +158,12 @@ func resourceCloudflareWaitingRoomRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("custom_page_html", waitingRoom.CustomPageHTML)
 	d.Set("default_template_language", waitingRoom.DefaultTemplateLanguage)
 	d.Set("json_response_enabled", waitingRoom.JsonResponseEnabled)
+	d.Set("queueing_status_code", waitingRoom.QueueingStatusCode)
+	d.Set("cookie_suffix", waitingRoom.CookieSuffix)
+	d.Set("enabled_origin_commands", waitingRoom.EnabledOriginCommands)
+	if err := d.Set("additional_routes", flattenWaitingRoomAdditionalRoutes(waitingRoom.AdditionalRoutes)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set additional_routes: %w", err))
+	}
 	return nil
 }
 
@@ -109,8 +173,9 @@ func resourceCloudflareWaitingRoomUpdate(ctx context.Context, d *schema.Resource
 	zoneID := d.Get("zone_id").(string)
 
 	waitingRoom := buildWaitingRoom(d)
+	waitingRoom.ID = waitingRoomID
 
-	_, err := client.ChangeWaitingRoom(ctx, zoneID, waitingRoomID, waitingRoom)
+	_, err := writeWaitingRoom(ctx, client, zoneID, http.MethodPatch, waitingRoom)
 
 	if err != nil {
 		name := d.Get("name").(string)
@@ -158,3 +223,37 @@ func resourceCloudflareWaitingRoomImport(ctx context.Context, d *schema.Resource
 	resourceCloudflareWaitingRoomRead(ctx, d, meta)
 	return []*schema.ResourceData{d}, nil
 }
+
+func getWaitingRoom(ctx context.Context, client *cloudflare.API, zoneID, waitingRoomID string) (waitingRoom, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/waiting_rooms/%s", zoneID, waitingRoomID), nil, nil)
+	if err != nil {
+		return waitingRoom{}, err
+	}
+
+	var room waitingRoom
+	if err := json.Unmarshal(res, &room); err != nil {
+		return waitingRoom{}, fmt.Errorf("error unmarshalling waiting room: %w", err)
+	}
+
+	return room, nil
+}
+
+// writeWaitingRoom creates (POST) or updates (PATCH) a waiting room.
+func writeWaitingRoom(ctx context.Context, client *cloudflare.API, zoneID, method string, room waitingRoom) (waitingRoom, error) {
+	uri := fmt.Sprintf("/zones/%s/waiting_rooms", zoneID)
+	if method != http.MethodPost {
+		uri = fmt.Sprintf("%s/%s", uri, room.ID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, room, nil)
+	if err != nil {
+		return waitingRoom{}, err
+	}
+
+	var result waitingRoom
+	if err := json.Unmarshal(res, &result); err != nil {
+		return waitingRoom{}, fmt.Errorf("error unmarshalling waiting room: %w", err)
+	}
+
+	return result, nil
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -13,6 +15,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// waitingRoomEvent extends cloudflare.WaitingRoomEvent with fields that
+// override the parent waiting room's settings.
+type waitingRoomEvent struct {
+	cloudflare.WaitingRoomEvent
+	JSONResponseEnabled *bool `json:"json_response_enabled,omitempty"`
+}
+
 func resourceCloudflareWaitingRoomEvent() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCloudflareWaitingRoomEventCreate,
@@ -28,7 +37,7 @@ func resourceCloudflareWaitingRoomEvent() *schema.Resource {
 	}
 }
 
-func expandWaitingRoomEvent(d *schema.ResourceData) (cloudflare.WaitingRoomEvent, error) {
+func expandWaitingRoomEvent(d *schema.ResourceData) (waitingRoomEvent, error) {
 	var disableSessionRenewal *bool
 	if b, ok := d.GetOk("disable_session_renewal"); ok {
 		disableSessionRenewal = cloudflare.BoolPtr(b.(bool))
@@ -36,12 +45,12 @@ func expandWaitingRoomEvent(d *schema.ResourceData) (cloudflare.WaitingRoomEvent
 
 	eventStartTime, err := time.Parse(time.RFC3339, d.Get("event_start_time").(string))
 	if err != nil {
-		return cloudflare.WaitingRoomEvent{}, err
+		return waitingRoomEvent{}, err
 	}
 
 	eventEndTime, err := time.Parse(time.RFC3339, d.Get("event_end_time").(string))
 	if err != nil {
-		return cloudflare.WaitingRoomEvent{}, err
+		return waitingRoomEvent{}, err
 	}
 
 	var prequeueStartTime *time.Time
@@ -49,24 +58,32 @@ func expandWaitingRoomEvent(d *schema.ResourceData) (cloudflare.WaitingRoomEvent
 		prequeueStartTimeValue, err := time.Parse(time.RFC3339, t.(string))
 		prequeueStartTime = cloudflare.TimePtr(prequeueStartTimeValue)
 		if err != nil {
-			return cloudflare.WaitingRoomEvent{}, err
+			return waitingRoomEvent{}, err
 		}
 	}
 
-	return cloudflare.WaitingRoomEvent{
-		Name:                  d.Get("name").(string),
-		EventStartTime:        eventStartTime,
-		EventEndTime:          eventEndTime,
-		PrequeueStartTime:     prequeueStartTime,
-		Description:           d.Get("description").(string),
-		QueueingMethod:        d.Get("queueing_method").(string),
-		ShuffleAtEventStart:   d.Get("shuffle_at_event_start").(bool),
-		Suspended:             d.Get("suspended").(bool),
-		TotalActiveUsers:      d.Get("total_active_users").(int),
-		NewUsersPerMinute:     d.Get("new_users_per_minute").(int),
-		CustomPageHTML:        d.Get("custom_page_html").(string),
-		SessionDuration:       d.Get("session_duration").(int),
-		DisableSessionRenewal: disableSessionRenewal,
+	var jsonResponseEnabled *bool
+	if b, ok := d.GetOkExists("json_response_enabled"); ok {
+		jsonResponseEnabled = cloudflare.BoolPtr(b.(bool))
+	}
+
+	return waitingRoomEvent{
+		WaitingRoomEvent: cloudflare.WaitingRoomEvent{
+			Name:                  d.Get("name").(string),
+			EventStartTime:        eventStartTime,
+			EventEndTime:          eventEndTime,
+			PrequeueStartTime:     prequeueStartTime,
+			Description:           d.Get("description").(string),
+			QueueingMethod:        d.Get("queueing_method").(string),
+			ShuffleAtEventStart:   d.Get("shuffle_at_event_start").(bool),
+			Suspended:             d.Get("suspended").(bool),
+			TotalActiveUsers:      d.Get("total_active_users").(int),
+			NewUsersPerMinute:     d.Get("new_users_per_minute").(int),
+			CustomPageHTML:        d.Get("custom_page_html").(string),
+			SessionDuration:       d.Get("session_duration").(int),
+			DisableSessionRenewal: disableSessionRenewal,
+		},
+		JSONResponseEnabled: jsonResponseEnabled,
 	}, nil
 }
 
@@ -81,7 +98,7 @@ func resourceCloudflareWaitingRoomEventCreate(ctx context.Context, d *schema.Res
 		return diag.FromErr(fmt.Errorf("error building waiting room event %q: %w", waitingRoomEventName, err))
 	}
 
-	waitingRoomEvent, err := client.CreateWaitingRoomEvent(ctx, zoneID, waitingRoomID, newWaitingRoomEvent)
+	waitingRoomEvent, err := writeWaitingRoomEvent(ctx, client, zoneID, waitingRoomID, http.MethodPost, newWaitingRoomEvent)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating waiting room event %q: %w", waitingRoomEventName, err))
@@ -97,7 +114,7 @@ func resourceCloudflareWaitingRoomEventRead(ctx context.Context, d *schema.Resou
 	waitingRoomID := d.Get("waiting_room_id").(string)
 	zoneID := d.Get("zone_id").(string)
 
-	waitingRoomEvent, err := client.WaitingRoomEvent(ctx, zoneID, waitingRoomID, d.Id())
+	waitingRoomEvent, err := getWaitingRoomEvent(ctx, client, zoneID, waitingRoomID, d.Id())
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -144,6 +161,10 @@ func resourceCloudflareWaitingRoomEventRead(ctx context.Context, d *schema.Resou
 		d.Set("disable_session_renewal", waitingRoomEvent.DisableSessionRenewal)
 	}
 
+	if waitingRoomEvent.JSONResponseEnabled != nil {
+		d.Set("json_response_enabled", waitingRoomEvent.JSONResponseEnabled)
+	}
+
 	return nil
 }
 
@@ -159,7 +180,7 @@ func resourceCloudflareWaitingRoomEventUpdate(ctx context.Context, d *schema.Res
 	}
 	waitingRoomEvent.ID = waitingRoomEventID
 
-	_, err = client.ChangeWaitingRoomEvent(ctx, zoneID, waitingRoomID, waitingRoomEvent)
+	_, err = writeWaitingRoomEvent(ctx, client, zoneID, waitingRoomID, http.MethodPatch, waitingRoomEvent)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating waiting room event %q: %w", waitingRoomEventName, err))
@@ -185,21 +206,15 @@ func resourceCloudflareWaitingRoomEventDelete(ctx context.Context, d *schema.Res
 
 func resourceCloudflareWaitingRoomEventImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*cloudflare.API)
-	idAttr := strings.SplitN(d.Id(), "/", 3)
-	var zoneID string
-	var waitingRoomID string
-	var waitingRoomEventID string
-	if len(idAttr) == 3 {
-		zoneID = idAttr[0]
-		waitingRoomID = idAttr[1]
-		waitingRoomEventID = idAttr[3]
-	} else {
+	idAttr := strings.Split(d.Id(), "/")
+	if len(idAttr) != 3 || idAttr[0] == "" || idAttr[1] == "" || idAttr[2] == "" {
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/waitingRoomID/eventID\" for import", d.Id())
 	}
+	zoneID, waitingRoomID, waitingRoomEventID := idAttr[0], idAttr[1], idAttr[2]
 
-	waitingRoomEvent, err := client.WaitingRoomEvent(ctx, zoneID, waitingRoomID, waitingRoomEventID)
+	waitingRoomEvent, err := getWaitingRoomEvent(ctx, client, zoneID, waitingRoomID, waitingRoomEventID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch Waiting room event %s", waitingRoomID)
+		return nil, fmt.Errorf("failed to fetch waiting room event %s for waiting room %s: %w", waitingRoomEventID, waitingRoomID, err)
 	}
 
 	d.SetId(waitingRoomEvent.ID)
@@ -210,3 +225,38 @@ func resourceCloudflareWaitingRoomEventImport(ctx context.Context, d *schema.Res
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func getWaitingRoomEvent(ctx context.Context, client *cloudflare.API, zoneID, waitingRoomID, eventID string) (waitingRoomEvent, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/waiting_rooms/%s/events/%s", zoneID, waitingRoomID, eventID), nil, nil)
+	if err != nil {
+		return waitingRoomEvent{}, err
+	}
+
+	var event waitingRoomEvent
+	if err := json.Unmarshal(res, &event); err != nil {
+		return waitingRoomEvent{}, fmt.Errorf("error unmarshalling waiting room event: %w", err)
+	}
+
+	return event, nil
+}
+
+// writeWaitingRoomEvent creates (POST) or updates (PATCH) a waiting room
+// event.
+func writeWaitingRoomEvent(ctx context.Context, client *cloudflare.API, zoneID, waitingRoomID, method string, event waitingRoomEvent) (waitingRoomEvent, error) {
+	uri := fmt.Sprintf("/zones/%s/waiting_rooms/%s/events", zoneID, waitingRoomID)
+	if method != http.MethodPost {
+		uri = fmt.Sprintf("%s/%s", uri, event.ID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, event, nil)
+	if err != nil {
+		return waitingRoomEvent{}, err
+	}
+
+	var result waitingRoomEvent
+	if err := json.Unmarshal(res, &result); err != nil {
+		return waitingRoomEvent{}, fmt.Errorf("error unmarshalling waiting room event: %w", err)
+	}
+
+	return result, nil
+}
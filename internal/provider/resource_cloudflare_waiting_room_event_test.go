@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -46,9 +48,22 @@ func TestAccCloudflareWaitingRoomEvent_Create(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "total_active_users", "405"),
 					resource.TestCheckResourceAttr(name, "session_duration", "10"),
 					resource.TestCheckResourceAttr(name, "shuffle_at_event_start", "false"),
+					resource.TestCheckResourceAttr(name, "json_response_enabled", "false"),
 					resource.TestCheckNoResourceAttr(name, "prequeue_start_time"),
 				),
 			},
+			{
+				ResourceName: name,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[name]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", name)
+					}
+					return fmt.Sprintf("%s/%s/%s", zoneID, rs.Primary.Attributes["waiting_room_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -103,6 +118,57 @@ resource "cloudflare_waiting_room_event" "%[1]s" {
   suspended                 = true
   description               = "my desc"
   session_duration          = 10
+  json_response_enabled     = false
 }
 `, resourceName, waitingRoomEventName, zoneID, waitingRoomID, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339), domain, waitingRoomName)
 }
+
+func TestCloudflareWaitingRoomEventImport(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const waitingRoomID = "699d98642c564d2e855e9661899b7252"
+	const eventID = "25756b2dfe6e378a06b033b670413757"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != fmt.Sprintf("/zones/%s/waiting_rooms/%s/events/%s", zoneID, waitingRoomID, eventID) {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, fmt.Sprintf(`{
+  "id": "%s",
+  "name": "production_webinar_event",
+  "event_start_time": "2021-09-28T15:30:00Z",
+  "event_end_time": "2021-09-28T17:00:00Z",
+  "json_response_enabled": false
+}`, eventID))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareWaitingRoomEventSchema(), map[string]interface{}{})
+	d.SetId(fmt.Sprintf("%s/%s/%s", zoneID, waitingRoomID, eventID))
+
+	if _, err := resourceCloudflareWaitingRoomEventImport(context.Background(), d, client); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d.Id() != eventID {
+		t.Errorf("expected ID %q, got %q", eventID, d.Id())
+	}
+	if got := d.Get("zone_id").(string); got != zoneID {
+		t.Errorf("expected zone_id %q, got %q", zoneID, got)
+	}
+	if got := d.Get("waiting_room_id").(string); got != waitingRoomID {
+		t.Errorf("expected waiting_room_id %q, got %q", waitingRoomID, got)
+	}
+	if got := d.Get("name").(string); got != "production_webinar_event" {
+		t.Errorf("expected name %q, got %q", "production_webinar_event", got)
+	}
+	if v, ok := d.GetOkExists("json_response_enabled"); !ok || v.(bool) {
+		t.Errorf("expected json_response_enabled to be set to false, got %v", v)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zoneID, waitingRoomID))
+	if _, err := resourceCloudflareWaitingRoomEventImport(context.Background(), d, client); err == nil {
+		t.Error("expected an error for an ID without the event ID")
+	}
+}
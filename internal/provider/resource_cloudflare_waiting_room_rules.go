@@ -59,8 +59,11 @@ func expandWaitingRoomRules(d *schema.ResourceData) ([]cloudflare.WaitingRoomRul
 	return waitingRoomRules, nil
 }
 
+// flattenWaitingRoomRules keeps the rules in the order returned by the API,
+// which is the order they're evaluated in, so reordering them shows up as a
+// change.
 func flattenWaitingRoomRules(rules []cloudflare.WaitingRoomRule) interface{} {
-	var rulesData []map[string]interface{}
+	rulesData := make([]map[string]interface{}, 0, len(rules))
 	for _, r := range rules {
 		rule := map[string]interface{}{
 			"id":         r.ID,
@@ -119,7 +122,7 @@ func resourceCloudflareWaitingRoomRulesRead(ctx context.Context, d *schema.Resou
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("error getting waiting room rules %q: %w", d.Get("name").(string), err))
+		return diag.FromErr(fmt.Errorf("error getting waiting room rules %q: %w", waitingRoomID, err))
 	}
 
 	if err := d.Set("rules", flattenWaitingRoomRules(waitingRoomRules)); err != nil {
@@ -135,7 +138,7 @@ func resourceCloudflareWaitingRoomRulesUpdate(ctx context.Context, d *schema.Res
 	zoneID := d.Get("zone_id").(string)
 	waitingRoomRules, err := expandWaitingRoomRules(d)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error building waiting room rules%q: %w", waitingRoomID, err))
+		return diag.FromErr(fmt.Errorf("error building waiting room rules %q: %w", waitingRoomID, err))
 	}
 
 	_, err = client.ReplaceWaitingRoomRules(ctx, cloudflare.ResourceIdentifier(zoneID), cloudflare.ReplaceWaitingRoomRuleParams{
@@ -169,14 +172,14 @@ func resourceCloudflareWaitingRoomRulesDelete(ctx context.Context, d *schema.Res
 
 func resourceCloudflareWaitingRoomRulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*cloudflare.API)
-	idAttr := strings.SplitN(d.Id(), "/", 3)
+	idAttr := strings.Split(d.Id(), "/")
 	var zoneID string
 	var waitingRoomID string
 	if len(idAttr) == 2 {
 		zoneID = idAttr[0]
 		waitingRoomID = idAttr[1]
 	} else {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/waitingRoomID/\" for import", d.Id())
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/waitingRoomID\" for import", d.Id())
 	}
 
 	_, err := client.ListWaitingRoomRules(ctx, cloudflare.ResourceIdentifier(zoneID), cloudflare.ListWaitingRoomRuleParams{
@@ -45,6 +45,22 @@ func TestAccCloudflareWaitingRoomRules_Create(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "rules.1.version", "1"),
 				),
 			},
+			{
+				Config: testAccCloudflareWaitingRoomRulesReordered(rnd, zoneID, domain, waitingRoomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "rules.0.description", "query string bypass"),
+					resource.TestCheckResourceAttr(name, "rules.0.status", "enabled"),
+					resource.TestCheckResourceAttr(name, "rules.1.description", "ip bypass"),
+					resource.TestCheckResourceAttr(name, "rules.1.status", "enabled"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
 		},
 	})
 }
@@ -110,3 +126,39 @@ resource "cloudflare_waiting_room_rules" "%[1]s" {
 }
 `, resourceName, zoneID, domain, waitingRoomName)
 }
+
+func testAccCloudflareWaitingRoomRulesReordered(resourceName, zoneID, domain, waitingRoomName string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_waiting_room" "%[1]s" {
+  name                      = "%[4]s"
+  zone_id                   = "%[2]s"
+  host                      = "www.%[3]s"
+  new_users_per_minute      = 400
+  total_active_users        = 405
+  path                      = "/foobar"
+  session_duration          = 10
+  custom_page_html          = "foobar"
+  description               = "my desc"
+  disable_session_renewal   = true
+  suspended                 = true
+  queue_all                 = false
+  json_response_enabled     = true
+}
+
+resource "cloudflare_waiting_room_rules" "%[1]s" {
+  zone_id         = "%[2]s"
+  waiting_room_id = cloudflare_waiting_room.%[1]s.id
+
+  rules {
+    action      = "bypass_waiting_room"
+    expression  = "http.request.uri.query contains \"bypass=true\""
+    description = "query string bypass"
+  }
+
+  rules {
+    action      = "bypass_waiting_room"
+    expression  = "ip.src in {1.2.3.4}"
+    description = "ip bypass"
+  }
+}`, resourceName, zoneID, domain, waitingRoomName)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// waitingRoomSettings are the zone level settings shared by all waiting
+// rooms of a zone.
+type waitingRoomSettings struct {
+	SearchEngineCrawlerBypass bool `json:"search_engine_crawler_bypass"`
+}
+
+func resourceCloudflareWaitingRoomSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWaitingRoomSettingsSchema(),
+		CreateContext: resourceCloudflareWaitingRoomSettingsCreate,
+		ReadContext:   resourceCloudflareWaitingRoomSettingsRead,
+		UpdateContext: resourceCloudflareWaitingRoomSettingsUpdate,
+		DeleteContext: resourceCloudflareWaitingRoomSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWaitingRoomSettingsImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Waiting Room Settings resource. There is a
+			single instance of the settings per zone, which apply to all of
+			its waiting rooms.
+		`),
+	}
+}
+
+func resourceCloudflareWaitingRoomSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("zone_id").(string))
+
+	return resourceCloudflareWaitingRoomSettingsUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareWaitingRoomSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/waiting_rooms/settings", zoneID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting waiting room settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings waitingRoomSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling waiting room settings: %w", err))
+	}
+
+	d.Set("search_engine_crawler_bypass", settings.SearchEngineCrawlerBypass)
+
+	return nil
+}
+
+func resourceCloudflareWaitingRoomSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	settings := waitingRoomSettings{
+		SearchEngineCrawlerBypass: d.Get("search_engine_crawler_bypass").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare waiting room settings for zone %q: %+v", zoneID, settings))
+
+	if _, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/waiting_rooms/settings", zoneID), settings, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating waiting room settings for zone %q: %w", zoneID, err))
+	}
+
+	return resourceCloudflareWaitingRoomSettingsRead(ctx, d, meta)
+}
+
+// resourceCloudflareWaitingRoomSettingsDelete resets the settings to their
+// defaults as they can't be removed from a zone.
+func resourceCloudflareWaitingRoomSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	if _, err := client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/waiting_rooms/settings", zoneID), waitingRoomSettings{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting waiting room settings for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWaitingRoomSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareWaitingRoomSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareWaitingRoomSettings_CreateThenUpdate(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_waiting_room_settings.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWaitingRoomSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWaitingRoomSettings(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "search_engine_crawler_bypass", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareWaitingRoomSettings(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "search_engine_crawler_bypass", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareWaitingRoomSettings(resourceName, zoneID string, searchEngineCrawlerBypass bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_waiting_room_settings" "%[1]s" {
+  zone_id                      = "%[2]s"
+  search_engine_crawler_bypass = %[3]t
+}`, resourceName, zoneID, searchEngineCrawlerBypass)
+}
+
+func testAccCheckCloudflareWaitingRoomSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_waiting_room_settings" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, fmt.Sprintf("/zones/%s/waiting_rooms/settings", rs.Primary.Attributes["zone_id"]), nil, nil)
+		if err != nil {
+			return fmt.Errorf("error reading waiting room settings: %w", err)
+		}
+
+		var settings waitingRoomSettings
+		if err := json.Unmarshal(res, &settings); err != nil {
+			return err
+		}
+
+		if settings.SearchEngineCrawlerBypass {
+			return fmt.Errorf("waiting room settings were not reset to their defaults")
+		}
+	}
+
+	return nil
+}
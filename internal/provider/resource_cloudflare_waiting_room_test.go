@@ -8,6 +8,7 @@ import (
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -40,12 +41,46 @@ func TestAccCloudflareWaitingRoom_Create(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "total_active_users", "405"),
 					resource.TestCheckResourceAttr(name, "session_duration", "10"),
 					resource.TestCheckResourceAttr(name, "json_response_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "queueing_status_code", "202"),
+					resource.TestCheckResourceAttr(name, "cookie_suffix", "queue1"),
+					resource.TestCheckResourceAttr(name, "enabled_origin_commands.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "enabled_origin_commands.*", "revoke"),
+					resource.TestCheckResourceAttr(name, "additional_routes.#", "2"),
+					resource.TestCheckResourceAttr(name, "additional_routes.0.host", "shop."+domain),
+					resource.TestCheckResourceAttr(name, "additional_routes.0.path", "/foobar"),
+					resource.TestCheckResourceAttr(name, "additional_routes.1.host", "www."+domain),
+					resource.TestCheckResourceAttr(name, "additional_routes.1.path", "/checkout"),
 				),
 			},
 		},
 	})
 }
 
+func TestSuppressWaitingRoomAdditionalRouteHost(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareWaitingRoomSchema(), map[string]interface{}{
+		"host": "www.example.com",
+	})
+
+	testCases := map[string]struct {
+		old, new string
+		expected bool
+	}{
+		"omitted host filled in by the API": {old: "www.example.com", new: "", expected: true},
+		"omitted host differs in case":      {old: "WWW.example.com", new: "", expected: true},
+		"explicit host on create":           {old: "", new: "www.example.com", expected: false},
+		"different host":                    {old: "shop.example.com", new: "", expected: false},
+		"host changed":                      {old: "shop.example.com", new: "www.example.com", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := suppressWaitingRoomAdditionalRouteHost("additional_routes.0.host", tc.old, tc.new, d); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
 func testAccCheckCloudflareWaitingRoomDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -81,6 +116,18 @@ resource "cloudflare_waiting_room" "%[1]s" {
   suspended                 = true
   queue_all                 = false
   json_response_enabled     = true
+  queueing_status_code      = 202
+  cookie_suffix             = "queue1"
+  enabled_origin_commands   = ["revoke"]
+
+  additional_routes {
+    host = "shop.%[4]s"
+    path = "%[5]s"
+  }
+
+  additional_routes {
+    path = "/checkout"
+  }
 }
 `, resourceName, waitingRoomName, zoneID, domain, path)
 }
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// webAnalyticsRule is the representation of a path rule in the ruleset of
+// a Web Analytics site.
+type webAnalyticsRule struct {
+	ID        string   `json:"id,omitempty"`
+	Host      string   `json:"host"`
+	Paths     []string `json:"paths"`
+	Inclusive bool     `json:"inclusive"`
+	IsPaused  bool     `json:"is_paused"`
+}
+
+// webAnalyticsRuleList is the result of listing the rules of a ruleset.
+type webAnalyticsRuleList struct {
+	Rules []webAnalyticsRule `json:"rules"`
+}
+
+func resourceCloudflareWebAnalyticsRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWebAnalyticsRuleSchema(),
+		CreateContext: resourceCloudflareWebAnalyticsRuleCreate,
+		ReadContext:   resourceCloudflareWebAnalyticsRuleRead,
+		UpdateContext: resourceCloudflareWebAnalyticsRuleUpdate,
+		DeleteContext: resourceCloudflareWebAnalyticsRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWebAnalyticsRuleImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Web Analytics Rule resource.
+		`),
+	}
+}
+
+func resourceCloudflareWebAnalyticsRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Web Analytics Rule in ruleset %s", rulesetID))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/rum/v2/%s/rule", accountID, rulesetID), buildWebAnalyticsRule(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Web Analytics Rule: %w", err))
+	}
+
+	var created webAnalyticsRule
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Web Analytics Rule: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareWebAnalyticsRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	rules, err := listWebAnalyticsRules(ctx, client, accountID, rulesetID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Web Analytics ruleset %s no longer exists", rulesetID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Web Analytics Rule %q: %w", d.Id(), err))
+	}
+
+	for _, rule := range rules {
+		if rule.ID != d.Id() {
+			continue
+		}
+
+		d.Set("host", rule.Host)
+		d.Set("paths", rule.Paths)
+		d.Set("inclusive", rule.Inclusive)
+		d.Set("is_paused", rule.IsPaused)
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Web Analytics Rule %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Web Analytics Rule %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/rum/v2/%s/rule/%s", accountID, rulesetID, d.Id()), buildWebAnalyticsRule(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Web Analytics Rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareWebAnalyticsRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Web Analytics Rule %s", d.Id()))
+
+	if err := deleteWebAnalyticsRule(ctx, client, accountID, rulesetID, d.Id()); err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting Web Analytics Rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/rulesetID/ruleID\"", d.Id())
+	}
+
+	accountID, rulesetID, ruleID := attributes[0], attributes[1], attributes[2]
+
+	d.Set("account_id", accountID)
+	d.Set("ruleset_id", rulesetID)
+	d.SetId(ruleID)
+
+	resourceCloudflareWebAnalyticsRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildWebAnalyticsRule(d *schema.ResourceData) webAnalyticsRule {
+	return webAnalyticsRule{
+		Host:      d.Get("host").(string),
+		Paths:     expandInterfaceToStringList(d.Get("paths")),
+		Inclusive: d.Get("inclusive").(bool),
+		IsPaused:  d.Get("is_paused").(bool),
+	}
+}
+
+func listWebAnalyticsRules(ctx context.Context, client *cloudflare.API, accountID, rulesetID string) ([]webAnalyticsRule, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rum/v2/%s/rules", accountID, rulesetID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var list webAnalyticsRuleList
+	if err := json.Unmarshal(res, &list); err != nil {
+		return nil, fmt.Errorf("error unmarshalling Web Analytics Rules: %w", err)
+	}
+
+	return list.Rules, nil
+}
+
+func deleteWebAnalyticsRule(ctx context.Context, client *cloudflare.API, accountID, rulesetID, ruleID string) error {
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/rum/v2/%s/rule/%s", accountID, rulesetID, ruleID), nil, nil)
+	return err
+}
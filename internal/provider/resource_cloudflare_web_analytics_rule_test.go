@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareWebAnalyticsRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_web_analytics_rule." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWebAnalyticsRuleConfig(rnd, accountID, zoneID, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttrPair(resourceName, "ruleset_id", "cloudflare_web_analytics_site."+rnd, "ruleset_id"),
+					resource.TestCheckResourceAttr(resourceName, "host", domain),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "paths.0", "/excluded"),
+					resource.TestCheckResourceAttr(resourceName, "inclusive", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareWebAnalyticsRuleConfig(rnd, accountID, zoneID, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCloudflareWebAnalyticsRuleImportStateIdFunc(resourceName, accountID),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareWebAnalyticsRuleImportStateIdFunc(resourceName, accountID string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", accountID, rs.Primary.Attributes["ruleset_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCloudflareWebAnalyticsRuleConfig(rnd, accountID, zoneID, domain string, paused bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_web_analytics_site" "%[1]s" {
+  account_id   = "%[2]s"
+  zone_tag     = "%[3]s"
+  auto_install = true
+}
+
+resource "cloudflare_web_analytics_rule" "%[1]s" {
+  account_id = "%[2]s"
+  ruleset_id = cloudflare_web_analytics_site.%[1]s.ruleset_id
+  host       = "%[4]s"
+  paths      = ["/excluded"]
+  inclusive  = false
+  is_paused  = %[5]t
+}
+`, rnd, accountID, zoneID, domain, paused)
+}
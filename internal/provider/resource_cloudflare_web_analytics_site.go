@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// webAnalyticsSite is the representation of a Web Analytics site.
+type webAnalyticsSite struct {
+	SiteTag     string                   `json:"site_tag,omitempty"`
+	SiteToken   string                   `json:"site_token,omitempty"`
+	Host        string                   `json:"host,omitempty"`
+	ZoneTag     string                   `json:"zone_tag,omitempty"`
+	AutoInstall bool                     `json:"auto_install"`
+	Snippet     string                   `json:"snippet,omitempty"`
+	Ruleset     *webAnalyticsSiteRuleset `json:"ruleset,omitempty"`
+}
+
+type webAnalyticsSiteRuleset struct {
+	ID      string `json:"id"`
+	ZoneTag string `json:"zone_tag"`
+}
+
+func resourceCloudflareWebAnalyticsSite() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWebAnalyticsSiteSchema(),
+		CreateContext: resourceCloudflareWebAnalyticsSiteCreate,
+		ReadContext:   resourceCloudflareWebAnalyticsSiteRead,
+		UpdateContext: resourceCloudflareWebAnalyticsSiteUpdate,
+		DeleteContext: resourceCloudflareWebAnalyticsSiteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWebAnalyticsSiteImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Web Analytics Site resource.
+		`),
+	}
+}
+
+func resourceCloudflareWebAnalyticsSiteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	site := buildWebAnalyticsSite(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Web Analytics Site for account %s", accountID))
+
+	res, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/rum/site_info", accountID), site, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Web Analytics Site: %w", err))
+	}
+
+	var created webAnalyticsSite
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Web Analytics Site: %w", err))
+	}
+
+	d.SetId(created.SiteTag)
+
+	return resourceCloudflareWebAnalyticsSiteRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsSiteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rum/site_info/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Web Analytics Site %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	var site webAnalyticsSite
+	if err := json.Unmarshal(res, &site); err != nil {
+		return diag.FromErr(fmt.Errorf("error unmarshalling Web Analytics Site: %w", err))
+	}
+
+	d.Set("site_tag", site.SiteTag)
+	d.Set("site_token", site.SiteToken)
+	d.Set("auto_install", site.AutoInstall)
+	d.Set("snippet", site.Snippet)
+
+	// sites are either tied to a zone or to a host and only the one that
+	// was used to create (or import) the site is tracked.
+	if site.Ruleset != nil {
+		d.Set("ruleset_id", site.Ruleset.ID)
+
+		if d.Get("host").(string) == "" {
+			d.Set("zone_tag", site.Ruleset.ZoneTag)
+		}
+	}
+
+	if d.Get("zone_tag").(string) == "" {
+		d.Set("host", site.Host)
+	}
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	site := buildWebAnalyticsSite(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Web Analytics Site %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/rum/site_info/%s", accountID, d.Id()), site, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareWebAnalyticsSiteRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	// rules belong to the site's ruleset and are removed first so that
+	// nothing is left behind once the site is gone.
+	if rulesetID := d.Get("ruleset_id").(string); rulesetID != "" {
+		rules, err := listWebAnalyticsRules(ctx, client, accountID, rulesetID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing rules of Web Analytics Site %q: %w", d.Id(), err))
+		}
+
+		for _, rule := range rules {
+			tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Web Analytics Rule %s of site %s", rule.ID, d.Id()))
+
+			if err := deleteWebAnalyticsRule(ctx, client, accountID, rulesetID, rule.ID); err != nil {
+				return diag.FromErr(fmt.Errorf("error deleting Web Analytics Rule %q: %w", rule.ID, err))
+			}
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Web Analytics Site %s", d.Id()))
+
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/accounts/%s/rum/site_info/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsSiteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 || attributes[0] != "account" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/siteTag\"", d.Id())
+	}
+
+	accountID, siteTag := attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Web Analytics Site: site tag %s for account %s", siteTag, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(siteTag)
+
+	resourceCloudflareWebAnalyticsSiteRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildWebAnalyticsSite(d *schema.ResourceData) webAnalyticsSite {
+	return webAnalyticsSite{
+		Host:        d.Get("host").(string),
+		ZoneTag:     d.Get("zone_tag").(string),
+		AutoInstall: d.Get("auto_install").(bool),
+	}
+}
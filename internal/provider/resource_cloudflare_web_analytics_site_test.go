@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWebAnalyticsSite_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_web_analytics_site." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWebAnalyticsSiteConfig(rnd, accountID, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", accountID),
+					resource.TestCheckResourceAttr(resourceName, "zone_tag", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "auto_install", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "site_tag"),
+					resource.TestCheckResourceAttrSet(resourceName, "site_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "snippet"),
+					resource.TestCheckResourceAttrSet(resourceName, "ruleset_id"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("account/%s/", accountID),
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareWebAnalyticsSiteConfig(rnd, accountID, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_web_analytics_site" "%[1]s" {
+  account_id   = "%[2]s"
+  zone_tag     = "%[3]s"
+  auto_install = true
+}
+`, rnd, accountID, zoneID)
+}
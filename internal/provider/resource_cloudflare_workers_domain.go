@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWorkersDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkersDomainSchema(),
+		CreateContext: resourceCloudflareWorkersDomainAttach,
+		ReadContext:   resourceCloudflareWorkersDomainRead,
+		UpdateContext: resourceCloudflareWorkersDomainAttach,
+		DeleteContext: resourceCloudflareWorkersDomainDetach,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkersDomainImport,
+		},
+		Description: heredoc.Doc(`
+			Creates a Worker Custom Domain. Unlike routes, Custom Domains
+			point all paths of a domain or subdomain to a Worker and
+			manage the DNS record and certificate on your behalf.
+		`),
+	}
+}
+
+func resourceCloudflareWorkersDomainAttach(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	params := cloudflare.AttachWorkersDomainParams{
+		ZoneID:      d.Get("zone_id").(string),
+		Hostname:    d.Get("hostname").(string),
+		Service:     d.Get("service").(string),
+		Environment: d.Get("environment").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Attaching Cloudflare Workers Domain from struct: %+v", params))
+
+	domain, err := client.AttachWorkersDomain(ctx, cloudflare.AccountIdentifier(accountID), params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching workers domain %q: %w", params.Hostname, err))
+	}
+
+	d.SetId(domain.ID)
+
+	return resourceCloudflareWorkersDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	domain, err := client.GetWorkersDomain(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Workers domain %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading workers domain %q: %w", d.Id(), err))
+	}
+
+	d.Set("zone_id", domain.ZoneID)
+	d.Set("hostname", domain.Hostname)
+	d.Set("service", domain.Service)
+	d.Set("environment", domain.Environment)
+
+	return nil
+}
+
+// resourceCloudflareWorkersDomainDetach only removes the association between
+// the hostname and the Worker; neither the Worker script nor the zone are
+// touched.
+func resourceCloudflareWorkersDomainDetach(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Detaching Cloudflare Workers Domain: %s", d.Id()))
+
+	err := client.DetachWorkersDomain(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("error detaching workers domain %q: %w", d.Id(), err))
+		}
+		tflog.Info(ctx, fmt.Sprintf("Workers domain %s was already detached", d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareWorkersDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+	if len(attributes) != 3 || attributes[0] != "account" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"account/accountID/domainID\"", d.Id())
+	}
+
+	accountID, domainID := attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Workers Domain: id %s for account %s", domainID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(domainID)
+
+	resourceCloudflareWorkersDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
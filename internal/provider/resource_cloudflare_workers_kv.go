@@ -2,8 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"strings"
+	"unicode/utf8"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -52,7 +58,36 @@ func resourceCloudflareWorkersKVRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set("account_id", accountID)
-	d.Set("value", string(value))
+
+	// Values are kept in the attribute they're configured with, imported
+	// pairs use `value_base64` only when their value isn't valid UTF-8.
+	_, withBase64 := d.GetOk("value_base64")
+	if _, ok := d.GetOk("value"); !ok && !withBase64 {
+		withBase64 = !utf8.Valid(value)
+	}
+
+	if withBase64 {
+		d.Set("value_base64", base64.StdEncoding.EncodeToString(value))
+	} else {
+		d.Set("value", string(value))
+	}
+
+	metadata, err := getWorkersKVMetadata(ctx, client, accountID, namespaceID, key)
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, "error reading workers kv metadata"))
+	}
+	d.Set("metadata", metadata)
+
+	// Writes with a TTL are returned with the absolute time they expire at,
+	// so the expiration is only read when it's set.
+	if _, ok := d.GetOk("expiration"); ok {
+		expiration, err := getWorkersKVExpiration(ctx, client, accountID, namespaceID, key)
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, "error reading workers kv expiration"))
+		}
+		d.Set("expiration", expiration)
+	}
+
 	return nil
 }
 
@@ -60,17 +95,31 @@ func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*cloudflare.API)
 	namespaceID := d.Get("namespace_id").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
 
 	accountID := d.Get("account_id").(string)
 	if accountID == "" {
 		accountID = client.AccountID
 	}
 
-	_, err := client.WriteWorkersKVEntry(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.WriteWorkersKVEntryParams{
+	// The bulk endpoint is used as it's the only one writing the metadata
+	// along with the value.
+	pair := &cloudflare.WorkersKVPair{
+		Key:           key,
+		Value:         d.Get("value").(string),
+		Expiration:    d.Get("expiration").(int),
+		ExpirationTTL: d.Get("expiration_ttl").(int),
+	}
+	if value, ok := d.GetOk("value_base64"); ok {
+		pair.Value = value.(string)
+		pair.Base64 = true
+	}
+	if metadata, ok := d.GetOk("metadata"); ok {
+		pair.Metadata = json.RawMessage(metadata.(string))
+	}
+
+	_, err := client.WriteWorkersKVEntries(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.WriteWorkersKVEntriesParams{
 		NamespaceID: namespaceID,
-		Key:         key,
-		Value:       []byte(value),
+		KVs:         []*cloudflare.WorkersKVPair{pair},
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating workers kv"))
@@ -129,3 +178,59 @@ func parseId(id string) (string, string, error) {
 	}
 	return parts[0], parts[1], nil
 }
+
+// getWorkersKVMetadata returns the JSON encoded metadata of a KV pair, or an
+// empty string if it has none.
+func getWorkersKVMetadata(ctx context.Context, client *cloudflare.API, accountID, namespaceID, key string) (string, error) {
+	uri := fmt.Sprintf("/accounts/%s/storage/kv/namespaces/%s/metadata/%s", accountID, namespaceID, url.PathEscape(key))
+	res, err := client.Raw(ctx, http.MethodGet, uri, nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if len(res) == 0 || string(res) == "null" {
+		return "", nil
+	}
+
+	return string(res), nil
+}
+
+// getWorkersKVExpiration returns the time, in seconds since the UNIX epoch,
+// at which a KV pair expires.
+func getWorkersKVExpiration(ctx context.Context, client *cloudflare.API, accountID, namespaceID, key string) (int, error) {
+	params := cloudflare.ListWorkersKVsParams{NamespaceID: namespaceID, Prefix: key}
+	for {
+		keys, err := client.ListWorkersKVKeys(ctx, cloudflare.AccountIdentifier(accountID), params)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, storageKey := range keys.Result {
+			if storageKey.Name == key {
+				return storageKey.Expiration, nil
+			}
+		}
+
+		if keys.Cursor == "" {
+			return 0, nil
+		}
+		params.Cursor = keys.Cursor
+	}
+}
+
+func suppressEquivalentWorkersKVMetadata(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	var decodedOld, decodedNew interface{}
+	if json.Unmarshal([]byte(old), &decodedOld) != nil || json.Unmarshal([]byte(new), &decodedNew) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(decodedOld, decodedNew)
+}
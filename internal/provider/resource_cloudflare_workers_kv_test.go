@@ -2,12 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -108,6 +113,39 @@ func TestAccCloudflareWorkersKV_WithAccountID(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkersKV_MetadataAndExpiration(t *testing.T) {
+	t.Parallel()
+	var kvPair cloudflare.WorkersKVPair
+	name := generateRandomResourceName()
+	key := generateRandomResourceName()
+	resourceName := "cloudflare_workers_kv." + name
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCloudflareWorkersKVDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkersKVMetadataAndExpiration(name, key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkersKVExists(key, &kvPair),
+					resource.TestCheckResourceAttr(resourceName, "value_base64", "aGVsbG8gd29ybGQ="),
+					resource.TestCheckResourceAttr(resourceName, "expiration_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "metadata", `{"enabled":true}`),
+				),
+			},
+			{
+				// Writes with a TTL must not drift.
+				Config:   testAccCheckCloudflareWorkersKVMetadataAndExpiration(name, key),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCloudflareWorkersKVDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -153,6 +191,17 @@ resource "cloudflare_workers_kv" "%[1]s" {
 }`, rName, key, value, accountID)
 }
 
+func testAccCheckCloudflareWorkersKVMetadataAndExpiration(rName string, key string) string {
+	return testAccCheckCloudflareWorkersKVNamespace(rName) + fmt.Sprintf(`
+resource "cloudflare_workers_kv" "%[1]s" {
+	namespace_id   = cloudflare_workers_kv_namespace.%[1]s.id
+	key            = "%[2]s"
+	value_base64   = "aGVsbG8gd29ybGQ="
+	expiration_ttl = 3600
+	metadata       = jsonencode({ enabled = true })
+}`, rName, key)
+}
+
 func testAccCheckCloudflareWorkersKVExists(key string, kv *cloudflare.WorkersKVPair) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*cloudflare.API)
@@ -181,3 +230,139 @@ func testAccCheckCloudflareWorkersKVExists(key string, kv *cloudflare.WorkersKVP
 		return nil
 	}
 }
+
+func TestCloudflareWorkersKVWritesMetadataAndExpiration(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const namespaceID = "0f2ac74b498b48028cb68387c421e279"
+	const key = "feature-flags"
+	const base = "/accounts/" + accountID + "/storage/kv/namespaces/" + namespaceID
+
+	testCases := map[string]struct {
+		config     map[string]interface{}
+		pair       map[string]interface{}
+		expiration int
+		listed     bool
+	}{
+		"ttl": {
+			config: map[string]interface{}{
+				"value_base64":   "AAEC",
+				"expiration_ttl": 3600,
+				"metadata":       `{"enabled": true}`,
+			},
+			pair: map[string]interface{}{"key": key, "value": "AAEC", "base64": true, "expiration_ttl": float64(3600), "metadata": map[string]interface{}{"enabled": true}},
+		},
+		"expiration": {
+			config: map[string]interface{}{
+				"value":      "on",
+				"expiration": 1893456000,
+			},
+			pair:       map[string]interface{}{"key": key, "value": "on", "expiration": float64(1893456000)},
+			expiration: 1893456000,
+			listed:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var written []map[string]interface{}
+			listed := false
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPut && r.URL.Path == base+"/bulk":
+					if err := json.NewDecoder(r.Body).Decode(&written); err != nil {
+						t.Fatal(err)
+					}
+					testAPIResult(w, `{}`)
+				case r.Method == http.MethodGet && r.URL.Path == base+"/values/"+key:
+					value := []byte(written[0]["value"].(string))
+					if written[0]["base64"] == true {
+						value, _ = base64.StdEncoding.DecodeString(string(value))
+					}
+					w.Write(value)
+				case r.Method == http.MethodGet && r.URL.Path == base+"/metadata/"+key:
+					testAPIResultJSON(w, written[0]["metadata"])
+				case r.Method == http.MethodGet && r.URL.Path == base+"/keys":
+					listed = true
+					testAPIResultWithInfo(w, fmt.Sprintf(`[{"name": "%s-old", "expiration": 1}, {"name": "%s", "expiration": %d}]`, key, key, tc.expiration), `{"cursor": ""}`)
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			config := map[string]interface{}{"account_id": accountID, "namespace_id": namespaceID, "key": key}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, resourceCloudflareWorkerKVSchema(), config)
+
+			if diags := resourceCloudflareWorkersKVUpdate(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if len(written) != 1 || !reflect.DeepEqual(written[0], tc.pair) {
+				t.Errorf("expected the pair %v to be written, got %v", tc.pair, written)
+			}
+			if listed != tc.listed {
+				t.Errorf("expected keys to be listed: %t, got %t", tc.listed, listed)
+			}
+
+			state := d.State().Attributes
+			for k, v := range tc.config {
+				if k == "metadata" {
+					v = `{"enabled":true}`
+				}
+				if got := state[k]; got != fmt.Sprint(v) {
+					t.Errorf("expected %s to be %v, got %q", k, v, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCloudflareWorkersKVImport(t *testing.T) {
+	const accountID = "f037e56e89293a057740de681ac9abbe"
+	const namespaceID = "0f2ac74b498b48028cb68387c421e279"
+	const key = "feature-flags"
+	const base = "/accounts/" + accountID + "/storage/kv/namespaces/" + namespaceID
+
+	testCases := map[string]struct {
+		value    []byte
+		expected map[string]string
+	}{
+		"text":   {value: []byte("on"), expected: map[string]string{"value": "on", "value_base64": ""}},
+		"binary": {value: []byte{0x00, 0xff, 0xfe}, expected: map[string]string{"value": "", "value_base64": "AP/+"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == base+"/values/"+key:
+					w.Write(tc.value)
+				case r.Method == http.MethodGet && r.URL.Path == base+"/metadata/"+key:
+					testAPIResult(w, `null`)
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}, cloudflare.UsingAccount(accountID))
+
+			r := resourceCloudflareWorkerKV()
+			d := r.Data(nil)
+			d.SetId(namespaceID + "/" + key)
+
+			imported, err := r.Importer.StateContext(context.Background(), d, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := imported[0].State().Attributes
+			for k, v := range tc.expected {
+				if got := state[k]; got != v {
+					t.Errorf("expected %s to be %q, got %q", k, v, got)
+				}
+			}
+		})
+	}
+}
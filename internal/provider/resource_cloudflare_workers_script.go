@@ -1,10 +1,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -51,56 +56,129 @@ func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData,
 	}, nil
 }
 
-type ScriptBindings map[string]cloudflare.WorkerBinding
+const (
+	workerScriptQueueBindingType      cloudflare.WorkerBindingType = "queue"
+	workerScriptD1DatabaseBindingType cloudflare.WorkerBindingType = "d1"
+)
+
+// workerScriptBinding is a single binding as it is sent in the upload
+// metadata and returned from the bindings endpoint. cloudflare-go doesn't
+// model every binding type (queues and D1 databases) so bindings are
+// (de)serialised here instead of using cloudflare.WorkerBinding.
+type workerScriptBinding struct {
+	Name        string                       `json:"name"`
+	Type        cloudflare.WorkerBindingType `json:"type"`
+	NamespaceID string                       `json:"namespace_id,omitempty"`
+	Text        string                       `json:"text,omitempty"`
+	Part        string                       `json:"part,omitempty"`
+	Service     string                       `json:"service,omitempty"`
+	Environment *string                      `json:"environment,omitempty"`
+	BucketName  string                       `json:"bucket_name,omitempty"`
+	Dataset     string                       `json:"dataset,omitempty"`
+	QueueName   string                       `json:"queue_name,omitempty"`
+	ID          string                       `json:"id,omitempty"`
+}
+
+// workerScriptMetadata is the metadata part of a worker script upload. The
+// script settings endpoint returns the same shape.
+type workerScriptMetadata struct {
+	BodyPart           string                `json:"body_part,omitempty"`
+	MainModule         string                `json:"main_module,omitempty"`
+	Bindings           []workerScriptBinding `json:"bindings"`
+	CompatibilityDate  string                `json:"compatibility_date,omitempty"`
+	CompatibilityFlags []string              `json:"compatibility_flags,omitempty"`
+	Logpush            *bool                 `json:"logpush,omitempty"`
+}
+
+type ScriptBindings map[string]workerScriptBinding
 
 func getWorkerScriptBindings(ctx context.Context, accountId, scriptName string, client *cloudflare.API) (ScriptBindings, error) {
-	resp, err := client.ListWorkerBindings(ctx, cloudflare.AccountIdentifier(accountId), cloudflare.ListWorkerBindingsParams{ScriptName: scriptName})
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/bindings", accountId, scriptName), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("cannot list script bindings: %w", err)
 	}
 
-	bindings := make(ScriptBindings, len(resp.BindingList))
+	var list []workerScriptBinding
+	if err := json.Unmarshal(res, &list); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal script bindings: %w", err)
+	}
 
-	for _, b := range resp.BindingList {
-		bindings[b.Name] = b.Binding
+	bindings := make(ScriptBindings, len(list))
+
+	for _, b := range list {
+		bindings[b.Name] = b
 	}
 
 	return bindings, nil
 }
 
-func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
+// getWorkerScriptWebAssemblyModule fetches the content of a WebAssembly
+// binding. The content endpoint doesn't return a JSON response so this relies
+// on the lazy reader cloudflare-go provides for these bindings.
+func getWorkerScriptWebAssemblyModule(ctx context.Context, accountId, scriptName, bindingName string, client *cloudflare.API) ([]byte, error) {
+	resp, err := client.ListWorkerBindings(ctx, cloudflare.AccountIdentifier(accountId), cloudflare.ListWorkerBindingsParams{ScriptName: scriptName})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list script bindings: %w", err)
+	}
+
+	for _, b := range resp.BindingList {
+		if v, ok := b.Binding.(cloudflare.WorkerWebAssemblyBinding); ok && b.Name == bindingName {
+			return ioutil.ReadAll(v.Module)
+		}
+	}
+
+	return nil, fmt.Errorf("cannot find wasm binding %s", bindingName)
+}
+
+// parseWorkerBindings builds the bindings from the resource configuration
+// along with any additional form parts they reference, keyed by part name.
+func parseWorkerBindings(d *schema.ResourceData) (ScriptBindings, map[string][]byte, error) {
+	bindings := make(ScriptBindings)
+	parts := make(map[string][]byte)
+
 	for _, rawData := range d.Get("kv_namespace_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerKvNamespaceBinding{
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type:        cloudflare.WorkerKvNamespaceBindingType,
 			NamespaceID: data["namespace_id"].(string),
 		}
 	}
 
 	for _, rawData := range d.Get("plain_text_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerPlainTextBinding{
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type: cloudflare.WorkerPlainTextBindingType,
 			Text: data["text"].(string),
 		}
 	}
 
 	for _, rawData := range d.Get("secret_text_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerSecretTextBinding{
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type: cloudflare.WorkerSecretTextBindingType,
 			Text: data["text"].(string),
 		}
 	}
 
 	for _, rawData := range d.Get("webassembly_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		module := base64.NewDecoder(base64.StdEncoding, strings.NewReader(data["module"].(string)))
-		bindings[data["name"].(string)] = cloudflare.WorkerWebAssemblyBinding{
-			Module: module,
+		name := data["name"].(string)
+		module, err := base64.StdEncoding.DecodeString(data["module"].(string))
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot decode wasm module for binding %s: %w", name, err)
+		}
+		partName := "wasm_" + name
+		parts[partName] = module
+		bindings[name] = workerScriptBinding{
+			Type: cloudflare.WorkerWebAssemblyBindingType,
+			Part: partName,
 		}
 	}
 
 	for _, rawData := range d.Get("service_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerServiceBinding{
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type:        cloudflare.WorkerServiceBindingType,
 			Service:     data["service"].(string),
 			Environment: cloudflare.StringPtr(data["environment"].(string)),
 		}
@@ -108,17 +186,148 @@ func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
 
 	for _, rawData := range d.Get("r2_bucket_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerR2BucketBinding{
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type:       cloudflare.WorkerR2BucketBindingType,
 			BucketName: data["bucket_name"].(string),
 		}
 	}
 
 	for _, rawData := range d.Get("analytics_engine_binding").(*schema.Set).List() {
 		data := rawData.(map[string]interface{})
-		bindings[data["name"].(string)] = cloudflare.WorkerAnalyticsEngineBinding{
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type:    cloudflare.WorkerAnalyticsEngineBindingType,
 			Dataset: data["dataset"].(string),
 		}
 	}
+
+	for _, rawData := range d.Get("queue_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		bindings[data["binding"].(string)] = workerScriptBinding{
+			Type:      workerScriptQueueBindingType,
+			QueueName: data["queue"].(string),
+		}
+	}
+
+	for _, rawData := range d.Get("d1_database_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = workerScriptBinding{
+			Type: workerScriptD1DatabaseBindingType,
+			ID:   data["database_id"].(string),
+		}
+	}
+
+	return bindings, parts, nil
+}
+
+// getWorkerScriptSettings fetches the settings, such as the compatibility
+// date, that were uploaded alongside the script.
+func getWorkerScriptSettings(ctx context.Context, accountId, scriptName string, client *cloudflare.API) (workerScriptMetadata, error) {
+	var settings workerScriptMetadata
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/settings", accountId, scriptName), nil, nil)
+	if err != nil {
+		return settings, fmt.Errorf("cannot fetch script settings: %w", err)
+	}
+
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return settings, fmt.Errorf("cannot unmarshal script settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// parseWorkerScriptSettings builds the upload metadata for the script
+// settings in the resource configuration.
+func parseWorkerScriptSettings(d *schema.ResourceData) workerScriptMetadata {
+	meta := workerScriptMetadata{
+		CompatibilityDate: d.Get("compatibility_date").(string),
+		Logpush:           cloudflare.BoolPtr(d.Get("logpush").(bool)),
+	}
+
+	for _, flag := range d.Get("compatibility_flags").([]interface{}) {
+		meta.CompatibilityFlags = append(meta.CompatibilityFlags, flag.(string))
+	}
+
+	return meta
+}
+
+// uploadWorkerScript uploads the script content, settings and bindings as a
+// multipart form. Module syntax scripts are referenced from the metadata by
+// `main_module` whereas service worker scripts use `body_part`.
+func uploadWorkerScript(ctx context.Context, client *cloudflare.API, accountID, scriptName, script string, module bool, meta workerScriptMetadata, bindings ScriptBindings, parts map[string][]byte) error {
+	var buf bytes.Buffer
+	mpw := multipart.NewWriter(&buf)
+
+	meta.Bindings = make([]workerScriptBinding, 0, len(bindings))
+
+	scriptPartName, scriptContentType := "script", "application/javascript"
+	if module {
+		scriptPartName, scriptContentType = "worker.mjs", "application/javascript+module"
+		meta.MainModule = scriptPartName
+	} else {
+		meta.BodyPart = scriptPartName
+	}
+
+	for name, b := range bindings {
+		b.Name = name
+		meta.Bindings = append(meta.Bindings, b)
+	}
+
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("cannot marshal script metadata: %w", err)
+	}
+
+	hdr := textproto.MIMEHeader{}
+	hdr.Set("Content-Disposition", `form-data; name="metadata"`)
+	hdr.Set("Content-Type", "application/json")
+	if err := writeWorkerScriptPart(mpw, hdr, metaJSON); err != nil {
+		return err
+	}
+
+	hdr = textproto.MIMEHeader{}
+	if module {
+		hdr.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%[1]s"`, scriptPartName))
+	} else {
+		hdr.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, scriptPartName))
+	}
+	hdr.Set("Content-Type", scriptContentType)
+	if err := writeWorkerScriptPart(mpw, hdr, []byte(script)); err != nil {
+		return err
+	}
+
+	for partName, content := range parts {
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, partName))
+		hdr.Set("Content-Type", "application/wasm")
+		if err := writeWorkerScriptPart(mpw, hdr, content); err != nil {
+			return err
+		}
+	}
+
+	if err := mpw.Close(); err != nil {
+		return fmt.Errorf("cannot write script upload: %w", err)
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", mpw.FormDataContentType())
+
+	_, err = client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/workers/scripts/%s", accountID, scriptName), buf.Bytes(), headers)
+
+	return err
+}
+
+func writeWorkerScriptPart(mpw *multipart.Writer, hdr textproto.MIMEHeader, content []byte) error {
+	pw, err := mpw.CreatePart(hdr)
+	if err != nil {
+		return fmt.Errorf("cannot create script upload part: %w", err)
+	}
+
+	if _, err := pw.Write(content); err != nil {
+		return fmt.Errorf("cannot write script upload part: %w", err)
+	}
+
+	return nil
 }
 
 func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -147,16 +356,12 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 
 	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare Worker Script from struct: %+v", &scriptData.Params))
 
-	bindings := make(ScriptBindings)
-
-	parseWorkerBindings(d, bindings)
+	bindings, parts, err := parseWorkerBindings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	_, err = client.UploadWorker(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateWorkerParams{
-		ScriptName: scriptData.Params.ScriptName,
-		Script:     scriptBody,
-		Module:     d.Get("module").(bool),
-		Bindings:   bindings,
-	})
+	err = uploadWorkerScript(ctx, client, accountID, scriptData.Params.ScriptName, scriptBody, d.Get("module").(bool), parseWorkerScriptSettings(d), bindings, parts)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating worker script"))
 	}
@@ -193,9 +398,10 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 			fmt.Sprintf("Error reading worker script from API for resource %+v", &scriptData.Params)))
 	}
 
-	existingBindings := make(ScriptBindings)
-
-	parseWorkerBindings(d, existingBindings)
+	existingBindings, _, err := parseWorkerBindings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	bindings, err := getWorkerScriptBindings(ctx, accountID, d.Get("name").(string), client)
 	if err != nil {
@@ -209,31 +415,34 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 	serviceBindings := &schema.Set{F: schema.HashResource(serviceBindingResource)}
 	r2BucketBindings := &schema.Set{F: schema.HashResource(r2BucketBindingResource)}
 	analyticsEngineBindings := &schema.Set{F: schema.HashResource(analyticsEngineBindingResource)}
+	queueBindings := &schema.Set{F: schema.HashResource(queueBindingResource)}
+	d1DatabaseBindings := &schema.Set{F: schema.HashResource(d1DatabaseBindingResource)}
 
 	for name, binding := range bindings {
-		switch v := binding.(type) {
-		case cloudflare.WorkerKvNamespaceBinding:
+		switch binding.Type {
+		case cloudflare.WorkerKvNamespaceBindingType:
 			kvNamespaceBindings.Add(map[string]interface{}{
 				"name":         name,
-				"namespace_id": v.NamespaceID,
+				"namespace_id": binding.NamespaceID,
 			})
-		case cloudflare.WorkerPlainTextBinding:
+		case cloudflare.WorkerPlainTextBindingType:
 			plainTextBindings.Add(map[string]interface{}{
 				"name": name,
-				"text": v.Text,
+				"text": binding.Text,
 			})
-		case cloudflare.WorkerSecretTextBinding:
-			value := v.Text
-			switch v := existingBindings[name].(type) {
-			case cloudflare.WorkerSecretTextBinding:
-				value = v.Text
+		case cloudflare.WorkerSecretTextBindingType:
+			// the API never returns secret values so the configured value is
+			// kept as long as the binding still exists remotely.
+			value := binding.Text
+			if existing, ok := existingBindings[name]; ok && existing.Type == cloudflare.WorkerSecretTextBindingType {
+				value = existing.Text
 			}
 			secretTextBindings.Add(map[string]interface{}{
 				"name": name,
 				"text": value,
 			})
-		case cloudflare.WorkerWebAssemblyBinding:
-			module, err := ioutil.ReadAll(v.Module)
+		case cloudflare.WorkerWebAssemblyBindingType:
+			module, err := getWorkerScriptWebAssemblyModule(ctx, accountID, d.Get("name").(string), name, client)
 			if err != nil {
 				return diag.FromErr(errors.Wrap(err, fmt.Sprintf("cannot read contents of wasm bindings (%s)", name)))
 			}
@@ -241,21 +450,31 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 				"name":   name,
 				"module": base64.StdEncoding.EncodeToString(module),
 			})
-		case cloudflare.WorkerServiceBinding:
+		case cloudflare.WorkerServiceBindingType:
 			serviceBindings.Add(map[string]interface{}{
 				"name":        name,
-				"service":     v.Service,
-				"environment": cloudflare.String(v.Environment),
+				"service":     binding.Service,
+				"environment": cloudflare.String(binding.Environment),
 			})
-		case cloudflare.WorkerR2BucketBinding:
+		case cloudflare.WorkerR2BucketBindingType:
 			r2BucketBindings.Add(map[string]interface{}{
 				"name":        name,
-				"bucket_name": v.BucketName,
+				"bucket_name": binding.BucketName,
 			})
-		case cloudflare.WorkerAnalyticsEngineBinding:
+		case cloudflare.WorkerAnalyticsEngineBindingType:
 			analyticsEngineBindings.Add(map[string]interface{}{
 				"name":    name,
-				"dataset": v.Dataset,
+				"dataset": binding.Dataset,
+			})
+		case workerScriptQueueBindingType:
+			queueBindings.Add(map[string]interface{}{
+				"binding": name,
+				"queue":   binding.QueueName,
+			})
+		case workerScriptD1DatabaseBindingType:
+			d1DatabaseBindings.Add(map[string]interface{}{
+				"name":        name,
+				"database_id": binding.ID,
 			})
 		}
 	}
@@ -264,6 +483,27 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
 	}
 
+	if err := d.Set("module", r.Module); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set module: %w", err))
+	}
+
+	settings, err := getWorkerScriptSettings(ctx, accountID, d.Get("name").(string), client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("compatibility_date", settings.CompatibilityDate); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set compatibility date (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("compatibility_flags", settings.CompatibilityFlags); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set compatibility flags (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("logpush", cloudflare.Bool(settings.Logpush)); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set logpush (%s): %w", d.Id(), err))
+	}
+
 	if err := d.Set("kv_namespace_binding", kvNamespaceBindings); err != nil {
 		return diag.FromErr(fmt.Errorf("cannot set kv namespace bindings (%s): %w", d.Id(), err))
 	}
@@ -292,6 +532,14 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("cannot set analytics engine bindings (%s): %w", d.Id(), err))
 	}
 
+	if err := d.Set("queue_binding", queueBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set queue bindings (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("d1_database_binding", d1DatabaseBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set d1 database bindings (%s): %w", d.Id(), err))
+	}
+
 	d.SetId(scriptData.ID)
 
 	return nil
@@ -317,16 +565,12 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Worker Script from struct: %+v", &scriptData.Params))
 
-	bindings := make(ScriptBindings)
-
-	parseWorkerBindings(d, bindings)
+	bindings, parts, err := parseWorkerBindings(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	_, err = client.UploadWorker(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateWorkerParams{
-		ScriptName: scriptData.Params.ScriptName,
-		Script:     scriptBody,
-		Module:     d.Get("module").(bool),
-		Bindings:   bindings,
-	})
+	err = uploadWorkerScript(ctx, client, accountID, scriptData.Params.ScriptName, scriptBody, d.Get("module").(bool), parseWorkerScriptSettings(d), bindings, parts)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
 	}
@@ -31,7 +31,6 @@ func TestAccCloudflareWorkerScript_MultiScriptEnt(t *testing.T) {
 		PreCheck: func() {
 			testAccPreCheck(t)
 			testAccPreCheckAccount(t)
-			testAccCheckCloudflareWorkerScriptCreateBucket(t, rnd)
 		},
 		ProviderFactories: providerFactories,
 		CheckDestroy:      testAccCheckCloudflareWorkerScriptDestroy,
@@ -55,7 +54,7 @@ func TestAccCloudflareWorkerScript_MultiScriptEnt(t *testing.T) {
 			{
 				Config: testAccCheckCloudflareWorkerScriptConfigMultiScriptUpdateBinding(rnd, accountID),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCloudflareWorkerScriptExists(name, &script, []string{"MY_KV_NAMESPACE", "MY_PLAIN_TEXT", "MY_SECRET_TEXT", "MY_WASM", "MY_SERVICE_BINDING", "MY_BUCKET"}),
+					testAccCheckCloudflareWorkerScriptExists(name, &script, []string{"MY_KV_NAMESPACE", "MY_PLAIN_TEXT", "MY_SECRET_TEXT", "MY_WASM", "MY_SERVICE_BINDING", "MY_BUCKET", "MY_QUEUE", "MY_DATABASE"}),
 					resource.TestCheckResourceAttr(name, "name", rnd),
 					resource.TestCheckResourceAttr(name, "content", scriptContent2),
 				),
@@ -85,28 +84,23 @@ func TestAccCloudflareWorkerScript_ModuleUpload(t *testing.T) {
 					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
 					resource.TestCheckResourceAttr(name, "name", rnd),
 					resource.TestCheckResourceAttr(name, "content", moduleContent),
+					resource.TestCheckResourceAttr(name, "compatibility_date", "2022-08-16"),
+					resource.TestCheckResourceAttr(name, "compatibility_flags.#", "1"),
+					resource.TestCheckResourceAttr(name, "compatibility_flags.0", "nodejs_compat"),
+					resource.TestCheckResourceAttr(name, "logpush", "true"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkerScriptUploadModuleCompatibilityDate(rnd, accountID, "2023-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "compatibility_date", "2023-01-01"),
 				),
 			},
 		},
 	})
 }
 
-// Create a bucket before creating a worker script binding.
-// When a cloudflare_r2_bucket resource is added, we can switch to that instead
-func testAccCheckCloudflareWorkerScriptCreateBucket(t *testing.T, rnd string) {
-	client := testAccProvider.Meta().(*cloudflare.API)
-	err := client.CreateR2Bucket(context.Background(), cloudflare.AccountIdentifier(accountID), cloudflare.CreateR2BucketParameters{Name: rnd})
-	if err != nil {
-		t.Fatalf("unable to create test bucket named %s: %v", rnd, err)
-	}
-	t.Cleanup(func() {
-		err := client.DeleteR2Bucket(context.Background(), cloudflare.AccountIdentifier(accountID), rnd)
-		if err != nil {
-			t.Errorf("Failed to clean up bucket named %s: %v", rnd, err)
-		}
-	})
-}
-
 func testAccCheckCloudflareWorkerScriptConfigMultiScriptInitial(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_worker_script" "%[1]s" {
@@ -132,6 +126,21 @@ resource "cloudflare_workers_kv_namespace" "%[1]s" {
 	title = "%[1]s"
 }
 
+resource "cloudflare_r2_bucket" "%[1]s" {
+	account_id = "%[4]s"
+	name       = "%[1]s"
+}
+
+resource "cloudflare_queue" "%[1]s" {
+	account_id = "%[4]s"
+	name       = "%[1]s"
+}
+
+resource "cloudflare_d1_database" "%[1]s" {
+	account_id = "%[4]s"
+	name       = "%[1]s"
+}
+
 resource "cloudflare_worker_script" "%[1]s-service" {
 	account_id = "%[4]s"
 	name    = "%[1]s-service"
@@ -165,7 +174,17 @@ resource "cloudflare_worker_script" "%[1]s" {
 
   r2_bucket_binding {
 	name = "MY_BUCKET"
-	bucket_name = "%[1]s"
+	bucket_name = cloudflare_r2_bucket.%[1]s.name
+  }
+
+  queue_binding {
+    binding = "MY_QUEUE"
+    queue   = cloudflare_queue.%[1]s.name
+  }
+
+  d1_database_binding {
+    name        = "MY_DATABASE"
+    database_id = cloudflare_d1_database.%[1]s.id
   }
 
   service_binding {
@@ -183,9 +202,25 @@ resource "cloudflare_worker_script" "%[1]s" {
   name = "%[1]s"
   content = "%[2]s"
   module = true
+  compatibility_date = "2022-08-16"
+  compatibility_flags = ["nodejs_compat"]
+  logpush = true
 }`, rnd, moduleContent, accountID)
 }
 
+func testAccCheckCloudflareWorkerScriptUploadModuleCompatibilityDate(rnd, accountID, compatibilityDate string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[3]s"
+  name = "%[1]s"
+  content = "%[2]s"
+  module = true
+  compatibility_date = "%[4]s"
+  compatibility_flags = ["nodejs_compat"]
+  logpush = true
+}`, rnd, moduleContent, accountID, compatibilityDate)
+}
+
 func testAccCheckCloudflareWorkerScriptExists(n string, script *cloudflare.WorkerScript, bindings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"golang.org/x/net/idna"
 
@@ -31,6 +33,10 @@ const (
 	planIDPartnerEnterprise = "partners_enterprise"
 )
 
+// zonePendingStatuses are the statuses of a zone which hasn't been activated
+// yet.
+var zonePendingStatuses = []string{"initializing", "pending"}
+
 type subscriptionData struct {
 	ID, Name, Description string
 }
@@ -95,9 +101,14 @@ func resourceCloudflareZone() *schema.Resource {
 		ReadContext:   resourceCloudflareZoneRead,
 		UpdateContext: resourceCloudflareZoneUpdate,
 		DeleteContext: resourceCloudflareZoneDelete,
+		CustomizeDiff: resourceCloudflareZoneCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Zone resource. Zone is the basic resource for
 			working with Cloudflare and is roughly equivalent to a domain name
@@ -147,14 +158,21 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	if ztype, ok := d.GetOk("type"); ok {
-		_, err := client.ZoneSetType(ctx, zone.ID, ztype.(string))
+	// The type is sent on creation so it only needs to be set again when
+	// the API didn't apply it.
+	if zone.Type != "" && zone.Type != zoneType {
+		_, err := client.ZoneSetType(ctx, zone.ID, zoneType)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error setting type on zone ID %q: %w", zone.ID, err))
 		}
 	}
 
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if d.Get("wait_for_active").(bool) {
+		diags = waitForZoneActivation(ctx, d, client, zoneActivationTimeout(d.Timeout(schema.TimeoutCreate)))
+	}
+
+	return append(diags, resourceCloudflareZoneRead(ctx, d, meta)...)
 }
 
 func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -190,8 +208,9 @@ func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("paused", zone.Paused)
 	d.Set("vanity_name_servers", zone.VanityNS)
 	d.Set("status", zone.Status)
-	d.Set("type", zone.Type)
+	d.Set("type", zoneTypeOrDefault(zone.Type))
 	d.Set("name_servers", zone.NameServers)
+	d.Set("original_name_servers", zone.OriginalNS)
 	d.Set("meta", flattenMeta(d, zone.Meta))
 	d.Set("zone", zone.Name)
 	d.Set("plan", plan)
@@ -221,7 +240,7 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		_, err := client.ZoneSetType(ctx, zoneID, ztype.(string))
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error setting type for on zone ID %q: %w", zoneID, err))
+			return diag.FromErr(fmt.Errorf("error setting type on zone ID %q: %w", zoneID, err))
 		}
 	}
 
@@ -245,7 +264,69 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if waitForActive := d.Get("wait_for_active").(bool); waitForActive && d.HasChange("wait_for_active") {
+		diags = waitForZoneActivation(ctx, d, client, zoneActivationTimeout(d.Timeout(schema.TimeoutUpdate)))
+	}
+
+	return append(diags, resourceCloudflareZoneRead(ctx, d, meta)...)
+}
+
+// zoneActivationTimeout returns how long to wait for a zone to become active
+// during an operation with the given timeout. A minute is kept to read the
+// zone afterwards, but short timeouts still wait for half of their time.
+func zoneActivationTimeout(timeout time.Duration) time.Duration {
+	if wait := timeout - time.Minute; wait > timeout/2 {
+		return wait
+	}
+
+	return timeout / 2
+}
+
+// waitForZoneActivation triggers an activation check of a pending zone and
+// waits for it to become active. Activation depends on the name servers being
+// updated at the registrar, which can take days, so running out of time only
+// results in a warning.
+func waitForZoneActivation(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, timeout time.Duration) diag.Diagnostics {
+	zoneID := d.Id()
+
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Zone %q: %w", zoneID, err))
+	}
+
+	if !contains(zonePendingStatuses, zone.Status) {
+		return nil
+	}
+
+	if _, err := client.ZoneActivationCheck(ctx, zoneID); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error triggering activation check of zone %q: %s", zoneID, err))
+	}
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		zone, err = client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error finding Zone %q: %w", zoneID, err))
+		}
+
+		if contains(zonePendingStatuses, zone.Status) {
+			return resource.RetryableError(fmt.Errorf("zone %q is %s", zoneID, zone.Status))
+		}
+
+		return nil
+	})
+
+	// Errors getting the zone reset it, so a pending status is left only
+	// when the wait ran out of time.
+	if err != nil && contains(zonePendingStatuses, zone.Status) {
+		return diag.Diagnostics{diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Zone %q is not active yet", zone.Name),
+			Detail:   fmt.Sprintf("The zone is still %s. It becomes active once the name servers %s are set at the registrar of the domain, which can take up to 24 hours to be picked up.", zone.Status, strings.Join(zone.NameServers, ", ")),
+		}}
+	}
+
+	return diag.FromErr(err)
 }
 
 func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -263,6 +344,30 @@ func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// zoneTypeOrDefault returns the type of a zone, treating zones without one
+// as full zones so that they keep matching the default of `type`.
+func zoneTypeOrDefault(zoneType string) string {
+	if zoneType == "" {
+		return "full"
+	}
+
+	return zoneType
+}
+
+// resourceCloudflareZoneCustomizeDiff plans the name servers and verification
+// key as changing when the type of an existing zone is switched, as both
+// depend on whether the zone is a full or partial setup.
+func resourceCloudflareZoneCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("type") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("name_servers"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("verification_key")
+}
+
 func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]interface{} {
 	cfg := map[string]interface{}{}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -18,7 +19,10 @@ func resourceCloudflareZoneCacheVariants() *schema.Resource {
 		ReadContext:   resourceCloudflareZoneCacheVariantsRead,
 		UpdateContext: resourceCloudflareZoneCacheVariantsUpdate,
 		DeleteContext: resourceCloudflareZoneCacheVariantsDelete,
-		Description:   "Provides a resource which customizes Cloudflare zone cache variants.",
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneCacheVariantsImport,
+		},
+		Description: "Provides a resource which customizes Cloudflare zone cache variants.",
 	}
 }
 
@@ -95,13 +99,45 @@ func resourceCloudflareZoneCacheVariantsUpdate(ctx context.Context, d *schema.Re
 	zoneID := d.Get("zone_id").(string)
 	d.SetId(zoneID)
 
+	current, err := client.ZoneCacheVariants(ctx, d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("error reading cache variants for zone %q: %w", d.Id(), err))
+		}
+	}
+
 	variantsValue := cacheVariantsValuesFromResource(d)
-	tflog.Info(ctx, fmt.Sprintf("Setting Zone Cache Variants to struct: %+v for zone ID: %q", variantsValue, d.Id()))
 
-	_, err := client.UpdateZoneCacheVariants(ctx, d.Id(), variantsValue)
+	// Extensions which are omitted from a PATCH are left as they are and the
+	// API can only delete the variants of every extension at once, so they
+	// are all deleted first when an extension is no longer configured and
+	// the configured ones are set again.
+	deleted := ""
+	for ext, variants := range cacheVariantsValuesToMap(current.Value) {
+		if _, ok := variantsValue[ext]; ok || variants == nil {
+			continue
+		}
 
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error setting cache variants for zone %q: %w", d.Id(), err))
+		tflog.Info(ctx, fmt.Sprintf("Deleting Zone Cache Variants for zone ID %q as %s is no longer configured", d.Id(), ext))
+		if err := client.DeleteZoneCacheVariants(ctx, d.Id()); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting cache variants for zone %q: %w", d.Id(), err))
+		}
+		deleted = ext
+		break
+	}
+
+	if len(variantsValue) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Setting Zone Cache Variants to %+v for zone ID: %q", variantsValue, d.Id()))
+
+		uri := fmt.Sprintf("/zones/%s/cache/variants", d.Id())
+		if _, err := client.Raw(ctx, http.MethodPatch, uri, map[string]interface{}{"value": variantsValue}, nil); err != nil {
+			if deleted != "" {
+				err = fmt.Errorf("all cache variants of zone %q were removed to remove the ones of %s but setting the configured variants failed, the zone has no cache variants: %w", d.Id(), deleted, err)
+				return append(resourceCloudflareZoneCacheVariantsRead(ctx, d, meta), diag.FromErr(err)...)
+			}
+			return diag.FromErr(fmt.Errorf("error setting cache variants for zone %q: %w", d.Id(), err))
+		}
 	}
 
 	return resourceCloudflareZoneCacheVariantsRead(ctx, d, meta)
@@ -121,52 +157,53 @@ func resourceCloudflareZoneCacheVariantsDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
-func cacheVariantsValuesFromResource(d *schema.ResourceData) cloudflare.ZoneCacheVariantsValues {
-	variantsValue := cloudflare.ZoneCacheVariantsValues{}
-
-	if value, ok := d.GetOk("avif"); ok {
-		variantsValue.Avif = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("bmp"); ok {
-		variantsValue.Bmp = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("gif"); ok {
-		variantsValue.Gif = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("jpeg"); ok {
-		variantsValue.Jpeg = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
-
-	if value, ok := d.GetOk("jpg"); ok {
-		variantsValue.Jpg = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+func resourceCloudflareZoneCacheVariantsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("zone_id", d.Id())
 
-	if value, ok := d.GetOk("jp2"); ok {
-		variantsValue.Jp2 = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+	resourceCloudflareZoneCacheVariantsRead(ctx, d, meta)
 
-	if value, ok := d.GetOk("jpg2"); ok {
-		variantsValue.Jpg2 = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+	return []*schema.ResourceData{d}, nil
+}
 
-	if value, ok := d.GetOk("png"); ok {
-		variantsValue.Png = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+// zoneCacheVariantsExtensions are the file extensions which can have cache
+// variants.
+var zoneCacheVariantsExtensions = []string{"avif", "bmp", "gif", "jpeg", "jpg", "jpg2", "jp2", "png", "tiff", "tif", "webp"}
+
+// cacheVariantsValuesFromResource returns the variants of the extensions in
+// the configuration. Extensions configured with an empty list are included
+// so they are cleared.
+func cacheVariantsValuesFromResource(d *schema.ResourceData) map[string][]string {
+	variantsValue := map[string][]string{}
+	rawConfig := d.GetRawConfig()
+
+	for _, ext := range zoneCacheVariantsExtensions {
+		value := d.Get(ext).(*schema.Set)
+		if rawConfig.IsNull() {
+			if value.Len() == 0 {
+				continue
+			}
+		} else if rawValue := rawConfig.GetAttr(ext); rawValue.IsNull() {
+			continue
+		}
 
-	if value, ok := d.GetOk("tif"); ok {
-		variantsValue.Tif = expandInterfaceToStringList(value.(*schema.Set).List())
+		variantsValue[ext] = expandInterfaceToStringList(value.List())
 	}
 
-	if value, ok := d.GetOk("tiff"); ok {
-		variantsValue.Tiff = expandInterfaceToStringList(value.(*schema.Set).List())
-	}
+	return variantsValue
+}
 
-	if value, ok := d.GetOk("webp"); ok {
-		variantsValue.Webp = expandInterfaceToStringList(value.(*schema.Set).List())
+func cacheVariantsValuesToMap(value cloudflare.ZoneCacheVariantsValues) map[string][]string {
+	return map[string][]string{
+		"avif": value.Avif,
+		"bmp":  value.Bmp,
+		"gif":  value.Gif,
+		"jpeg": value.Jpeg,
+		"jpg":  value.Jpg,
+		"jpg2": value.Jpg2,
+		"jp2":  value.Jp2,
+		"png":  value.Png,
+		"tiff": value.Tiff,
+		"tif":  value.Tif,
+		"webp": value.Webp,
 	}
-
-	return variantsValue
 }
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func init() {
@@ -61,6 +68,11 @@ func TestAccCloudflareZoneCacheVariants_OneExt(t *testing.T) {
 					resource.TestCheckNoResourceAttr(name, "webp.#"),
 				),
 			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -141,3 +153,137 @@ func testAccCloudflareZoneCacheVariants_AllExt(zoneID, name string) string {
 			webp = ["image/webp"]
 		}`, zoneID, name)
 }
+
+func TestCloudflareZoneCacheVariantsUpdateClearsAndRemovesExtensions(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	variants := map[string][]string{}
+	var requests []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/cache/variants" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, "PATCH "+string(body))
+
+			var update struct {
+				Value map[string][]string `json:"value"`
+			}
+			if err := json.Unmarshal(body, &update); err != nil {
+				t.Fatal(err)
+			}
+			for ext, value := range update.Value {
+				variants[ext] = value
+			}
+		case http.MethodDelete:
+			requests = append(requests, "DELETE")
+			variants = map[string][]string{}
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		value, _ := json.Marshal(variants)
+		testAPIResult(w, fmt.Sprintf(`{"id": "variants", "modified_on": "2023-01-05T20:15:30Z", "value": %s}`, value))
+	})
+
+	steps := []struct {
+		config   map[string]interface{}
+		requests []string
+		expected map[string][]string
+	}{
+		{
+			config:   map[string]interface{}{"zone_id": zoneID, "avif": []interface{}{"image/webp"}, "png": []interface{}{"image/webp"}},
+			requests: []string{`PATCH {"value":{"avif":["image/webp"],"png":["image/webp"]}}`},
+			expected: map[string][]string{"avif": {"image/webp"}, "png": {"image/webp"}},
+		},
+		{
+			config:   map[string]interface{}{"zone_id": zoneID, "avif": []interface{}{}, "png": []interface{}{"image/webp"}},
+			requests: []string{`PATCH {"value":{"avif":[],"png":["image/webp"]}}`},
+			expected: map[string][]string{"avif": {}, "png": {"image/webp"}},
+		},
+		{
+			config:   map[string]interface{}{"zone_id": zoneID, "png": []interface{}{"image/webp"}},
+			requests: []string{"DELETE", `PATCH {"value":{"png":["image/webp"]}}`},
+			expected: map[string][]string{"png": {"image/webp"}},
+		},
+	}
+
+	for i, step := range steps {
+		requests = nil
+		d := testZoneCacheVariantsResourceData(t, step.config)
+
+		if diags := resourceCloudflareZoneCacheVariantsUpdate(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("step %d: unexpected error: %v", i, diags)
+		}
+
+		if !reflect.DeepEqual(requests, step.requests) {
+			t.Errorf("step %d: expected requests %v, got %v", i, step.requests, requests)
+		}
+		if !reflect.DeepEqual(variants, step.expected) {
+			t.Errorf("step %d: expected variants %v, got %v", i, step.expected, variants)
+		}
+	}
+}
+
+func TestCloudflareZoneCacheVariantsUpdateReportsRemovedVariants(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	variants := `{"avif": ["image/webp"], "png": ["image/webp"]}`
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodDelete:
+			variants = `{}`
+		case http.MethodPatch:
+			testAPIError(w, http.StatusBadRequest, 1004, "Invalid variants")
+			return
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		testAPIResult(w, fmt.Sprintf(`{"id": "variants", "modified_on": "2023-01-05T20:15:30Z", "value": %s}`, variants))
+	})
+
+	d := testZoneCacheVariantsResourceData(t, map[string]interface{}{"zone_id": zoneID, "png": []interface{}{"image/webp"}})
+
+	diags := resourceCloudflareZoneCacheVariantsUpdate(context.Background(), d, client)
+	if !diags.HasError() || !strings.Contains(diags[len(diags)-1].Summary, "all cache variants") {
+		t.Fatalf("expected an error saying all variants were removed, got %v", diags)
+	}
+	if got := d.Get("png").(*schema.Set).Len(); got != 0 {
+		t.Errorf("expected the removed variants to be read back, got %d png variants", got)
+	}
+}
+
+// testZoneCacheVariantsResourceData returns resource data with raw as both
+// its state and configuration.
+func testZoneCacheVariantsResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	r := resourceCloudflareZoneCacheVariants()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(raw["zone_id"].(string))
+
+	config, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := d.State()
+	state.RawConfig, err = ctyjson.Unmarshal(config, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r.Data(state)
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneHold is the representation of the hold placed on a zone. HoldAfter is
+// a pointer so that an empty value can be sent to clear it.
+type zoneHold struct {
+	Hold              bool    `json:"hold"`
+	HoldAfter         *string `json:"hold_after,omitempty"`
+	IncludeSubdomains bool    `json:"include_subdomains"`
+}
+
+func resourceCloudflareZoneHold() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneHoldSchema(),
+		CreateContext: resourceCloudflareZoneHoldCreate,
+		ReadContext:   resourceCloudflareZoneHoldRead,
+		UpdateContext: resourceCloudflareZoneHoldUpdate,
+		DeleteContext: resourceCloudflareZoneHoldDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneHoldImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Zone Hold resource that prevents adding
+			the hostname to another account for use.
+		`),
+	}
+}
+
+func resourceCloudflareZoneHoldCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zoneID := d.Get("zone_id").(string)
+
+	d.SetId(zoneID)
+
+	if err := applyZoneHold(ctx, d, meta.(*cloudflare.API)); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneHoldRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneHoldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	hold, err := getZoneHold(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone hold for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("hold", hold.Hold)
+	d.Set("include_subdomains", hold.IncludeSubdomains)
+
+	// once a hold has been released (or has expired) the API doesn't return
+	// a meaningful hold_after, so the configured value is left untouched.
+	if hold.Hold {
+		d.Set("hold_after", cloudflare.String(hold.HoldAfter))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneHoldUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := applyZoneHold(ctx, d, meta.(*cloudflare.API)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCloudflareZoneHoldRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneHoldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Removing zone hold for zone %s", zoneID))
+
+	if err := removeZoneHold(ctx, client, zoneID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneHoldImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	d.Set("zone_id", zoneID)
+
+	resourceCloudflareZoneHoldRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// applyZoneHold brings the hold on the zone in line with the configuration.
+// A hold is only created when the zone doesn't already have one, otherwise
+// the existing hold is updated in place.
+func applyZoneHold(ctx context.Context, d *schema.ResourceData, client *cloudflare.API) error {
+	zoneID := d.Get("zone_id").(string)
+	includeSubdomains := d.Get("include_subdomains").(bool)
+
+	if !d.Get("hold").(bool) {
+		tflog.Debug(ctx, fmt.Sprintf("Removing zone hold for zone %s", zoneID))
+		return removeZoneHold(ctx, client, zoneID)
+	}
+
+	current, err := getZoneHold(ctx, client, zoneID)
+	if err != nil {
+		return fmt.Errorf("error reading zone hold for zone %q: %w", zoneID, err)
+	}
+
+	if !current.Hold {
+		tflog.Debug(ctx, fmt.Sprintf("Creating zone hold for zone %s", zoneID))
+
+		params := url.Values{}
+		params.Set("include_subdomains", strconv.FormatBool(includeSubdomains))
+
+		_, err := client.Raw(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/hold?%s", zoneID, params.Encode()), nil, nil)
+		if err != nil {
+			return fmt.Errorf("error creating zone hold for zone %q: %w", zoneID, err)
+		}
+
+		if _, ok := d.GetOk("hold_after"); !ok {
+			return nil
+		}
+	}
+
+	hold := zoneHold{IncludeSubdomains: includeSubdomains}
+
+	// a hold_after removed from the configuration is cleared by sending it
+	// empty.
+	oldHoldAfter, newHoldAfter := d.GetChange("hold_after")
+	if value := newHoldAfter.(string); value != "" {
+		holdAfter, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("error parsing hold_after %q: %w", value, err)
+		}
+		hold.HoldAfter = cloudflare.StringPtr(holdAfter.UTC().Format(time.RFC3339))
+	} else if oldHoldAfter.(string) != "" {
+		hold.HoldAfter = cloudflare.StringPtr("")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating zone hold for zone %s", zoneID))
+
+	_, err = client.Raw(ctx, http.MethodPatch, fmt.Sprintf("/zones/%s/hold", zoneID), hold, nil)
+	if err != nil {
+		return fmt.Errorf("error updating zone hold for zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
+
+func getZoneHold(ctx context.Context, client *cloudflare.API, zoneID string) (zoneHold, error) {
+	var hold zoneHold
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/hold", zoneID), nil, nil)
+	if err != nil {
+		return hold, err
+	}
+
+	if err := json.Unmarshal(res, &hold); err != nil {
+		return hold, fmt.Errorf("error unmarshalling zone hold: %w", err)
+	}
+
+	return hold, nil
+}
+
+func removeZoneHold(ctx context.Context, client *cloudflare.API, zoneID string) error {
+	_, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/hold", zoneID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error removing zone hold for zone %q: %w", zoneID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneHold_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_zone_hold." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneHoldConfig(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceName, "hold", "true"),
+					resource.TestCheckResourceAttr(resourceName, "include_subdomains", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneHoldConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hold", "true"),
+					resource.TestCheckResourceAttr(resourceName, "include_subdomains", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneHoldConfig(rnd, zoneID string, includeSubdomains bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_hold" "%[1]s" {
+  zone_id            = "%[2]s"
+  include_subdomains = %[3]t
+}
+`, rnd, zoneID, includeSubdomains)
+}
+
+func TestCloudflareZoneHoldUpdateClearsHoldAfter(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	var sent map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/"+zoneID+"/hold" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Fatal(err)
+			}
+		}
+		testAPIResult(w, `{"hold": true, "hold_after": "", "include_subdomains": false}`)
+	})
+
+	config := map[string]interface{}{
+		"zone_id": zoneID,
+		"hold":    true,
+	}
+
+	r := resourceCloudflareZoneHold()
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	d.Set("hold_after", "2023-01-31T15:56:36+00:00")
+	d.SetId(zoneID)
+
+	state := d.State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if holdAfter, ok := sent["hold_after"]; !ok || holdAfter != "" {
+		t.Errorf("expected hold_after to be cleared with an empty string, got %#v", sent["hold_after"])
+	}
+}
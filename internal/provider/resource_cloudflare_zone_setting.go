@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneSettingToggles maps boolean values to those used by zone settings
+// which are turned on and off.
+var zoneSettingToggles = map[string]string{
+	"true":  "on",
+	"false": "off",
+}
+
+func resourceCloudflareZoneSetting() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSettingSchema(),
+		CreateContext: resourceCloudflareZoneSettingCreate,
+		ReadContext:   resourceCloudflareZoneSettingRead,
+		UpdateContext: resourceCloudflareZoneSettingUpdate,
+		DeleteContext: resourceCloudflareZoneSettingDelete,
+		CustomizeDiff: resourceCloudflareZoneSettingCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSettingImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages a single Cloudflare zone setting.
+			Unlike ` + "`cloudflare_zone_settings_override`" + ` each setting of a
+			zone can be managed by a separate resource. Destroying the resource
+			restores the value the setting had when the resource was created.
+		`),
+	}
+}
+
+// flattenZoneSettingValue returns the value of a zone setting as stored in
+// state, with object valued settings encoded as JSON.
+func flattenZoneSettingValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling zone setting value: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+// expandZoneSettingValue converts a configured value to the type of the
+// current value of the zone setting.
+func expandZoneSettingValue(value string, current interface{}) (interface{}, error) {
+	switch current := current.(type) {
+	case string:
+		if current == "on" || current == "off" {
+			if toggle, ok := zoneSettingToggles[value]; ok {
+				return toggle, nil
+			}
+		}
+		return value, nil
+	case float64:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q must be a number", value)
+		}
+		return n, nil
+	case bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value %q must be a boolean", value)
+		}
+		return b, nil
+	case nil:
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return value, nil
+		}
+		return v, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return nil, fmt.Errorf("value must be a JSON encoded object: %w", err)
+	}
+
+	return v, nil
+}
+
+// zoneSettingValuesEquivalent returns whether two values of a zone setting
+// are the same once toggles are normalised and JSON is decoded.
+func zoneSettingValuesEquivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if toggle, ok := zoneSettingToggles[a]; ok {
+		a = toggle
+	}
+	if toggle, ok := zoneSettingToggles[b]; ok {
+		b = toggle
+	}
+	if a == b {
+		return true
+	}
+
+	var decodedA, decodedB interface{}
+	if json.Unmarshal([]byte(a), &decodedA) != nil || json.Unmarshal([]byte(b), &decodedB) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+func suppressEquivalentZoneSettingValue(k, old, new string, d *schema.ResourceData) bool {
+	return zoneSettingValuesEquivalent(old, new)
+}
+
+func writeZoneSetting(ctx context.Context, client *cloudflare.API, zoneID, settingID, value string, current cloudflare.ZoneSetting) error {
+	expanded, err := expandZoneSettingValue(value, current.Value)
+	if err != nil {
+		return fmt.Errorf("invalid value for zone setting %q: %w", settingID, err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare zone setting %q of zone %q to %#v", settingID, zoneID, expanded))
+
+	_, err = client.UpdateZoneSingleSetting(ctx, zoneID, settingID, cloudflare.ZoneSetting{Value: expanded})
+	return err
+}
+
+func resourceCloudflareZoneSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	settingID := d.Get("setting_id").(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, settingID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q of zone %q: %w", settingID, zoneID, err))
+	}
+
+	if !setting.Editable {
+		return diag.Errorf("zone setting %q of zone %q is read-only", settingID, zoneID)
+	}
+
+	initialValue, err := flattenZoneSettingValue(setting.Value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := writeZoneSetting(ctx, client, zoneID, settingID, d.Get("value").(string), setting); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating zone setting %q of zone %q: %w", settingID, zoneID, err))
+	}
+
+	d.SetId(settingID)
+	d.Set("initial_value", initialValue)
+
+	return resourceCloudflareZoneSettingRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, fmt.Sprintf("Removing zone setting %q from state because it's not found in API", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q of zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	value, err := flattenZoneSettingValue(setting.Value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the configured form of the value, such as `true` instead of `on`.
+	if !zoneSettingValuesEquivalent(d.Get("value").(string), value) {
+		d.Set("value", value)
+	}
+	d.Set("setting_id", setting.ID)
+	d.Set("modified_on", setting.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareZoneSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q of zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	if err := writeZoneSetting(ctx, client, zoneID, d.Id(), d.Get("value").(string), setting); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating zone setting %q of zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return resourceCloudflareZoneSettingRead(ctx, d, meta)
+}
+
+// resourceCloudflareZoneSettingDelete restores the value the setting had
+// before it was managed by the resource.
+func resourceCloudflareZoneSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	initialValue := d.Get("initial_value").(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q of zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	value, err := flattenZoneSettingValue(setting.Value)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if zoneSettingValuesEquivalent(value, initialValue) {
+		return nil
+	}
+
+	if err := writeZoneSetting(ctx, client, zoneID, d.Id(), initialValue, setting); err != nil {
+		return diag.FromErr(fmt.Errorf("error restoring zone setting %q of zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSettingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*cloudflare.API)
+
+	attributes := strings.SplitN(d.Id(), "/", 2)
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/settingID\"", d.Id())
+	}
+	zoneID, settingID := attributes[0], attributes[1]
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, settingID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading zone setting %q of zone %q: %w", settingID, zoneID, err)
+	}
+
+	// The value before the setting was managed isn't known, so the current
+	// one is kept on destroy.
+	initialValue, err := flattenZoneSettingValue(setting.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(settingID)
+	d.Set("zone_id", zoneID)
+	d.Set("initial_value", initialValue)
+
+	resourceCloudflareZoneSettingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareZoneSettingCustomizeDiff rejects settings which can't be
+// changed for the zone, and values which can't be converted to the type of
+// the setting, before anything is applied.
+func resourceCloudflareZoneSettingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("zone_id") || !d.NewValueKnown("setting_id") {
+		return nil
+	}
+
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	settingID := d.Get("setting_id").(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, settingID)
+	if err != nil {
+		return fmt.Errorf("error reading zone setting %q of zone %q: %w", settingID, zoneID, err)
+	}
+
+	if !setting.Editable {
+		return fmt.Errorf("zone setting %q is read-only for zone %q and can't be managed, this usually depends on the plan of the zone", settingID, zoneID)
+	}
+
+	if d.NewValueKnown("value") {
+		if _, err := expandZoneSettingValue(d.Get("value").(string), setting.Value); err != nil {
+			return fmt.Errorf("invalid value for zone setting %q: %w", settingID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneSetting_Basic(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_setting.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSetting(rnd, zoneID, "always_use_https", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "setting_id", "always_use_https"),
+					resource.TestCheckResourceAttr(name, "value", "true"),
+					resource.TestCheckResourceAttrSet(name, "initial_value"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSetting(rnd, zoneID, "always_use_https", `"off"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "off"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", zoneID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_value"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneSetting_Number(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zone_setting.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSetting(rnd, zoneID, "browser_cache_ttl", "14400"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "14400"),
+				),
+			},
+			{
+				Config:      testAccCloudflareZoneSetting(rnd, zoneID, "browser_cache_ttl", `"forever"`),
+				ExpectError: regexp.MustCompile(`must be a number`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneSetting(resourceName, zoneID, settingID, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_setting" "%[1]s" {
+  zone_id    = "%[2]s"
+  setting_id = "%[3]s"
+  value      = %[4]s
+}`, resourceName, zoneID, settingID, value)
+}
+
+func TestZoneSettingValuesEquivalent(t *testing.T) {
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"same string":         {a: "1.2", b: "1.2", expected: true},
+		"different string":    {a: "1.2", b: "1.3", expected: false},
+		"toggle on":           {a: "true", b: "on", expected: true},
+		"toggle off":          {a: "off", b: "false", expected: true},
+		"toggle mismatch":     {a: "true", b: "off", expected: false},
+		"number":              {a: "14400", b: "14400", expected: true},
+		"object key order":    {a: `{"enabled":true,"max_age":0}`, b: `{"max_age": 0, "enabled": true}`, expected: true},
+		"object value change": {a: `{"enabled":true}`, b: `{"enabled":false}`, expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := zoneSettingValuesEquivalent(tc.a, tc.b); got != tc.expected {
+				t.Errorf("zoneSettingValuesEquivalent(%q, %q) = %t, expected %t", tc.a, tc.b, got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestExpandZoneSettingValue(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		current  interface{}
+		expected interface{}
+		err      bool
+	}{
+		"string":         {value: "1.2", current: "1.0", expected: "1.2"},
+		"toggle":         {value: "true", current: "off", expected: "on"},
+		"toggle as is":   {value: "off", current: "on", expected: "off"},
+		"not a toggle":   {value: "true", current: "flexible", expected: "true"},
+		"number":         {value: "14400", current: float64(0), expected: float64(14400)},
+		"invalid number": {value: "forever", current: float64(0), err: true},
+		"object": {
+			value:    `{"enabled":true}`,
+			current:  map[string]interface{}{"enabled": false},
+			expected: map[string]interface{}{"enabled": true},
+		},
+		"invalid object": {value: "on", current: map[string]interface{}{}, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := expandZoneSettingValue(tc.value, tc.current)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCloudflareZoneSettingRestoresInitialValue(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+	const settingPath = "/zones/" + zoneID + "/settings/security_header"
+
+	value := `{"strict_transport_security": {"enabled": false, "max_age": 0}}`
+	var patches []string
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == settingPath:
+			var setting cloudflare.ZoneSetting
+			if err := json.NewDecoder(r.Body).Decode(&setting); err != nil {
+				t.Fatal(err)
+			}
+			encoded, _ := json.Marshal(setting.Value)
+			value = string(encoded)
+			patches = append(patches, value)
+		case r.Method == http.MethodGet && r.URL.Path == settingPath:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		testAPIResult(w, fmt.Sprintf(`{"id": "security_header", "editable": true, "value": %s}`, value))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingSchema(), map[string]interface{}{
+		"zone_id":    zoneID,
+		"setting_id": "security_header",
+		"value":      `{"strict_transport_security":{"enabled":true,"max_age":86400}}`,
+	})
+
+	if diags := resourceCloudflareZoneSettingCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "security_header" {
+		t.Errorf("expected ID %q, got %q", "security_header", d.Id())
+	}
+	if got := d.Get("initial_value").(string); got != `{"strict_transport_security":{"enabled":false,"max_age":0}}` {
+		t.Errorf("expected the initial value to be captured, got %q", got)
+	}
+	if got := d.Get("value").(string); got != `{"strict_transport_security":{"enabled":true,"max_age":86400}}` {
+		t.Errorf("expected the configured value to be kept, got %q", got)
+	}
+
+	if diags := resourceCloudflareZoneSettingDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(patches) != 2 || !strings.Contains(patches[1], `"enabled":false`) {
+		t.Errorf("expected the setting to be restored on delete, got updates %v", patches)
+	}
+}
+
+func TestCloudflareZoneSettingCustomizeDiffRejectsReadOnlySetting(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/zones/"+zoneID+"/settings/http2" {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		testAPIResult(w, `{"id": "http2", "editable": false, "value": "on"}`)
+	})
+
+	_, err := resourceCloudflareZoneSetting().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":    zoneID,
+		"setting_id": "http2",
+		"value":      "off",
+	}), client)
+	if err == nil || !strings.Contains(err.Error(), "read-only") {
+		t.Errorf("expected a read-only setting to be rejected, got %v", err)
+	}
+}
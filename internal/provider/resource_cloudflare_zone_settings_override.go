@@ -33,6 +33,24 @@ var fetchAsSingleSetting = []string{
 	"image_resizing",
 	"early_hints",
 	"origin_max_http_version",
+	"fonts",
+	"speed_brain",
+	"replace_insecure_js",
+}
+
+// settingsRequiringEntitlement are only available to zones on some plans.
+// When they can't be read they are treated as read only, so zones without
+// them can still manage their other settings.
+var settingsRequiringEntitlement = []string{
+	"fonts",
+	"speed_brain",
+	"replace_insecure_js",
+}
+
+// removedZoneSettings are kept in the schema for existing configurations
+// but are no longer supported by the API.
+var removedZoneSettings = []string{
+	"privacy_pass",
 }
 
 func resourceCloudflareZoneSettingsOverrideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -82,6 +100,11 @@ func updateZoneSettingsResponseWithSingleZoneSettings(ctx context.Context, zoneS
 	for _, settingName := range fetchAsSingleSetting {
 		singleSetting, err := client.ZoneSingleSetting(ctx, zoneId, settingName)
 		if err != nil {
+			if contains(settingsRequiringEntitlement, settingName) {
+				tflog.Warn(ctx, fmt.Sprintf("Setting %q is not available for zone %q: %s", settingName, zoneId, err))
+				zoneSettings.Result = append(zoneSettings.Result, cloudflare.ZoneSetting{ID: settingName, Editable: false})
+				continue
+			}
 			return errors.Wrap(err, fmt.Sprintf("Error reading setting '%q' for zone %q", settingName, zoneId))
 		}
 		zoneSettings.Result = append(zoneSettings.Result, singleSetting)
@@ -144,6 +167,7 @@ func resourceCloudflareZoneSettingsOverrideRead(ctx context.Context, d *schema.R
 
 	d.Set("zone_status", zone.Status)
 	d.Set("zone_type", zone.Type)
+	d.Set("reset_on_destroy", zoneSettingsOverrideResetOnDestroy(d))
 
 	newZoneSettings := flattenZoneSettings(ctx, d, zoneSettings.Result, false)
 	// if polish is off (or we don't know) we need to ignore what comes back from the api for webp
@@ -327,6 +351,11 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 }
 
 func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settingValue interface{}, readOnlySettings []string) (interface{}, error) {
+	if contains(removedZoneSettings, k) {
+		log.Printf("[WARN] Ignoring zone setting %q as it is no longer supported", k)
+		return nil, nil
+	}
+
 	if contains(readOnlySettings, k) {
 		return nil, fmt.Errorf("invalid zone setting %q (value: %v) found - cannot be set as it is read only", k, settingValue)
 	}
@@ -367,11 +396,34 @@ func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settin
 	return zoneSettingValue, nil
 }
 
+// zoneSettingsOverrideResetOnDestroy returns whether the settings are reverted
+// on destroy. Resources created before reset_on_destroy existed have no value
+// for it in their state and keep reverting the settings.
+func zoneSettingsOverrideResetOnDestroy(d *schema.ResourceData) bool {
+	if rawState := d.GetRawState(); !rawState.IsNull() && rawState.GetAttr("reset_on_destroy").IsNull() {
+		return true
+	}
+
+	return d.Get("reset_on_destroy").(bool)
+}
+
 func resourceCloudflareZoneSettingsOverrideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
+	if !zoneSettingsOverrideResetOnDestroy(d) {
+		tflog.Info(ctx, fmt.Sprintf("Removing zone settings of zone %q from state without reverting them to the initial settings", d.Id()))
+		return nil
+	}
+
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
-		readOnlySettings := expandInterfaceToStringList(d.Get("readonly_settings"))
+		// Settings can become read-only after the initial settings were read,
+		// for example when the plan of the zone changes, so the current ones
+		// are used to leave those out of the revert.
+		currentSettings, err := client.ZoneSettings(ctx, d.Id())
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("Error reading settings for zone %q", d.Id())))
+		}
+		readOnlySettings := flattenReadOnlyZoneSettings(ctx, currentSettings.Result)
 
 		zoneSettings, err := expandRevertibleZoneSettings(d, readOnlySettings)
 		if err != nil {
@@ -414,6 +466,11 @@ func expandRevertibleZoneSettings(d *schema.ResourceData, readOnlySettings []str
 			k = "0rtt"
 		}
 
+		if contains(readOnlySettings, k) {
+			log.Printf("[WARN] Not reverting zone setting %q as it is read only", k)
+			continue
+		}
+
 		// if the value was never set we don't need to revert it
 		if currentVal, ok := d.GetOk(currentKey); ok && !schemaValueEquals(initialVal, currentVal) {
 			zoneSettingValue, err := expandZoneSetting(d, keyFormat, k, initialVal, readOnlySettings)
@@ -2,14 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"reflect"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -49,6 +53,38 @@ func TestAccCloudflareZoneSettingsOverride_Full(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZoneSettingsOverride_NewSettings(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_settings_override." + rnd
+
+	initialSettings := make(map[string]interface{})
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigEmpty(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccGetInitialZoneSettings(t, zoneID, initialSettings),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigNewSettings(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.fonts", "on"),
+					resource.TestCheckResourceAttr(name, "settings.0.speed_brain", "on"),
+					resource.TestCheckResourceAttr(name, "settings.0.replace_insecure_js", "on"),
+					resource.TestCheckResourceAttr(name, "settings.0.origin_max_http_version", "1"),
+					resource.TestCheckResourceAttr(name, "settings.0.zero_rtt", "on"),
+					resource.TestCheckResourceAttr(name, "settings.0.proxy_read_timeout", "100"),
+				),
+			},
+		},
+		CheckDestroy: testAccCheckInitialZoneSettings(zoneID, initialSettings),
+	})
+}
+
 func TestAccCloudflareZoneSettingsOverride_RemoveAttributes(t *testing.T) {
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
 	rnd := generateRandomResourceName()
@@ -216,3 +252,133 @@ resource "cloudflare_zone_settings_override" "%[1]s" {
 	}
 }`, rnd, zoneID)
 }
+
+func testAccCheckCloudflareZoneSettingsOverrideConfigNewSettings(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_settings_override" "%[1]s" {
+	zone_id = "%[2]s"
+	settings {
+		fonts = "on"
+		speed_brain = "on"
+		replace_insecure_js = "on"
+		origin_max_http_version = "1"
+		zero_rtt = "on"
+		proxy_read_timeout = "100"
+	}
+}`, rnd, zoneID)
+}
+
+func TestCloudflareZoneSettingsOverrideDelete(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	testCases := map[string]struct {
+		resetOnDestroy bool
+		preUpgrade     bool
+		expected       []string
+	}{
+		"reverts writable settings": {resetOnDestroy: true, expected: []string{"brotli"}},
+		"only removes from state":   {resetOnDestroy: false},
+		"reverts pre-upgrade state": {preUpgrade: true, expected: []string{"brotli"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var reverted []string
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/zones/"+zoneID+"/settings" {
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				switch r.Method {
+				case http.MethodGet:
+					testAPIResult(w, `[
+  {"id": "brotli", "editable": true, "value": "on"},
+  {"id": "http3", "editable": false, "value": "on"}
+]`)
+				case http.MethodPatch:
+					var body struct {
+						Items []cloudflare.ZoneSetting `json:"items"`
+					}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatal(err)
+					}
+					for _, setting := range body.Items {
+						reverted = append(reverted, setting.ID)
+					}
+					testAPIResult(w, `[]`)
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingsOverrideSchema(), map[string]interface{}{
+				"zone_id":          zoneID,
+				"reset_on_destroy": tc.resetOnDestroy,
+				"settings": []interface{}{map[string]interface{}{
+					"brotli": "on",
+					"http3":  "on",
+				}},
+			})
+			d.SetId(zoneID)
+			d.Set("initial_settings", []interface{}{map[string]interface{}{
+				"brotli": "off",
+				"http3":  "off",
+			}})
+			if tc.preUpgrade {
+				d = testZoneSettingsOverridePreUpgradeResourceData(t, d)
+			}
+
+			if diags := resourceCloudflareZoneSettingsOverrideDelete(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !reflect.DeepEqual(reverted, tc.expected) {
+				t.Errorf("expected settings %v to be reverted, got %v", tc.expected, reverted)
+			}
+		})
+	}
+}
+
+// testZoneSettingsOverridePreUpgradeResourceData returns d as it is read
+// from state written before reset_on_destroy existed.
+func testZoneSettingsOverridePreUpgradeResourceData(t *testing.T, d *schema.ResourceData) *schema.ResourceData {
+	r := resourceCloudflareZoneSettingsOverride()
+
+	state := d.State()
+	delete(state.Attributes, "reset_on_destroy")
+
+	var err error
+	state.RawState, err = state.AttrsAsObjectValue(r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r.Data(state)
+}
+
+func TestUpdateZoneSettingsResponseWithSingleZoneSettingsNotEntitled(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		settingID := strings.TrimPrefix(r.URL.Path, "/zones/"+zoneID+"/settings/")
+		if settingID == "speed_brain" {
+			testAPIError(w, http.StatusForbidden, 1015, "Unable to access setting with current plan.")
+			return
+		}
+		testAPIResult(w, fmt.Sprintf(`{"id": %q, "editable": true, "value": "on"}`, settingID))
+	})
+
+	zoneSettings := &cloudflare.ZoneSettingResponse{}
+	if err := updateZoneSettingsResponseWithSingleZoneSettings(context.Background(), zoneSettings, zoneID, client); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(zoneSettings.Result) != len(fetchAsSingleSetting) {
+		t.Fatalf("expected %d settings, got %d", len(fetchAsSingleSetting), len(zoneSettings.Result))
+	}
+
+	readOnly := flattenReadOnlyZoneSettings(context.Background(), zoneSettings.Result)
+	if !reflect.DeepEqual(readOnly, []string{"speed_brain"}) {
+		t.Errorf("expected the unavailable setting to be read only, got %v", readOnly)
+	}
+}
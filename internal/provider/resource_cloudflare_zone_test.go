@@ -1,11 +1,19 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareZone_Basic(t *testing.T) {
@@ -94,6 +102,7 @@ func TestAccCloudflareZone_PartialSetup(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "paused", "true"),
 					resource.TestCheckResourceAttr(name, "plan", planIDFree),
 					resource.TestCheckResourceAttr(name, "type", "partial"),
+					resource.TestCheckResourceAttrSet(name, "verification_key"),
 				),
 			},
 		},
@@ -251,23 +260,50 @@ func TestAccCloudflareZone_SetType(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_zone." + rnd
 	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	var zoneID string
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: providerFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testZoneConfigWithExplicitFullSetup(rnd, fmt.Sprintf("%s.%s", rnd, zoneName), "true", "false", "enterprise", accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "full"),
+					resource.TestCheckResourceAttr(name, "verification_key", ""),
+					testAccCheckCloudflareZoneID(name, &zoneID),
+				),
 			},
 			{
 				Config: testZoneConfigWithPartialSetup(rnd, fmt.Sprintf("%s.%s", rnd, zoneName), "true", "false", "enterprise", accountID),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "type", "partial"),
+					resource.TestCheckResourceAttrSet(name, "verification_key"),
+					testAccCheckCloudflareZoneID(name, &zoneID),
 				),
 			},
 		},
 	})
 }
 
+// testAccCheckCloudflareZoneID records the ID of the zone on the first call
+// and fails if it changes afterwards.
+func testAccCheckCloudflareZoneID(name string, zoneID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if *zoneID == "" {
+			*zoneID = rs.Primary.ID
+		} else if rs.Primary.ID != *zoneID {
+			return fmt.Errorf("expected zone to be updated in place, ID changed from %q to %q", *zoneID, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func testZoneConfigWithPartialSetup(resourceID, zoneName, paused, jumpStart, plan, accountID string) string {
 	return fmt.Sprintf(`
 				resource "cloudflare_zone" "%[1]s" {
@@ -291,3 +327,165 @@ func testZoneConfigWithExplicitFullSetup(resourceID, zoneName, paused, jumpStart
 					type = "full"
 				}`, resourceID, zoneName, paused, jumpStart, plan, accountID)
 }
+
+func TestCloudflareZoneCreateFullAndSwitchToPartial(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	zoneType := ""
+	var created map[string]interface{}
+	var patches []map[string]interface{}
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/zones":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Fatal(err)
+			}
+		case r.Method == http.MethodPatch && r.URL.Path == "/zones/"+zoneID:
+			var patch map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				t.Fatal(err)
+			}
+			patches = append(patches, patch)
+			zoneType = fmt.Sprint(patch["type"])
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/"+zoneID:
+		default:
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		verificationKey := ""
+		if zoneType == "partial" {
+			verificationKey = "484789451-3f9a7c2e2b0a9c9f"
+		}
+		testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "name": "example.com",
+  "status": "pending",
+  "type": %q,
+  "verification_key": %q,
+  "plan": {"legacy_id": "free"}
+}`, zoneID, zoneType, verificationKey))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSchema(), map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"zone":       "example.com",
+	})
+
+	if diags := resourceCloudflareZoneCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if created["type"] != "full" {
+		t.Errorf("expected the zone to be created as a full zone, got %v", created["type"])
+	}
+	if len(patches) != 0 {
+		t.Errorf("expected the type of a new full zone not to be changed, got %v", patches)
+	}
+	if got := d.Get("type").(string); got != "full" {
+		t.Errorf("expected a zone without a type to be read as full, got %q", got)
+	}
+
+	r := resourceCloudflareZone()
+	state := d.State()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"zone":       "example.com",
+		"type":       "partial",
+	}), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatal("expected switching the zone type not to require a new zone")
+	}
+	if attr, ok := diff.Attributes["verification_key"]; !ok || !attr.NewComputed {
+		t.Errorf("expected the verification key to be planned as changing, got %#v", diff.Attributes["verification_key"])
+	}
+
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := resourceCloudflareZoneUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(patches) != 1 || patches[0]["type"] != "partial" {
+		t.Errorf("expected the type to be changed in place, got %v", patches)
+	}
+	if got := d.Get("verification_key").(string); got != "484789451-3f9a7c2e2b0a9c9f" {
+		t.Errorf("expected the verification key of the partial zone, got %q", got)
+	}
+}
+
+func TestWaitForZoneActivation(t *testing.T) {
+	const zoneID = "0da42c8d2132a9ddaf714f9e7c920711"
+
+	testCases := map[string]struct {
+		activates bool
+		warning   bool
+	}{
+		"activated by the check": {activates: true},
+		"still pending":          {activates: false, warning: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status := "pending"
+			var activationChecks int
+			client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPut && r.URL.Path == "/zones/"+zoneID+"/activation_check":
+					activationChecks++
+					if tc.activates {
+						status = "active"
+					}
+					testAPIResult(w, fmt.Sprintf(`{"id": %q}`, zoneID))
+				case r.Method == http.MethodGet && r.URL.Path == "/zones/"+zoneID:
+					testAPIResult(w, fmt.Sprintf(`{
+  "id": %q,
+  "name": "example.com",
+  "status": %q,
+  "name_servers": ["ns1.example.com", "ns2.example.com"]
+}`, zoneID, status))
+				default:
+					t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := resourceCloudflareZone().Data(nil)
+			d.SetId(zoneID)
+
+			diags := waitForZoneActivation(context.Background(), d, client, time.Second)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if activationChecks != 1 {
+				t.Errorf("expected a single activation check, got %d", activationChecks)
+			}
+			if tc.warning != (len(diags) == 1 && diags[0].Severity == diag.Warning) {
+				t.Errorf("expected warning %t, got %v", tc.warning, diags)
+			}
+			if tc.warning && !strings.Contains(diags[0].Detail, "ns1.example.com, ns2.example.com") {
+				t.Errorf("expected the warning to list the name servers, got %q", diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestZoneActivationTimeout(t *testing.T) {
+	for timeout, expected := range map[time.Duration]time.Duration{
+		20 * time.Minute: 19 * time.Minute,
+		2 * time.Minute:  time.Minute,
+		time.Minute:      30 * time.Second,
+		10 * time.Second: 5 * time.Second,
+	} {
+		if got := zoneActivationTimeout(timeout); got != expected {
+			t.Errorf("expected to wait %s with a timeout of %s, got %s", expected, timeout, got)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// retryableStatusCodes are the responses considered transient and retried
+// with backoff before being surfaced to the caller.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// retryTransport is a http.RoundTripper which retries rate limited and
+// failed requests using exponential backoff with jitter. When the API sends
+// a `Retry-After` header that delay is used instead, up to the maximum
+// backoff.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// wait pauses between attempts and is swapped out in tests.
+	wait func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryTransport(next http.RoundTripper, maxRetries, minBackoffSecs, maxBackoffSecs int) *retryTransport {
+	return &retryTransport{
+		next:       next,
+		maxRetries: maxRetries,
+		minBackoff: time.Duration(minBackoffSecs) * time.Second,
+		maxBackoff: time.Duration(maxBackoffSecs) * time.Second,
+		wait:       waitContext,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		// A RoundTripper must not modify the request, so retries are sent as
+		// clones with a fresh copy of the body.
+		attemptReq := req
+		if attempt > 0 {
+			attemptReq = req.Clone(ctx)
+			if req.Body != nil && req.Body != http.NoBody {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("error rewinding request body for retry: %w", err)
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !t.retryable(req, resp, err) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				delay = retryAfter
+				if delay > t.maxBackoff {
+					delay = t.maxBackoff
+				}
+			}
+
+			// drain the body so the connection can be reused
+			io.Copy(io.Discard, resp.Body) //nolint:errcheck
+			resp.Body.Close()
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("retrying %s %s after %s (attempt %d of %d)", req.Method, req.URL.Path, delay, attempt+1, t.maxRetries))
+
+		if err := t.wait(ctx, delay); err != nil {
+			return nil, fmt.Errorf("operation aborted during backoff: %w", err)
+		}
+	}
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response, err error) bool {
+	// requests with a body can only be replayed if it can be rewound.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return req.Context().Err() == nil
+	}
+
+	return retryableStatusCodes[resp.StatusCode]
+}
+
+// backoff returns the exponential delay for attempt, bounded by the maximum
+// backoff, with up to half of it replaced by random jitter so that parallel
+// requests don't retry in lockstep.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := time.Duration(math.Pow(2, float64(attempt)) * float64(t.minBackoff))
+	if delay > t.maxBackoff || delay <= 0 {
+		delay = t.maxBackoff
+	}
+
+	if half := int64(delay / 2); half > 0 {
+		delay = time.Duration(half + rand.Int63n(half))
+	}
+
+	return delay
+}
+
+// parseRetryAfter handles both forms of the `Retry-After` header; a number
+// of seconds or a HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func waitContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
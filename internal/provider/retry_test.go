@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+func TestRetryTransportRetriesRateLimitedRequests(t *testing.T) {
+	var requests int32
+	var waits []time.Duration
+	transport := newRetryTransport(http.DefaultTransport, 3, 1, 30)
+	transport.wait = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.Header().Set("Retry-After", "7")
+			testAPIError(w, http.StatusTooManyRequests, 10000, "Rate limited")
+			return
+		}
+		testAPIResult(w, `{"id":"023e105f4ecef8ad9ca31a8372d0c353"}`)
+	}, cloudflare.UsingRetryPolicy(0, 1, 30), cloudflare.HTTPClient(&http.Client{Transport: transport}))
+
+	if _, err := client.Raw(context.Background(), http.MethodPost, "/zones", map[string]string{"name": "example.com"}, nil); err != nil {
+		t.Fatalf("expected request to succeed after retries, got %s", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+
+	if len(waits) != 2 || waits[0] != 7*time.Second || waits[1] != 7*time.Second {
+		t.Errorf("expected two waits of 7s from the Retry-After header, got %v", waits)
+	}
+}
+
+func TestRetryTransportCapsRetryAfterAtMaxBackoff(t *testing.T) {
+	var requests int32
+	var waits []time.Duration
+	transport := newRetryTransport(http.DefaultTransport, 3, 1, 30)
+	transport.wait = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			testAPIError(w, http.StatusTooManyRequests, 10000, "Rate limited")
+			return
+		}
+		testAPIResult(w, `{"id":"023e105f4ecef8ad9ca31a8372d0c353"}`)
+	}, cloudflare.UsingRetryPolicy(0, 1, 30), cloudflare.HTTPClient(&http.Client{Transport: transport}))
+
+	if _, err := client.Raw(context.Background(), http.MethodGet, "/zones", nil, nil); err != nil {
+		t.Fatalf("expected request to succeed after a retry, got %s", err)
+	}
+
+	if len(waits) != 1 || waits[0] != 30*time.Second {
+		t.Errorf("expected a single wait of the 30s maximum backoff, got %v", waits)
+	}
+}
+
+func TestRetryTransportResendsRequestBody(t *testing.T) {
+	var bodies []string
+	transport := newRetryTransport(http.DefaultTransport, 3, 1, 30)
+	transport.wait = func(ctx context.Context, d time.Duration) error { return nil }
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bodies = append(bodies, string(body))
+
+		if len(bodies) <= 2 {
+			testAPIError(w, http.StatusServiceUnavailable, 10000, "unavailable")
+			return
+		}
+		testAPIResult(w, `{"id":"023e105f4ecef8ad9ca31a8372d0c353"}`)
+	}, cloudflare.UsingRetryPolicy(0, 1, 30), cloudflare.HTTPClient(&http.Client{Transport: transport}))
+
+	if _, err := client.Raw(context.Background(), http.MethodPost, "/zones", map[string]string{"name": "example.com"}, nil); err != nil {
+		t.Fatalf("expected request to succeed after retries, got %s", err)
+	}
+
+	if len(bodies) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(bodies))
+	}
+	for i, body := range bodies {
+		if body != `{"name":"example.com"}` {
+			t.Errorf("expected request %d to send the full body, got %q", i+1, body)
+		}
+	}
+}
+
+func TestRetryTransportGivesUpAfterMaxRetries(t *testing.T) {
+	var requests int32
+	transport := newRetryTransport(http.DefaultTransport, 2, 1, 30)
+	transport.wait = func(ctx context.Context, d time.Duration) error { return nil }
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, cloudflare.UsingRetryPolicy(0, 1, 30), cloudflare.HTTPClient(&http.Client{Transport: transport}))
+
+	if _, err := client.Raw(context.Background(), http.MethodGet, "/zones", nil, nil); err == nil {
+		t.Fatal("expected an error once retries are exhausted")
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestRetryTransportDoesNotRetryClientErrors(t *testing.T) {
+	var requests int32
+	transport := newRetryTransport(http.DefaultTransport, 3, 1, 30)
+	transport.wait = func(ctx context.Context, d time.Duration) error { return nil }
+
+	client := testFakeAPI(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		testAPIError(w, http.StatusBadRequest, 1000, "bad request")
+	}, cloudflare.UsingRetryPolicy(0, 1, 30), cloudflare.HTTPClient(&http.Client{Transport: transport}))
+
+	if _, err := client.Raw(context.Background(), http.MethodGet, "/zones", nil, nil); err == nil || !strings.Contains(err.Error(), "bad request") {
+		t.Fatalf("expected the API error to be returned, got %v", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 10, 1, 30)
+
+	for attempt, max := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		got := transport.backoff(attempt)
+		if got < max/2 || got > max {
+			t.Errorf("attempt %d: expected backoff between %s and %s, got %s", attempt, max/2, max, got)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got, ok := parseRetryAfter("120"); !ok || got != 2*time.Minute {
+		t.Errorf("expected 2m from seconds, got %s", got)
+	}
+
+	if got, ok := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); !ok || got < 59*time.Minute || got > time.Hour {
+		t.Errorf("expected ~1h from date, got %s", got)
+	}
+
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("expected empty header to be ignored")
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected invalid header to be ignored")
+	}
+}
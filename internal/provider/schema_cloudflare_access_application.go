@@ -139,15 +139,23 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Description: "SaaS configuration for the Access Application.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
+					"auth_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "saml",
+						ValidateFunc: validation.StringInSlice([]string{"saml", "oidc"}, false),
+						Description:  fmt.Sprintf("The authentication protocol of the SaaS application. %s", renderAvailableDocumentationValuesStringSlice([]string{"saml", "oidc"})),
+					},
 					"sp_entity_id": {
 						Type:        schema.TypeString,
-						Required:    true,
-						Description: "A globally unique name for an identity or service provider.",
+						Optional:    true,
+						Description: "A globally unique name for an identity or service provider. Required for SAML applications.",
 					},
 					"consumer_service_url": {
 						Type:        schema.TypeString,
-						Required:    true,
-						Description: "The service provider's endpoint that is responsible for receiving and parsing a SAML assertion.",
+						Optional:    true,
+						Description: "The service provider's endpoint that is responsible for receiving and parsing a SAML assertion. Required for SAML applications.",
 					},
 					"name_id_format": {
 						Type:         schema.TypeString,
@@ -156,6 +164,113 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 						ValidateFunc: validation.StringInSlice([]string{"email", "id"}, false),
 						Description:  "The format of the name identifier sent to the SaaS application.",
 					},
+					"custom_attributes": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Description: "Custom attribute mapped from IDPs for SAML applications.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "The name of the attribute as provided to the SaaS app.",
+								},
+								"name_format": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice([]string{"urn:oasis:names:tc:SAML:2.0:attrname-format:unspecified", "urn:oasis:names:tc:SAML:2.0:attrname-format:basic", "urn:oasis:names:tc:SAML:2.0:attrname-format:uri"}, false),
+									Description:  "The format of the attribute name.",
+								},
+								"friendly_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "A friendly name for the attribute as provided to the SaaS app.",
+								},
+								"required": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "True if the attribute must be always present.",
+								},
+								"source": {
+									Type:     schema.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"name": {
+												Type:        schema.TypeString,
+												Required:    true,
+												Description: "The name of the attribute as provided by the IDP.",
+											},
+											"name_by_idp": {
+												Type:        schema.TypeMap,
+												Optional:    true,
+												Elem:        &schema.Schema{Type: schema.TypeString},
+												Description: "A mapping from IdP ID to claim name.",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"redirect_uris": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Description: "The permitted URL's for Cloudflare to return Authorization codes and Access/ID tokens. Required for OIDC applications.",
+					},
+					"grant_types": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{"authorization_code", "authorization_code_with_pkce"}, false),
+						},
+						Description: fmt.Sprintf("The OIDC flows supported by this application. %s", renderAvailableDocumentationValuesStringSlice([]string{"authorization_code", "authorization_code_with_pkce"})),
+					},
+					"scopes": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{"openid", "groups", "email", "profile"}, false),
+						},
+						Description: fmt.Sprintf("Define the user information shared with access. %s", renderAvailableDocumentationValuesStringSlice([]string{"openid", "groups", "email", "profile"})),
+					},
+					"app_launcher_url": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The URL where this applications tile redirects users.",
+					},
+					"idp_entity_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The unique identifier for the SaaS application.",
+					},
+					"sso_endpoint": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The endpoint where the SaaS application will send login requests.",
+					},
+					"public_key": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The public certificate that will be used to verify identities.",
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The application client id for OIDC applications.",
+					},
+					"client_secret": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+						Description: "The application client secret for OIDC applications. Only returned on creation.",
+					},
 				},
 			},
 		},
@@ -189,6 +304,19 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Option that redirects to a custom URL when a user is denied access to the application.",
 		},
+		"custom_non_identity_deny_url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Option that redirects to a custom URL when a user is denied access to the application via identity based rules.",
+		},
+		"custom_pages": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The custom pages selected for the application. See the `cloudflare_access_custom_page` resource.",
+		},
 		"http_only_cookie_attribute": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -217,6 +345,26 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Default:     true,
 			Description: "Option to show/hide applications in App Launcher.",
 		},
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "The tags associated with the application.",
+		},
+		"allow_authenticate_via_warp": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "When set to true, users can authenticate to this application using their WARP session. When set to false this application will always require direct IdP authentication. This setting always overrides the organization setting for WARP authentication.",
+		},
+		"options_preflight_bypass": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"cors_headers"},
+			Description:   "Allows options preflight requests to bypass Access authentication and go directly to the origin. Cannot turn on if `cors_headers` is set.",
+		},
 		"service_auth_401_redirect": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -303,26 +451,112 @@ func convertCORSStructToSchema(d *schema.ResourceData, headers *cloudflare.Acces
 	return []interface{}{m}
 }
 
-func convertSaasSchemaToStruct(d *schema.ResourceData) *cloudflare.SaasApplication {
-	SaasConfig := cloudflare.SaasApplication{}
+func convertSaasSchemaToStruct(d *schema.ResourceData) *accessApplicationSaasApp {
+	SaasConfig := accessApplicationSaasApp{}
 	if _, ok := d.GetOk("saas_app"); ok {
-		SaasConfig.SPEntityID = d.Get("saas_app.0.sp_entity_id").(string)
-		SaasConfig.ConsumerServiceUrl = d.Get("saas_app.0.consumer_service_url").(string)
-		SaasConfig.NameIDFormat = d.Get("saas_app.0.name_id_format").(string)
+		SaasConfig.AuthType = d.Get("saas_app.0.auth_type").(string)
+
+		if SaasConfig.AuthType == "oidc" {
+			SaasConfig.RedirectURIs = expandInterfaceToStringList(d.Get("saas_app.0.redirect_uris").(*schema.Set).List())
+			SaasConfig.GrantTypes = expandInterfaceToStringList(d.Get("saas_app.0.grant_types").(*schema.Set).List())
+			SaasConfig.Scopes = expandInterfaceToStringList(d.Get("saas_app.0.scopes").(*schema.Set).List())
+			SaasConfig.AppLauncherURL = d.Get("saas_app.0.app_launcher_url").(string)
+		} else {
+			SaasConfig.SPEntityID = d.Get("saas_app.0.sp_entity_id").(string)
+			SaasConfig.ConsumerServiceUrl = d.Get("saas_app.0.consumer_service_url").(string)
+			SaasConfig.NameIDFormat = d.Get("saas_app.0.name_id_format").(string)
+
+			for _, value := range d.Get("saas_app.0.custom_attributes").(*schema.Set).List() {
+				attribute := value.(map[string]interface{})
+
+				customAttribute := cloudflare.SAMLAttributeConfig{
+					Name:         attribute["name"].(string),
+					NameFormat:   attribute["name_format"].(string),
+					FriendlyName: attribute["friendly_name"].(string),
+					Required:     attribute["required"].(bool),
+				}
+
+				if sources := attribute["source"].([]interface{}); len(sources) > 0 && sources[0] != nil {
+					source := sources[0].(map[string]interface{})
+					customAttribute.Source.Name = source["name"].(string)
+
+					if nameByIDP, ok := source["name_by_idp"].(map[string]interface{}); ok && len(nameByIDP) > 0 {
+						customAttribute.Source.NameByIDP = make(map[string]string, len(nameByIDP))
+						for idp, name := range nameByIDP {
+							customAttribute.Source.NameByIDP[idp] = name.(string)
+						}
+					}
+				}
+
+				SaasConfig.CustomAttributes = append(SaasConfig.CustomAttributes, customAttribute)
+			}
+		}
 	}
 
 	return &SaasConfig
 }
 
-func convertSaasStructToSchema(d *schema.ResourceData, app *cloudflare.SaasApplication) []interface{} {
+func convertSaasStructToSchema(d *schema.ResourceData, app *accessApplicationSaasApp) []interface{} {
 	if _, ok := d.GetOk("saas_app"); !ok {
 		return []interface{}{}
 	}
 
+	if app == nil {
+		return []interface{}{}
+	}
+
+	authType := app.AuthType
+	if authType == "" {
+		authType = "saml"
+	}
+
+	// the client secret is only returned when the application is created.
+	clientSecret := app.ClientSecret
+	if clientSecret == "" {
+		clientSecret = d.Get("saas_app.0.client_secret").(string)
+	}
+
 	m := map[string]interface{}{
-		"sp_entity_id":         app.SPEntityID,
-		"consumer_service_url": app.ConsumerServiceUrl,
-		"name_id_format":       app.NameIDFormat,
+		"auth_type":        authType,
+		"idp_entity_id":    app.IDPEntityID,
+		"sso_endpoint":     app.SSOEndpoint,
+		"public_key":       app.PublicKey,
+		"client_id":        app.ClientID,
+		"client_secret":    clientSecret,
+		"app_launcher_url": app.AppLauncherURL,
+		"redirect_uris":    flattenStringList(app.RedirectURIs),
+		"grant_types":      flattenStringList(app.GrantTypes),
+		"scopes":           flattenStringList(app.Scopes),
+	}
+
+	if authType == "saml" {
+		m["sp_entity_id"] = app.SPEntityID
+		m["consumer_service_url"] = app.ConsumerServiceUrl
+		m["name_id_format"] = app.NameIDFormat
+
+		customAttributes := make([]interface{}, 0, len(app.CustomAttributes))
+		for _, attribute := range app.CustomAttributes {
+			nameByIDP := make(map[string]interface{}, len(attribute.Source.NameByIDP))
+			for idp, name := range attribute.Source.NameByIDP {
+				nameByIDP[idp] = name
+			}
+
+			customAttributes = append(customAttributes, map[string]interface{}{
+				"name":          attribute.Name,
+				"name_format":   attribute.NameFormat,
+				"friendly_name": attribute.FriendlyName,
+				"required":      attribute.Required,
+				"source": []interface{}{map[string]interface{}{
+					"name":        attribute.Source.Name,
+					"name_by_idp": nameByIDP,
+				}},
+			})
+		}
+		m["custom_attributes"] = customAttributes
+	} else {
+		// name_id_format has a default that doesn't apply to OIDC
+		// applications so the configured value is kept.
+		m["name_id_format"] = d.Get("saas_app.0.name_id_format").(string)
 	}
 
 	return []interface{}{m}
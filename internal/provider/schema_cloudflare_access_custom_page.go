@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareAccessCustomPageSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description:   "The account identifier to target for the resource.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"zone_id"},
+		},
+		"zone_id": {
+			Description:   "The zone identifier to target for the resource.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"account_id"},
+		},
+		"name": {
+			Description: "Friendly name of the Access Custom Page configuration.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"type": {
+			Description:  "Type of Access custom page to create. Available values: `identity_denied`, `forbidden`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"identity_denied", "forbidden"}, false),
+		},
+		"custom_html": {
+			Description: "Custom HTML to display on the custom page.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"app_count": {
+			Description: "Number of Access Applications the custom page is assigned to.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+	}
+}
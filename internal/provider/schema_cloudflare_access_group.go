@@ -181,6 +181,29 @@ var AccessGroupOptionSchemaElement = &schema.Resource{
 				},
 			},
 		},
+		"auth_context": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ID of the Authentication Context.",
+					},
+					"ac_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ACID of the Authentication Context.",
+					},
+					"identity_provider_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ID of the Azure Identity provider.",
+					},
+				},
+			},
+		},
 		"okta": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -144,5 +144,42 @@ func resourceCloudflareAccessIdentityProviderSchema() map[string]*schema.Schema
 				},
 			},
 		},
+		"scim_config": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "Configuration for SCIM provisioning of users and groups from the identity provider.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether SCIM provisioning is enabled for the identity provider.",
+					},
+					"secret": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+						Description: "The SCIM secret used to authenticate the identity provider. Only returned by the API when SCIM provisioning is first enabled.",
+					},
+					"user_deprovision": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether to deprovision users from Zero Trust when they are deprovisioned in the identity provider.",
+					},
+					"seat_deprovision": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether to revoke a user's seat when they are deprovisioned in the identity provider. Requires `user_deprovision`.",
+					},
+					"group_member_deprovision": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Whether to remove a user from Access groups when they are removed from the group in the identity provider.",
+					},
+				},
+			},
+		},
 	}
 }
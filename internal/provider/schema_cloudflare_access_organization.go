@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +40,33 @@ func resourceCloudflareAccessOrganizationSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "When set to true, this will disable all editing of Access resources via the Zero Trust Dashboard",
 		},
+		"session_duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateAccessOrganizationDuration,
+			Description:  "How often a user will be forced to re-authorise. Must be in the format `48h` or `2h45m`.",
+		},
+		"warp_auth_session_duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateAccessOrganizationDuration,
+			Description:  "The amount of time that tokens issued for applications will be valid. Must be in the format `30m` or `2h45m`.",
+		},
+		"user_seat_expiration_inactive_time": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateAccessOrganizationDuration,
+			Description:  "The amount of time a user seat is inactive before it expires. When the user seat exceeds the set time of inactivity, the user is removed as an active seat and no longer counts against your Teams seat count. Must be in the format `300ms` or `2h45m`.",
+		},
+		"auto_redirect_to_identity": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When set to true, users skip the identity provider selection step during login.",
+		},
 		"login_design": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -74,6 +103,15 @@ func resourceCloudflareAccessOrganizationSchema() map[string]*schema.Schema {
 	}
 }
 
+func validateAccessOrganizationDuration(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	_, err := time.ParseDuration(v)
+	if err != nil {
+		errs = append(errs, fmt.Errorf(`%q only supports "ns", "us" (or "µs"), "ms", "s", "m", or "h" as valid units`, key))
+	}
+	return
+}
+
 func convertLoginDesignSchemaToStruct(d *schema.ResourceData) *cloudflare.AccessOrganizationLoginDesign {
 	LoginDesign := cloudflare.AccessOrganizationLoginDesign{}
 
@@ -74,13 +74,20 @@ func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 			Description:  "The prompt to display to the user for a justification for accessing the resource.",
 		},
 		"approval_required": {
-			Type:     schema.TypeBool,
-			Optional: true,
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the user must be approved by an approval group before accessing the resource.",
 		},
 		"approval_group": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Elem:     AccessPolicyApprovalGroupElement,
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        AccessPolicyApprovalGroupElement,
+			Description: "The approval groups to request approval from. Can only be set when `approval_required` is `true`.",
+		},
+		"isolation_required": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Require this application to be served in an isolated browser for users matching this policy.",
 		},
 	}
 }
@@ -88,8 +95,9 @@ func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 var AccessPolicyApprovalGroupElement = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"email_list_uuid": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the Teams list of emails to request approval from.",
 		},
 		"email_addresses": {
 			Type:     schema.TypeList,
@@ -31,19 +31,18 @@ func resourceCloudflareAccessServiceTokenSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Computed:    true,
 			Sensitive:   true,
-			ForceNew:    true,
 			Description: "A secret for interacting with Access protocols.",
 		},
 		"expires_at": {
 			Type:        schema.TypeString,
 			Computed:    true,
-			Description: "Date when the token expires",
+			Description: "Date when the token expires.",
 		},
 		"min_days_for_renewal": {
 			Type:        schema.TypeInt,
 			Optional:    true,
 			Default:     0,
-			Description: "Refresh the token if terraform is run within the specified amount of days before expiration",
+			Description: "Renew the token, and rotate its client secret, if Terraform is run within the specified amount of days before expiration.",
 		},
 	}
 }
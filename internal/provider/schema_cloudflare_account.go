@@ -23,10 +23,35 @@ func resourceCloudflareAccountSchema() map[string]*schema.Schema {
 			ForceNew:     true, // "Updating account type is not supported from client api"
 		},
 		"enforce_twofactor": {
-			Description: "Whether 2FA is enforced on the account.",
-			Type:        schema.TypeBool,
-			Default:     false,
+			Description:   "Whether 2FA is enforced on the account.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Computed:      true,
+			Deprecated:    "Use `settings.enforce_twofactor` instead.",
+			ConflictsWith: []string{"settings"},
+		},
+		"settings": {
+			Type:        schema.TypeList,
 			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "Settings of the account.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enforce_twofactor": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether 2FA is enforced on the account.",
+					},
+					"use_account_custom_ns_by_default": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether new zones of the account use the custom nameservers of the account by default.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -16,17 +16,28 @@ func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 		"email_address": {
 			Type:        schema.TypeString,
 			Required:    true,
+			ForceNew:    true,
 			Description: "The email address of the user who you wish to manage. Following creation, this field becomes read only via the API and cannot be updated.",
 		},
 		"role_ids": {
-			Type:        schema.TypeSet,
-			Required:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "List of account role IDs that you want to assign to a member.",
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Computed:     true,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ExactlyOneOf: []string{"role_ids", "role_names"},
+			Description:  "List of account role IDs that you want to assign to a member.",
+		},
+		"role_names": {
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ExactlyOneOf: []string{"role_ids", "role_names"},
+			Description:  "List of account role names, such as `Administrator`, that you want to assign to a member. The names are resolved to role IDs using the roles of the account.",
 		},
 		"status": {
 			Type:        schema.TypeString,
 			Optional:    true,
+			Computed:    true,
 			Description: fmt.Sprintf("A member's status in the account. %s", renderAvailableDocumentationValuesStringSlice([]string{"accepted", "pending"})),
 		},
 	}
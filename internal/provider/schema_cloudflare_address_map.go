@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var addressMapMembershipKinds = []string{"zone", "account"}
+
+func resourceCloudflareAddressMapSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"description": {
+			Description: "Description of the address map.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"default_sni": {
+			Description: "If you have legacy TLS clients which do not send the TLS server name indicator, then you can specify one default SNI on the map.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"enabled": {
+			Description: "Whether the Address Map is enabled or not.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"can_delete": {
+			Description: "If set to false, then the Address Map cannot be deleted via API. This is true for Cloudflare-managed maps.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"can_modify_ips": {
+			Description: "If set to false, then the IPs on the Address Map cannot be modified via the API. This is true for Cloudflare-managed maps.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"ips": {
+			Description: "The set of IPs on the Address Map.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip": {
+						Description:  "An IPv4 or IPv6 address.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+				},
+			},
+		},
+		"memberships": {
+			Description: "Zones and Accounts which will be assigned IPs on this Address Map.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"identifier": {
+						Description: "Identifier of the account or zone.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"kind": {
+						Description:  fmt.Sprintf("The type of the membership. %s", renderAvailableDocumentationValuesStringSlice(addressMapMembershipKinds)),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(addressMapMembershipKinds, false),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var apiShieldUserSchemaKinds = []string{"openapi_v3"}
+
+func resourceCloudflareAPIShieldUserSchemaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Name of the schema.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Description:  fmt.Sprintf("Kind of schema. %s", renderAvailableDocumentationValuesStringSlice(apiShieldUserSchemaKinds)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "openapi_v3",
+			ValidateFunc: validation.StringInSlice(apiShieldUserSchemaKinds, false),
+		},
+		"source": {
+			Description: "Schema file bytes, for example `file(\"openapi.json\")`. Uploaded schemas are immutable so changing the content creates a new schema.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"validation_enabled": {
+			Description: "Flag whether schema is enabled for validation.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
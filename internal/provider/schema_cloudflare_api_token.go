@@ -40,7 +40,7 @@ func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
 		},
 		"policy": {
 			Type:        schema.TypeSet,
-			Set:         schema.HashResource(&p),
+			Set:         hashAPITokenPolicy,
 			Required:    true,
 			Elem:        &p,
 			Description: "Permissions policy. Multiple policy blocks can be defined.",
@@ -78,16 +78,18 @@ func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
 			},
 		},
 		"not_before": {
-			Type:         schema.TypeString,
-			ValidateFunc: validation.IsRFC3339Time,
-			Description:  "The time before which the token MUST NOT be accepted for processing",
-			Optional:     true,
+			Type:             schema.TypeString,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppressEquivalentAPITokenTimestamp,
+			Description:      "The time before which the token MUST NOT be accepted for processing",
+			Optional:         true,
 		},
 		"expires_on": {
-			Type:         schema.TypeString,
-			ValidateFunc: validation.IsRFC3339Time,
-			Description:  "The expiration time on or after which the token MUST NOT be accepted for processing",
-			Optional:     true,
+			Type:             schema.TypeString,
+			ValidateFunc:     validation.IsRFC3339Time,
+			DiffSuppressFunc: suppressEquivalentAPITokenTimestamp,
+			Description:      "The expiration time on or after which the token MUST NOT be accepted for processing",
+			Optional:         true,
 		},
 		"value": {
 			Type:        schema.TypeString,
@@ -96,8 +98,12 @@ func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
 			Description: "The value of the API Token.",
 		},
 		"status": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "active",
+			ValidateFunc:     validation.StringInSlice([]string{"active", "disabled"}, false),
+			DiffSuppressFunc: suppressExpiredAPITokenStatus,
+			Description:      fmt.Sprintf("Status of the API Token. Tokens which expired keep the `expired` status. %s", renderAvailableDocumentationValuesStringSlice([]string{"active", "disabled"})),
 		},
 		"issued_on": {
 			Type:        schema.TypeString,
@@ -19,12 +19,14 @@ func resourceCloudflareArgoSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
 			Optional:     true,
 			Description:  fmt.Sprintf("Whether tiered caching is enabled. %s", renderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
+			Deprecated:   "Use the `cloudflare_tiered_cache` resource instead. Remove the argument and import the zone ID into `cloudflare_tiered_cache` to keep the current setting.",
 		},
 		"smart_routing": {
 			Type:         schema.TypeString,
 			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
 			Optional:     true,
 			Description:  fmt.Sprintf("Whether smart routing is enabled. %s", renderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
+			Deprecated:   "Use the `cloudflare_argo_smart_routing` resource instead. Remove the argument and import the zone ID into `cloudflare_argo_smart_routing` to keep the current setting.",
 		},
 	}
 }
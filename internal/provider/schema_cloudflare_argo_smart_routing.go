@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareArgoSmartRoutingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"value": {
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+			Required:     true,
+			Description:  fmt.Sprintf("Whether smart routing is enabled. %s", renderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
+		},
+	}
+}
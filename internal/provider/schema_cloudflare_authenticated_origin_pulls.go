@@ -11,9 +11,11 @@ func resourceCloudflareAuthenticatedOriginPullsSchema() map[string]*schema.Schem
 			ForceNew:    true,
 		},
 		"hostname": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Specify a hostname to enable Per-Hostname Authenticated Origin Pulls on, using the provided certificate.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"authenticated_origin_pulls_certificate"},
+			Description:  "Specify a hostname to enable Per-Hostname Authenticated Origin Pulls on, using the provided certificate.",
 		},
 		"authenticated_origin_pulls_certificate": {
 			Type:        schema.TypeString,
@@ -25,5 +27,15 @@ func resourceCloudflareAuthenticatedOriginPullsSchema() map[string]*schema.Schem
 			Required:    true,
 			Description: "Whether to enable Authenticated Origin Pulls on the given zone or hostname.",
 		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The deployment status of Per-Hostname Authenticated Origin Pulls on the hostname.",
+		},
+		"cert_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the certificate used for Per-Zone or Per-Hostname Authenticated Origin Pulls.",
+		},
 	}
 }
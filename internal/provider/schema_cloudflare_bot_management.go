@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	botManagementSBFMActions         = []string{"allow", "block", "managed_challenge"}
+	botManagementSBFMVerifiedActions = []string{"allow", "block"}
+)
+
+func resourceCloudflareBotManagementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enable_js": {
+			Description: "Use lightweight, invisible JavaScript detections to improve Bot Management.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"fight_mode": {
+			Description: "Whether to enable Bot Fight Mode.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"sbfm_definitely_automated": {
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on definitely automated requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementSBFMActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementSBFMActions, false),
+		},
+		"sbfm_likely_automated": {
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on likely automated requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementSBFMActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementSBFMActions, false),
+		},
+		"sbfm_verified_bots": {
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on verified bots requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementSBFMVerifiedActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementSBFMVerifiedActions, false),
+		},
+		"sbfm_static_resource_protection": {
+			Description: "Super Bot Fight Mode (SBFM) to enable static resource protection. Enable if static resources on your application need bot protection. Note: Static resource protection can also result in legitimate traffic being blocked.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"optimize_wordpress": {
+			Description: "Whether to optimize Super Bot Fight Mode protections for Wordpress.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"suppress_session_score": {
+			Description: "Whether to disable tracking the highest bot score for a session in the Bot Management cookie.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"auto_update_model": {
+			Description: "Automatically update to the newest bot detection models created by Cloudflare as they are released.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"using_latest_model": {
+			Description: "A read-only field that indicates whether the zone currently is running the latest ML model.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
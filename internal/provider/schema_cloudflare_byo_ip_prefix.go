@@ -33,5 +33,20 @@ func resourceCloudflareBYOIPPrefixSchema() map[string]*schema.Schema {
 			Optional:     true,
 			Description:  fmt.Sprintf("Whether or not the prefix shall be announced. A prefix can be activated or deactivated once every 15 minutes (attempting more regular updates will trigger rate limiting). %s", renderAvailableDocumentationValuesStringSlice([]string{"on", "off"})),
 		},
+		"advertised_modified_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the advertisement status of the prefix was last changed.",
+		},
+		"on_demand_enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether advertisement of the prefix to the Internet may be dynamically enabled or disabled.",
+		},
+		"on_demand_locked": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the advertisement status of the prefix is locked, in which case it can't be changed.",
+		},
 	}
 }
@@ -76,7 +76,7 @@ func resourceCloudflareCertificatePackSchema() map[string]*schema.Schema {
 			ForceNew:    true,
 			Optional:    true,
 			Default:     false,
-			Description: "Whether or not to wait for a certificate pack to reach status `active` during creation.",
+			Description: "Whether or not to wait for a certificate pack to reach status `active` during creation. The wait is bounded by the `create` timeout and fails with the validation errors of the certificate pack if it isn't active by then.",
 		},
 	}
 }
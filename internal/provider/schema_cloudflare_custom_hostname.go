@@ -164,10 +164,18 @@ func resourceCloudflareCustomHostnameSchema() map[string]*schema.Schema {
 			},
 		},
 		"wait_for_ssl_pending_validation": {
-			Type:        schema.TypeBool,
-			Optional:    true,
-			Default:     false,
-			Description: "Whether to wait for a custom hostname SSL sub-object to reach status `pending_validation` during creation.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"wait_for_active"},
+			Description:   "Whether to wait for a custom hostname SSL sub-object to reach status `pending_validation` during creation, or after the SSL method or type is changed.",
+		},
+		"wait_for_active": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"wait_for_ssl_pending_validation"},
+			Description:   "Whether to wait for a custom hostname SSL sub-object to reach status `active` during creation, or after the SSL method or type is changed. The wait is bounded by the `create` and `update` timeouts and fails with the validation errors of the certificate if it isn't active by then.",
 		},
 	}
 }
@@ -7,6 +7,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// customPageAccountTypes are the types of custom pages which can be managed
+// on accounts as well as zones.
+var customPageAccountTypes = []string{
+	"basic_challenge",
+	"waf_challenge",
+	"waf_block",
+	"ratelimit_block",
+	"country_challenge",
+	"ip_block",
+	"under_attack",
+	"500_errors",
+	"1000_errors",
+	"managed_challenge",
+}
+
+// customPageZoneTypes are the types of custom pages which can be managed on
+// zones.
+var customPageZoneTypes = append(append([]string{}, customPageAccountTypes...), "always_online")
+
 func resourceCloudflareCustomPagesSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"zone_id": {
@@ -22,34 +41,10 @@ func resourceCloudflareCustomPagesSchema() map[string]*schema.Schema {
 			ConflictsWith: []string{"zone_id"},
 		},
 		"type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ValidateFunc: validation.StringInSlice([]string{
-				"basic_challenge",
-				"waf_challenge",
-				"waf_block",
-				"ratelimit_block",
-				"country_challenge",
-				"ip_block",
-				"under_attack",
-				"500_errors",
-				"1000_errors",
-				"always_online",
-				"managed_challenge",
-			}, true),
-			Description: fmt.Sprintf("The type of custom page you wish to update. %s", renderAvailableDocumentationValuesStringSlice([]string{
-				"basic_challenge",
-				"waf_challenge",
-				"waf_block",
-				"ratelimit_block",
-				"country_challenge",
-				"ip_block",
-				"under_attack",
-				"500_errors",
-				"1000_errors",
-				"always_online",
-				"managed_challenge",
-			})),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(customPageZoneTypes, true),
+			Description:  fmt.Sprintf("The type of custom page you wish to update. Pages of type `always_online` can only be managed on zones. %s", renderAvailableDocumentationValuesStringSlice(customPageZoneTypes)),
 		},
 		"url": {
 			Type:        schema.TypeString,
@@ -59,8 +54,9 @@ func resourceCloudflareCustomPagesSchema() map[string]*schema.Schema {
 		"state": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Default:      "customized",
 			ValidateFunc: validation.StringInSlice([]string{"default", "customized"}, true),
-			Description:  fmt.Sprintf("Managed state of the custom page. %s", renderAvailableDocumentationValuesStringSlice([]string{"default", "customized"})),
+			Description:  fmt.Sprintf("Managed state of the custom page. A page reset to `default` outside of Terraform is shown as drift. %s Defaults to `customized`.", renderAvailableDocumentationValuesStringSlice([]string{"default", "customized"})),
 		},
 	}
 }
@@ -34,7 +34,6 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			MaxItems:    1,
 			Optional:    true,
-			ForceNew:    true,
 			Description: "The certificate associated parameters.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -52,18 +51,27 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 					"bundle_method": {
 						Type:         schema.TypeString,
 						Optional:     true,
+						ForceNew:     true,
 						ValidateFunc: validation.StringInSlice([]string{"ubiquitous", "optimal", "force"}, false),
 						Description:  fmt.Sprintf("Method of building intermediate certificate chain. A ubiquitous bundle has the highest probability of being verified everywhere, even by clients using outdated or unusual trust stores. An optimal bundle uses the shortest chain and newest intermediates. And the force bundle verifies the chain, but does not otherwise modify it. %s", renderAvailableDocumentationValuesStringSlice([]string{"ubiquitous", "optimal", "force"})),
 					},
 					"geo_restrictions": {
-						Type:         schema.TypeString,
-						Optional:     true,
-						ValidateFunc: validation.StringInSlice([]string{"us", "eu", "highest_security"}, false),
-						Description:  fmt.Sprintf("Specifies the region where your private key can be held locally. %s", renderAvailableDocumentationValuesStringSlice([]string{"us", "eu", "highest_security"})),
+						Type:          schema.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"custom_ssl_options.0.policy"},
+						ValidateFunc:  validation.StringInSlice([]string{"us", "eu", "highest_security"}, false),
+						Description:   fmt.Sprintf("Specifies the region where your private key can be held locally. %s", renderAvailableDocumentationValuesStringSlice([]string{"us", "eu", "highest_security"})),
+					},
+					"policy": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						ConflictsWith: []string{"custom_ssl_options.0.geo_restrictions"},
+						Description:   "Specifies the regions where your private key can be held locally, using a policy expression such as `(country: US) or (region: EU)`.",
 					},
 					"type": {
 						Type:         schema.TypeString,
 						Optional:     true,
+						ForceNew:     true,
 						ValidateFunc: validation.StringInSlice([]string{"legacy_custom", "sni_custom"}, false),
 						Description:  fmt.Sprintf("Whether to enable support for legacy clients which do not include SNI in the TLS handshake. %s", renderAvailableDocumentationValuesStringSlice([]string{"legacy_custom", "sni_custom"})),
 					},
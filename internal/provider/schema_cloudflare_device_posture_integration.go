@@ -22,8 +22,8 @@ func resourceCloudflareDevicePostureIntegrationSchema() map[string]*schema.Schem
 		"type": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice([]string{ws1, uptycs, crowdstrike, intune}, false),
-			Description:  fmt.Sprintf("The device posture integration type. %s", renderAvailableDocumentationValuesStringSlice([]string{ws1, uptycs, crowdstrike, intune})),
+			ValidateFunc: validation.StringInSlice([]string{ws1, uptycs, crowdstrike, intune, kolide, tanium, sentinelone}, false),
+			Description:  fmt.Sprintf("The device posture integration type. %s", renderAvailableDocumentationValuesStringSlice([]string{ws1, uptycs, crowdstrike, intune, kolide, tanium, sentinelone})),
 		},
 		"identifier": {
 			Type:     schema.TypeString,
@@ -74,6 +74,17 @@ func resourceCloudflareDevicePostureIntegrationSchema() map[string]*schema.Schem
 						Sensitive:   true,
 						Description: "The client key for authenticating API calls.",
 					},
+					"access_client_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "If present, this id will be passed in the `CF-Access-Client-ID` header when hitting the `api_url`.",
+					},
+					"access_client_secret": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "If present, this secret will be passed in the `CF-Access-Client-Secret` header when hitting the `api_url`.",
+					},
 				},
 			},
 		},
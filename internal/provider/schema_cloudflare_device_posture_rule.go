@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var devicePostureRuleTypes = []string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s", "intune", "client_certificate", "client_certificate_v2", "sentinelone_s2s", "tanium_s2s"}
+
 func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_id": {
@@ -17,8 +19,8 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 		"type": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice([]string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s"}, false),
-			Description:  fmt.Sprintf("The device posture rule type. %s", renderAvailableDocumentationValuesStringSlice([]string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s"})),
+			ValidateFunc: validation.StringInSlice(devicePostureRuleTypes, false),
+			Description:  fmt.Sprintf("The device posture rule type. %s", renderAvailableDocumentationValuesStringSlice(devicePostureRuleTypes)),
 		},
 		"name": {
 			Type:        schema.TypeString,
@@ -128,8 +130,8 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 					"compliance_status": {
 						Type:         schema.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringInSlice([]string{"compliant", "noncompliant"}, true),
-						Description:  fmt.Sprintf("The workspace one device compliance status. %s", renderAvailableDocumentationValuesStringSlice([]string{"compliant", "noncompliant"})),
+						ValidateFunc: validation.StringInSlice([]string{"compliant", "noncompliant", "unknown", "notapplicable", "ingraceperiod", "error"}, true),
+						Description:  fmt.Sprintf("The workspace one or intune device compliance status. `unknown`, `notapplicable`, `ingraceperiod` and `error` are only supported by intune. %s", renderAvailableDocumentationValuesStringSlice([]string{"compliant", "noncompliant", "unknown", "notapplicable", "ingraceperiod", "error"})),
 					},
 					"os_distro_name": {
 						Type:        schema.TypeString,
@@ -162,6 +164,73 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 						ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<=", "=="}, true),
 						Description:  fmt.Sprintf("The version comparison operator for crowdstrike. %s", renderAvailableDocumentationValuesStringSlice([]string{">", ">=", "<", "<=", "=="})),
 					},
+					"certificate_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The UUID of a Cloudflare managed certificate.",
+					},
+					"cn": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The common name for a certificate.",
+					},
+					"check_private_key": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Confirm the certificate was not imported from another device.",
+					},
+					"extended_key_usage": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{"clientAuth", "emailProtection"}, false),
+						},
+						Description: fmt.Sprintf("List of values indicating purposes for which the certificate public key can be used. %s", renderAvailableDocumentationValuesStringSlice([]string{"clientAuth", "emailProtection"})),
+					},
+					"active_threats": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "The number of active threats from SentinelOne.",
+					},
+					"infected": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "True if SentinelOne device is infected.",
+					},
+					"is_active": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "True if SentinelOne device is active.",
+					},
+					"network_status": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"connected", "disconnected", "disconnecting", "connecting"}, false),
+						Description:  fmt.Sprintf("The network status from SentinelOne. %s", renderAvailableDocumentationValuesStringSlice([]string{"connected", "disconnected", "disconnecting", "connecting"})),
+					},
+					"eid_last_seen": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "For date comparison, the time elapsed since the device was last seen by Tanium (e.g. `1d`, `2h`).",
+					},
+					"risk_level": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"low", "medium", "high", "critical"}, false),
+						Description:  fmt.Sprintf("The risk level from Tanium. %s", renderAvailableDocumentationValuesStringSlice([]string{"low", "medium", "high", "critical"})),
+					},
+					"score_operator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<=", "=="}, true),
+						Description:  fmt.Sprintf("The score comparison operator for Tanium. %s", renderAvailableDocumentationValuesStringSlice([]string{">", ">=", "<", "<=", "=="})),
+					},
+					"total_score": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "The total score from Tanium.",
+					},
 				},
 			},
 		},
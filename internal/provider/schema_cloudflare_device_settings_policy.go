@@ -74,6 +74,12 @@ func resourceCloudflareDeviceSettingsPolicySchema() map[string]*schema.Schema {
 			Optional:    true,
 			Default:     true,
 		},
+		"dex_tests": {
+			Description: "Whether DEX tests run on the devices matching this policy.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
 		"support_url": {
 			Description: "The support URL that will be opened when sending feedback.",
 			Type:        schema.TypeString,
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareDEXTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Device DEX Test. Must be unique.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Additional details about the test.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Determines whether or not the test is active.",
+		},
+		"interval": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validateDEXTestInterval,
+			DiffSuppressFunc: suppressEquivalentDEXTestInterval,
+			Description:      "How often the test will run, as a duration (e.g. `30m` or `1h`).",
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "The configuration object which contains the details for the WARP client to conduct the test.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"http", "traceroute"}, false),
+						Description:  fmt.Sprintf("The type of test. %s", renderAvailableDocumentationValuesStringSlice([]string{"http", "traceroute"})),
+					},
+					"host": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ExactlyOneOf: []string{"data.0.host", "data.0.url"},
+						Description:  "The host to test. Must be a full URL for `http` tests and a hostname or IP address for `traceroute` tests.",
+					},
+					"url": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ExactlyOneOf: []string{"data.0.host", "data.0.url"},
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						Description:  "The URL to test. Only applicable to `http` tests.",
+					},
+					"method": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"GET"}, false),
+						Description:  fmt.Sprintf("The HTTP request method. Only applicable to `http` tests. %s", renderAvailableDocumentationValuesStringSlice([]string{"GET"})),
+					},
+				},
+			},
+		},
+		"updated": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp of when the test was last updated.",
+		},
+		"created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp of when the test was created.",
+		},
+	}
+}
@@ -82,6 +82,20 @@ func resourceCloudflareDLPProfileSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice([]string{DLPProfileTypeCustom, DLPProfileTypePredefined}, false),
 			Description:  fmt.Sprintf("The type of the profile. %s", renderAvailableDocumentationValuesStringSlice([]string{DLPProfileTypeCustom, DLPProfileTypePredefined})),
 		},
+		"profile_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The ID of the existing predefined profile to manage. Required for predefined profiles.",
+		},
+		"allowed_match_count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 1000),
+			Description:  "Related DLP policies will trigger when the match count exceeds the number set.",
+		},
 		"entry": {
 			Type:        schema.TypeSet,
 			Description: "List of entries to apply to the profile.",
@@ -38,9 +38,11 @@ func resourceCloudflareFallbackDomainSchema() map[string]*schema.Schema {
 			},
 		},
 		"policy_id": {
-			Optional:    true,
-			Type:        schema.TypeString,
-			Description: "The settings policy for which to configure this fallback domain policy.",
+			Optional:         true,
+			ForceNew:         true,
+			Type:             schema.TypeString,
+			DiffSuppressFunc: suppressEquivalentDevicePolicyID,
+			Description:      "The settings policy for which to configure this fallback domain policy. Accepts the ID of a `cloudflare_device_settings_policy` resource or a bare policy ID. Defaults to the default device settings policy.",
 		},
 	}
 }
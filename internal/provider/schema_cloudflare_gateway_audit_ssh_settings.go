@@ -0,0 +1,24 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceCloudflareGatewayAuditSSHSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "SSH encryption public key used to encrypt audited SSH session commands.",
+		},
+		"seed_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Seed ID of the SSH encryption key.",
+		},
+	}
+}
@@ -54,22 +54,26 @@ func resourceCloudflareGRETunnelSchema() map[string]*schema.Schema {
 			Description:  "Maximum Transmission Unit (MTU) in bytes for the GRE tunnel.",
 			ValidateFunc: validation.IntBetween(576, 1476),
 		},
+		"health_check": magicTransitTunnelHealthCheckSchema(),
 		"health_check_enabled": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Computed:    true,
+			Deprecated:  "Use `health_check` instead.",
 			Description: "Specifies if ICMP tunnel health checks are enabled.",
 		},
 		"health_check_target": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Computed:    true,
+			Deprecated:  "Use `health_check` instead.",
 			Description: "The IP address of the customer endpoint that will receive tunnel health checks.",
 		},
 		"health_check_type": {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Computed:     true,
+			Deprecated:   "Use `health_check` instead.",
 			ValidateFunc: validation.StringInSlice([]string{"request", "reply"}, false),
 			Description:  fmt.Sprintf("Specifies the ICMP echo type for the health check. %s", renderAvailableDocumentationValuesStringSlice([]string{"request", "reply"})),
 		},
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var hostnameTLSSettings = []string{"min_tls_version", "http2"}
+
+func resourceCloudflareHostnameTLSSettingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostname": {
+			Description: "Hostname that belongs to this zone name.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"setting": {
+			Description:  fmt.Sprintf("TLS setting name. %s", renderAvailableDocumentationValuesStringSlice(hostnameTLSSettings)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(hostnameTLSSettings, false),
+		},
+		"value": {
+			Description: "TLS setting value, for example `1.2` for `min_tls_version` or `on` for `http2`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"created_at": {
+			Description: "The RFC3339 timestamp of when the setting was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "The RFC3339 timestamp of when the setting was last updated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
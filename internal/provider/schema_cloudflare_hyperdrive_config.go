@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var hyperdriveConfigOriginSchemes = []string{"postgres", "postgresql"}
+
+func resourceCloudflareHyperdriveConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the Hyperdrive configuration.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"origin": {
+			Description: "The origin details for the Hyperdrive configuration.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": {
+						Description: "The host (hostname or IP) of your origin database.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"port": {
+						Description: "The port (default: 5432 for Postgres) of your origin database. Not used when connecting through Cloudflare Access.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+					},
+					"database": {
+						Description: "The name of your origin database.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"user": {
+						Description: "The user of your origin database.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"password": {
+						Description: "The password required to access your origin database. This value is write-only and never returned by the API.",
+						Type:        schema.TypeString,
+						Required:    true,
+						Sensitive:   true,
+					},
+					"scheme": {
+						Description:  fmt.Sprintf("Specifies the URL scheme used to connect to your origin database. %s", renderAvailableDocumentationValuesStringSlice(hyperdriveConfigOriginSchemes)),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "postgres",
+						ValidateFunc: validation.StringInSlice(hyperdriveConfigOriginSchemes, false),
+					},
+					"access_client_id": {
+						Description:  "Client ID associated with the Cloudflare Access Service Token used to connect via Access.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						RequiredWith: []string{"origin.0.access_client_secret"},
+					},
+					"access_client_secret": {
+						Description:  "Client Secret associated with the Cloudflare Access Service Token used to connect via Access. This value is write-only and never returned by the API.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"origin.0.access_client_id"},
+					},
+				},
+			},
+		},
+		"caching": {
+			Description: "The caching details for the Hyperdrive configuration.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disabled": {
+						Description: "Disable caching for this Hyperdrive configuration.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+					},
+					"max_age": {
+						Description: "Configure the `max_age` value of this Hyperdrive configuration, in seconds.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+					},
+					"stale_while_revalidate": {
+						Description: "Configure the `stale_while_revalidate` value of this Hyperdrive configuration, in seconds.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	imageVariantFits     = []string{"scale-down", "contain", "cover", "crop", "pad"}
+	imageVariantMetadata = []string{"keep", "copyright", "none"}
+)
+
+func resourceCloudflareImageVariantSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"variant_id": {
+			Description: "The name of the variant, used in the URLs of images. Variants named `public` update the built-in variant of the account.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"options": {
+			Description: "How images are transformed for the variant.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"fit": {
+						Description:  fmt.Sprintf("How the image is resized to fit the width and height. %s", renderAvailableDocumentationValuesStringSlice(imageVariantFits)),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(imageVariantFits, false),
+					},
+					"width": {
+						Description:  "The maximum width of the image in pixels.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"height": {
+						Description:  "The maximum height of the image in pixels.",
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"metadata": {
+						Description:  fmt.Sprintf("Which EXIF metadata of the image is kept. %s", renderAvailableDocumentationValuesStringSlice(imageVariantMetadata)),
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(imageVariantMetadata, false),
+					},
+				},
+			},
+		},
+		"never_require_signed_urls": {
+			Description: "Whether images using the variant can be accessed without a signed URL, even when the image requires them.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
@@ -40,22 +40,26 @@ func resourceCloudflareIPsecTunnelSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "An optional description of the IPsec tunnel.",
 		},
+		"health_check": magicTransitTunnelHealthCheckSchema(),
 		"health_check_enabled": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Computed:    true,
+			Deprecated:  "Use `health_check` instead.",
 			Description: "Specifies if ICMP tunnel health checks are enabled. Default: `true`.",
 		},
 		"health_check_target": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Computed:    true,
+			Deprecated:  "Use `health_check` instead.",
 			Description: "The IP address of the customer endpoint that will receive tunnel health checks. Default: `<customer_gre_endpoint>`.",
 		},
 		"health_check_type": {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Computed:     true,
+			Deprecated:   "Use `health_check` instead.",
 			ValidateFunc: validation.StringInSlice([]string{"request", "reply"}, false),
 			Description:  fmt.Sprintf("Specifies the ICMP echo type for the health check (`request` or `reply`). %s Default: `reply`.", renderAvailableDocumentationValuesStringSlice([]string{"request", "reply"})),
 		},
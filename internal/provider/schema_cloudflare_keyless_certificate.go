@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// suppressKeylessCertificateImportDiff suppresses the diff of the write-only
+// attributes of an imported keyless certificate, which the API doesn't
+// return, rather than replacing the certificate.
+func suppressKeylessCertificateImportDiff(k, old, new string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != ""
+}
+
+func resourceCloudflareKeylessCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"certificate": {
+			Description:      "The zone's SSL certificate or SSL certificate and intermediate(s).",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: suppressKeylessCertificateImportDiff,
+		},
+		"bundle_method": {
+			Description:      fmt.Sprintf("A ubiquitous bundle has the highest probability of being verified everywhere, even by clients using outdated or unusual trust stores. An optimal bundle uses the shortest chain and newest intermediates. And the force bundle verifies the chain, but does not otherwise modify it. %s", renderAvailableDocumentationValuesStringSlice([]string{"ubiquitous", "optimal", "force"})),
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          "ubiquitous",
+			ValidateFunc:     validation.StringInSlice([]string{"ubiquitous", "optimal", "force"}, false),
+			DiffSuppressFunc: suppressKeylessCertificateImportDiff,
+		},
+		"host": {
+			Description: "The keyless SSL host.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"port": {
+			Description:  "The keyless SSL port used to communicate between Cloudflare and the client's Keyless SSL server.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      24008,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"name": {
+			Description: "The keyless SSL name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"enabled": {
+			Description: "Whether the Keyless SSL is on or off.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"tunnel": {
+			Description: "Configuration for using Keyless SSL through a Cloudflare Tunnel.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"private_ip": {
+						Description:  "Private IP of the Key Server Host.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+					"vnet_id": {
+						Description: "Cloudflare Tunnel Virtual Network ID.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"status": {
+			Description: "Status of the Keyless SSL.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -28,15 +28,16 @@ func resourceCloudflareListSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"kind": {
-			Description:  "The type of items the list will contain.",
+			Description:  fmt.Sprintf("The type of items the list will contain. %s", renderAvailableDocumentationValuesStringSlice([]string{"ip", "redirect", "hostname", "asn"})),
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice([]string{"ip", "redirect"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"ip", "redirect", "hostname", "asn"}, false),
 			Required:     true,
 		},
 		"item": {
-			Type:     schema.TypeSet,
-			Optional: true,
-			Elem:     listItemElem,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        listItemElem,
+			Description: "The items in the list. Omit to manage the items separately using `cloudflare_list_item` resources.",
 		},
 	}
 }
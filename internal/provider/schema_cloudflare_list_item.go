@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var listItemValueKeys = []string{"ip", "redirect", "hostname", "asn"}
+
+func resourceCloudflareListItemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"list_id": {
+			Description: "The list identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"ip": {
+			Description:  "IP address to include in the list. Must only be set if the list is of kind `ip`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: listItemValueKeys,
+		},
+		"asn": {
+			Description:  "Autonomous system number to include in the list. Must only be set if the list is of kind `asn`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: listItemValueKeys,
+		},
+		"hostname": {
+			Description:  "Hostname to include in the list. Must only be set if the list is of kind `hostname`.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: listItemValueKeys,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url_hostname": {
+						Description: "The FQDN to match on. Wildcard sub-domain matching is allowed, e.g. `*.example.com`.",
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+					},
+				},
+			},
+		},
+		"redirect": {
+			Description:  "Redirect to include in the list. Must only be set if the list is of kind `redirect`.",
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: listItemValueKeys,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"source_url": {
+						Description: "The source url of the redirect.",
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+					},
+					"target_url": {
+						Description: "The target url of the redirect.",
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+					},
+					"include_subdomains": {
+						Description:  fmt.Sprintf("Whether the redirect also matches subdomains of the source url. %s", renderAvailableDocumentationValuesStringSlice([]string{"disabled", "enabled"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+					},
+					"subpath_matching": {
+						Description:  fmt.Sprintf("Whether the redirect also matches subpaths of the source url. %s", renderAvailableDocumentationValuesStringSlice([]string{"disabled", "enabled"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+					},
+					"status_code": {
+						Description: "The status code to be used when redirecting a request.",
+						Type:        schema.TypeInt,
+						Optional:    true,
+						ForceNew:    true,
+					},
+					"preserve_query_string": {
+						Description:  fmt.Sprintf("Whether the redirect target url should keep the query string of the request's url. %s", renderAvailableDocumentationValuesStringSlice([]string{"disabled", "enabled"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+					},
+					"preserve_path_suffix": {
+						Description:  fmt.Sprintf("Whether to preserve the path suffix when doing subpath matching. %s", renderAvailableDocumentationValuesStringSlice([]string{"disabled", "enabled"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
+					},
+				},
+			},
+		},
+		"comment": {
+			Description: "An optional comment for the item.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+	}
+}
@@ -314,6 +314,7 @@ var (
 						"adaptive_routing": {
 							Type:        schema.TypeSet,
 							Optional:    true,
+							MaxItems:    1,
 							Elem:        loadBalancerOverridesAdaptiveRoutingElem,
 							Description: "See [`adaptive_routing`](#adaptive_routing).",
 						},
@@ -321,6 +322,7 @@ var (
 						"location_strategy": {
 							Type:        schema.TypeSet,
 							Optional:    true,
+							MaxItems:    1,
 							Elem:        loadBalancerOverridesLocationStrategyElem,
 							Description: "See [`location_strategy`](#location_strategy).",
 						},
@@ -328,6 +330,7 @@ var (
 						"random_steering": {
 							Type:        schema.TypeSet,
 							Optional:    true,
+							MaxItems:    1,
 							Elem:        loadBalancerOverridesRandomSteeringElem,
 							Description: "See [`random_steering`](#random_steering).",
 						},
@@ -525,6 +528,7 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		"adaptive_routing": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			MaxItems:    1,
 			Elem:        loadBalancerAdaptiveRoutingElem,
 			Description: "Controls features that modify the routing of requests to pools and origins in response to dynamic conditions, such as during the interval between active health monitoring requests.",
 		},
@@ -532,6 +536,7 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		"location_strategy": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			MaxItems:    1,
 			Elem:        loadBalancerLocationStrategyElem,
 			Description: "Controls location-based steering for non-proxied requests.",
 		},
@@ -539,6 +544,7 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		"random_steering": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			MaxItems:    1,
 			Elem:        loadBalancerRandomSteeringElem,
 			Description: "Configures pool weights for random steering. When the [`steering_policy=\"random\"`](#steering_policy), a random pool is selected with probability proportional to these pool weights.",
 		},
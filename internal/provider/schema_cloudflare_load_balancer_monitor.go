@@ -40,7 +40,7 @@ func resourceCloudflareLoadBalancerMonitorSchema() map[string]*schema.Schema {
 			Type:         schema.TypeInt,
 			Optional:     true,
 			ValidateFunc: validation.IntBetween(0, 65535),
-			Description:  "The port number to use for the healthcheck, required when creating a TCP monitor.",
+			Description:  "The port number to use for the healthcheck, required when creating a TCP or UDP monitor.",
 		},
 
 		"retries": {
@@ -51,6 +51,22 @@ func resourceCloudflareLoadBalancerMonitorSchema() map[string]*schema.Schema {
 			Description:  "The number of retries to attempt in case of a timeout before marking the origin as unhealthy. Retries are attempted immediately.",
 		},
 
+		"consecutive_up": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The number of consecutive successful health checks required before marking an unhealthy origin as healthy.",
+		},
+
+		"consecutive_down": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The number of consecutive failed health checks required before marking a healthy origin as unhealthy.",
+		},
+
 		"timeout": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -27,6 +27,7 @@ func resourceCloudflareLoadBalancerPoolSchema() map[string]*schema.Schema {
 			Type:        schema.TypeSet,
 			Required:    true,
 			Elem:        originsElem,
+			Set:         hashLoadBalancerPoolOrigin,
 			Description: "The list of origins within this pool. Traffic directed at this pool is balanced across all currently healthy origins, provided the pool itself is healthy.",
 		},
 
@@ -91,6 +92,7 @@ func resourceCloudflareLoadBalancerPoolSchema() map[string]*schema.Schema {
 		"load_shedding": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			MaxItems:    1,
 			Elem:        loadShedElem,
 			Description: "Setting for controlling load shedding for this pool.",
 		},
@@ -98,6 +100,7 @@ func resourceCloudflareLoadBalancerPoolSchema() map[string]*schema.Schema {
 		"origin_steering": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			MaxItems:    1,
 			Elem:        originSteeringElem,
 			Description: "Set an origin steering policy to control origin selection within a pool.",
 		},
@@ -149,10 +152,16 @@ var originsElem = &schema.Resource{
 			Description: "Whether this origin is enabled. Disabled origins will not receive traffic and are excluded from health checks.",
 		},
 
+		"virtual_network_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The virtual network subnet ID the origin belongs in. Virtual network must also belong to the account.",
+		},
+
 		"header": {
 			Type:        schema.TypeSet,
 			Optional:    true,
-			Description: "HTTP request headers.",
+			Description: "HTTP request headers. Only the `Host` header can be overridden, for example so that the origin receives requests for a hostname other than the one the load balancer serves.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"header": {
@@ -211,14 +220,16 @@ var loadShedElem = &schema.Resource{
 	},
 }
 
+var loadBalancerPoolOriginSteeringPolicies = []string{"", "hash", "random", "least_outstanding_requests", "least_connections"}
+
 var originSteeringElem = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"policy": {
 			Type:         schema.TypeString,
 			Default:      "random",
 			Optional:     true,
-			ValidateFunc: validation.StringInSlice([]string{"", "hash", "random"}, false),
-			Description:  fmt.Sprintf("Origin steering policy to be used. %s", renderAvailableDocumentationValuesStringSlice([]string{"", "hash", "random"})),
+			ValidateFunc: validation.StringInSlice(loadBalancerPoolOriginSteeringPolicies, false),
+			Description:  fmt.Sprintf("Origin steering policy to be used. Value `random` selects an origin randomly, taking weights into account. Value `hash` selects an origin by hashing the client IP address. Value `least_outstanding_requests` selects the origin with the fewest pending requests and `least_connections` the origin with the fewest active connections, both weighted. %s", renderAvailableDocumentationValuesStringSlice(loadBalancerPoolOriginSteeringPolicies)),
 		},
 	},
 }
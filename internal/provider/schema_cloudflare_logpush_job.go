@@ -40,49 +40,111 @@ func resourceCloudflareLogpushJobSchema() map[string]*schema.Schema {
 			Description:  "The name of the logpush job to create.",
 		},
 		"dataset": {
-			Type:     schema.TypeString,
-			Required: true,
-			ValidateFunc: validation.StringInSlice([]string{
-				"access_requests",
-				"firewall_events",
-				"http_requests",
-				"spectrum_events",
-				"nel_reports",
-				"audit_logs",
-				"gateway_dns",
-				"gateway_http",
-				"gateway_network",
-				"dns_logs",
-				"network_analytics_logs",
-				"workers_trace_events",
-			}, false),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(logpushJobDatasets(), false),
 			Description: fmt.Sprintf(
-				"Uniquely identifies a resource (such as an s3 bucket) where data will be pushed. Additional configuration parameters supported by the destination may be included. See [Logpush destination documentation](https://developers.cloudflare.com/logs/reference/logpush-api-configuration#destination). %s",
-				renderAvailableDocumentationValuesStringSlice([]string{
-					"access_requests",
-					"firewall_events",
-					"http_requests",
-					"spectrum_events",
-					"nel_reports",
-					"audit_logs",
-					"gateway_dns",
-					"gateway_http",
-					"gateway_network",
-					"dns_logs",
-					"network_analytics_logs",
-					"workers_trace_events",
-				}),
+				"The kind of the dataset to use with the job. Datasets are only available to either account or zone scoped jobs. %s",
+				renderAvailableDocumentationValuesStringSlice(logpushJobDatasets()),
 			),
 		},
 		"logpull_options": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: `Configuration string for the Logshare API. It specifies things like requested fields and timestamp formats. See [Logpull options documentation](https://developers.cloudflare.com/logs/logpush/logpush-configuration-api/understanding-logpush-api/#options).`,
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"output_options"},
+			Description:   "Configuration string for the Logshare API. It specifies things like requested fields and timestamp formats. See [Logpull options documentation](https://developers.cloudflare.com/logs/logpush/logpush-configuration-api/understanding-logpush-api/#options). Conflicts with `output_options`.",
+		},
+		"output_options": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"logpull_options"},
+			Description:   "Structured replacement for `logpull_options`. When including this field, the `logpull_options` field will be ignored.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"field_names": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of field names to be included in the Logpush output.",
+					},
+					"timestamp_format": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "unixnano",
+						ValidateFunc: validation.StringInSlice([]string{"unixnano", "unix", "rfc3339"}, false),
+						Description:  fmt.Sprintf("Specifies the format for timestamps. %s", renderAvailableDocumentationValuesStringSlice([]string{"unixnano", "unix", "rfc3339"})),
+					},
+					"output_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "ndjson",
+						ValidateFunc: validation.StringInSlice([]string{"ndjson", "csv"}, false),
+						Description:  fmt.Sprintf("Specifies the output type. %s", renderAvailableDocumentationValuesStringSlice([]string{"ndjson", "csv"})),
+					},
+					"cve20214428": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Mitigation for CVE-2021-44228. If set to true, will cause all occurrences of `${` in the generated files to be replaced with `x{`.",
+					},
+					"sample_rate": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						Default:      1.0,
+						ValidateFunc: validation.FloatBetween(0, 1),
+						Description:  "Specifies the sampling rate.",
+					},
+					"batch_prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be prepended before each batch.",
+					},
+					"batch_suffix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be appended after each batch.",
+					},
+					"field_delimiter": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to join fields. This field will be ignored when `record_template` is set.",
+					},
+					"record_delimiter": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be inserted in-between the records as separator.",
+					},
+					"record_prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be prepended before each record.",
+					},
+					"record_suffix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be appended after each record.",
+					},
+					"record_template": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to use as template for each record instead of the default comma-separated list.",
+					},
+				},
+			},
 		},
 		"destination_conf": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Uniquely identifies a resource (such as an s3 bucket) where data will be pushed. Additional configuration parameters supported by the destination may be included. See [Logpush destination documentation](https://developers.cloudflare.com/logs/reference/logpush-api-configuration#destination).",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateLogpushJobDestinationConf,
+			Description:  "Uniquely identifies a resource (such as an s3 bucket) where data will be pushed. Additional configuration parameters supported by the destination may be included. R2 destinations must include the account ID, such as `r2://bucket/{DATE}?account-id=...`. See [Logpush destination documentation](https://developers.cloudflare.com/logs/reference/logpush-api-configuration#destination).",
 		},
 		"ownership_challenge": {
 			Type:        schema.TypeString,
@@ -90,9 +152,29 @@ func resourceCloudflareLogpushJobSchema() map[string]*schema.Schema {
 			Description: `Ownership challenge token to prove destination ownership, required when destination is Amazon S3, Google Cloud Storage, Microsoft Azure or Sumo Logic. See [Developer documentation](https://developers.cloudflare.com/logs/logpush/logpush-configuration-api/understanding-logpush-api/#usage).`,
 		},
 		"filter": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Use filters to select the events to include and/or remove from your logs. For more information, refer to [Filters](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/filters/).",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: suppressEquivalentLogpushJobFilter,
+			Description:      "Use filters to select the events to include and/or remove from your logs. For more information, refer to [Filters](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/filters/).",
+		},
+		"max_upload_bytes": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(5000000, 1000000000)),
+			Description:  "The maximum uncompressed file size of a batch of logs. Value must be between 5MB and 1GB, or `0` to disable it.",
+		},
+		"max_upload_records": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(1000, 1000000)),
+			Description:  "The maximum number of log lines per batch. Value must be between 1000 and 1,000,000, or `0` to disable it.",
+		},
+		"max_upload_interval_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(30, 300)),
+			Description:  "The maximum interval in seconds for log batches. Value must be between 30 and 300, or `0` to disable it.",
 		},
 		"frequency": {
 			Type:         schema.TypeString,
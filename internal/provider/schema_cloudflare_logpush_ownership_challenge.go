@@ -27,5 +27,11 @@ func resourceCloudflareLogpushOwnershipChallengeSchema() map[string]*schema.Sche
 			Computed:    true,
 			Description: "The filename of the ownership challenge which	contains the contents required for Logpush Job creation.",
 		},
+		"ownership_challenge_contents": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The contents of the ownership challenge, only available for R2 destinations. For other destinations, read the contents of `ownership_challenge_filename` from the destination.",
+		},
 	}
 }
@@ -29,6 +29,12 @@ func resourceCloudflareMagicFirewallRulesetSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     ruleElem,
 		},
+		"rule_ids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The identifiers assigned to the rules by the API, in the same order as `rules`.",
+		},
 	}
 }
 
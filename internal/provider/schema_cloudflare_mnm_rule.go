@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var mnmRuleDurations = []string{"1m", "5m", "10m", "15m", "20m", "30m", "45m", "60m"}
+
+func resourceCloudflareMNMRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the rule, it can only contain letters, numbers, underscores and hyphens.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"prefixes": {
+			Description: "The IP prefixes monitored by the rule.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+		"automatic_advertisement": {
+			Description: "Whether the prefixes are advertised automatically through Magic Transit when the rule is triggered. Defaults to `false`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"bandwidth_threshold": {
+			Description:  "The number of bits per second for the rule, it's triggered when the traffic of the prefixes exceeds it for the duration.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			AtLeastOneOf: []string{"bandwidth_threshold", "packet_threshold"},
+		},
+		"packet_threshold": {
+			Description:  "The number of packets per second for the rule, it's triggered when the traffic of the prefixes exceeds it for the duration.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			AtLeastOneOf: []string{"bandwidth_threshold", "packet_threshold"},
+		},
+		"duration": {
+			Description:  fmt.Sprintf("The amount of time the thresholds have to be exceeded for the rule to be triggered. %s Defaults to `1m`.", renderAvailableDocumentationValuesStringSlice(mnmRuleDurations)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1m",
+			ValidateFunc: validation.StringInSlice(mnmRuleDurations, false),
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Optional unique name for the certificate.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"certificates": {
+			Description: "Certificate you intend to use with mTLS-enabled services.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"private_key": {
+			Description: "The certificate's private key.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			ForceNew:    true,
+		},
+		"ca": {
+			Description: "Whether this is a CA or leaf certificate.",
+			Type:        schema.TypeBool,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"issuer": {
+			Description: "The certificate authority that issued the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"signature": {
+			Description: "The type of hash used for the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"serial_number": {
+			Description: "The certificate serial number.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"uploaded_on": {
+			Description: "When the certificate was uploaded to Cloudflare.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_on": {
+			Description: "When the certificate expires.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -30,104 +30,10 @@ func resourceCloudflareNotificationPolicySchema() map[string]*schema.Schema {
 			Description: "The status of the notification policy.",
 		},
 		"alert_type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ValidateFunc: validation.StringInSlice([]string{
-				"billing_usage_alert",
-				"health_check_status_notification",
-				"g6_pool_toggle_alert",
-				"real_origin_monitoring",
-				"universal_ssl_event_type",
-				"dedicated_ssl_certificate_event_type",
-				"custom_ssl_certificate_event_type",
-				"access_custom_certificate_expiration_type",
-				"zone_aop_custom_certificate_expiration_type",
-				"bgp_hijack_notification",
-				"http_alert_origin_error",
-				"workers_alert",
-				"weekly_account_overview",
-				"expiring_service_token_alert",
-				"secondary_dns_all_primaries_failing",
-				"secondary_dns_zone_validation_warning",
-				"secondary_dns_primaries_failing",
-				"secondary_dns_zone_successfully_updated",
-				"dos_attack_l7",
-				"dos_attack_l4",
-				"advanced_ddos_attack_l7_alert",
-				"advanced_ddos_attack_l4_alert",
-				"fbm_volumetric_attack",
-				"fbm_auto_advertisement",
-				"load_balancing_pool_enablement_alert",
-				"load_balancing_health_alert",
-				"g6_health_alert",
-				"http_alert_edge_error",
-				"clickhouse_alert_fw_anomaly",
-				"clickhouse_alert_fw_ent_anomaly",
-				"failing_logpush_job_disabled_alert",
-				"scriptmonitor_alert_new_hosts",
-				"scriptmonitor_alert_new_scripts",
-				"scriptmonitor_alert_new_malicious_scripts",
-				"scriptmonitor_alert_new_malicious_url",
-				"scriptmonitor_alert_new_code_change_detections",
-				"scriptmonitor_alert_new_max_length_script_url",
-				"scriptmonitor_alert_new_malicious_hosts",
-				"sentinel_alert",
-				"hostname_aop_custom_certificate_expiration_type",
-				"stream_live_notifications",
-				"block_notification_new_block",
-				"block_notification_review_rejected",
-				"block_notification_review_accepted",
-				"web_analytics_metrics_update",
-				"workers_uptime",
-			}, false),
-			Description: fmt.Sprintf("The event type that will trigger the dispatch of a notification. See the developer documentation for descriptions of [available alert types](https://developers.cloudflare.com/fundamentals/notifications/notification-available/). %s", renderAvailableDocumentationValuesStringSlice([]string{
-				"billing_usage_alert",
-				"health_check_status_notification",
-				"g6_pool_toggle_alert",
-				"real_origin_monitoring",
-				"universal_ssl_event_type",
-				"dedicated_ssl_certificate_event_type",
-				"custom_ssl_certificate_event_type",
-				"access_custom_certificate_expiration_type",
-				"zone_aop_custom_certificate_expiration_type",
-				"bgp_hijack_notification",
-				"http_alert_origin_error",
-				"workers_alert",
-				"weekly_account_overview",
-				"expiring_service_token_alert",
-				"secondary_dns_all_primaries_failing",
-				"secondary_dns_zone_validation_warning",
-				"secondary_dns_primaries_failing",
-				"secondary_dns_zone_successfully_updated",
-				"dos_attack_l7",
-				"dos_attack_l4",
-				"advanced_ddos_attack_l7_alert",
-				"advanced_ddos_attack_l4_alert",
-				"fbm_volumetric_attack",
-				"fbm_auto_advertisement",
-				"load_balancing_pool_enablement_alert",
-				"load_balancing_health_alert",
-				"g6_health_alert",
-				"http_alert_edge_error",
-				"clickhouse_alert_fw_anomaly",
-				"clickhouse_alert_fw_ent_anomaly",
-				"failing_logpush_job_disabled_alert",
-				"scriptmonitor_alert_new_hosts",
-				"scriptmonitor_alert_new_scripts",
-				"scriptmonitor_alert_new_malicious_scripts",
-				"scriptmonitor_alert_new_malicious_url",
-				"scriptmonitor_alert_new_code_change_detections",
-				"scriptmonitor_alert_new_max_length_script_url",
-				"scriptmonitor_alert_new_malicious_hosts",
-				"sentinel_alert",
-				"hostname_aop_custom_certificate_expiration_type",
-				"stream_live_notifications",
-				"block_notification_new_block",
-				"block_notification_review_rejected",
-				"block_notification_review_accepted",
-				"web_analytics_metrics_update",
-				"workers_uptime",
-			})),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(notificationPolicyAlertTypes, false),
+			Description:  fmt.Sprintf("The event type that will trigger the dispatch of a notification. See the developer documentation for descriptions of [available alert types](https://developers.cloudflare.com/fundamentals/notifications/notification-available/) or use the `cloudflare_notification_alert_types` data source. %s", renderAvailableDocumentationValuesStringSlice(notificationPolicyAlertTypes)),
 		},
 		"filters": notificationPolicyFilterSchema(),
 		"created": {
@@ -161,6 +67,74 @@ func resourceCloudflareNotificationPolicySchema() map[string]*schema.Schema {
 	}
 }
 
+// notificationPolicyAlertTypes are the alert types listed by the available
+// alerts endpoint, along with the legacy names still accepted by the API.
+var notificationPolicyAlertTypes = []string{
+	"access_custom_certificate_expiration_type",
+	"advanced_ddos_attack_l4_alert",
+	"advanced_ddos_attack_l7_alert",
+	"advanced_http_alert_error",
+	"bgp_hijack_notification",
+	"billing_usage_alert",
+	"block_notification_block_removed",
+	"block_notification_new_block",
+	"block_notification_review_accepted",
+	"block_notification_review_rejected",
+	"brand_protection_alert",
+	"brand_protection_digest",
+	"clickhouse_alert_fw_anomaly",
+	"clickhouse_alert_fw_ent_anomaly",
+	"custom_ssl_certificate_event_type",
+	"dedicated_ssl_certificate_event_type",
+	"dos_attack_l4",
+	"dos_attack_l7",
+	"expiring_service_token_alert",
+	"failing_logpush_job_disabled_alert",
+	"fbm_auto_advertisement",
+	"fbm_dosd_attack",
+	"fbm_volumetric_attack",
+	"g6_health_alert",
+	"g6_pool_toggle_alert",
+	"health_check_status_notification",
+	"hostname_aop_custom_certificate_expiration_type",
+	"http_alert_edge_error",
+	"http_alert_origin_error",
+	"incident_alert",
+	"load_balancing_health_alert",
+	"load_balancing_pool_enablement_alert",
+	"logo_match_alert",
+	"magic_tunnel_health_check_event",
+	"maintenance_event_notification",
+	"mtls_certificate_store_certificate_expiration_type",
+	"pages_event_alert",
+	"radar_notification",
+	"real_origin_monitoring",
+	"scriptmonitor_alert_new_code_change_detections",
+	"scriptmonitor_alert_new_hosts",
+	"scriptmonitor_alert_new_malicious_hosts",
+	"scriptmonitor_alert_new_malicious_scripts",
+	"scriptmonitor_alert_new_malicious_url",
+	"scriptmonitor_alert_new_max_length_resource_url",
+	"scriptmonitor_alert_new_max_length_script_url",
+	"scriptmonitor_alert_new_resources",
+	"scriptmonitor_alert_new_scripts",
+	"secondary_dns_all_primaries_failing",
+	"secondary_dns_primaries_failing",
+	"secondary_dns_zone_successfully_updated",
+	"secondary_dns_zone_validation_warning",
+	"sentinel_alert",
+	"stream_live_notifications",
+	"traffic_anomalies_alert",
+	"tunnel_health_event",
+	"tunnel_update_event",
+	"universal_ssl_event_type",
+	"web_analytics_metrics_update",
+	"weekly_account_overview",
+	"workers_alert",
+	"workers_uptime",
+	"zone_aop_custom_certificate_expiration_type",
+}
+
 var mechanismData = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"id": {
@@ -322,6 +296,103 @@ func notificationPolicyFilterSchema() *schema.Schema {
 					Optional:    true,
 					Description: "Stream event type to alert on.",
 				},
+				"actions": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Targeted actions for alert.",
+				},
+				"affected_components": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Affected components for alert. Example: `API`, `Dashboard`.",
+				},
+				"airport_code": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Filter on Points of Presence.",
+				},
+				"alert_trigger_preferences": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Alert trigger preferences. Example: `slo`.",
+				},
+				"group_by": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Alert grouping.",
+				},
+				"incident_impact": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{"INCIDENT_IMPACT_NONE", "INCIDENT_IMPACT_MINOR", "INCIDENT_IMPACT_MAJOR", "INCIDENT_IMPACT_CRITICAL"}, false),
+					},
+					Optional:    true,
+					Description: fmt.Sprintf("Filter by incident impact. %s", renderAvailableDocumentationValuesStringSlice([]string{"INCIDENT_IMPACT_NONE", "INCIDENT_IMPACT_MINOR", "INCIDENT_IMPACT_MAJOR", "INCIDENT_IMPACT_CRITICAL"})),
+				},
+				"megabits_per_second": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Megabits per second threshold for dos alert.",
+				},
+				"new_status": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Tunnel health status to alert on.",
+				},
+				"pop_names": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Points of Presence to alert on.",
+				},
+				"selectors": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Selectors for alert. Valid options depend on the alert type.",
+				},
+				"tunnel_id": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Tunnel IDs to alert on.",
+				},
+				"where": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional:    true,
+					Description: "Filter for alert.",
+				},
 			},
 		},
 	}
@@ -22,7 +22,8 @@ func resourceCloudflareNotificationPolicyWebhookSchema() map[string]*schema.Sche
 		"secret": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "An optional secret can be provided that will be passed in the `cf-webhook-auth` header when dispatching a webhook notification. Secrets are not returned in any API response body. Refer to the [documentation](https://api.cloudflare.com/#notification-webhooks-create-webhook) for more details.",
+			Sensitive:   true,
+			Description: "An optional secret can be provided that will be passed in the `cf-webhook-auth` header when dispatching a webhook notification. Secrets are not returned in any API response body and changing the value rotates the secret in place. Refer to the [documentation](https://api.cloudflare.com/#notification-webhooks-create-webhook) for more details.",
 		},
 		"type": {
 			Type:     schema.TypeString,
@@ -41,7 +42,7 @@ func resourceCloudflareNotificationPolicyWebhookSchema() map[string]*schema.Sche
 		"last_failure": {
 			Type:        schema.TypeString,
 			Computed:    true,
-			Description: "Timestamp of when the notification webhook last faiuled.",
+			Description: "Timestamp of when the notification webhook last failed.",
 		},
 	}
 }
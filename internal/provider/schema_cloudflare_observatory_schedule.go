@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var observatoryRegions = []string{
+	"asia-east1",
+	"asia-northeast1",
+	"asia-northeast2",
+	"asia-south1",
+	"asia-southeast1",
+	"australia-southeast1",
+	"europe-north1",
+	"europe-southwest1",
+	"europe-west1",
+	"europe-west2",
+	"europe-west3",
+	"europe-west4",
+	"europe-west8",
+	"europe-west9",
+	"me-west1",
+	"southamerica-east1",
+	"us-central1",
+	"us-east1",
+	"us-east4",
+	"us-south1",
+	"us-west1",
+}
+
+var observatoryFrequencies = []string{"DAILY", "WEEKLY"}
+
+func resourceCloudflareObservatoryScheduledTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"url": {
+			Description: "URL of the page to test, without the scheme. The hostname is case insensitive and a trailing slash is ignored.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return normalizeObservatoryURL(old) == normalizeObservatoryURL(new)
+			},
+		},
+		"region": {
+			Description:  fmt.Sprintf("Region the test is run from. %s", renderAvailableDocumentationValuesStringSlice(observatoryRegions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "us-central1",
+			ValidateFunc: validation.StringInSlice(observatoryRegions, false),
+		},
+		"frequency": {
+			Description:  fmt.Sprintf("How often the test is run. %s", renderAvailableDocumentationValuesStringSlice(observatoryFrequencies)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(observatoryFrequencies, true),
+			StateFunc: func(i interface{}) string {
+				return strings.ToUpper(i.(string))
+			},
+		},
+		"test_id": {
+			Description: "Identifier of the test run when the schedule was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"next_run": {
+			Description: "Expected time of the next scheduled test, based on the most recent test and the frequency.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -322,24 +322,30 @@ func resourceCloudflarePageRuleSchema() map[string]*schema.Schema {
 												Type:     schema.TypeSet,
 												Optional: true,
 												Computed: true,
+												Set:      hashPageRuleCacheKeyHeader,
 												Elem: &schema.Schema{
-													Type: schema.TypeString,
+													Type:             schema.TypeString,
+													DiffSuppressFunc: suppressEquivalentPageRuleHeaderNames,
 												},
 											},
 											"exclude": {
 												Type:     schema.TypeSet,
 												Optional: true,
 												Computed: true,
+												Set:      hashPageRuleCacheKeyHeader,
 												Elem: &schema.Schema{
-													Type: schema.TypeString,
+													Type:             schema.TypeString,
+													DiffSuppressFunc: suppressEquivalentPageRuleHeaderNames,
 												},
 											},
 											"include": {
 												Type:     schema.TypeSet,
 												Optional: true,
 												Computed: true,
+												Set:      hashPageRuleCacheKeyHeader,
 												Elem: &schema.Schema{
-													Type: schema.TypeString,
+													Type:             schema.TypeString,
+													DiffSuppressFunc: suppressEquivalentPageRuleHeaderNames,
 												},
 											},
 										},
@@ -425,11 +431,13 @@ func resourceCloudflarePageRuleSchema() map[string]*schema.Schema {
 					"cache_ttl_by_status": {
 						Type:     schema.TypeSet,
 						Optional: true,
+						Set:      hashPageRuleCacheTTLByStatus,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"codes": {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:             schema.TypeString,
+									Required:         true,
+									DiffSuppressFunc: suppressEquivalentPageRuleStatusCodes,
 								},
 								"ttl": {
 									Type:     schema.TypeInt,
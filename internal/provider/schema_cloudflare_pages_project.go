@@ -8,6 +8,11 @@ import (
 func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
 	buildConfig := schema.Resource{
 		Schema: map[string]*schema.Schema{
+			"build_caching": {
+				Type:        schema.TypeBool,
+				Description: "Enable build caching for the project.",
+				Optional:    true,
+			},
 			"build_command": {
 				Type:        schema.TypeString,
 				Description: "Command used to build project.",
@@ -119,6 +124,12 @@ func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
 				Description: "Environment variables for Pages Functions.",
 				Optional:    true,
 			},
+			"secrets": {
+				Type:        schema.TypeMap,
+				Description: "Encrypted environment variables for Pages Functions.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			"kv_namespaces": {
 				Type:        schema.TypeMap,
 				Description: "KV namespaces used for Pages Functions.",
@@ -139,6 +150,16 @@ func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
 				Description: "R2 Buckets used for Pages Functions.",
 				Optional:    true,
 			},
+			"queue_producers": {
+				Type:        schema.TypeMap,
+				Description: "Queue Producer bindings used for Pages Functions, mapping binding names to queue names.",
+				Optional:    true,
+			},
+			"analytics_engine_datasets": {
+				Type:        schema.TypeMap,
+				Description: "Analytics Engine bindings used for Pages Functions, mapping binding names to dataset names.",
+				Optional:    true,
+			},
 			"compatibility_date": {
 				Type:        schema.TypeString,
 				Description: "Compatibility date used for Pages Functions.",
@@ -224,8 +245,9 @@ func resourceCloudflarePagesProjectSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"source": {
-			Description: "Configuration for the project source.",
+			Description: "Configuration for the project source. Projects connected to a repository outside of Terraform keep their source when it's omitted.",
 			Optional:    true,
+			Computed:    true,
 			Type:        schema.TypeList,
 			Elem:        &source,
 			MaxItems:    1,
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareQueueConsumerSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"queue_name": {
+			Description: "The name of the queue to consume messages from.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"script_name": {
+			Description: "The name of the Worker script that will consume the queue messages.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"environment": {
+			Description: "The environment of the Worker script that will consume the queue messages.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"dead_letter_queue": {
+			Description: "The name of the queue that messages will be sent to once they have exhausted all retries.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"max_batch_size": {
+			Description:  "The maximum number of messages to include in a batch.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+		"max_batch_timeout": {
+			Description:  "The maximum number of seconds to wait until a batch is full.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 30),
+		},
+		"max_retries": {
+			Description:  "The maximum number of retries for a message, if it fails or `retryAll()` is invoked.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		"max_concurrency": {
+			Description:  "The maximum number of concurrent consumer Worker invocations. Leaving this unset will allow the number of invocations to scale to the maximum allowed.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
@@ -38,8 +38,8 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS"}, false),
-			Description:  fmt.Sprintf("The type of the record. %s", renderAvailableDocumentationValuesStringSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS"})),
+			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"}, false),
+			Description:  fmt.Sprintf("The type of the record. %s", renderAvailableDocumentationValuesStringSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS", "SVCB"})),
 		},
 
 		"value": {
@@ -69,8 +69,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"flags": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Flags of CAA and DNSKEY records.",
 					},
 					"service": {
 						Type:     schema.TypeString,
@@ -101,7 +102,8 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 
-					// SRV record properties
+					// SRV record properties, priority and target are also used by
+					// HTTPS and SVCB records
 					"proto": {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -194,6 +196,11 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 					},
 
 					// NAPTR record properties
+					"naptr_flags": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Flags of NAPTR records.",
+					},
 					"order": {
 						Type:     schema.TypeInt,
 						Optional: true,
@@ -223,7 +230,8 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 
-					// CAA record properties
+					// CAA record properties, value is also used for the parameters of
+					// HTTPS and SVCB records
 					"tag": {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareRegionalHostnameSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostname": {
+			Description: "The hostname to regionalize.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"region_key": {
+			Description: "The region key. See the `cloudflare_regional_hostname_regions` data source for the available region keys.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"created_on": {
+			Description: "The RFC3339 timestamp of when the hostname was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -68,8 +68,9 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 					},
 					"ref": {
 						Type:        schema.TypeString,
+						Optional:    true,
 						Computed:    true,
-						Description: "Rule reference.",
+						Description: "Rule reference. Set this to a stable value to keep the identity of the rule, and any counters attached to it, when rules are reordered. Defaults to the rule ID.",
 					},
 					"enabled": {
 						Type:        schema.TypeBool,
@@ -296,6 +297,12 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 															ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
 															Description:  fmt.Sprintf("Defines if the current tag-level override enables or disables the ruleset rules with the specified tag. %s", renderAvailableDocumentationValuesStringSlice([]string{"enabled", "disabled"})),
 														},
+														"sensitivity_level": {
+															Type:         schema.TypeString,
+															Optional:     true,
+															ValidateFunc: validation.StringInSlice([]string{"default", "medium", "low", "eoff"}, false),
+															Description:  fmt.Sprintf("Sensitivity level to override for all rules with the specified tag. %s", renderAvailableDocumentationValuesStringSlice([]string{"default", "medium", "low", "eoff"})),
+														},
 													},
 												},
 											},
@@ -335,9 +342,10 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 															Description: "Anomaly score threshold to apply in the ruleset rule override. Only applicable to modsecurity-based rulesets.",
 														},
 														"sensitivity_level": {
-															Type:        schema.TypeString,
-															Optional:    true,
-															Description: "Sensitivity level for a ruleset rule override.",
+															Type:         schema.TypeString,
+															Optional:     true,
+															ValidateFunc: validation.StringInSlice([]string{"default", "medium", "low", "eoff"}, false),
+															Description:  fmt.Sprintf("Sensitivity level for a ruleset rule override. %s", renderAvailableDocumentationValuesStringSlice([]string{"default", "medium", "low", "eoff"})),
 														},
 													},
 												},
@@ -399,6 +407,11 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Optional:    true,
 									Description: "HTTP status code of the custom error response",
 								},
+								"asset_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "Name of the custom error response asset to serve. Conflicts with `content`.",
+								},
 								"host_header": {
 									Type:        schema.TypeString,
 									Optional:    true,
@@ -828,6 +841,36 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Optional:    true,
 									Description: "Pass-through error page for origin.",
 								},
+								"origin_cache_control": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Description: "Enable or disable the use of a more compliant Cache Control parsing mechanism, enabled by default for most zones.",
+								},
+								"read_timeout": {
+									Type:        schema.TypeInt,
+									Optional:    true,
+									Description: "Specifies a maximum timeout in seconds for reading content from an origin server.",
+								},
+								"cache_reserve": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									MaxItems:    1,
+									Description: "List of cache reserve parameters to apply to the request.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"eligible": {
+												Type:        schema.TypeBool,
+												Required:    true,
+												Description: "Determines whether Cloudflare will write the eligible resource to cache reserve.",
+											},
+											"minimum_file_size": {
+												Type:        schema.TypeInt,
+												Optional:    true,
+												Description: "The minimum file size, in bytes, eligible for storage in cache reserve.",
+											},
+										},
+									},
+								},
 								"from_list": {
 									Type:        schema.TypeList,
 									Optional:    true,
@@ -901,7 +944,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 								"characteristics": {
 									Type:        schema.TypeSet,
 									Optional:    true,
-									Description: "List of parameters that define how Cloudflare tracks the request rate for this rule.",
+									Description: "List of parameters that define how Cloudflare tracks the request rate for this rule, such as `http.request.headers[\"x-api-key\"]` or `cf.bot_management.ja3_hash`. Must include `cf.colo.id`.",
 									Elem: &schema.Schema{
 										Type: schema.TypeString,
 									},
@@ -916,10 +959,20 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Optional:    true,
 									Description: "The number of requests over the period of time that will trigger the Rate Limiting rule.",
 								},
+								"score_per_period": {
+									Type:        schema.TypeInt,
+									Optional:    true,
+									Description: "The maximum aggregate score over the period of time that will trigger the Rate Limiting rule. Used instead of `requests_per_period` for complexity based rate limiting.",
+								},
+								"score_response_header_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "The name of the origin response header which holds the score of each request when using `score_per_period`.",
+								},
 								"mitigation_timeout": {
 									Type:        schema.TypeInt,
 									Optional:    true,
-									Description: "Once the request rate is reached, the Rate Limiting rule blocks further requests for the period of time defined in this field.",
+									Description: "Once the request rate is reached, the Rate Limiting rule blocks further requests for the period of time defined in this field. Must be `0` for rules which only log or challenge requests.",
 								},
 								"counting_expression": {
 									Type:        schema.TypeString,
@@ -16,9 +16,11 @@ func resourceCloudflareSpectrumApplicationSchema() map[string]*schema.Schema {
 		},
 
 		"protocol": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The port configuration at Cloudflare’s edge. e.g. `tcp/22`.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validateSpectrumApplicationProtocol,
+			DiffSuppressFunc: suppressSpectrumApplicationProtocolCase,
+			Description:      "The port configuration at Cloudflare’s edge. e.g. `tcp/22` or a range such as `tcp/1000-2000`.",
 		},
 
 		"traffic_type": {
@@ -74,11 +76,11 @@ func resourceCloudflareSpectrumApplicationSchema() map[string]*schema.Schema {
 		},
 
 		"origin_port": {
-			Type:          schema.TypeInt,
+			Type:          schema.TypeString,
 			Optional:      true,
 			ConflictsWith: []string{"origin_port_range"},
-			ValidateFunc:  validation.IntBetween(0, 65535),
-			Description:   "Origin port to proxy traffice to.",
+			ValidateFunc:  validateSpectrumApplicationOriginPort,
+			Description:   "Origin port to proxy traffice to, either a single port such as `22` or a range such as `2000-3000`. When using a range, the protocol field must also specify a range of the same length.",
 		},
 
 		"origin_port_range": {
@@ -86,6 +88,7 @@ func resourceCloudflareSpectrumApplicationSchema() map[string]*schema.Schema {
 			Optional:      true,
 			MaxItems:      1,
 			ConflictsWith: []string{"origin_port"},
+			Deprecated:    "Use `origin_port` with a range such as `2000-3000` instead.",
 			Description:   "Origin port range to proxy traffice to. When using a range, the protocol field must also specify a range, e.g. `tcp/22-23`.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -129,18 +132,34 @@ func resourceCloudflareSpectrumApplicationSchema() map[string]*schema.Schema {
 		},
 
 		"edge_ips": {
-			Type:        schema.TypeSet,
+			Type:        schema.TypeList,
 			Optional:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "A list of edge IPs (IPv4 and/or IPv6) to configure Spectrum application to. Requires [Bring Your Own IP](https://developers.cloudflare.com/spectrum/getting-started/byoip/) provisioned.",
-		},
-
-		"edge_ip_connectivity": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringInSlice([]string{"all", "ipv4", "ipv6"}, false),
-			Description:  fmt.Sprintf("Choose which types of IP addresses will be provisioned for this subdomain. %s", renderAvailableDocumentationValuesStringSlice([]string{"all", "ipv4", "ipv6"})),
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The anycast edge IP configuration for the hostname of this application.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"dynamic", "static"}, false),
+						Description:  fmt.Sprintf("The type of edge IP configuration specified. %s", renderAvailableDocumentationValuesStringSlice([]string{"dynamic", "static"})),
+					},
+					"connectivity": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"all", "ipv4", "ipv6"}, false),
+						Description:  fmt.Sprintf("The IP versions supported for inbound connections on Spectrum anycast IPs. Required when `type` is not `static`. %s", renderAvailableDocumentationValuesStringSlice([]string{"all", "ipv4", "ipv6"})),
+					},
+					"ips": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsIPAddress},
+						Description: "The collection of customer owned IPs to broadcast via anycast for this hostname and application. Requires [Bring Your Own IP](https://developers.cloudflare.com/spectrum/getting-started/byoip/) provisioned. Required when `type` is `static`.",
+					},
+				},
+			},
 		},
 
 		"argo_smart_routing": {
@@ -17,6 +17,7 @@ func resourceCloudflareSplitTunnelSchema() map[string]*schema.Schema {
 		"mode": {
 			Type:         schema.TypeString,
 			Required:     true,
+			ForceNew:     true,
 			Description:  fmt.Sprintf("The mode of the split tunnel policy. %s", renderAvailableDocumentationValuesStringSlice([]string{"include", "exclude"})),
 			ValidateFunc: validation.StringInSlice([]string{"include", "exclude"}, false),
 		},
@@ -27,9 +28,11 @@ func resourceCloudflareSplitTunnelSchema() map[string]*schema.Schema {
 			Elem:        tunnelSetResource,
 		},
 		"policy_id": {
-			Optional:    true,
-			Type:        schema.TypeString,
-			Description: "The settings policy for which to configure this split tunnel policy.",
+			Optional:         true,
+			ForceNew:         true,
+			Type:             schema.TypeString,
+			DiffSuppressFunc: suppressEquivalentDevicePolicyID,
+			Description:      "The settings policy for which to configure this split tunnel policy. Accepts the ID of a `cloudflare_device_settings_policy` resource or a bare policy ID. Defaults to the default device settings policy.",
 		},
 	}
 }
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareStreamLiveInputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"default_creator": {
+			Description: "The creator ID set on the videos recorded from the live input.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"recording": {
+			Description: "How the live input is recorded.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Description:  fmt.Sprintf("Whether the live input is recorded. %s", renderAvailableDocumentationValuesStringSlice([]string{"off", "automatic"})),
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "off",
+						ValidateFunc: validation.StringInSlice([]string{"off", "automatic"}, false),
+					},
+					"timeout": {
+						Description:  "The number of seconds a disconnected live input waits for the broadcast to resume before the recording is ended. `0` uses the default.",
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"require_signed_urls": {
+						Description: "Whether the recorded videos require signed URLs to be viewed.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"rtmps": {
+			Description: "The RTMPS ingest point of the live input.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Description: "The URL to broadcast to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"stream_key": {
+						Description: "The stream key to broadcast with.",
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+		},
+		"srt": {
+			Description: "The SRT ingest point of the live input.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Description: "The URL to broadcast to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"stream_id": {
+						Description: "The stream ID to broadcast with.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"passphrase": {
+						Description: "The passphrase to broadcast with.",
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+		},
+		"created": {
+			Description: "When the live input was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified": {
+			Description: "When the live input was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -1,6 +1,11 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceCloudflareTeamsAccountSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -51,6 +56,48 @@ func resourceCloudflareTeamsAccountSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Safely browse websites in Browser Isolation through a URL.",
 		},
+		"non_identity_browser_isolation_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable non-identity onramp for Browser Isolation.",
+		},
+		"protocol_detection_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Indicator that protocol detection is enabled.",
+		},
+		"body_scanning": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: bodyScanningSchema,
+			},
+			Description: "Configuration for body scanning.",
+		},
+		"extended_email_matching": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: extendedEmailMatchingSchema,
+			},
+			Description: "Configuration for matching email addresses regardless of dots and plus-addressing.",
+		},
+		"custom_certificate": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: customCertificateSchema,
+			},
+			Description: "Configuration for the custom certificate used to sign TLS traffic inspected by Gateway.",
+		},
 		"logging": {
 			Type:     schema.TypeList,
 			MaxItems: 1,
@@ -79,6 +126,46 @@ var fipsSchema = map[string]*schema.Schema{
 	},
 }
 
+var bodyScanningSchema = map[string]*schema.Schema{
+	"inspection_mode": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice([]string{"deep", "shallow"}, false),
+		Description:  fmt.Sprintf("Body scanning inspection mode. %s", renderAvailableDocumentationValuesStringSlice([]string{"deep", "shallow"})),
+	},
+}
+
+var extendedEmailMatchingSchema = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Whether e-mail addresses in rules should match regardless of dots and plus-addressing.",
+	},
+}
+
+var customCertificateSchema = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Whether TLS encryption should use a custom certificate.",
+	},
+	"id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "ID of custom certificate.",
+	},
+	"binding_status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Certificate status (internal).",
+	},
+	"updated_at": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Timestamp of when the custom certificate was last updated.",
+	},
+}
+
 var blockPageSchema = map[string]*schema.Schema{
 	"enabled": {
 		Type:        schema.TypeBool,
@@ -147,14 +234,16 @@ var loggingSchema = map[string]*schema.Schema{
 	"settings_by_rule_type": {
 		Type:        schema.TypeList,
 		MaxItems:    1,
-		Required:    true,
-		Description: "Represents whether all requests are logged or only the blocked requests are slogged in DNS, HTTP and L4 filters.",
+		Optional:    true,
+		Computed:    true,
+		Description: "Represents whether all requests are logged or only the blocked requests are slogged in DNS, HTTP and L4 filters. Rule types which aren't configured keep their current settings.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"dns": {
 					Type:        schema.TypeList,
 					MaxItems:    1,
-					Required:    true,
+					Optional:    true,
+					Computed:    true,
 					Description: "Logging configuration for DNS requests.",
 					Elem: &schema.Resource{
 						Schema: loggingEnabledSchema,
@@ -163,7 +252,8 @@ var loggingSchema = map[string]*schema.Schema{
 				"http": {
 					Type:        schema.TypeList,
 					MaxItems:    1,
-					Required:    true,
+					Optional:    true,
+					Computed:    true,
 					Description: "Logging configuration for HTTP requests.",
 					Elem: &schema.Resource{
 						Schema: loggingEnabledSchema,
@@ -172,7 +262,8 @@ var loggingSchema = map[string]*schema.Schema{
 				"l4": {
 					Type:        schema.TypeList,
 					MaxItems:    1,
-					Required:    true,
+					Optional:    true,
+					Computed:    true,
 					Description: "Logging configuration for layer 4 requests.",
 					Elem: &schema.Resource{
 						Schema: loggingEnabledSchema,
@@ -183,7 +274,8 @@ var loggingSchema = map[string]*schema.Schema{
 	},
 	"redact_pii": {
 		Type:        schema.TypeBool,
-		Required:    true,
+		Optional:    true,
+		Computed:    true,
 		Description: "Redact personally identifiable information from activity logging (PII fields are: source IP, user email, user ID, device ID, URL, referrer, user agent).",
 	},
 }
@@ -195,7 +287,8 @@ var loggingEnabledSchema = map[string]*schema.Schema{
 		Description: "Whether to log all activity.",
 	},
 	"log_blocks": {
-		Type:     schema.TypeBool,
-		Required: true,
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Whether to log blocked activity.",
 	},
 }
@@ -31,12 +31,33 @@ func resourceCloudflareTeamsListSchema() map[string]*schema.Schema {
 			Description: "The description of the teams list.",
 		},
 		"items": {
-			Type:        schema.TypeSet,
-			Optional:    true,
-			Description: "The items of the teams list.",
+			Type:          schema.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"items_with_description"},
+			Description:   "The items of the teams list.",
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
 		},
+		"items_with_description": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"items"},
+			Description:   "The items of the teams list, with a description of each item.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The value of the item.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The description of the item.",
+					},
+				},
+			},
+		},
 	}
 }
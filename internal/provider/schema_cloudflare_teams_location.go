@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -15,9 +18,12 @@ func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 			Description: "Name of the teams location.",
 		},
 		"networks": {
-			Type:        schema.TypeSet,
-			Optional:    true,
-			Description: "The networks CIDRs that comprise the location.",
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			Deprecated:    "Use `endpoints.ipv4.networks` instead.",
+			ConflictsWith: []string{"endpoints.0.ipv4.0.networks"},
+			Description:   "The networks CIDRs that comprise the location.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"id": {
@@ -32,6 +38,27 @@ func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"ecs_support": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the EDNS Client Subnet of DNS queries is sent to authoritative nameservers.",
+		},
+		"endpoints": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The endpoints of the location for each DNS protocol.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ipv4": teamsLocationEndpointSchema("The IPv4 endpoint of the location. Its networks are the same as the deprecated `networks`.", []string{"networks"}),
+					"ipv6": teamsLocationEndpointSchema("The IPv6 endpoint of the location.", nil),
+					"dot":  teamsLocationEndpointSchema("The DNS over TLS endpoint of the location.", nil),
+					"doh":  teamsLocationEndpointSchema("The DNS over HTTPS endpoint of the location.", nil),
+				},
+			},
+		},
 		"client_default": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -62,5 +89,51 @@ func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "IP to direct all IPv4 DNS queries to.",
 		},
+		"ipv4_destination_backup": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Backup IP to direct IPv4 DNS queries to.",
+		},
+		"dns_destination_ips_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the dedicated DNS resolver IPs of the location.",
+		},
+		"dns_destination_ipv6_block_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the IPv6 block of the dedicated DNS resolver IPs of the location.",
+		},
+	}
+}
+
+func teamsLocationEndpointSchema(description string, conflictsWith []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether the endpoint is enabled.",
+				},
+				"networks": {
+					Type:          schema.TypeSet,
+					Optional:      true,
+					Computed:      true,
+					ConflictsWith: conflictsWith,
+					Description:   "The CIDRs of the networks allowed to use the endpoint.",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsCIDR,
+					},
+				},
+			},
+		},
 	}
 }
@@ -140,6 +140,132 @@ var teamsRuleSettings = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Disable DNSSEC validation (must be Allow rule).",
 	},
+	"untrusted_cert": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsUntrustedCertSettings,
+		},
+		Description: "Configure behavior when an upstream cert is invalid / an SSL error occurs.",
+	},
+	"payload_log": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsPayloadLogSettings,
+		},
+		Description: "Configure DLP Payload Logging settings for this rule.",
+	},
+	"notification_settings": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsNotificationSettings,
+		},
+		Description: "Notification settings on a block rule.",
+	},
+	"resolve_dns_through_cloudflare": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"rule_settings.0.dns_resolvers"},
+		Description:   "Enable sending queries that match the resolver policy to Cloudflare's default 1.1.1.1 DNS resolver. Cannot be set when `dns_resolvers` are specified.",
+	},
+	"dns_resolvers": {
+		Type:          schema.TypeList,
+		MaxItems:      1,
+		Optional:      true,
+		ConflictsWith: []string{"rule_settings.0.resolve_dns_through_cloudflare"},
+		Elem: &schema.Resource{
+			Schema: teamsDNSResolverSettings,
+		},
+		Description: "Add your own custom resolvers to route queries that match the resolver policy. Cannot be used when `resolve_dns_through_cloudflare` is set.",
+	},
+	"ignore_cname_category_matches": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Set to true, to ignore the category matches at CNAME domains in a response.",
+	},
+}
+
+var teamsUntrustedCertSettings = map[string]*schema.Schema{
+	"action": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice([]string{"pass_through", "block", "error"}, false),
+		Description:  fmt.Sprintf("Action to be taken when the SSL certificate of upstream is invalid. %s", renderAvailableDocumentationValuesStringSlice([]string{"pass_through", "block", "error"})),
+	},
+}
+
+var teamsPayloadLogSettings = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Enable or disable DLP Payload Logging for this rule.",
+	},
+}
+
+var teamsNotificationSettings = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Enable notification settings.",
+	},
+	"message": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Notification content.",
+	},
+	"support_url": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Support URL to show in the notification.",
+	},
+}
+
+var teamsDNSResolverSettings = map[string]*schema.Schema{
+	"ipv4": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsDNSResolverAddress,
+		},
+		Description: "IPv4 resolvers.",
+	},
+	"ipv6": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsDNSResolverAddress,
+		},
+		Description: "IPv6 resolvers.",
+	},
+}
+
+var teamsDNSResolverAddress = map[string]*schema.Schema{
+	"ip": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The IP address of the DNS resolver.",
+	},
+	"port": {
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     53,
+		Description: "The port of the DNS resolver.",
+	},
+	"vnet_id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The ID of the virtual network the DNS resolver is reachable through, when routed through a private network.",
+	},
+	"route_through_private_network": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the DNS resolver should be reached through a private network via Magic WAN or a tunnel.",
+	},
 }
 
 var teamsL4OverrideSettings = map[string]*schema.Schema{
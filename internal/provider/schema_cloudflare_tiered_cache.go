@@ -16,7 +16,7 @@ func resourceCloudflareTieredCacheSchema() map[string]*schema.Schema {
 			ForceNew:    true,
 		},
 		"cache_type": {
-			Description:  fmt.Sprintf("The typed of tiered cache to utilize on the zone. %s", renderAvailableDocumentationValuesStringSlice([]string{"generic", "smart", "off"})),
+			Description:  fmt.Sprintf("The type of tiered cache to utilize on the zone. %s", renderAvailableDocumentationValuesStringSlice([]string{"generic", "smart", "off"})),
 			Type:         schema.TypeString,
 			Required:     true,
 			ValidateFunc: validation.StringInSlice([]string{"generic", "smart", "off"}, false),
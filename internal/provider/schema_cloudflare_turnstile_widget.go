@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var turnstileWidgetModes = []string{"managed", "non-interactive", "invisible"}
+
+func resourceCloudflareTurnstileWidgetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "Human readable widget name.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"domains": {
+			Description: "Domains where the widget is deployed.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"mode": {
+			Description:  fmt.Sprintf("Widget Mode. %s", renderAvailableDocumentationValuesStringSlice(turnstileWidgetModes)),
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(turnstileWidgetModes, false),
+		},
+		"bot_fight_mode": {
+			Description: "If bot_fight_mode is set to true, Cloudflare issues computationally expensive challenges in response to malicious bots (Enterprise only).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"region": {
+			Description: "Region where this widget can be used.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"offlabel": {
+			Description: "Do not show any Cloudflare branding on the widget (Enterprise only).",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"secret": {
+			Description: "Secret key for this widget. Only available on creation.",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+	}
+}
@@ -25,6 +25,14 @@ var defaultTemplateLanguages = []string{
 	"zh-CN",
 	"zh-TW",
 }
+var waitingRoomQueueingStatusCodes = []int{
+	200,
+	202,
+	429,
+}
+var waitingRoomOriginCommands = []string{
+	"revoke",
+}
 var waitingRoomQueueingMethod = []string{
 	"fifo",
 	"random",
@@ -132,6 +140,55 @@ func resourceCloudflareWaitingRoomSchema() map[string]*schema.Schema {
 			Default:     5,
 		},
 
+		"additional_routes": {
+			Description: "A list of additional hostname and paths to which the waiting room applies. Only one waiting room can be configured for a given host and path.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": {
+						Description:      "The additional host name for which the waiting room will be applied (no wildcards). Defaults to the waiting room `host`.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressWaitingRoomAdditionalRouteHost,
+						StateFunc: func(i interface{}) string {
+							return strings.ToLower(i.(string))
+						},
+					},
+					"path": {
+						Description: "The path within the additional host to enable the waiting room on.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "/",
+					},
+				},
+			},
+		},
+
+		"queueing_status_code": {
+			Description:  fmt.Sprintf("HTTP status code returned to a user while in the queue. %s", renderAvailableDocumentationValuesIntSlice(waitingRoomQueueingStatusCodes)),
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      200,
+			ValidateFunc: validation.IntInSlice(waitingRoomQueueingStatusCodes),
+		},
+
+		"cookie_suffix": {
+			Description: "A cookie suffix to be appended to the Cloudflare waiting room cookie name.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+
+		"enabled_origin_commands": {
+			Description: fmt.Sprintf("A list of enabled origin commands, sent by the origin in the `Cf-Waiting-Room-Command` response header. %s", renderAvailableDocumentationValuesStringSlice(waitingRoomOriginCommands)),
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(waitingRoomOriginCommands, false),
+			},
+		},
+
 		"json_response_enabled": {
 			Description: "If true, requests to the waiting room with the header `Accept: application/json` will receive a JSON response object.",
 			Type:        schema.TypeBool,
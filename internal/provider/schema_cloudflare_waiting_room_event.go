@@ -86,6 +86,12 @@ func resourceCloudflareWaitingRoomEventSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 
+		"json_response_enabled": {
+			Description: "If set, the event will override the waiting room's `json_response_enabled` property while it is active. If null, the event will inherit it.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+
 		"prequeue_start_time": {
 			Description: "ISO 8601 timestamp that marks when to begin queueing all users before the event starts. Must occur at least 5 minutes before `event_start_time`.",
 			Type:        schema.TypeString,
@@ -28,7 +28,7 @@ func resourceCloudflareWaitingRoomRulesSchema() map[string]*schema.Schema {
 		"rules": {
 			Type:        schema.TypeList,
 			Optional:    true,
-			Description: "List of rules to apply to the ruleset.",
+			Description: "List of rules to apply to the ruleset. Rules are evaluated in order and the whole list is replaced on update.",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"id": {
@@ -44,6 +44,7 @@ func resourceCloudflareWaitingRoomRulesSchema() map[string]*schema.Schema {
 					"status": {
 						Type:         schema.TypeString,
 						Optional:     true,
+						Default:      "enabled",
 						ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled"}, false),
 						Description:  fmt.Sprintf("Whether the rule is enabled or disabled. %s", renderAvailableDocumentationValuesStringSlice([]string{"enabled", "disabled"})),
 					},
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWebAnalyticsSiteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"zone_tag": {
+			Description:  "The zone identifier for automatic installation of the Web Analytics snippet on a proxied zone.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"zone_tag", "host"},
+		},
+		"host": {
+			Description:  "The hostname to use for gray-clouded sites.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"zone_tag", "host"},
+		},
+		"auto_install": {
+			Description: "Whether Cloudflare will automatically inject the JavaScript snippet for orange-clouded sites.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"site_tag": {
+			Description: "The Web Analytics site tag.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"site_token": {
+			Description: "The token for the Web Analytics site.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"snippet": {
+			Description: "The encoded JS snippet to add to your site's HTML page if `auto_install` is false.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ruleset_id": {
+			Description: "The ID for the ruleset associated to this Web Analytics site.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
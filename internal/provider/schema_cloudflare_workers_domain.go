@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWorkersDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"zone_id": {
+			Description: "The zone identifier where the domain is located.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hostname": {
+			Description: "Hostname of the Worker Domain.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"service": {
+			Description: "Name of the Worker script to attach the domain to.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"environment": {
+			Description: "The name of the Worker environment to attach the domain to.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "production",
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -23,9 +26,37 @@ func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
 			Description: "The ID of the Workers KV namespace in which you want to create the KV pair.",
 		},
 		"value": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Value of the KV pair.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"value", "value_base64"},
+			Description:  "Value of the KV pair.",
+		},
+		"value_base64": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsBase64,
+			ExactlyOneOf: []string{"value", "value_base64"},
+			Description:  "Base64 encoded value of the KV pair, for binary values.",
+		},
+		"expiration": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ConflictsWith: []string{"expiration_ttl"},
+			Description:   "The time, in seconds since the UNIX epoch, at which the KV pair expires.",
+		},
+		"expiration_ttl": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ValidateFunc:  validation.IntAtLeast(60),
+			ConflictsWith: []string{"expiration"},
+			Description:   "The number of seconds from when the KV pair is written until it expires. Must be at least `60`. The TTL restarts whenever the KV pair is updated.",
+		},
+		"metadata": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: suppressEquivalentWorkersKVMetadata,
+			Description:      "JSON encoded metadata of the KV pair.",
 		},
 	}
 }
@@ -1,6 +1,11 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var kvNamespaceBindingResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
@@ -113,6 +118,36 @@ var analyticsEngineBindingResource = &schema.Resource{
 	},
 }
 
+var queueBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"binding": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the global variable for the binding in your Worker code.",
+		},
+		"queue": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the queue you want to use.",
+		},
+	},
+}
+
+var d1DatabaseBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The global variable for the binding in your Worker code.",
+		},
+		"database_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "ID of the D1 database you want to use.",
+		},
+	},
+}
+
 func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_id": {
@@ -134,7 +169,28 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 		"module": {
 			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Whether to upload Worker as a module.",
+			Description: "Whether to upload Worker as a module using the ES module syntax.",
+		},
+		"compatibility_date": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "compatibility date must be in the format YYYY-MM-DD"),
+			Description:  "The date to use for the compatibility flag defaults, in the format `YYYY-MM-DD`. See [Compatibility Dates](https://developers.cloudflare.com/workers/platform/compatibility-dates/) for more details.",
+		},
+		"compatibility_flags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Compatibility flags used for the Worker script in addition to those implied by the `compatibility_date`.",
+		},
+		"logpush": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Enables Workers Logpush for the script.",
 		},
 		"plain_text_binding": {
 			Type:     schema.TypeSet,
@@ -171,5 +227,15 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     analyticsEngineBindingResource,
 		},
+		"queue_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     queueBindingResource,
+		},
+		"d1_database_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     d1DatabaseBindingResource,
+		},
 	}
 }
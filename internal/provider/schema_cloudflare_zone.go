@@ -97,6 +97,20 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 			},
 			Description: "Cloudflare-assigned name servers. This is only populated for zones that use Cloudflare DNS.",
 		},
+		"original_name_servers": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Name servers of the zone before it was moved to Cloudflare.",
+		},
+		"wait_for_active": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to trigger an activation check and wait for a pending zone to become active during creation, or once this is enabled. The wait is bounded by the `create` and `update` timeouts and, as activation can take days, only results in a warning if the zone isn't active by then.",
+		},
 		"verification_key": {
 			Type:        schema.TypeString,
 			Computed:    true,
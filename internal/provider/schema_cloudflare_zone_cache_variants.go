@@ -8,13 +8,12 @@ import (
 
 func resourceCloudflareZoneCacheVariantsExtensionSchema(ext string) *schema.Schema {
 	return &schema.Schema{
-		MinItems: 1,
 		Optional: true,
 		Type:     schema.TypeSet,
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
 		},
-		Description: fmt.Sprintf("List of strings with the MIME types of all the variants that should be served for %s", ext),
+		Description: fmt.Sprintf("List of strings with the MIME types of all the variants that should be served for %s. An empty list clears the variants of the extension.", ext),
 	}
 }
 
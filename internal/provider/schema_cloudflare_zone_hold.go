@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZoneHoldSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"hold": {
+			Description: "Whether the zone hold is enabled. Reflects `false` once a hold has expired.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"include_subdomains": {
+			Description: "Whether the hold also prevents the creation of subdomains of the zone in other accounts.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"hold_after": {
+			Description:  "RFC3339 timestamp after which the hold is temporarily released, allowing the zone to be added to another account.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				oldTime, err := time.Parse(time.RFC3339, old)
+				if err != nil {
+					return false
+				}
+				newTime, err := time.Parse(time.RFC3339, new)
+				if err != nil {
+					return false
+				}
+				return oldTime.Equal(newTime)
+			},
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSettingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"setting_id": {
+			Description: "The identifier of the zone setting, for example `min_tls_version` or `always_use_https`.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"value": {
+			Description:      "The value of the zone setting. Settings toggled with `on` and `off` also accept `true` and `false`, and object valued settings such as `security_header` take a JSON encoded object.",
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressEquivalentZoneSettingValue,
+		},
+		"initial_value": {
+			Description: "The value of the zone setting before it was managed by the resource, which it is restored to on destroy.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"modified_on": {
+			Description: "When the zone setting was last modified.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -45,6 +47,13 @@ func resourceCloudflareZoneSettingsOverrideSchema() map[string]*schema.Schema {
 			},
 		},
 
+		"reset_on_destroy": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to revert the overridden settings to `initial_settings` on destroy. When `false`, destroying the resource only removes it from state and leaves the settings as they are.",
+		},
+
 		"zone_status": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -279,10 +288,12 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 	},
 
 	"privacy_pass": {
-		Type:         schema.TypeString,
-		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
-		Optional:     true,
-		Computed:     true,
+		Type:             schema.TypeString,
+		ValidateFunc:     validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:         true,
+		Computed:         true,
+		Deprecated:       "Privacy Pass has been retired and the setting is no longer sent to or read from the API.",
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool { return true },
 	},
 
 	"response_buffering": {
@@ -510,9 +521,10 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 	},
 
 	"proxy_read_timeout": {
-		Type:     schema.TypeString,
-		Optional: true,
-		Computed: true,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "proxy_read_timeout must be a number of seconds"),
 	},
 
 	"binary_ast": {
@@ -528,4 +540,25 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 		Optional:     true,
 		Computed:     true,
 	},
+
+	"fonts": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"speed_brain": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
+
+	"replace_insecure_js": {
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+		Optional:     true,
+		Computed:     true,
+	},
 }
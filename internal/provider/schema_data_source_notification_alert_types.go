@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareNotificationAlertTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareNotificationAlertTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The lexically ordered list of alert types available to the account.",
+			},
+
+			"alert_types": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of alert types available to the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The alert type to use as the `alert_type` of a notification policy.",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-readable name of the alert type.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the alert type.",
+						},
+						"product": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The product the alert type belongs to.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to lookup the alert types available to [notification policies](https://developers.cloudflare.com/fundamentals/notifications/).",
+	}
+}
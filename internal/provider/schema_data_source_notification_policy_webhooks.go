@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareNotificationPolicyWebhooks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareNotificationPolicyWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return webhook destinations with this exact name.",
+			},
+
+			"webhooks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of webhook destinations, ordered by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The webhook destination identifier to use in a notification policy `webhooks_integration`.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the webhook destination.",
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The URL of the webhook destination.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the webhook destination.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the notification webhook was created.",
+						},
+						"last_success": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the notification webhook was last successful.",
+						},
+						"last_failure": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of when the notification webhook last failed.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to lookup the webhook destinations configured for [notification policies](https://developers.cloudflare.com/fundamentals/notifications/).",
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareRegionalHostnameRegions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareRegionalHostnameRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The lexically ordered list of available region keys.",
+			},
+
+			"regions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of available regions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifying key for the region.",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-readable text label for the region.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to lookup the regions available to [Regional Hostnames](https://developers.cloudflare.com/data-localization/regional-services/).",
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareRulesetsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description:  "The account identifier to target for the datasource lookups.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"account_id", "zone_id"},
+		},
+		"zone_id": {
+			Description:  "The zone identifier to target for the datasource lookups.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"account_id", "zone_id"},
+		},
+		"include_rules": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Include rule data in response. Fetching the rules requires an additional request for each matching ruleset.",
+		},
+		"filter": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "One or more values used to look up Rulesets. If more than one value is given all values must match in order to be included.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The ID of the Ruleset to target.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "A regular expression matching the name of the Ruleset to lookup.",
+					},
+					"kind": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(cloudflare.RulesetKindValues(), false),
+						Description:  fmt.Sprintf("Type of Ruleset to match. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetKindValues())),
+					},
+					"phase": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(cloudflare.RulesetPhaseValues(), false),
+						Description:  fmt.Sprintf("Point in the request/response lifecycle where the ruleset executes. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetPhaseValues())),
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Version of the Ruleset to filter on.",
+					},
+				},
+			},
+		},
+		"rulesets": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of Rulesets matching the filter.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the Ruleset.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the ruleset.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Brief summary of the ruleset and its intended use.",
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Type of Ruleset.",
+					},
+					"phase": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Point in the request/response lifecycle where the ruleset executes.",
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Version of the ruleset.",
+					},
+					"rules": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "List of rules in the ruleset. Only populated when `include_rules` is `true`.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"id": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Unique rule identifier.",
+								},
+								"version": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Version of the rule.",
+								},
+								"ref": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Rule reference.",
+								},
+								"action": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Action to perform in the ruleset rule.",
+								},
+								"expression": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Criteria for an HTTP request to trigger the ruleset rule action.",
+								},
+								"description": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "Brief summary of the ruleset rule and its intended use.",
+								},
+								"enabled": {
+									Type:        schema.TypeBool,
+									Computed:    true,
+									Description: "Whether the rule is active.",
+								},
+								"categories": {
+									Type:        schema.TypeList,
+									Computed:    true,
+									Description: "Tags associated with the rule, usable in tag-based overrides.",
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTeamsProxyEndpointSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Teams Proxy Endpoint name to search for.",
+		},
+		"subdomain": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The FQDN that proxy clients should be pointed at.",
+		},
+		"ips": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "The networks CIDRs that are allowed to initiate proxy connections.",
+		},
+	}
+}
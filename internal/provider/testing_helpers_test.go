@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// testFakeAPI starts a server answering the API requests of a test with
+// handler and returns a client using it. The server is closed once the test
+// has finished.
+func testFakeAPI(t *testing.T, handler http.HandlerFunc, opts ...cloudflare.Option) *cloudflare.API {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.New("deadbeef", "test@example.com", append([]cloudflare.Option{cloudflare.BaseURL(server.URL)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// testAPIResult writes a successful API response with the JSON encoded
+// result.
+func testAPIResult(w http.ResponseWriter, result string) {
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, result)
+}
+
+// testAPIResultWithInfo writes a successful API response with the JSON
+// encoded result and pagination information.
+func testAPIResultWithInfo(w http.ResponseWriter, result, resultInfo string) {
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": %s}`, result, resultInfo)
+}
+
+// testAPIResultJSON writes a successful API response with result encoded as
+// JSON.
+func testAPIResultJSON(w http.ResponseWriter, result interface{}) {
+	body, err := json.Marshal(result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	testAPIResult(w, string(body))
+}
+
+// testAPIError writes a failed API response with a single error.
+func testAPIError(w http.ResponseWriter, status, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"success": false, "errors": [{"code": %d, "message": %q}], "messages": [], "result": null}`, code, message)
+}
@@ -17,6 +17,8 @@ func TestValidateRecordType(t *testing.T) {
 		"MX":    cloudflare.BoolPtr(false),
 		"NS":    cloudflare.BoolPtr(false),
 		"SPF":   cloudflare.BoolPtr(false),
+		"HTTPS": cloudflare.BoolPtr(false),
+		"SVCB":  cloudflare.BoolPtr(false),
 	}
 	for k, v := range validTypes {
 		err := validateRecordType(k, *v)
@@ -35,6 +37,7 @@ func TestValidateRecordType(t *testing.T) {
 		"TXT":   cloudflare.BoolPtr(true),
 		"SRV":   cloudflare.BoolPtr(true),
 		"SPF":   cloudflare.BoolPtr(true),
+		"SVCB":  cloudflare.BoolPtr(true),
 	}
 	for k, v := range invalidTypes {
 		if err := validateRecordType(k, *v); err == nil {